@@ -14,6 +14,7 @@ profiles:
 extenders:
   - urlPrefix: http://127.0.0.1:10262/scheduler
     filterVerb: filter
+    prioritizeVerb: prioritize
     preemptVerb: preempt
     weight: 1
     enableHTTPS: false
@@ -36,6 +37,7 @@ profiles:
 extenders:
   - urlPrefix: http://127.0.0.1:10262/scheduler
     filterVerb: filter
+    prioritizeVerb: prioritize
     preemptVerb: preempt
     weight: 1
     enableHTTPS: false
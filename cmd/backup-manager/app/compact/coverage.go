@@ -0,0 +1,167 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compact
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+)
+
+// subcompaction is a subcompaction spawned by tikv-ctl, which compacts the log files of a region in a ts range.
+type subcompaction struct {
+	// Cid identifies the subcompaction in the log of tikv-ctl. It is kept raw because its format
+	// is not stable across the versions of tikv-ctl.
+	Cid        json.RawMessage `json:"cid"`
+	InputMinTS uint64          `json:"input_min_ts"`
+	InputMaxTS uint64          `json:"input_max_ts"`
+	Size       uint64          `json:"size"`
+}
+
+// subcompactionDone is the result of a subcompaction reported by tikv-ctl.
+type subcompactionDone struct {
+	Cid          json.RawMessage `json:"cid"`
+	WrittenBytes uint64          `json:"written_bytes"`
+}
+
+type tsRange struct {
+	start uint64
+	end   uint64
+}
+
+// coverageTracker collects the subcompactions from the log of tikv-ctl and reports the ts ranges compacted.
+type coverageTracker struct {
+	spawned     []subcompaction
+	finished    map[string]bool
+	outputBytes uint64
+	// finishedWithoutCid is set if tikv-ctl doesn't report which subcompaction is finished,
+	// the subcompactions can be regarded as finished only if the compaction is complete.
+	finishedWithoutCid bool
+}
+
+func newCoverageTracker() *coverageTracker {
+	return &coverageTracker{finished: map[string]bool{}}
+}
+
+func (t *coverageTracker) onSpawn(c subcompaction) {
+	t.spawned = append(t.spawned, c)
+}
+
+func (t *coverageTracker) onFinish(d subcompactionDone) {
+	t.outputBytes += d.WrittenBytes
+	if len(d.Cid) == 0 {
+		t.finishedWithoutCid = true
+		return
+	}
+	t.finished[string(d.Cid)] = true
+}
+
+// report returns the coverage of the compaction from fromTS to the max ts of the log files spawned.
+// All the spawned subcompactions are finished if the compaction is complete. The ts ranges are
+// reported as compacted only if the compaction is complete, because a subcompaction only compacts
+// the log files of a region, and the range is not compacted for the other regions of which the
+// subcompactions are not finished.
+func (t *coverageTracker) report(fromTS uint64, complete bool) *v1alpha1.CompactCoverage {
+	var (
+		ranges     []tsRange
+		inputBytes uint64
+		untilTS    uint64
+	)
+	for _, c := range t.spawned {
+		if c.InputMaxTS > untilTS {
+			untilTS = c.InputMaxTS
+		}
+		if !complete && (t.finishedWithoutCid || !t.finished[string(c.Cid)]) {
+			continue
+		}
+		ranges = append(ranges, tsRange{start: c.InputMinTS, end: c.InputMaxTS})
+		inputBytes += c.Size
+	}
+	var compacted []tsRange
+	if complete {
+		compacted = mergeTSRanges(ranges)
+	}
+
+	coverage := &v1alpha1.CompactCoverage{
+		InputBytes:   int64(inputBytes),
+		OutputBytes:  int64(t.outputBytes),
+		BytesReduced: int64(inputBytes) - int64(t.outputBytes),
+	}
+	if t.outputBytes == 0 {
+		// tikv-ctl doesn't report the size written
+		coverage.BytesReduced = 0
+	}
+	for _, r := range compacted {
+		coverage.CompactedRanges = append(coverage.CompactedRanges, toCompactTSRange(r))
+	}
+	for _, r := range gapsOf(compacted, fromTS, untilTS) {
+		coverage.Gaps = append(coverage.Gaps, toCompactTSRange(r))
+	}
+	return coverage
+}
+
+// mergeTSRanges merges the overlapped or adjacent ranges and sorts them in ascending order.
+func mergeTSRanges(ranges []tsRange) []tsRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+	sorted := append([]tsRange(nil), ranges...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].start < sorted[j].start
+	})
+
+	merged := []tsRange{sorted[0]}
+	for _, r := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if r.start <= last.end {
+			if r.end > last.end {
+				last.end = r.end
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// gapsOf returns the ranges between fromTS and untilTS which are not in the merged ranges.
+func gapsOf(merged []tsRange, fromTS, untilTS uint64) []tsRange {
+	var gaps []tsRange
+	cursor := fromTS
+	for _, r := range merged {
+		if r.end <= cursor {
+			continue
+		}
+		if r.start >= untilTS {
+			break
+		}
+		if r.start > cursor {
+			gaps = append(gaps, tsRange{start: cursor, end: r.start})
+		}
+		cursor = r.end
+	}
+	if cursor < untilTS {
+		gaps = append(gaps, tsRange{start: cursor, end: untilTS})
+	}
+	return gaps
+}
+
+func toCompactTSRange(r tsRange) v1alpha1.CompactTSRange {
+	return v1alpha1.CompactTSRange{
+		StartTs: strconv.FormatUint(r.start, 10),
+		EndTs:   strconv.FormatUint(r.end, 10),
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compact
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+)
+
+func TestCoverageReport(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	spawned := []string{
+		`{"Message":"Spawning compaction.","cid":1,"input_min_ts":100,"input_max_ts":200,"size":1000}`,
+		`{"Message":"Spawning compaction.","cid":2,"input_min_ts":150,"input_max_ts":300,"size":2000}`,
+		`{"Message":"Spawning compaction.","cid":3,"input_min_ts":400,"input_max_ts":500,"size":3000}`,
+		`{"Message":"Spawning compaction.","cid":4,"input_min_ts":600,"input_max_ts":700,"size":4000}`,
+	}
+	finished := []string{
+		`{"Message":"Finishing compaction.","cid":1,"written_bytes":100}`,
+		`{"Message":"Finishing compaction.","cid":2,"written_bytes":200}`,
+		`{"Message":"Finishing compaction.","cid":4,"written_bytes":400}`,
+	}
+	newTracker := func(finished []string) *coverageTracker {
+		tracker := newCoverageTracker()
+		for _, line := range spawned {
+			var c subcompaction
+			g.Expect(json.Unmarshal([]byte(line), &c)).To(Succeed())
+			tracker.onSpawn(c)
+		}
+		for _, line := range finished {
+			var d subcompactionDone
+			g.Expect(json.Unmarshal([]byte(line), &d)).To(Succeed())
+			tracker.onFinish(d)
+		}
+		return tracker
+	}
+
+	// the subcompaction 3 is not finished when the compaction is aborted, the ranges of the finished
+	// ones are not compacted for the regions of the others
+	g.Expect(newTracker(finished).report(50, false)).To(Equal(&v1alpha1.CompactCoverage{
+		Gaps: []v1alpha1.CompactTSRange{
+			{StartTs: "50", EndTs: "700"},
+		},
+		InputBytes:   7000,
+		OutputBytes:  700,
+		BytesReduced: 6300,
+	}))
+
+	// all the subcompactions are finished when the compaction is complete
+	finished = append(finished, `{"Message":"Finishing compaction.","cid":3,"written_bytes":300}`)
+	g.Expect(newTracker(finished).report(100, true)).To(Equal(&v1alpha1.CompactCoverage{
+		CompactedRanges: []v1alpha1.CompactTSRange{
+			{StartTs: "100", EndTs: "300"},
+			{StartTs: "400", EndTs: "500"},
+			{StartTs: "600", EndTs: "700"},
+		},
+		Gaps: []v1alpha1.CompactTSRange{
+			{StartTs: "300", EndTs: "400"},
+			{StartTs: "500", EndTs: "600"},
+		},
+		InputBytes:   10000,
+		OutputBytes:  1000,
+		BytesReduced: 9000,
+	}))
+
+	// nothing is regarded as compacted if tikv-ctl doesn't report the finished subcompactions
+	g.Expect(newTracker([]string{`{"Message":"Finishing compaction."}`}).report(100, false)).To(Equal(&v1alpha1.CompactCoverage{
+		Gaps: []v1alpha1.CompactTSRange{{StartTs: "100", EndTs: "700"}},
+	}))
+}
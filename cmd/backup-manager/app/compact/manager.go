@@ -149,8 +149,13 @@ func (cm *Manager) runCompaction(ctx context.Context, base64Storage string) (err
 		return errors.Annotate(err, "failed to start compact")
 	}
 
+	coverage := newCoverageTracker()
+	defer func() {
+		cm.statusUpdater.OnCoverage(ctx, cm.compact, coverage.report(cm.options.FromTS, err == nil))
+	}()
+
 	cm.statusUpdater.OnStart(ctx, cm.compact)
-	err = cm.processCompactionLogs(ctx, io.TeeReader(tikvLog, os.Stdout))
+	err = cm.processCompactionLogs(ctx, io.TeeReader(tikvLog, os.Stdout), coverage)
 	if err != nil {
 		cmd.Process.Kill()
 		return err
@@ -184,7 +189,7 @@ func (cm *Manager) compactCmd(ctx context.Context, base64Storage string) *exec.C
 	return exec.CommandContext(ctx, ctl, args...)
 }
 
-func (cm *Manager) processCompactionLogs(ctx context.Context, logStream io.Reader) error {
+func (cm *Manager) processCompactionLogs(ctx context.Context, logStream io.Reader, coverage *coverageTracker) error {
 	dec := json.NewDecoder(logStream)
 	currentEndTS, _ := strconv.ParseUint(cm.compact.Status.EndTs, 10, 64)
 	for dec.More() {
@@ -203,7 +208,7 @@ func (cm *Manager) processCompactionLogs(ctx context.Context, logStream io.Reade
 		}
 		line.Raw = raw
 
-		if err := cm.processLogLine(ctx, line, &currentEndTS); err != nil {
+		if err := cm.processLogLine(ctx, line, &currentEndTS, coverage); err != nil {
 			return err
 		}
 	}
@@ -211,7 +216,7 @@ func (cm *Manager) processCompactionLogs(ctx context.Context, logStream io.Reade
 	return nil
 }
 
-func (cm *Manager) processLogLine(ctx context.Context, l logLine, currentEndTS *uint64) error {
+func (cm *Manager) processLogLine(ctx context.Context, l logLine, currentEndTS *uint64, coverage *coverageTracker) error {
 	fmtError := func(err error, format string) error {
 		return errors.Annotatef(err, format, string(l.Raw))
 	}
@@ -222,18 +227,22 @@ func (cm *Manager) processLogLine(ctx context.Context, l logLine, currentEndTS *
 		if err := json.Unmarshal(l.Raw, &prog); err != nil {
 			return fmtError(err, "failed to decode progress message: %s")
 		}
+		var done subcompactionDone
+		if err := json.Unmarshal(l.Raw, &done); err != nil {
+			return fmtError(err, "failed to decode finished compaction message: %s")
+		}
+		coverage.onFinish(done)
 		cm.statusUpdater.OnProgress(ctx, cm.compact, &prog, "")
 
 	case messageCompactionSpawn:
-		var ts struct {
-			Input_max_ts uint64 `json:"input_max_ts"`
-		}
-		if err := json.Unmarshal(l.Raw, &ts); err != nil {
-			return fmtError(err, "failed to decode input_max_ts message: %s")
+		var sub subcompaction
+		if err := json.Unmarshal(l.Raw, &sub); err != nil {
+			return fmtError(err, "failed to decode spawned compaction message: %s")
 		}
+		coverage.onSpawn(sub)
 
-		if ts.Input_max_ts > *currentEndTS {
-			*currentEndTS = ts.Input_max_ts
+		if sub.InputMaxTS > *currentEndTS {
+			*currentEndTS = sub.InputMaxTS
 		}
 
 	case messageCompactAborted:
@@ -28,6 +28,9 @@ const (
 	// BackupRootPath is the root path to backup data
 	BackupRootPath = "/backup"
 
+	// LightningSortedKVPath is the path to the sorted KV files of TiDB Lightning in the physical import mode
+	LightningSortedKVPath = "/sorted-kv"
+
 	// MetaDataFile is the file which store the dumpling's meta info
 	MetaDataFile = "metadata"
 
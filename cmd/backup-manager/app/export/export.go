@@ -16,6 +16,7 @@ package export
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path"
@@ -28,11 +29,15 @@ import (
 	"github.com/pingcap/tidb-operator/cmd/backup-manager/app/constants"
 	backupUtil "github.com/pingcap/tidb-operator/cmd/backup-manager/app/util"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	pkgutil "github.com/pingcap/tidb-operator/pkg/backup/util"
 	"github.com/pingcap/tidb-operator/pkg/util"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
 )
 
+// listObjectsPageSize is the page size to list the objects of the streaming backup data
+const listObjectsPageSize = 1000
+
 // Options contains the input arguments to the backup command
 type Options struct {
 	backupUtil.GenericOptions
@@ -45,9 +50,14 @@ func (bo *Options) getBackupFullPath() string {
 	return filepath.Join(constants.BackupRootPath, bo.getBackupRelativePath())
 }
 
+// getBackupName returns the name of the directory of the backup data, which is also the top directory in the archive
+func getBackupName() string {
+	return fmt.Sprintf("backup-%s", time.Now().UTC().Format(time.RFC3339))
+}
+
 func (bo *Options) getBackupRelativePath() string {
 	var backupRelativePath string
-	backupName := fmt.Sprintf("backup-%s", time.Now().UTC().Format(time.RFC3339))
+	backupName := getBackupName()
 	if len(bo.Prefix) == 0 {
 		backupRelativePath = fmt.Sprintf("%s/%s", bo.Bucket, backupName)
 	} else {
@@ -60,18 +70,67 @@ func (bo *Options) getDestBucketURI(remotePath string) string {
 	return fmt.Sprintf("%s://%s", bo.StorageType, remotePath)
 }
 
+// getStreamingBucketURI returns the uri of the directory which dumpling streams the backup data to
+func (bo *Options) getStreamingBucketURI(backend *pkgutil.StorageBackend) string {
+	return bo.getDestBucketURI(path.Join(backend.GetBucket(), backend.GetPrefix()))
+}
+
+// getStreamingStorageProvider returns the storage provider of the directory which dumpling streams the backup data to,
+// the directory is at the same place as the archive of the non-streaming backup, so the import can find it in the same way.
+func getStreamingStorageProvider(provider v1alpha1.StorageProvider, backupName string) (v1alpha1.StorageProvider, error) {
+	provider = *provider.DeepCopy()
+	switch pkgutil.GetStorageType(provider) {
+	case v1alpha1.BackupStorageTypeS3:
+		provider.S3.Prefix = path.Join(provider.S3.Prefix, backupName)
+	case v1alpha1.BackupStorageTypeGcs:
+		provider.Gcs.Prefix = path.Join(provider.Gcs.Prefix, backupName)
+	case v1alpha1.BackupStorageTypeAzblob:
+		provider.Azblob.Prefix = path.Join(provider.Azblob.Prefix, backupName)
+	default:
+		return provider, fmt.Errorf("storage %s is not supported by streaming", pkgutil.GetStorageType(provider))
+	}
+	return provider, nil
+}
+
+// genStreamingOutputArgs constructs the output args of dumpling from the storage args, which are shared by BR and dumpling
+func genStreamingOutputArgs(provider v1alpha1.StorageProvider) ([]string, error) {
+	args, err := pkgutil.GenStorageArgsForFlag(provider, "")
+	if err != nil {
+		return nil, err
+	}
+	for i, arg := range args {
+		if strings.HasPrefix(arg, "--storage=") {
+			args[i] = "--output=" + strings.TrimPrefix(arg, "--storage=")
+		}
+	}
+	return args, nil
+}
+
 func (bo *Options) dumpTidbClusterData(ctx context.Context, bfPath string, backup *v1alpha1.Backup) error {
 	err := backupUtil.EnsureDirectoryExist(bfPath)
 	if err != nil {
 		return err
 	}
-	args := []string{
-		fmt.Sprintf("--output=%s", bfPath),
+	return bo.runDumpling(ctx, []string{fmt.Sprintf("--output=%s", bfPath)}, backup)
+}
+
+// streamTidbClusterData dumps the backup data to the external storage directly
+func (bo *Options) streamTidbClusterData(ctx context.Context, provider v1alpha1.StorageProvider, backup *v1alpha1.Backup) error {
+	outputArgs, err := genStreamingOutputArgs(provider)
+	if err != nil {
+		return err
+	}
+	return bo.runDumpling(ctx, outputArgs, backup)
+}
+
+func (bo *Options) runDumpling(ctx context.Context, outputArgs []string, backup *v1alpha1.Backup) error {
+	args := append([]string{}, outputArgs...)
+	args = append(args,
 		fmt.Sprintf("--host=%s", bo.Host),
 		fmt.Sprintf("--port=%d", bo.Port),
 		fmt.Sprintf("--user=%s", bo.User),
 		fmt.Sprintf("--password=%s", bo.Password),
-	}
+	)
 	args = append(args, backupUtil.ConstructDumplingOptionsForBackup(backup)...)
 	if bo.TLSClient {
 		if !bo.SkipClientCA {
@@ -147,6 +206,34 @@ func getBackupSize(ctx context.Context, backupPath string, opts []string) (int64
 	return size, nil
 }
 
+// getStreamingBackupInfo reads the commitTs from the metadata of the backup data and sums up the size of the objects
+func getStreamingBackupInfo(ctx context.Context, backend *pkgutil.StorageBackend) (string, int64, string, error) {
+	contents, err := backend.ReadAll(ctx, constants.MetaDataFile)
+	if err != nil {
+		return "", 0, "GetCommitTsFailed", fmt.Errorf("read metadata file %s failed, err: %v", constants.MetaDataFile, err)
+	}
+	commitTs, err := backupUtil.ParseCommitTsFromMetadata(constants.MetaDataFile, contents)
+	if err != nil {
+		return "", 0, "GetCommitTsFailed", err
+	}
+
+	var size int64
+	iter := backend.ListPage(nil)
+	for {
+		objs, err := iter.Next(ctx, listObjectsPageSize)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", 0, "GetBackupSizeFailed", fmt.Errorf("list objects of backup data failed, err: %v", err)
+		}
+		for _, obj := range objs {
+			size += obj.Size
+		}
+	}
+	return commitTs, size, "", nil
+}
+
 // archiveBackupData archive backup data by destFile's extension name.
 // NOTE: no context/timeout supported for archiving, this may cause to be KILLed when blocking.
 func archiveBackupData(backupDir, destFile string) error {
@@ -0,0 +1,76 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+)
+
+func TestGenStreamingOutputArgs(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tests := []struct {
+		name     string
+		provider v1alpha1.StorageProvider
+		expected []string
+	}{
+		{
+			name: "s3",
+			provider: v1alpha1.StorageProvider{
+				S3: &v1alpha1.S3StorageProvider{Bucket: "bucket", Prefix: "prefix", Region: "us-west-2", Provider: "aws"},
+			},
+			expected: []string{
+				"--output=s3://bucket/prefix/backup-name",
+				"--s3.region=us-west-2",
+				"--s3.provider=aws",
+			},
+		},
+		{
+			name: "gcs",
+			provider: v1alpha1.StorageProvider{
+				Gcs: &v1alpha1.GcsStorageProvider{Bucket: "bucket", StorageClass: "COLDLINE"},
+			},
+			expected: []string{
+				"--output=gcs://bucket/backup-name/",
+				"--gcs.storage-class=COLDLINE",
+			},
+		},
+		{
+			name: "azblob",
+			provider: v1alpha1.StorageProvider{
+				Azblob: &v1alpha1.AzblobStorageProvider{Container: "container", Prefix: "prefix", AccessTier: "Cool"},
+			},
+			expected: []string{
+				"--output=azure://container/prefix/backup-name/",
+				"--azblob.access-tier=Cool",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := getStreamingStorageProvider(tt.provider, "backup-name")
+			g.Expect(err).Should(Succeed())
+			args, err := genStreamingOutputArgs(provider)
+			g.Expect(err).Should(Succeed())
+			g.Expect(args).Should(Equal(tt.expected))
+		})
+	}
+
+	// the local storage is not supported by streaming
+	_, err := getStreamingStorageProvider(v1alpha1.StorageProvider{Local: &v1alpha1.LocalStorageProvider{}}, "backup-name")
+	g.Expect(err).ShouldNot(Succeed())
+}
@@ -251,11 +251,40 @@ func (bm *BackupManager) performBackup(ctx context.Context, backup *v1alpha1.Bac
 		klog.Infof("set cluster %s %s to %s success", bm, constants.TikvGCVariable, constants.TikvGCLifeTime)
 	}
 
-	backupFullPath := bm.getBackupFullPath()
-	// TODO: Concurrent get file size and upload backup data to speed up processing time
-	archiveBackupPath := backupFullPath + constants.DefaultArchiveExtention
-	remotePath := strings.TrimPrefix(archiveBackupPath, constants.BackupRootPath+"/")
-	bucketURI := bm.getDestBucketURI(remotePath)
+	var (
+		backupFullPath    string
+		archiveBackupPath string
+		bucketURI         string
+		streamingProvider v1alpha1.StorageProvider
+		streamingBackend  *backuputil.StorageBackend
+	)
+	streaming := backup.IsDumplingStreaming()
+	if streaming {
+		streamingProvider, err = getStreamingStorageProvider(backup.Spec.StorageProvider, getBackupName())
+		if err == nil {
+			streamingBackend, err = backuputil.NewStorageBackend(streamingProvider, &backuputil.StorageCredential{})
+		}
+		if err != nil {
+			errs = append(errs, err)
+			klog.Errorf("cluster %s prepare streaming storage failed, err: %s", bm, err)
+			uerr := bm.StatusUpdater.Update(backup, &v1alpha1.BackupCondition{
+				Type:    v1alpha1.BackupFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "PrepareStreamingStorageFailed",
+				Message: err.Error(),
+			}, nil)
+			errs = append(errs, uerr)
+			return errorutils.NewAggregate(errs)
+		}
+		defer streamingBackend.Close()
+		bucketURI = bm.getStreamingBucketURI(streamingBackend)
+	} else {
+		backupFullPath = bm.getBackupFullPath()
+		// TODO: Concurrent get file size and upload backup data to speed up processing time
+		archiveBackupPath = backupFullPath + constants.DefaultArchiveExtention
+		remotePath := strings.TrimPrefix(archiveBackupPath, constants.BackupRootPath+"/")
+		bucketURI = bm.getDestBucketURI(remotePath)
+	}
 	updatePathStatus := &controller.BackupUpdateStatus{
 		BackupPath: &bucketURI,
 	}
@@ -267,7 +296,12 @@ func (bm *BackupManager) performBackup(ctx context.Context, backup *v1alpha1.Bac
 		return err
 	}
 
-	backupErr := bm.dumpTidbClusterData(ctx, backupFullPath, backup)
+	var backupErr error
+	if streaming {
+		backupErr = bm.streamTidbClusterData(ctx, streamingProvider, backup)
+	} else {
+		backupErr = bm.dumpTidbClusterData(ctx, backupFullPath, backup)
+	}
 	if oldTikvGCTimeDuration < tikvGCTimeDuration {
 		// use another context to revert `tikv_gc_life_time` back.
 		// `DefaultTerminationGracePeriodSeconds` for a pod is 30, so we use a smaller timeout value here.
@@ -304,6 +338,24 @@ func (bm *BackupManager) performBackup(ctx context.Context, backup *v1alpha1.Bac
 		errs = append(errs, uerr)
 		return errorutils.NewAggregate(errs)
 	}
+	if streaming {
+		klog.Infof("dump cluster %s data to %s success", bm, bucketURI)
+		commitTs, size, reason, err := getStreamingBackupInfo(ctx, streamingBackend)
+		if err != nil {
+			errs = append(errs, err)
+			klog.Errorf("get cluster %s streaming backup %s info failed, err: %s", bm, bucketURI, err)
+			uerr := bm.StatusUpdater.Update(backup, &v1alpha1.BackupCondition{
+				Type:    v1alpha1.BackupFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  reason,
+				Message: err.Error(),
+			}, nil)
+			errs = append(errs, uerr)
+			return errorutils.NewAggregate(errs)
+		}
+		klog.Infof("get cluster %s streaming backup %s commitTs %s size %d success", bm, bucketURI, commitTs, size)
+		return bm.completeBackup(backup, started, commitTs, size)
+	}
 	klog.Infof("dump cluster %s data to %s success", bm, backupFullPath)
 
 	commitTs, err := util.GetCommitTsFromMetadata(backupFullPath)
@@ -372,6 +424,10 @@ func (bm *BackupManager) performBackup(ctx context.Context, backup *v1alpha1.Bac
 	// backup to remote succeed, archive can be deleted now
 	os.RemoveAll(archiveBackupPath)
 
+	return bm.completeBackup(backup, started, commitTs, size)
+}
+
+func (bm *BackupManager) completeBackup(backup *v1alpha1.Backup, started time.Time, commitTs string, size int64) error {
 	finish := time.Now()
 
 	backupSizeReadable := humanize.Bytes(uint64(size))
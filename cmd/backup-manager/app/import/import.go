@@ -14,6 +14,7 @@
 package _import
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"io"
@@ -21,14 +22,18 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mholt/archives"
 	"github.com/pingcap/tidb-operator/cmd/backup-manager/app/constants"
 	backupUtil "github.com/pingcap/tidb-operator/cmd/backup-manager/app/util"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/util"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 )
 
@@ -44,6 +49,12 @@ func (ro *Options) getRestoreDataPath() string {
 	return filepath.Join(constants.BackupRootPath, backupSuffix)
 }
 
+// isArchivedBackup returns whether the backup data is an archive, otherwise it is a directory streamed by dumpling
+func (ro *Options) isArchivedBackup() bool {
+	return strings.HasSuffix(ro.BackupPath, constants.DefaultArchiveExtention)
+}
+
+// downloadBackupData downloads the archive or the directory of the backup data to localPath
 func (ro *Options) downloadBackupData(ctx context.Context, localPath string, opts []string) error {
 	if err := backupUtil.EnsureDirectoryExist(filepath.Dir(localPath)); err != nil {
 		return err
@@ -84,7 +95,7 @@ func (ro *Options) downloadBackupData(ctx context.Context, localPath string, opt
 	return nil
 }
 
-func (ro *Options) loadTidbClusterData(ctx context.Context, restorePath string, restore *v1alpha1.Restore) error {
+func (ro *Options) loadTidbClusterData(ctx context.Context, restorePath string, restore *v1alpha1.Restore, statusUpdater controller.RestoreConditionUpdaterInterface) (err error) {
 	tableFilter := restore.Spec.TableFilter
 
 	if exist := backupUtil.IsDirExist(restorePath); !exist {
@@ -93,7 +104,6 @@ func (ro *Options) loadTidbClusterData(ctx context.Context, restorePath string,
 	// args for restore
 	args := []string{
 		"--status-addr=0.0.0.0:8289",
-		"--backend=tidb",
 		"--server-mode=false",
 		"--log-file=-", // "-" to stdout
 		fmt.Sprintf("--tidb-user=%s", ro.User),
@@ -115,6 +125,21 @@ func (ro *Options) loadTidbClusterData(ctx context.Context, restorePath string,
 		args = append(args, fmt.Sprintf("--key=%s", path.Join(util.TiDBClientTLSPath, corev1.TLSPrivateKeyKey)))
 	}
 
+	if restore.Spec.Lightning == nil {
+		args = append(args, "--backend=tidb")
+	} else {
+		// the backend and the other configs are set in the config file
+		opts := backupUtil.GetOptions(restore.Spec.StorageProvider)
+		configArgs, err := ro.prepareLightningConfig(ctx, restore, opts)
+		if err != nil {
+			return err
+		}
+		args = append(args, configArgs...)
+		defer func() {
+			ro.finishLightningCheckpoint(ctx, restore, opts, err)
+		}()
+	}
+
 	binPath := "/tidb-lightning"
 	if restore.Spec.ToolImage != "" {
 		binPath = path.Join(util.LightningBinPath, "tidb-lightning")
@@ -122,13 +147,62 @@ func (ro *Options) loadTidbClusterData(ctx context.Context, restorePath string,
 
 	klog.Infof("The lightning process is ready, command \"%s %s\"", binPath, strings.Join(args, " "))
 
-	output, err := exec.CommandContext(ctx, binPath, args...).CombinedOutput()
+	cmd := exec.CommandContext(ctx, binPath, args...)
+	stdOut, err := cmd.StdoutPipe()
 	if err != nil {
-		return fmt.Errorf("cluster %s, execute loader command %v failed, output: %s, err: %v", ro, args, string(output), err)
+		return fmt.Errorf("cluster %s, create stdout pipe failed, err: %v", ro, err)
+	}
+	cmd.Stderr = cmd.Stdout
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("cluster %s, execute loader command %v failed, err: %v", ro, args, err)
+	}
+
+	var errMsg string
+	reader := bufio.NewReader(stdOut)
+	for {
+		line, err := reader.ReadString('\n')
+		if strings.Contains(line, "[ERROR]") {
+			errMsg += line
+		} else {
+			ro.updateProgressAccordingToLightningLog(line, restore, statusUpdater)
+		}
+		klog.Info(strings.Replace(line, "\n", "", -1))
+		if err != nil {
+			if err != io.EOF {
+				klog.Errorf("read stdout error: %s", err.Error())
+			}
+			break
+		}
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("cluster %s, execute loader command %v failed, errMsg: %s, err: %v", ro, args, errMsg, err)
 	}
 	return nil
 }
 
+// updateProgressAccordingToLightningLog update restore progress according to the lightning log.
+func (ro *Options) updateProgressAccordingToLightningLog(line string, restore *v1alpha1.Restore, statusUpdater controller.RestoreConditionUpdaterInterface) {
+	progress := backupUtil.ParseLightningProgress(line)
+	if progress == "" {
+		return
+	}
+	fvalue, err := strconv.ParseFloat(progress, 64)
+	if err != nil {
+		klog.Errorf("parse restore %s progress string value %s to float error %v", ro, progress, err)
+		return
+	}
+	step := lightningProgressStep
+	klog.Infof("update restore %s step %s progress %s float value %f", ro, step, progress, fvalue)
+	if err := statusUpdater.Update(restore, nil, &controller.RestoreUpdateStatus{
+		ProgressStep:       &step,
+		Progress:           &fvalue,
+		ProgressUpdateTime: &metav1.Time{Time: time.Now()},
+	}); err != nil {
+		klog.Errorf("update restore %s progress error %v", ro, err)
+	}
+}
+
 // unarchiveBackupData unarchive backup data to dest dir
 // NOTE: no context/timeout supported for extraction, this may cause to be KILLed when blocking.
 func unarchiveBackupData(backupFile, destDir string) (string, error) {
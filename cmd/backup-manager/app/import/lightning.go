@@ -0,0 +1,178 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _import
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/pingcap/tidb-operator/cmd/backup-manager/app/constants"
+	backupUtil "github.com/pingcap/tidb-operator/cmd/backup-manager/app/util"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/util/toml"
+	bkconstants "github.com/pingcap/tidb-operator/pkg/backup/constants"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// lightningProgressStep is the step name of the import progress in the restore status
+	lightningProgressStep = "Import"
+	// lightningLogProgressInterval is the interval TiDB Lightning logs the progress
+	lightningLogProgressInterval = "1m"
+	// lightningConfigFile is the config file of TiDB Lightning generated from the spec of the restore
+	lightningConfigFile = "/tmp/tidb-lightning.toml"
+	// lightningRemoteCheckpointFile is the local copy of the checkpoint stored in the storage of the restore
+	lightningRemoteCheckpointFile = "/tmp/tidb-lightning-checkpoint.pb"
+	// defaultLightningDiskQuotaPercent is the default disk quota in percentage of the sorted KV PVC
+	defaultLightningDiskQuotaPercent = 80
+)
+
+// lightningCheckpointPath returns the local path of the checkpoint of TiDB Lightning,
+// the checkpoint in the PVC is kept across the retries of the restore job.
+func lightningCheckpointPath(restore *v1alpha1.Restore) string {
+	if restore.Spec.Lightning.Checkpoint == v1alpha1.LightningCheckpointStorageRemote {
+		return lightningRemoteCheckpointFile
+	}
+	return filepath.Join(constants.BackupRootPath, "lightning-checkpoint", fmt.Sprintf("%s.pb", restore.Name))
+}
+
+// remoteLightningCheckpointPath returns the path of the checkpoint in the storage of the restore, which is next to the export
+func (ro *Options) remoteLightningCheckpointPath(restore *v1alpha1.Restore) string {
+	return fmt.Sprintf("%s.%s.lightning-checkpoint.pb", backupUtil.NormalizeBucketURI(ro.BackupPath), restore.Name)
+}
+
+// genLightningConfig generates the config of TiDB Lightning from the spec of the restore
+func genLightningConfig(restore *v1alpha1.Restore) ([]byte, error) {
+	config := restore.Spec.Lightning
+	backend := config.Backend
+	if backend == "" {
+		backend = v1alpha1.LightningBackendTiDB
+	}
+
+	importer := map[string]interface{}{
+		"backend": string(backend),
+	}
+	if backend == v1alpha1.LightningBackendLocal {
+		importer["sorted-kv-dir"] = filepath.Join(constants.LightningSortedKVPath, "data")
+		if config.DiskQuota != nil {
+			importer["disk-quota"] = config.DiskQuota.Value()
+		} else {
+			storageSize := restore.GetLightningSortedKVStorageSize()
+			if storageSize == "" {
+				storageSize = bkconstants.DefaultStorageSize
+			}
+			size, err := resource.ParseQuantity(storageSize)
+			if err != nil {
+				return nil, fmt.Errorf("parse storage size %s failed, err: %v", storageSize, err)
+			}
+			importer["disk-quota"] = size.Value() / 100 * defaultLightningDiskQuotaPercent
+		}
+	}
+
+	cfg := map[string]interface{}{
+		"tikv-importer": importer,
+		"cron": map[string]interface{}{
+			"log-progress": lightningLogProgressInterval,
+		},
+	}
+	if config.Checkpoint != "" {
+		cfg["checkpoint"] = map[string]interface{}{
+			"enable": true,
+			"driver": "file",
+			"dsn":    lightningCheckpointPath(restore),
+		}
+	}
+	if config.Conflict != nil {
+		conflict := map[string]interface{}{
+			"strategy": string(config.Conflict.Strategy),
+		}
+		if config.Conflict.Threshold != nil {
+			conflict["threshold"] = *config.Conflict.Threshold
+		}
+		if config.Conflict.MaxRecordRows != nil {
+			conflict["max-record-rows"] = *config.Conflict.MaxRecordRows
+		}
+		cfg["conflict"] = conflict
+	}
+	return toml.Marshal(cfg)
+}
+
+// prepareLightningConfig writes the config of TiDB Lightning and prepares the checkpoint, it returns the args of TiDB Lightning.
+func (ro *Options) prepareLightningConfig(ctx context.Context, restore *v1alpha1.Restore, opts []string) ([]string, error) {
+	data, err := genLightningConfig(restore)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(lightningConfigFile, data, 0644); err != nil {
+		return nil, fmt.Errorf("cluster %s, write lightning config failed, err: %v", ro, err)
+	}
+	klog.Infof("The lightning config of cluster %s is ready:\n%s", ro, string(data))
+
+	switch restore.Spec.Lightning.Checkpoint {
+	case v1alpha1.LightningCheckpointStoragePVC:
+		if err := backupUtil.EnsureDirectoryExist(filepath.Dir(lightningCheckpointPath(restore))); err != nil {
+			return nil, err
+		}
+	case v1alpha1.LightningCheckpointStorageRemote:
+		// the checkpoint doesn't exist if the import is never interrupted
+		remote := ro.remoteLightningCheckpointPath(restore)
+		if err := ro.runRclone(ctx, opts, "copyto", remote, lightningRemoteCheckpointFile); err != nil {
+			klog.Warningf("cluster %s, download lightning checkpoint %s failed, import from the beginning, err: %v", ro, remote, err)
+		} else {
+			klog.Infof("cluster %s, download lightning checkpoint %s success", ro, remote)
+		}
+	}
+
+	args := []string{fmt.Sprintf("--config=%s", lightningConfigFile)}
+	return append(args, restore.Spec.Lightning.Options...), nil
+}
+
+// finishLightningCheckpoint uploads the checkpoint to the storage of the restore for the next retry if the import fails,
+// otherwise removes it.
+func (ro *Options) finishLightningCheckpoint(ctx context.Context, restore *v1alpha1.Restore, opts []string, importErr error) {
+	if restore.Spec.Lightning == nil || restore.Spec.Lightning.Checkpoint != v1alpha1.LightningCheckpointStorageRemote {
+		return
+	}
+	// the context may be canceled by the termination signal, the checkpoint must be uploaded anyway
+	ctx = context.WithoutCancel(ctx)
+
+	remote := ro.remoteLightningCheckpointPath(restore)
+	if importErr == nil {
+		if err := ro.runRclone(ctx, opts, "deletefile", remote, ""); err != nil {
+			klog.Warningf("cluster %s, remove lightning checkpoint %s failed, err: %v", ro, remote, err)
+		}
+		return
+	}
+	if !backupUtil.IsFileExist(lightningRemoteCheckpointFile) {
+		return
+	}
+	if err := ro.runRclone(ctx, opts, "copyto", lightningRemoteCheckpointFile, remote); err != nil {
+		klog.Errorf("cluster %s, upload lightning checkpoint %s failed, err: %v", ro, remote, err)
+		return
+	}
+	klog.Infof("cluster %s, upload lightning checkpoint %s success", ro, remote)
+}
+
+func (ro *Options) runRclone(ctx context.Context, opts []string, command, source, dest string) error {
+	args := backupUtil.ConstructRcloneArgs(constants.RcloneConfigArg, opts, command, source, dest, false)
+	output, err := exec.CommandContext(ctx, "rclone", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("execute rclone %s failed, output: %s, err: %v", command, string(output), err)
+	}
+	return nil
+}
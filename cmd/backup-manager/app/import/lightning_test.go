@@ -0,0 +1,87 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _import
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/util/toml"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestGenLightningConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	restore := &v1alpha1.Restore{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "import"},
+		Spec: v1alpha1.RestoreSpec{
+			StorageSize: "100Gi",
+			Lightning: &v1alpha1.LightningConfig{
+				Backend:    v1alpha1.LightningBackendLocal,
+				Checkpoint: v1alpha1.LightningCheckpointStoragePVC,
+				Conflict: &v1alpha1.LightningConflictConfig{
+					Strategy:  v1alpha1.LightningConflictStrategyReplace,
+					Threshold: pointer.Int64Ptr(100),
+				},
+			},
+		},
+	}
+	parse := func() map[string]interface{} {
+		t.Helper()
+		data, err := genLightningConfig(restore)
+		g.Expect(err).Should(BeNil())
+		cfg := map[string]interface{}{}
+		g.Expect(toml.Unmarshal(data, &cfg)).Should(Succeed())
+		return cfg
+	}
+
+	// the disk quota defaults to 80% of the sorted KV pvc
+	cfg := parse()
+	g.Expect(cfg["tikv-importer"]).Should(Equal(map[string]interface{}{
+		"backend":       "local",
+		"sorted-kv-dir": "/sorted-kv/data",
+		"disk-quota":    int64(100 << 30 / 100 * 80),
+	}))
+	g.Expect(cfg["checkpoint"]).Should(Equal(map[string]interface{}{
+		"enable": true,
+		"driver": "file",
+		"dsn":    "/backup/lightning-checkpoint/import.pb",
+	}))
+	g.Expect(cfg["conflict"]).Should(Equal(map[string]interface{}{
+		"strategy":  "replace",
+		"threshold": int64(100),
+	}))
+
+	restore.Spec.Lightning.SortedKVStorageSize = "200Gi"
+	cfg = parse()
+	g.Expect(cfg["tikv-importer"]).Should(HaveKeyWithValue("disk-quota", int64(200<<30/100*80)))
+
+	quota := resource.MustParse("50Gi")
+	restore.Spec.Lightning.DiskQuota = &quota
+	restore.Spec.Lightning.Checkpoint = v1alpha1.LightningCheckpointStorageRemote
+	cfg = parse()
+	g.Expect(cfg["tikv-importer"]).Should(HaveKeyWithValue("disk-quota", int64(50<<30)))
+	g.Expect(cfg["checkpoint"]).Should(HaveKeyWithValue("dsn", lightningRemoteCheckpointFile))
+
+	// the logical import mode doesn't need the sorted KV files
+	restore.Spec.Lightning = &v1alpha1.LightningConfig{}
+	cfg = parse()
+	g.Expect(cfg["tikv-importer"]).Should(Equal(map[string]interface{}{"backend": "tidb"}))
+	g.Expect(cfg).ShouldNot(HaveKey("checkpoint"))
+	g.Expect(cfg).ShouldNot(HaveKey("conflict"))
+}
@@ -128,21 +128,24 @@ func (rm *RestoreManager) performRestore(ctx context.Context, restore *v1alpha1.
 	}
 	klog.Infof("download cluster %s backup %s data success", rm, rm.BackupPath)
 
-	restoreDataDir := filepath.Dir(restoreDataPath)
-	unarchiveDataPath, err := unarchiveBackupData(restoreDataPath, restoreDataDir)
-	if err != nil {
-		errs = append(errs, err)
-		klog.Errorf("unarchive cluster %s backup %s data failed, err: %s", rm, restoreDataPath, err)
-		uerr := rm.StatusUpdater.Update(restore, &v1alpha1.RestoreCondition{
-			Type:    v1alpha1.RestoreFailed,
-			Status:  corev1.ConditionTrue,
-			Reason:  "UnarchiveBackupDataFailed",
-			Message: fmt.Sprintf("unarchive backup %s data failed, err: %v", restoreDataPath, err),
-		}, nil)
-		errs = append(errs, uerr)
-		return errorutils.NewAggregate(errs)
+	// the backup data streamed by dumpling is a directory which has the same layout as the unarchived one
+	unarchiveDataPath := restoreDataPath
+	if rm.isArchivedBackup() {
+		unarchiveDataPath, err = unarchiveBackupData(restoreDataPath, filepath.Dir(restoreDataPath))
+		if err != nil {
+			errs = append(errs, err)
+			klog.Errorf("unarchive cluster %s backup %s data failed, err: %s", rm, restoreDataPath, err)
+			uerr := rm.StatusUpdater.Update(restore, &v1alpha1.RestoreCondition{
+				Type:    v1alpha1.RestoreFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "UnarchiveBackupDataFailed",
+				Message: fmt.Sprintf("unarchive backup %s data failed, err: %v", restoreDataPath, err),
+			}, nil)
+			errs = append(errs, uerr)
+			return errorutils.NewAggregate(errs)
+		}
+		klog.Infof("unarchive cluster %s backup %s data success", rm, restoreDataPath)
 	}
-	klog.Infof("unarchive cluster %s backup %s data success", rm, restoreDataPath)
 
 	commitTs, err := util.GetCommitTsFromMetadata(unarchiveDataPath)
 	if err != nil {
@@ -159,7 +162,7 @@ func (rm *RestoreManager) performRestore(ctx context.Context, restore *v1alpha1.
 	}
 	klog.Infof("get cluster %s commitTs %s success", rm, commitTs)
 
-	err = rm.loadTidbClusterData(ctx, unarchiveDataPath, restore)
+	err = rm.loadTidbClusterData(ctx, unarchiveDataPath, restore, rm.StatusUpdater)
 	if err != nil {
 		errs = append(errs, err)
 		klog.Errorf("restore cluster %s from backup %s failed, err: %s", rm, rm.BackupPath, err)
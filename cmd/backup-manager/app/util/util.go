@@ -335,7 +335,12 @@ func GetCommitTsFromMetadata(backupPath string) (string, error) {
 	if err != nil {
 		return commitTs, fmt.Errorf("read metadata file %s failed, err: %v", metaFile, err)
 	}
+	return ParseCommitTsFromMetadata(metaFile, contents)
+}
 
+// ParseCommitTsFromMetadata parses commitTs from the contents of mydumper's metadata file
+func ParseCommitTsFromMetadata(metaFile string, contents []byte) (string, error) {
+	var commitTs string
 	for _, lineStr := range strings.Split(string(contents), "\n") {
 		if !strings.Contains(lineStr, "Pos") {
 			continue
@@ -520,6 +525,18 @@ func ParseRestoreProgress(line string) (step, progress string) {
 	return
 }
 
+// ParseLightningProgress parse the total progress of TiDB Lightning
+func ParseLightningProgress(line string) (progress string) {
+	matchStr := "\\[progress\\] \\[total=(.*?)\\%\\]"
+	complieRegex := regexp.MustCompile(matchStr)
+	matchs := complieRegex.FindStringSubmatch(line)
+	if len(matchs) < 2 {
+		return
+	}
+	progress = matchs[1]
+	return
+}
+
 // ReadAllStdErrToChannel read the stdErr and send the output to channel
 func ReadAllStdErrToChannel(stdErr io.Reader, errMsgCh chan []byte) {
 	errMsg, err := io.ReadAll(stdErr)
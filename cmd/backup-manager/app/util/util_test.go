@@ -629,6 +629,30 @@ func TestParseRestoreProgress(t *testing.T) {
 	}
 }
 
+func TestParseLightningProgress(t *testing.T) {
+	g := NewGomegaWithT(t)
+	cases := []struct {
+		testStr        string
+		expectProgress string
+	}{
+		{
+			testStr:        "",
+			expectProgress: "",
+		},
+		{
+			testStr:        `[2024/01/02 15:04:05.000 +00:00] [INFO] [import.go:1] [progress] [total=12.5%] [tables="0/2 (0.0%)"] [state=writing]`,
+			expectProgress: "12.5",
+		},
+		{
+			testStr:        `[2024/01/02 15:04:05.000 +00:00] [INFO] [import.go:1] [progress] [tables="0/2 (0.0%)"]`,
+			expectProgress: "",
+		},
+	}
+	for _, test := range cases {
+		g.Expect(ParseLightningProgress(test.testStr)).To(Equal(test.expectProgress))
+	}
+}
+
 func newBackup() *v1alpha1.Backup {
 	return &v1alpha1.Backup{
 		TypeMeta: metav1.TypeMeta{
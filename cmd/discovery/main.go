@@ -22,19 +22,26 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"sync/atomic"
 	"syscall"
 	"time"
 
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/discovery"
 	"github.com/pingcap/tidb-operator/pkg/discovery/server"
 	"github.com/pingcap/tidb-operator/pkg/dmapi"
 	"github.com/pingcap/tidb-operator/pkg/pdapi"
 	"github.com/pingcap/tidb-operator/pkg/version"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"k8s.io/component-base/logs"
 	"k8s.io/klog/v2"
 
@@ -46,6 +53,8 @@ var (
 	printVersion bool
 	port         int
 	proxyPort    int
+	leaderElect  bool
+	resolverName string
 )
 
 func init() {
@@ -54,6 +63,8 @@ func init() {
 	flag.BoolVar(&printVersion, "version", false, "Show version and quit")
 	flag.IntVar(&port, "port", 10261, "The port that the tidb discovery's http service runs on (default 10261)")
 	flag.IntVar(&proxyPort, "proxy-port", 10262, "The port that the tidb discovery's proxy service runs on (default 10262)")
+	flag.BoolVar(&leaderElect, "leader-elect", false, "Elect a leader among the replicas of discovery, only the leader answers the bootstrap requests")
+	flag.StringVar(&resolverName, "address-resolver", string(v1alpha1.DiscoveryAddressResolverFQDN), "The resolver of the advertised peer URLs of PD, fqdn or pod-ip")
 	flag.Parse()
 }
 
@@ -108,11 +119,22 @@ func main() {
 	// waiting for the shared informer's store has synced.
 	cache.WaitForCacheSync(ctx.Done(), secretInformer.HasSynced)
 
+	resolver, err := discovery.NewAddressResolver(v1alpha1.DiscoveryAddressResolver(resolverName), kubeCli, os.Getenv("MY_POD_NAMESPACE"))
+	if err != nil {
+		klog.Fatalf("failed to create address resolver: %v", err)
+	}
+	opts := []server.Option{server.WithAddressResolver(resolver)}
+	if leaderElect {
+		var isLeader atomic.Bool
+		opts = append(opts, server.WithLeaderCheck(isLeader.Load))
+		go runLeaderElection(ctx, kubeCli, tcName, &isLeader)
+	}
+
 	go wait.Forever(func() {
 		addr := fmt.Sprintf("0.0.0.0:%d", port)
 		klog.Infof("starting TiDB Discovery server, listening on %s", addr)
 		lister := kubeInformerFactory.Core().V1().Secrets().Lister()
-		discoveryServer := server.NewServer(pdapi.NewDefaultPDControl(lister), dmapi.NewDefaultMasterControl(lister), cli, kubeCli)
+		discoveryServer := server.NewServer(pdapi.NewDefaultPDControl(lister), dmapi.NewDefaultMasterControl(lister), cli, kubeCli, opts...)
 		discoveryServer.ListenAndServe(addr)
 	}, 5*time.Second)
 	go wait.Forever(func() {
@@ -144,3 +166,42 @@ func main() {
 	}
 	klog.Infof("tidb-discovery exited")
 }
+
+// runLeaderElection elects the leader of the discovery replicas by the Lease named after the discovery,
+// a replica losing the leadership keeps running and waits to be elected again, because the bootstrap
+// state is persisted and reloaded by the leader
+func runLeaderElection(ctx context.Context, kubeCli kubernetes.Interface, tcName string, isLeader *atomic.Bool) {
+	hostName, err := os.Hostname()
+	if err != nil {
+		klog.Fatalf("failed to get hostname: %v", err)
+	}
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Namespace: os.Getenv("MY_POD_NAMESPACE"),
+			Name:      controller.DiscoveryMemberName(tcName),
+		},
+		Client: kubeCli.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: hostName,
+		},
+	}
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+			Lock:            lock,
+			LeaseDuration:   15 * time.Second,
+			RenewDeadline:   10 * time.Second,
+			RetryPeriod:     2 * time.Second,
+			ReleaseOnCancel: true,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(context.Context) {
+					klog.Infof("%s became the leader of discovery", hostName)
+					isLeader.Store(true)
+				},
+				OnStoppedLeading: func() {
+					klog.Infof("%s stopped leading discovery", hostName)
+					isLeader.Store(false)
+				},
+			},
+		})
+	}, 5*time.Second)
+}
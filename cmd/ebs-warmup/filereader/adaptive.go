@@ -0,0 +1,144 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filereader
+
+import (
+	"context"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/pingcap/tidb-operator/cmd/ebs-warmup/worker"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// readBlockSize is aligned with the buffer size of the workers.
+	readBlockSize              = 256 * units.KiB
+	initialAdaptiveConcurrency = 16
+	defaultAdaptiveInterval    = 5 * time.Second
+)
+
+// AdaptiveConfig is the config for adjusting the concurrency by the observed read throughput and latency.
+type AdaptiveConfig struct {
+	Enabled bool
+	// TargetThroughput is the expected read throughput in MiB/s.
+	// When it is zero, the concurrency keeps growing until the latency exceeds the target.
+	TargetThroughput float64
+	// TargetLatency is the expected average latency of reading a block(256KiB).
+	// When it is exceeded, the concurrency will be decreased.
+	TargetLatency time.Duration
+	// Interval is how often the concurrency is adjusted.
+	Interval time.Duration
+}
+
+type adaptiveController struct {
+	config         AdaptiveConfig
+	maxConcurrency int
+	limiter        *worker.ConcurrencyLimiter
+
+	bytes        uint64
+	blocks       uint64
+	latencyNanos uint64
+}
+
+func newAdaptiveController(config AdaptiveConfig, maxConcurrency int) *adaptiveController {
+	if config.Interval <= 0 {
+		config.Interval = defaultAdaptiveInterval
+	}
+	initial := initialAdaptiveConcurrency
+	if initial > maxConcurrency {
+		initial = maxConcurrency
+	}
+	return &adaptiveController{
+		config:         config,
+		maxConcurrency: maxConcurrency,
+		limiter:        worker.NewConcurrencyLimiter(initial),
+	}
+}
+
+// observe records a finished read.
+func (c *adaptiveController) observe(readBytes int, take time.Duration) {
+	blocks := (readBytes + readBlockSize - 1) / readBlockSize
+	if blocks == 0 {
+		blocks = 1
+	}
+	atomic.AddUint64(&c.bytes, uint64(readBytes))
+	atomic.AddUint64(&c.blocks, uint64(blocks))
+	atomic.AddUint64(&c.latencyNanos, uint64(take.Nanoseconds()))
+}
+
+func (c *adaptiveController) run(ctx context.Context) {
+	ticker := time.NewTicker(c.config.Interval)
+	defer ticker.Stop()
+	last := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			c.adjust(now.Sub(last))
+			last = now
+		}
+	}
+}
+
+func (c *adaptiveController) adjust(elapsed time.Duration) {
+	bytes := atomic.SwapUint64(&c.bytes, 0)
+	blocks := atomic.SwapUint64(&c.blocks, 0)
+	latencyNanos := atomic.SwapUint64(&c.latencyNanos, 0)
+	if blocks == 0 || elapsed <= 0 {
+		return
+	}
+	throughput := float64(bytes) / elapsed.Seconds()
+	latency := time.Duration(latencyNanos / blocks)
+
+	cur := c.limiter.Limit()
+	next := nextConcurrency(cur, c.maxConcurrency, throughput, latency, c.config)
+	if next != cur {
+		c.limiter.SetLimit(next)
+	}
+	klog.InfoS("Adjusted the concurrency.", "from", cur, "to", next,
+		"rate/s", units.HumanSizeWithPrecision(throughput, 4), "latency", latency)
+}
+
+// nextConcurrency calculates the concurrency of the next interval by the throughput and latency of the last interval.
+func nextConcurrency(cur, maxConcurrency int, throughput float64, latency time.Duration, config AdaptiveConfig) int {
+	if throughput <= 0 {
+		// Nothing was read during the last interval, there is nothing to learn from.
+		return cur
+	}
+	// Grows slowly when there isn't a target throughput, the latency will stop it.
+	next := cur + cur/4 + 1
+	if config.TargetThroughput > 0 {
+		// Assume the throughput is proportional to the concurrency, but don't move too fast.
+		next = int(math.Round(float64(cur) * config.TargetThroughput * units.MiB / throughput))
+		next = clamp(next, cur/2, cur*2)
+	}
+	if config.TargetLatency > 0 && latency > config.TargetLatency && next > cur*3/4 {
+		next = cur * 3 / 4
+	}
+	return clamp(next, 1, maxConcurrency)
+}
+
+func clamp(n, lo, hi int) int {
+	if n < lo {
+		return lo
+	}
+	if n > hi {
+		return hi
+	}
+	return n
+}
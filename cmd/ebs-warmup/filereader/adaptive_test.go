@@ -0,0 +1,96 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filereader
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextConcurrency(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cur        int
+		throughput float64
+		latency    time.Duration
+		config     AdaptiveConfig
+		expected   int
+	}{
+		{
+			name:     "nothing read",
+			cur:      16,
+			expected: 16,
+		},
+		{
+			name:       "grow without target throughput",
+			cur:        16,
+			throughput: 100 * units.MiB,
+			latency:    10 * time.Millisecond,
+			config:     AdaptiveConfig{TargetLatency: 100 * time.Millisecond},
+			expected:   21,
+		},
+		{
+			name:       "shrink when latency is too high",
+			cur:        16,
+			throughput: 100 * units.MiB,
+			latency:    200 * time.Millisecond,
+			config:     AdaptiveConfig{TargetLatency: 100 * time.Millisecond},
+			expected:   12,
+		},
+		{
+			name:       "approach target throughput",
+			cur:        16,
+			throughput: 100 * units.MiB,
+			config:     AdaptiveConfig{TargetThroughput: 150},
+			expected:   24,
+		},
+		{
+			name:       "grow at most twice",
+			cur:        16,
+			throughput: 10 * units.MiB,
+			config:     AdaptiveConfig{TargetThroughput: 150},
+			expected:   32,
+		},
+		{
+			name:       "shrink at most half",
+			cur:        16,
+			throughput: 1000 * units.MiB,
+			config:     AdaptiveConfig{TargetThroughput: 150},
+			expected:   8,
+		},
+		{
+			name:       "no more than max",
+			cur:        60,
+			throughput: 10 * units.MiB,
+			config:     AdaptiveConfig{TargetThroughput: 150},
+			expected:   64,
+		},
+		{
+			name:       "at least one",
+			cur:        1,
+			throughput: 100 * units.MiB,
+			latency:    200 * time.Millisecond,
+			config:     AdaptiveConfig{TargetLatency: 100 * time.Millisecond},
+			expected:   1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, nextConcurrency(tc.cur, 64, tc.throughput, tc.latency, tc.config))
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filereader
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/docker/go-units"
+	"github.com/pingcap/errors"
+)
+
+// blockSegmentSize is the size of each read task of a block device.
+// Block devices are much larger than files, splitting them by a fixed count would make too few tasks for the workers.
+const blockSegmentSize = 64 * units.MiB
+
+// blockDeviceInfo overrides the size of a block device, which is always zero in the result of stat(2).
+type blockDeviceInfo struct {
+	os.FileInfo
+	size int64
+}
+
+func (b blockDeviceInfo) Size() int64 {
+	return b.size
+}
+
+// StatBlockDevices stats the comma separated block devices.
+func StatBlockDevices(devices string) ([]StatedFile, error) {
+	stats := make([]StatedFile, 0)
+	for _, device := range strings.Split(devices, ",") {
+		device = strings.TrimSpace(device)
+		if device == "" {
+			continue
+		}
+		s, err := statBlockDevice(device)
+		if err != nil {
+			return nil, errors.Annotatef(err, "failed to stat block device %s", device)
+		}
+		stats = append(stats, StatedFile{Info: s, Path: device})
+	}
+	return stats, nil
+}
+
+func statBlockDevice(device string) (os.FileInfo, error) {
+	fd, err := os.Open(device)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+	s, err := fd.Stat()
+	if err != nil {
+		return nil, err
+	}
+	// The size of a block device can only be got by seeking to the end.
+	size, err := fd.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to seek to the end")
+	}
+	return blockDeviceInfo{FileInfo: s, size: size}, nil
+}
+
+func warmUpBlockDevicesOf(devices []StatedFile, sendToWorker sendFileHook) error {
+	for _, device := range devices {
+		if sendFileBySegmentSize(device, blockSegmentSize, sendToWorker) {
+			return context.Canceled
+		}
+	}
+	return nil
+}
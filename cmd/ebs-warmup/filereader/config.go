@@ -14,6 +14,9 @@
 package filereader
 
 import (
+	"time"
+
+	"github.com/pingcap/tidb-operator/cmd/ebs-warmup/progress"
 	"github.com/pingcap/tidb-operator/cmd/ebs-warmup/worker"
 	"github.com/pingcap/tidb-operator/cmd/ebs-warmup/worker/tasks"
 )
@@ -26,6 +29,11 @@ type Config struct {
 	Direct          bool
 	CheckpointEvery uint64
 	CheckpointFile  string
+	Adaptive        AdaptiveConfig
+
+	// Reporters receive the progress every ReportInterval and once all files are warmed up.
+	Reporters      []progress.Reporter
+	ReportInterval time.Duration
 
 	OnStep        worker.OnStepHook
 	OnFireRequest func(*tasks.ReadFile)
@@ -25,6 +25,7 @@ import (
 
 	"github.com/docker/go-units"
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb-operator/cmd/ebs-warmup/progress"
 	"github.com/pingcap/tidb-operator/cmd/ebs-warmup/worker"
 	"github.com/pingcap/tidb-operator/cmd/ebs-warmup/worker/tasks"
 	"golang.org/x/sync/errgroup"
@@ -36,6 +37,9 @@ const (
 	channelBufSize      = 128
 	minimalSegmentSize  = 64 * 1024
 	defaultSegmentCount = 16
+	footerSize          = 16 * 1024
+
+	defaultReportInterval = 30 * time.Second
 )
 
 type sendFileHook func(tasks.ReadFile) (brk bool)
@@ -78,9 +82,13 @@ func WarmUpFooters(glob string, sendToWorker sendFileHook) error {
 	if err != nil {
 		return errors.Annotatef(err, "failed to stat files with glob %s", glob)
 	}
+	return warmUpFootersOf(files, sendToWorker)
+}
+
+func warmUpFootersOf(files []StatedFile, sendToWorker sendFileHook) error {
 	for _, file := range files {
 		shouldBrk := sendToWorker(tasks.ReadFile{
-			Type:     tasks.ReadLastNBytes(16 * 1024),
+			Type:     tasks.ReadLastNBytes(footerSize),
 			File:     file.Info,
 			FilePath: file.Path,
 		})
@@ -102,6 +110,10 @@ func warmUpWholeFileBy(glob string, onFile func(StatedFile) (brk bool)) error {
 	if err != nil {
 		return errors.Annotatef(err, "failed to stat files with glob %s", glob)
 	}
+	return warmUpWholeFileOf(files, onFile)
+}
+
+func warmUpWholeFileOf(files []StatedFile, onFile func(StatedFile) (brk bool)) error {
 	sort.Slice(files, func(i, j int) bool {
 		// Desc order of modify time.
 		return files[i].Info.ModTime().After(files[j].Info.ModTime())
@@ -119,6 +131,10 @@ func sendFileWithSegmenting(file StatedFile, partitions int, sendToWorker sendFi
 	if partitionSize < minimalSegmentSize {
 		partitionSize = minimalSegmentSize
 	}
+	return sendFileBySegmentSize(file, partitionSize, sendToWorker)
+}
+
+func sendFileBySegmentSize(file StatedFile, partitionSize int64, sendToWorker sendFileHook) (brk bool) {
 	offset := int64(0)
 	for offset <= file.Info.Size() {
 		length := partitionSize
@@ -147,6 +163,8 @@ type WorkersOpt struct {
 	ObserveTotalSize *uint64
 	RateLimitInMiB   float64
 	OnStep           worker.OnStepHook
+	Concurrency      *worker.ConcurrencyLimiter
+	OnDone           func(tasks.ReadFile)
 }
 
 func CreateWorkers(ctx context.Context, n int, opt WorkersOpt) ([]chan<- tasks.ReadFile, *errgroup.Group) {
@@ -166,6 +184,8 @@ func CreateWorkers(ctx context.Context, n int, opt WorkersOpt) ([]chan<- tasks.R
 		eg.Go(func() error {
 			wr := worker.New(ch)
 			wr.RateLimiter = limiter
+			wr.Concurrency = opt.Concurrency
+			wr.OnDone = opt.OnDone
 			wr.OnStep = func(file os.FileInfo, readBytes int, take time.Duration) {
 				if opt.OnStep != nil {
 					opt.OnStep(file, readBytes, take)
@@ -238,6 +258,11 @@ type ExecContext struct {
 	lastSent uint64
 	start    time.Time
 
+	tracker  *progress.Tracker
+	adaptive *adaptiveController
+	// curFile is the file whose tasks are being sent.
+	curFile string
+
 	cancel context.CancelFunc
 }
 
@@ -272,16 +297,33 @@ func New(config Config) *ExecContext {
 	execCtx := &ExecContext{
 		config: config,
 	}
-	wCtx, cancel := context.WithCancel(context.Background())
-	execCtx.wkrs, execCtx.eg = CreateWorkers(wCtx, execCtx.config.NWorkers, WorkersOpt{
+	execCtx.start = time.Now()
+	execCtx.tracker = progress.NewTracker(execCtx.start)
+	opt := WorkersOpt{
 		ObserveTotalSize: &execCtx.total,
 		RateLimitInMiB:   execCtx.config.RateLimit,
 		OnStep:           config.OnStep,
-	})
-	execCtx.start = time.Now()
+		OnDone: func(rf tasks.ReadFile) {
+			execCtx.tracker.FinishTask(rf.FilePath, expectedReadBytes(rf))
+		},
+	}
+	if config.Adaptive.Enabled {
+		execCtx.adaptive = newAdaptiveController(config.Adaptive, config.NWorkers)
+		opt.Concurrency = execCtx.adaptive.limiter
+		opt.OnStep = func(file os.FileInfo, readBytes int, take time.Duration) {
+			execCtx.adaptive.observe(readBytes, take)
+			if config.OnStep != nil {
+				config.OnStep(file, readBytes, take)
+			}
+		}
+	}
+	wCtx, cancel := context.WithCancel(context.Background())
+	execCtx.wkrs, execCtx.eg = CreateWorkers(wCtx, execCtx.config.NWorkers, opt)
 	execCtx.chooser = RoundRobin(execCtx.wkrs)
 	execCtx.cnt = uint64(0)
-	execCtx.lastSent = execCtx.checkpoint()
+	if execCtx.checkpointEnabled() {
+		execCtx.lastSent = execCtx.checkpoint()
+	}
 	execCtx.cancel = cancel
 	return execCtx
 }
@@ -289,22 +331,43 @@ func New(config Config) *ExecContext {
 func (execCtx *ExecContext) RunAndClose(ctx context.Context) error {
 	defer execCtx.cancel()
 
-	klog.InfoS("Using checkpoint.", "checkpoint", execCtx.lastSent, "time", time.UnixMilli(int64(execCtx.lastSent)).String())
-	var err error
+	if execCtx.checkpointEnabled() {
+		klog.InfoS("Using checkpoint.", "checkpoint", execCtx.lastSent, "time", time.UnixMilli(int64(execCtx.lastSent)).String())
+	}
+	files, err := execCtx.statFiles()
+	if err != nil {
+		klog.ErrorS(err, "Failed to initialize the task.")
+		return err
+	}
+	execCtx.observeTotal(files)
+
+	bgCtx, bgCancel := context.WithCancel(ctx)
+	bgDone := execCtx.runBackground(bgCtx)
+	defer func() {
+		bgCancel()
+		<-bgDone
+	}()
+
+	sendToWorker := func(rf tasks.ReadFile) bool {
+		return execCtx.sendToWorker(ctx, rf)
+	}
 	switch execCtx.config.Type {
 	case "footer":
-		err = WarmUpFooters(execCtx.config.Files, func(rf tasks.ReadFile) bool {
-			return execCtx.sendToWorker(ctx, rf)
-		})
+		err = warmUpFootersOf(files, sendToWorker)
 	case "whole":
-		err = WarmUpWholeFile(execCtx.config.Files, func(rf tasks.ReadFile) bool {
-			return execCtx.sendToWorker(ctx, rf)
+		err = warmUpWholeFileOf(files, func(sf StatedFile) bool {
+			return sendFileWithSegmenting(sf, defaultSegmentCount, sendToWorker)
 		})
+	case "block":
+		err = warmUpBlockDevicesOf(files, sendToWorker)
 	}
 	if err != nil {
 		klog.ErrorS(err, "Failed to initialize the task.")
 		return err
 	}
+	if execCtx.curFile != "" {
+		execCtx.tracker.EndFile(execCtx.curFile)
+	}
 
 	for _, wkr := range execCtx.wkrs {
 		close(wkr)
@@ -312,16 +375,131 @@ func (execCtx *ExecContext) RunAndClose(ctx context.Context) error {
 	if err := execCtx.eg.Wait(); err != nil {
 		return err
 	}
+	bgCancel()
+	<-bgDone
+	execCtx.tracker.MarkComplete()
+	execCtx.report(ctx)
 
 	take := time.Since(execCtx.start)
 	total := atomic.LoadUint64(&execCtx.total)
 	rate := float64(total) / take.Seconds()
 	klog.InfoS("Done.", "take", take, "total", total, "rate", fmt.Sprintf("%s/s", units.HumanSize(rate)))
+	if !execCtx.checkpointEnabled() {
+		return nil
+	}
 	err = os.Remove(execCtx.config.CheckpointFile)
 	klog.InfoS("Try remove the checkpoint file.", "err", err, "file", execCtx.config.CheckpointFile)
 	return nil
 }
 
+// checkpointEnabled returns whether the checkpoint is used.
+// The checkpoint is the modify time of the last sent file, which makes no sense for block devices.
+func (execCtx *ExecContext) checkpointEnabled() bool {
+	return execCtx.config.Type != "block"
+}
+
+func (execCtx *ExecContext) statFiles() ([]StatedFile, error) {
+	if execCtx.config.Type == "block" {
+		return StatBlockDevices(execCtx.config.Files)
+	}
+	files, err := StatFilesByGlob(execCtx.config.Files)
+	if err != nil {
+		return nil, errors.Annotatef(err, "failed to stat files with glob %s", execCtx.config.Files)
+	}
+	return files, nil
+}
+
+// observeTotal adds the files and the bytes to be read to the progress.
+func (execCtx *ExecContext) observeTotal(files []StatedFile) {
+	bytes := int64(0)
+	for _, file := range files {
+		size := file.Info.Size()
+		if execCtx.config.Type == "footer" && size > footerSize {
+			size = footerSize
+		}
+		bytes += size
+	}
+	execCtx.tracker.AddTotal(int64(len(files)), bytes)
+}
+
+// runBackground runs the adaptive controller and reports the progress periodically until the context is done.
+func (execCtx *ExecContext) runBackground(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+	eg := new(errgroup.Group)
+	if execCtx.adaptive != nil {
+		eg.Go(func() error {
+			execCtx.adaptive.run(ctx)
+			return nil
+		})
+	}
+	eg.Go(func() error {
+		interval := execCtx.config.ReportInterval
+		if interval <= 0 {
+			interval = defaultReportInterval
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				execCtx.report(ctx)
+			}
+		}
+	})
+	go func() {
+		_ = eg.Wait()
+		close(done)
+	}()
+	return done
+}
+
+func (execCtx *ExecContext) concurrency() int {
+	if execCtx.adaptive != nil {
+		return execCtx.adaptive.limiter.Limit()
+	}
+	return execCtx.config.NWorkers
+}
+
+// Progress returns the current progress of the warmup.
+func (execCtx *ExecContext) Progress() progress.Snapshot {
+	s := execCtx.tracker.Snapshot(time.Now())
+	s.Concurrency = execCtx.concurrency()
+	return s
+}
+
+func (execCtx *ExecContext) report(ctx context.Context) {
+	s := execCtx.Progress()
+	klog.InfoS("Printing progress.", "files", fmt.Sprintf("%d/%d", s.FilesDone, s.FilesTotal),
+		"bytes", fmt.Sprintf("%s/%s", units.HumanSize(float64(s.BytesDone)), units.HumanSize(float64(s.BytesTotal))),
+		"rate/s", units.HumanSize(float64(s.Throughput)), "concurrency", s.Concurrency,
+		"eta", s.EstimatedCompletion, "complete", s.Complete)
+	for _, r := range execCtx.config.Reporters {
+		if err := r.Report(ctx, s); err != nil {
+			// Failing to report the progress shouldn't stop the warmup.
+			klog.ErrorS(err, "Failed to report the progress.")
+		}
+	}
+}
+
+// expectedReadBytes returns how many bytes the task is going to read, regardless the alignment of direct I/O.
+func expectedReadBytes(rf tasks.ReadFile) int64 {
+	switch t := rf.Type.(type) {
+	case tasks.ReadLastNBytes:
+		if size := rf.File.Size(); size < int64(t) {
+			return size
+		}
+		return int64(t)
+	case tasks.ReadOffsetAndLength:
+		return t.Length
+	case tasks.ReadFull:
+		return rf.File.Size()
+	default:
+		return 0
+	}
+}
+
 func (execCtx *ExecContext) checkpointTick(ctx context.Context) {
 	execCtx.cnt += 1
 	if execCtx.cnt%execCtx.config.CheckpointEvery == 0 {
@@ -347,12 +525,26 @@ func (execCtx *ExecContext) sendToWorker(ctx context.Context, rf tasks.ReadFile)
 		klog.InfoS("early exit due to context canceled.", "err", ctx.Err())
 		return true
 	}
-	if createTs > int64(execCtx.lastSent) {
-		return false
+	if rf.FilePath != execCtx.curFile {
+		if execCtx.curFile != "" {
+			execCtx.tracker.EndFile(execCtx.curFile)
+		}
+		execCtx.tracker.StartFile(rf.FilePath)
+		execCtx.curFile = rf.FilePath
+	}
+	if execCtx.checkpointEnabled() {
+		if createTs > int64(execCtx.lastSent) {
+			execCtx.tracker.Skip(expectedReadBytes(rf))
+			return false
+		}
+		execCtx.checkpointTick(ctx)
 	}
-	execCtx.checkpointTick(ctx)
 	rf.Direct = execCtx.config.Direct
+	execCtx.tracker.AddTask(rf.FilePath)
 	execCtx.chooser() <- rf
+	if !execCtx.checkpointEnabled() {
+		return false
+	}
 	if execCtx.lastSent < uint64(createTs) {
 		klog.Warningln("unordered files: checkpoint is unavailable.", "checkpoint=", execCtx.checkpoint(),
 			"createTs=", uint64(createTs), "lastSent=", execCtx.lastSent)
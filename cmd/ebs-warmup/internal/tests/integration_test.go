@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/pingcap/tidb-operator/cmd/ebs-warmup/filereader"
+	"github.com/pingcap/tidb-operator/cmd/ebs-warmup/progress"
 	"github.com/pingcap/tidb-operator/cmd/ebs-warmup/worker/tasks"
 	"github.com/stretchr/testify/require"
 )
@@ -195,3 +196,85 @@ func TestSigAndCheckpoint(t *testing.T) {
 	coll2.CheckWith(t, tw.files[:101])
 	require.NoFileExists(t, cfg.CheckpointFile)
 }
+
+type progressCollector struct {
+	mu        sync.Mutex
+	snapshots []progress.Snapshot
+}
+
+func (c *progressCollector) Report(_ context.Context, s progress.Snapshot) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.snapshots = append(c.snapshots, s)
+	return nil
+}
+
+func TestAdaptiveAndProgress(t *testing.T) {
+	tw := createTestDataSet(t)
+
+	for i := 0; i < 100; i++ {
+		tw.createFile(4096, false)
+	}
+	for i := 0; i < 5; i++ {
+		tw.createFile(4096*20, false)
+	}
+	total := int64(0)
+	for _, f := range tw.files {
+		total += f.Size()
+	}
+
+	cfg := tw.defaultConfig()
+	cfg.NWorkers = 4
+	cfg.Adaptive = filereader.AdaptiveConfig{Enabled: true, Interval: 10 * time.Millisecond}
+	coll := NewCollector()
+	cfg.OnStep = coll.OnStep
+	reporter := &progressCollector{}
+	cfg.Reporters = []progress.Reporter{reporter}
+	runner := filereader.New(cfg)
+	require.NoError(t, runner.RunAndClose(context.Background()))
+
+	coll.CheckWith(t, tw.files)
+	require.NotEmpty(t, reporter.snapshots)
+	last := reporter.snapshots[len(reporter.snapshots)-1]
+	require.True(t, last.Complete)
+	require.EqualValues(t, len(tw.files), last.FilesTotal)
+	require.Equal(t, last.FilesTotal, last.FilesDone)
+	require.Equal(t, total, last.BytesTotal)
+	require.Equal(t, total, last.BytesDone)
+	require.LessOrEqual(t, last.Concurrency, 4)
+}
+
+func TestBlock(t *testing.T) {
+	tw := createTestDataSet(t)
+
+	// Regular files stand for the block devices here, they are read in the same way.
+	tw.createFile(4096*20, false)
+	tw.createFile(4096*300, false)
+	devices := make([]string, 0, len(tw.files))
+	total := int64(0)
+	for _, f := range tw.files {
+		devices = append(devices, filepath.Join(tw.basicPath, f.Name()))
+		total += f.Size()
+	}
+	// The checkpoint doesn't make sense for block devices, so it should be ignored.
+	tw.saveCheckpoint(0)
+
+	cfg := tw.defaultConfig()
+	cfg.Type = "block"
+	cfg.Files = strings.Join(devices, ",")
+	coll := NewCollector()
+	cfg.OnStep = coll.OnStep
+	reporter := &progressCollector{}
+	cfg.Reporters = []progress.Reporter{reporter}
+	runner := filereader.New(cfg)
+	require.NoError(t, runner.RunAndClose(context.Background()))
+
+	for _, f := range tw.files {
+		require.EqualValues(t, f.Size(), coll.records[f.Name()])
+	}
+	last := reporter.snapshots[len(reporter.snapshots)-1]
+	require.True(t, last.Complete)
+	require.EqualValues(t, 2, last.FilesDone)
+	require.Equal(t, total, last.BytesTotal)
+	require.Equal(t, total, last.BytesDone)
+}
@@ -19,8 +19,10 @@ import (
 	"math"
 	"os"
 	"os/signal"
+	"time"
 
 	"github.com/pingcap/tidb-operator/cmd/ebs-warmup/filereader"
+	"github.com/pingcap/tidb-operator/cmd/ebs-warmup/progress"
 	"github.com/spf13/pflag"
 	"k8s.io/klog/v2"
 
@@ -29,13 +31,20 @@ import (
 )
 
 var (
-	files               = pflag.String("files", "*", "What files should be warmed up? This can be a bash glob.")
-	ty                  = pflag.String("type", "footer", "Where to warm up? `footer` or `whole`.")
+	files               = pflag.String("files", "*", "What files should be warmed up? This can be a bash glob. For the block type, this is a comma separated list of block devices.")
+	ty                  = pflag.String("type", "footer", "Where to warm up? `footer`, `whole` or `block`(reading the raw block devices).")
 	rateLimit           = pflag.Float64P("ratelimit", "r", math.Inf(1), "What is the max speed of reading? (in MiB/s)")
 	nWorkers            = pflag.IntP("workers", "P", 32, "How many workers should we start?")
 	direct              = pflag.Bool("direct", false, "Should we use direct I/O?")
 	checkpointFileCount = pflag.Uint64("checkpoint.every", 100, "After processing how many files, should we save the checkpoint?")
 	checkpointFile      = pflag.String("checkpoint.at", "warmup-checkpoint.txt", "Where should we save & read the checkpoint?")
+	adaptive            = pflag.Bool("adaptive", false, "Should we adjust the concurrency by the observed throughput and latency? The workers would be the max concurrency.")
+	targetThroughput    = pflag.Float64("adaptive.target-throughput", 0, "What is the expected speed of reading in the adaptive mode? (in MiB/s, 0 means as fast as the latency allows)")
+	targetLatency       = pflag.Duration("adaptive.target-latency", 100*time.Millisecond, "What is the max average latency of reading a 256KiB block in the adaptive mode?")
+	adaptiveInterval    = pflag.Duration("adaptive.interval", 5*time.Second, "How often should we adjust the concurrency in the adaptive mode?")
+	progressRestore     = pflag.String("progress.restore", "", "Which restore(in the format of `<namespace>/<name>`) should we report the progress to? Empty means don't report.")
+	progressKey         = pflag.String("progress.key", "", "What is the key of the progress in the status of the restore? Usually the name of the TiKV pod.")
+	progressInterval    = pflag.Duration("progress.interval", 30*time.Second, "How often should we report the progress?")
 )
 
 func main() {
@@ -51,6 +60,22 @@ func main() {
 		Direct:          *direct,
 		CheckpointEvery: *checkpointFileCount,
 		CheckpointFile:  *checkpointFile,
+		Adaptive: filereader.AdaptiveConfig{
+			Enabled:          *adaptive,
+			TargetThroughput: *targetThroughput,
+			TargetLatency:    *targetLatency,
+			Interval:         *adaptiveInterval,
+		},
+		ReportInterval: *progressInterval,
+	}
+	if *progressRestore != "" {
+		reporter, err := progress.NewRestoreReporter(*progressRestore, *progressKey)
+		if err != nil {
+			// The warmup is still useful without reporting the progress.
+			klog.ErrorS(err, "Failed to create the reporter, the progress won't be reported to the restore.")
+		} else {
+			config.Reporters = append(config.Reporters, reporter)
+		}
 	}
 
 	rd := filereader.New(config)
@@ -0,0 +1,100 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package progress
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned/fake"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTracker(t *testing.T) {
+	start := time.Unix(1000, 0)
+	tr := NewTracker(start)
+	tr.AddTotal(3, 300)
+
+	// The first file is skipped by the checkpoint.
+	tr.StartFile("a")
+	tr.Skip(100)
+	tr.EndFile("a")
+
+	// The second file is split into two tasks, one of which is finished before the other is sent.
+	tr.StartFile("b")
+	tr.AddTask("b")
+	tr.FinishTask("b", 50)
+	s := tr.Snapshot(start.Add(time.Second))
+	require.EqualValues(t, 1, s.FilesDone)
+	require.EqualValues(t, 150, s.BytesDone)
+	// The skipped bytes aren't counted for the throughput.
+	require.EqualValues(t, 50, s.Throughput)
+	require.Equal(t, start.Add(4*time.Second), s.EstimatedCompletion)
+
+	tr.AddTask("b")
+	tr.EndFile("b")
+	s = tr.Snapshot(start.Add(time.Second))
+	require.EqualValues(t, 1, s.FilesDone)
+	tr.FinishTask("b", 50)
+	s = tr.Snapshot(start.Add(time.Second))
+	require.EqualValues(t, 2, s.FilesDone)
+	require.False(t, s.Complete)
+
+	tr.StartFile("c")
+	tr.AddTask("c")
+	tr.EndFile("c")
+	tr.FinishTask("c", 100)
+	tr.MarkComplete()
+	s = tr.Snapshot(start.Add(2 * time.Second))
+	require.Equal(t, Snapshot{
+		FilesDone:           3,
+		FilesTotal:          3,
+		BytesDone:           300,
+		BytesTotal:          300,
+		Throughput:          100,
+		EstimatedCompletion: start.Add(2 * time.Second),
+		Complete:            true,
+	}, s)
+
+	require.True(t, NewTracker(start).Snapshot(start.Add(time.Second)).EstimatedCompletion.IsZero())
+}
+
+func TestRestoreReporter(t *testing.T) {
+	cli := fake.NewSimpleClientset(&v1alpha1.Restore{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "restore"},
+	})
+	ctx := context.Background()
+	r1 := &RestoreReporter{Cli: cli, Namespace: "ns", Name: "restore", Key: "basic-tikv-0"}
+	r2 := &RestoreReporter{Cli: cli, Namespace: "ns", Name: "restore", Key: "basic-tikv-1"}
+
+	require.NoError(t, r1.Report(ctx, Snapshot{FilesDone: 1, FilesTotal: 2, BytesDone: 10, BytesTotal: 20, Concurrency: 16}))
+	require.NoError(t, r2.Report(ctx, Snapshot{FilesDone: 2, FilesTotal: 2, BytesDone: 20, BytesTotal: 20, Complete: true}))
+
+	restore, err := cli.PingcapV1alpha1().Restores("ns").Get(ctx, "restore", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, restore.Status.WarmupProgress, 2)
+	p := restore.Status.WarmupProgress["basic-tikv-0"]
+	require.EqualValues(t, 1, p.FilesDone)
+	require.EqualValues(t, 20, p.BytesTotal)
+	require.EqualValues(t, 16, p.Concurrency)
+	require.False(t, p.Complete)
+	require.Nil(t, p.EstimatedCompletionTime)
+	require.NotNil(t, p.LastUpdateTime)
+	require.True(t, restore.Status.WarmupProgress["basic-tikv-1"].Complete)
+
+	require.Error(t, (&RestoreReporter{Cli: cli, Namespace: "ns", Name: "not-exist", Key: "basic-tikv-0"}).Report(ctx, Snapshot{}))
+}
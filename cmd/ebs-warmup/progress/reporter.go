@@ -0,0 +1,99 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package progress
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+)
+
+// Reporter reports the progress of the warmup to somewhere.
+type Reporter interface {
+	Report(ctx context.Context, s Snapshot) error
+}
+
+// RestoreReporter reports the progress to `status.warmupProgress` of the restore which creates the warmup job,
+// so users of the async warmup can know when the volumes are fully warmed up.
+// The service account of the warmup job needs the `get` and `update` permissions on `restores`.
+type RestoreReporter struct {
+	Cli       versioned.Interface
+	Namespace string
+	Name      string
+	// Key is the key of the progress in `status.warmupProgress`, which is the name of the TiKV pod.
+	Key string
+}
+
+// NewRestoreReporter creates a RestoreReporter with the in-cluster config.
+// The restore is in the format of `<namespace>/<name>`.
+func NewRestoreReporter(restore, key string) (*RestoreReporter, error) {
+	ns, name, err := cache.SplitMetaNamespaceKey(restore)
+	if err != nil {
+		return nil, errors.Annotatef(err, "failed to parse restore %s", restore)
+	}
+	if ns == "" || name == "" || key == "" {
+		return nil, fmt.Errorf("both restore in the format of <namespace>/<name> and key are required, got restore %q and key %q", restore, key)
+	}
+	cfg, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to get in-cluster config")
+	}
+	cli, err := versioned.NewForConfig(cfg)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to create clientset")
+	}
+	return &RestoreReporter{Cli: cli, Namespace: ns, Name: name, Key: key}, nil
+}
+
+func (r *RestoreReporter) Report(ctx context.Context, s Snapshot) error {
+	progress := ToWarmupProgress(s, time.Now())
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		restore, err := r.Cli.PingcapV1alpha1().Restores(r.Namespace).Get(ctx, r.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if restore.Status.WarmupProgress == nil {
+			restore.Status.WarmupProgress = make(map[string]v1alpha1.WarmupProgress)
+		}
+		restore.Status.WarmupProgress[r.Key] = progress
+		_, err = r.Cli.PingcapV1alpha1().Restores(r.Namespace).Update(ctx, restore, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// ToWarmupProgress converts the snapshot to the progress in the status of the restore.
+func ToWarmupProgress(s Snapshot, now time.Time) v1alpha1.WarmupProgress {
+	progress := v1alpha1.WarmupProgress{
+		FilesDone:      s.FilesDone,
+		FilesTotal:     s.FilesTotal,
+		BytesDone:      s.BytesDone,
+		BytesTotal:     s.BytesTotal,
+		Throughput:     s.Throughput,
+		Concurrency:    int32(s.Concurrency),
+		Complete:       s.Complete,
+		LastUpdateTime: &metav1.Time{Time: now},
+	}
+	if !s.EstimatedCompletion.IsZero() {
+		progress.EstimatedCompletionTime = &metav1.Time{Time: s.EstimatedCompletion}
+	}
+	return progress
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package progress
+
+import (
+	"sync"
+	"time"
+)
+
+// Snapshot is the progress of the warmup at some point.
+type Snapshot struct {
+	FilesDone  int64
+	FilesTotal int64
+	BytesDone  int64
+	BytesTotal int64
+	// Throughput is the average read throughput in bytes per second.
+	Throughput int64
+	// Concurrency is how many reads are allowed to run at the same time.
+	Concurrency int
+	// EstimatedCompletion is zero when it cannot be estimated yet.
+	EstimatedCompletion time.Time
+	Complete            bool
+}
+
+// Tracker tracks how many files and bytes have been warmed up.
+//
+// A file may be split into many read tasks, which are finished by different workers out of order.
+// A file is done once it is ended by the sender and all of its tasks are finished.
+type Tracker struct {
+	mu sync.Mutex
+
+	start      time.Time
+	filesTotal int64
+	filesDone  int64
+	bytesTotal int64
+	bytesDone  int64
+	// bytesSkipped is the bytes skipped by the checkpoint, which shouldn't be counted for the throughput.
+	bytesSkipped int64
+	pending      map[string]int
+	complete     bool
+}
+
+func NewTracker(start time.Time) *Tracker {
+	return &Tracker{
+		start:   start,
+		pending: make(map[string]int),
+	}
+}
+
+// AddTotal adds the files and bytes that need to be warmed up.
+func (t *Tracker) AddTotal(files, bytes int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.filesTotal += files
+	t.bytesTotal += bytes
+}
+
+// StartFile marks that the tasks of the file are going to be sent.
+func (t *Tracker) StartFile(path string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending[path]++
+}
+
+// EndFile marks that all tasks of the file have been sent.
+func (t *Tracker) EndFile(path string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.doneLocked(path)
+}
+
+// AddTask marks that a task of the file has been sent to a worker.
+func (t *Tracker) AddTask(path string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending[path]++
+}
+
+// FinishTask marks that a task of the file has been finished.
+func (t *Tracker) FinishTask(path string, bytes int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.bytesDone += bytes
+	t.doneLocked(path)
+}
+
+// Skip marks that a task has been skipped, usually because it has been done before the checkpoint.
+func (t *Tracker) Skip(bytes int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.bytesDone += bytes
+	t.bytesSkipped += bytes
+}
+
+// MarkComplete marks that all files have been warmed up.
+func (t *Tracker) MarkComplete() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.complete = true
+}
+
+func (t *Tracker) doneLocked(path string) {
+	t.pending[path]--
+	if t.pending[path] <= 0 {
+		delete(t.pending, path)
+		t.filesDone++
+	}
+}
+
+func (t *Tracker) Snapshot(now time.Time) Snapshot {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s := Snapshot{
+		FilesDone:  t.filesDone,
+		FilesTotal: t.filesTotal,
+		BytesDone:  t.bytesDone,
+		BytesTotal: t.bytesTotal,
+		Complete:   t.complete,
+	}
+	elapsed := now.Sub(t.start).Seconds()
+	if elapsed > 0 {
+		s.Throughput = int64(float64(t.bytesDone-t.bytesSkipped) / elapsed)
+	}
+	switch {
+	case s.Complete:
+		s.EstimatedCompletion = now
+	case s.Throughput > 0:
+		remaining := s.BytesTotal - s.BytesDone
+		if remaining < 0 {
+			remaining = 0
+		}
+		s.EstimatedCompletion = now.Add(time.Duration(float64(remaining) / float64(s.Throughput) * float64(time.Second)))
+	}
+	return s
+}
@@ -38,6 +38,10 @@ type Worker struct {
 
 	OnStep      OnStepHook
 	RateLimiter *rate.Limiter
+	// Concurrency, if set, is shared by all workers to limit how many of them are reading at the same time.
+	Concurrency *ConcurrencyLimiter
+	// OnDone is called once a read task is finished, no matter whether it succeeded.
+	OnDone func(task tasks.ReadFile)
 }
 
 func New(input <-chan tasks.ReadFile) Worker {
@@ -60,6 +64,9 @@ func (w *Worker) MainLoop(cx context.Context) error {
 			if err := w.handleReadFile(task); err != nil {
 				klog.InfoS("Failed to read file.", "err", err)
 			}
+			if _, isSync := task.Type.(tasks.Sync); !isSync && w.OnDone != nil {
+				w.OnDone(task)
+			}
 		}
 	}
 }
@@ -69,6 +76,10 @@ func (w *Worker) handleReadFile(task tasks.ReadFile) error {
 		close(sync.C)
 		return nil
 	}
+	if w.Concurrency != nil {
+		w.Concurrency.Acquire()
+		defer w.Concurrency.Release()
+	}
 	fd, err := w.openFileByTask(task)
 	if err != nil {
 		return errors.Annotatef(err, "failed to open file for task %s", task)
@@ -0,0 +1,111 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auth authenticates the callers of the HTTP API and authorizes their calls.
+//
+// The authenticators run in the HTTP server, which terminates TLS and sees the credentials of the
+// callers. The principal is passed to the internal gRPC server through the gRPC metadata set by
+// the gateway, where the policy is checked against the RPC and the cluster of the request.
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+const (
+	// AnonymousName is the name of the principal when no authenticator is configured
+	AnonymousName = "system:anonymous"
+)
+
+// Principal is the authenticated caller of the API
+type Principal struct {
+	// Name is the user name of the caller
+	Name string `json:"name"`
+	// Groups are the groups of the caller
+	Groups []string `json:"groups,omitempty"`
+	// Authenticator is the name of the authenticator which authenticates the caller
+	Authenticator string `json:"authenticator"`
+}
+
+// Anonymous returns the principal used when no authenticator is configured
+func Anonymous() *Principal {
+	return &Principal{Name: AnonymousName, Authenticator: "none"}
+}
+
+// Authenticator authenticates the caller of a request
+type Authenticator interface {
+	// Name returns the name of the authenticator
+	Name() string
+	// Authenticate returns the principal of the request. ok is false if the request does not carry
+	// the kind of credential of the authenticator, err is not nil if the credential is invalid.
+	Authenticate(r *http.Request) (p *Principal, ok bool, err error)
+}
+
+// union authenticates a request by the first authenticator which accepts its credential
+type union []Authenticator
+
+// NewUnion returns an authenticator which tries the authenticators in order. The request is
+// authenticated by the first authenticator which succeeds, so a bearer token is accepted if
+// either the OIDC issuer or the TokenReview of Kubernetes accepts it.
+func NewUnion(authenticators ...Authenticator) Authenticator {
+	return union(authenticators)
+}
+
+func (u union) Name() string {
+	names := make([]string, 0, len(u))
+	for _, a := range u {
+		names = append(names, a.Name())
+	}
+	return strings.Join(names, ",")
+}
+
+func (u union) Authenticate(r *http.Request) (*Principal, bool, error) {
+	var errs []error
+	for _, a := range u {
+		p, ok, err := a.Authenticate(r)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if ok {
+			return p, true, nil
+		}
+	}
+	return nil, false, errors.Join(errs...)
+}
+
+// bearerToken returns the bearer token in the Authorization header of the request
+func bearerToken(r *http.Request) (string, bool) {
+	authz := r.Header.Get("Authorization")
+	if len(authz) < len("Bearer ") || !strings.EqualFold(authz[:len("Bearer ")], "Bearer ") {
+		return "", false
+	}
+	token := strings.TrimSpace(authz[len("Bearer "):])
+	return token, token != ""
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a context carrying the principal
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal carried by the context
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+type fakeAuthenticator struct {
+	name string
+	p    *Principal
+	ok   bool
+	err  error
+}
+
+func (a *fakeAuthenticator) Name() string {
+	return a.name
+}
+
+func (a *fakeAuthenticator) Authenticate(*http.Request) (*Principal, bool, error) {
+	return a.p, a.ok, a.err
+}
+
+func TestUnionAuthenticator(t *testing.T) {
+	alice := &Principal{Name: "alice", Authenticator: "oidc"}
+	skip := &fakeAuthenticator{name: "skip"}
+	fail := &fakeAuthenticator{name: "fail", err: errors.New("invalid token")}
+	accept := &fakeAuthenticator{name: "accept", p: alice, ok: true}
+
+	tests := []struct {
+		name           string
+		authenticators []Authenticator
+		expected       *Principal
+		expectOK       bool
+		expectErr      bool
+	}{
+		{
+			name:           "no credentials",
+			authenticators: []Authenticator{skip, skip},
+		},
+		{
+			name:           "accepted by the second",
+			authenticators: []Authenticator{skip, accept},
+			expected:       alice,
+			expectOK:       true,
+		},
+		{
+			name:           "the failure of the first is ignored if the second accepts",
+			authenticators: []Authenticator{fail, accept},
+			expected:       alice,
+			expectOK:       true,
+		},
+		{
+			name:           "all fail",
+			authenticators: []Authenticator{fail, skip},
+			expectErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, ok, err := NewUnion(tt.authenticators...).Authenticate(httptest.NewRequest("GET", "/", nil))
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expect error %v, got %v", tt.expectErr, err)
+			}
+			if ok != tt.expectOK {
+				t.Fatalf("expect ok %v, got %v", tt.expectOK, ok)
+			}
+			if !reflect.DeepEqual(p, tt.expected) {
+				t.Fatalf("expect principal %+v, got %+v", tt.expected, p)
+			}
+		})
+	}
+
+	if name := NewUnion(skip, accept).Name(); name != "skip,accept" {
+		t.Fatalf("expect name skip,accept, got %s", name)
+	}
+}
+
+func TestPrincipalMetadata(t *testing.T) {
+	p := &Principal{Name: "alice", Groups: []string{"tidb-admins", "team-a"}, Authenticator: "x509"}
+	ctx := metadata.NewIncomingContext(context.Background(), ToMetadata(p))
+	got, ok := FromIncomingContext(ctx)
+	if !ok || !reflect.DeepEqual(got, p) {
+		t.Fatalf("expect principal %+v, got %+v", p, got)
+	}
+
+	// the calls without the principal, e.g. not from the gateway, are unauthenticated
+	if _, ok = FromIncomingContext(context.Background()); ok {
+		t.Fatal("expect no principal without metadata")
+	}
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("kubernetes-id", "prod"))
+	if _, ok = FromIncomingContext(ctx); ok {
+		t.Fatal("expect no principal without the principal name")
+	}
+
+	if !IsReservedMetadataKey("X-Principal-Name") || IsReservedMetadataKey("kubernetes-id") {
+		t.Fatal("unexpected reserved metadata keys")
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// the keys of the gRPC metadata which carry the principal from the gateway to the internal gRPC server,
+// the gateway must not forward these keys from the HTTP headers of the callers.
+const (
+	MetadataKeyPrincipalName          = "x-principal-name"
+	MetadataKeyPrincipalGroups        = "x-principal-groups"
+	MetadataKeyPrincipalAuthenticator = "x-principal-authenticator"
+)
+
+// IsReservedMetadataKey returns whether the key is reserved to carry the principal, case-insensitive
+func IsReservedMetadataKey(key string) bool {
+	key = strings.ToLower(key)
+	return key == MetadataKeyPrincipalName ||
+		key == MetadataKeyPrincipalGroups ||
+		key == MetadataKeyPrincipalAuthenticator
+}
+
+// ToMetadata returns the gRPC metadata carrying the principal
+func ToMetadata(p *Principal) metadata.MD {
+	md := metadata.Pairs(
+		MetadataKeyPrincipalName, p.Name,
+		MetadataKeyPrincipalAuthenticator, p.Authenticator,
+	)
+	if len(p.Groups) > 0 {
+		md.Set(MetadataKeyPrincipalGroups, p.Groups...)
+	}
+	return md
+}
+
+// FromIncomingContext returns the principal carried by the incoming gRPC metadata
+func FromIncomingContext(ctx context.Context) (*Principal, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	names := md.Get(MetadataKeyPrincipalName)
+	if len(names) == 0 || names[0] == "" {
+		return nil, false
+	}
+	p := &Principal{
+		Name:   names[0],
+		Groups: md.Get(MetadataKeyPrincipalGroups),
+	}
+	if vals := md.Get(MetadataKeyPrincipalAuthenticator); len(vals) > 0 {
+		p.Authenticator = vals[0]
+	}
+	return p, true
+}
@@ -0,0 +1,168 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+)
+
+const (
+	defaultUsernameClaim = "sub"
+	defaultGroupsClaim   = "groups"
+)
+
+// OIDCOptions is the options of the OIDC authenticator
+type OIDCOptions struct {
+	// IssuerURL is the URL of the issuer, the tokens must be issued by it
+	IssuerURL string
+	// ClientID is the audience of the tokens
+	ClientID string
+	// UsernameClaim is the claim used as the name of the principal, default to `sub`
+	UsernameClaim string
+	// GroupsClaim is the claim used as the groups of the principal, default to `groups`
+	GroupsClaim string
+	// StaticKeysFile is a PEM file of the public keys used to verify the tokens.
+	// If it's set, the JWKS of the issuer is not discovered, which is useful for
+	// the environments without an OIDC provider, e.g. local development and tests.
+	StaticKeysFile string
+}
+
+// OIDCAuthenticator authenticates the bearer tokens which are JWTs signed by an OIDC issuer
+type OIDCAuthenticator struct {
+	verifier      *oidc.IDTokenVerifier
+	usernameClaim string
+	groupsClaim   string
+}
+
+var _ Authenticator = &OIDCAuthenticator{}
+
+// NewOIDCAuthenticator creates an OIDC authenticator, the discovery document and the JWKS
+// of the issuer are fetched unless the static keys are used.
+func NewOIDCAuthenticator(ctx context.Context, opts OIDCOptions) (*OIDCAuthenticator, error) {
+	if opts.IssuerURL == "" {
+		return nil, fmt.Errorf("issuer URL of OIDC is not set")
+	}
+	if opts.UsernameClaim == "" {
+		opts.UsernameClaim = defaultUsernameClaim
+	}
+	if opts.GroupsClaim == "" {
+		opts.GroupsClaim = defaultGroupsClaim
+	}
+	config := &oidc.Config{
+		ClientID:          opts.ClientID,
+		SkipClientIDCheck: opts.ClientID == "",
+	}
+
+	var verifier *oidc.IDTokenVerifier
+	if opts.StaticKeysFile != "" {
+		keys, err := loadPublicKeys(opts.StaticKeysFile)
+		if err != nil {
+			return nil, err
+		}
+		verifier = oidc.NewVerifier(opts.IssuerURL, &oidc.StaticKeySet{PublicKeys: keys}, config)
+	} else {
+		provider, err := oidc.NewProvider(ctx, opts.IssuerURL)
+		if err != nil {
+			return nil, fmt.Errorf("discover OIDC issuer %s failed: %v", opts.IssuerURL, err)
+		}
+		verifier = provider.Verifier(config)
+	}
+
+	return &OIDCAuthenticator{
+		verifier:      verifier,
+		usernameClaim: opts.UsernameClaim,
+		groupsClaim:   opts.GroupsClaim,
+	}, nil
+}
+
+func (a *OIDCAuthenticator) Name() string {
+	return "oidc"
+}
+
+func (a *OIDCAuthenticator) Authenticate(r *http.Request) (*Principal, bool, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return nil, false, nil
+	}
+	idToken, err := a.verifier.Verify(r.Context(), token)
+	if err != nil {
+		return nil, false, fmt.Errorf("verify OIDC token failed: %v", err)
+	}
+
+	var claims map[string]interface{}
+	if err = idToken.Claims(&claims); err != nil {
+		return nil, false, fmt.Errorf("parse claims of OIDC token failed: %v", err)
+	}
+	name, ok := claims[a.usernameClaim].(string)
+	if !ok || name == "" {
+		return nil, false, fmt.Errorf("claim %q of OIDC token is not a non-empty string", a.usernameClaim)
+	}
+
+	p := &Principal{Name: name, Authenticator: a.Name()}
+	switch groups := claims[a.groupsClaim].(type) {
+	case string:
+		p.Groups = []string{groups}
+	case []interface{}:
+		for _, g := range groups {
+			if s, ok := g.(string); ok {
+				p.Groups = append(p.Groups, s)
+			}
+		}
+	}
+	return p, true, nil
+}
+
+// loadPublicKeys loads the public keys in a PEM file, both the `PUBLIC KEY` blocks and
+// the `CERTIFICATE` blocks are accepted.
+func loadPublicKeys(file string) ([]crypto.PublicKey, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read static keys file %s failed: %v", file, err)
+	}
+
+	var keys []crypto.PublicKey
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("parse public key in %s failed: %v", file, err)
+			}
+			keys = append(keys, key)
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("parse certificate in %s failed: %v", file, err)
+			}
+			keys = append(keys, cert.PublicKey)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no public key found in %s", file)
+	}
+	return keys, nil
+}
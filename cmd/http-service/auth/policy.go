@@ -0,0 +1,138 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Policy restricts the RPCs and the clusters each principal may call.
+//
+// A call is allowed if any rule matches it, and denied if no rule matches it.
+// An example of the policy file:
+//
+//	rules:
+//	# the admins can call any RPC on any cluster
+//	- groups: ["tidb-admins"]
+//	# the team can only read their own clusters in the `prod` Kubernetes cluster
+//	- users: ["alice", "system:serviceaccount:team-a:dashboard"]
+//	  kubernetes: ["prod"]
+//	  clusters: ["team-a-*"]
+//	  methods: ["Get*", "List*"]
+type Policy struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Rule allows the principals to call the methods on the clusters.
+// The fields other than Users and Groups are glob patterns and an empty field matches anything.
+type Rule struct {
+	// Users are the names of the principals, `*` matches all authenticated principals
+	Users []string `json:"users,omitempty"`
+	// Groups are the groups of the principals
+	Groups []string `json:"groups,omitempty"`
+	// Kubernetes are the `kubernetes-id` of the calls
+	Kubernetes []string `json:"kubernetes,omitempty"`
+	// Clusters are the `cluster_id`, i.e. the namespaces, of the calls
+	Clusters []string `json:"clusters,omitempty"`
+	// Methods are the names of the RPCs, e.g. `GetCluster`
+	Methods []string `json:"methods,omitempty"`
+}
+
+// LoadPolicy loads the policy from a YAML or JSON file
+func LoadPolicy(file string) (*Policy, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read policy file %s failed: %v", file, err)
+	}
+	p := &Policy{}
+	if err = yaml.UnmarshalStrict(data, p); err != nil {
+		return nil, fmt.Errorf("parse policy file %s failed: %v", file, err)
+	}
+	for i, r := range p.Rules {
+		if len(r.Users) == 0 && len(r.Groups) == 0 {
+			return nil, fmt.Errorf("rule %d in policy file %s matches no principal, users or groups must be set", i, file)
+		}
+		for _, patterns := range [][]string{r.Kubernetes, r.Clusters, r.Methods} {
+			for _, pattern := range patterns {
+				if _, err = path.Match(pattern, ""); err != nil {
+					return nil, fmt.Errorf("rule %d in policy file %s has an invalid pattern %q: %v", i, file, pattern, err)
+				}
+			}
+		}
+	}
+	return p, nil
+}
+
+// Allowed returns whether the principal may call the method on the cluster.
+// A nil policy allows all calls so that the authorization is disabled if no policy is configured.
+func (p *Policy) Allowed(principal *Principal, k8sID, clusterID, method string) bool {
+	if p == nil {
+		return true
+	}
+	if principal == nil {
+		return false
+	}
+	for i := range p.Rules {
+		if p.Rules[i].matches(principal, k8sID, clusterID, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matches(principal *Principal, k8sID, clusterID, method string) bool {
+	return r.matchesPrincipal(principal) &&
+		matchesPatterns(r.Kubernetes, k8sID) &&
+		matchesPatterns(r.Clusters, clusterID) &&
+		matchesPatterns(r.Methods, method)
+}
+
+func (r *Rule) matchesPrincipal(principal *Principal) bool {
+	for _, u := range r.Users {
+		if u == "*" || u == principal.Name {
+			return true
+		}
+	}
+	for _, g := range r.Groups {
+		for _, pg := range principal.Groups {
+			if g == pg {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchesPatterns(patterns []string, s string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, s); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// IsMutating returns whether the RPC changes any resource,
+// all the read-only RPCs are named `Get*` or `List*`.
+func IsMutating(method string) bool {
+	return !strings.HasPrefix(method, "Get") && !strings.HasPrefix(method, "List")
+}
@@ -0,0 +1,230 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testPolicy = `
+rules:
+- groups: ["tidb-admins"]
+- users: ["alice", "system:serviceaccount:team-a:dashboard"]
+  kubernetes: ["prod"]
+  clusters: ["team-a-*"]
+  methods: ["Get*", "List*"]
+- users: ["*"]
+  methods: ["ListKubernetes"]
+`
+
+func writePolicy(t *testing.T, content string) string {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "policy.yaml")
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestLoadPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		rules     int
+		expectErr bool
+	}{
+		{
+			name:    "valid",
+			content: testPolicy,
+			rules:   3,
+		},
+		{
+			name:    "empty",
+			content: "",
+			rules:   0,
+		},
+		{
+			name:      "unknown field",
+			content:   "rules:\n- users: [alice]\n  namespaces: [team-a]\n",
+			expectErr: true,
+		},
+		{
+			name:      "no principal",
+			content:   "rules:\n- clusters: [team-a]\n",
+			expectErr: true,
+		},
+		{
+			name:      "invalid pattern",
+			content:   "rules:\n- users: [alice]\n  methods: [\"Get[\"]\n",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := LoadPolicy(writePolicy(t, tt.content))
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expect error %v, got %v", tt.expectErr, err)
+			}
+			if err == nil && len(p.Rules) != tt.rules {
+				t.Fatalf("expect %d rules, got %d", tt.rules, len(p.Rules))
+			}
+		})
+	}
+
+	if _, err := LoadPolicy(filepath.Join(t.TempDir(), "not-found.yaml")); err == nil {
+		t.Fatal("expect error for a policy file not found")
+	}
+}
+
+func TestPolicyAllowed(t *testing.T) {
+	policy, err := LoadPolicy(writePolicy(t, testPolicy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	admin := &Principal{Name: "bob", Groups: []string{"tidb-admins"}, Authenticator: "oidc"}
+	alice := &Principal{Name: "alice", Authenticator: "x509"}
+	dashboard := &Principal{Name: "system:serviceaccount:team-a:dashboard", Groups: []string{"system:serviceaccounts"}, Authenticator: "tokenreview"}
+	other := &Principal{Name: "carol", Groups: []string{"team-b"}, Authenticator: "oidc"}
+
+	tests := []struct {
+		name      string
+		policy    *Policy
+		principal *Principal
+		k8sID     string
+		clusterID string
+		method    string
+		allowed   bool
+	}{
+		{
+			name:      "no policy allows all calls",
+			principal: other,
+			k8sID:     "prod",
+			clusterID: "team-b-db",
+			method:    "DeleteCluster",
+			allowed:   true,
+		},
+		{
+			name:      "unauthenticated call is denied",
+			policy:    policy,
+			k8sID:     "prod",
+			clusterID: "team-a-db",
+			method:    "GetCluster",
+			allowed:   false,
+		},
+		{
+			name:      "group matches",
+			policy:    policy,
+			principal: admin,
+			k8sID:     "prod",
+			clusterID: "team-b-db",
+			method:    "DeleteCluster",
+			allowed:   true,
+		},
+		{
+			name:      "user matches",
+			policy:    policy,
+			principal: alice,
+			k8sID:     "prod",
+			clusterID: "team-a-db",
+			method:    "GetCluster",
+			allowed:   true,
+		},
+		{
+			name:      "service account matches",
+			policy:    policy,
+			principal: dashboard,
+			k8sID:     "prod",
+			clusterID: "team-a-db",
+			method:    "ListBackup",
+			allowed:   true,
+		},
+		{
+			name:      "method misses",
+			policy:    policy,
+			principal: alice,
+			k8sID:     "prod",
+			clusterID: "team-a-db",
+			method:    "DeleteCluster",
+			allowed:   false,
+		},
+		{
+			name:      "cluster misses",
+			policy:    policy,
+			principal: alice,
+			k8sID:     "prod",
+			clusterID: "team-b-db",
+			method:    "GetCluster",
+			allowed:   false,
+		},
+		{
+			name:      "kubernetes misses",
+			policy:    policy,
+			principal: alice,
+			k8sID:     "staging",
+			clusterID: "team-a-db",
+			method:    "GetCluster",
+			allowed:   false,
+		},
+		{
+			name:      "wildcard user matches all authenticated principals",
+			policy:    policy,
+			principal: other,
+			method:    "ListKubernetes",
+			allowed:   true,
+		},
+		{
+			name:      "principal misses",
+			policy:    policy,
+			principal: other,
+			k8sID:     "prod",
+			clusterID: "team-a-db",
+			method:    "GetCluster",
+			allowed:   false,
+		},
+		{
+			name:      "empty policy denies all calls",
+			policy:    &Policy{},
+			principal: admin,
+			k8sID:     "prod",
+			clusterID: "team-a-db",
+			method:    "GetCluster",
+			allowed:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if allowed := tt.policy.Allowed(tt.principal, tt.k8sID, tt.clusterID, tt.method); allowed != tt.allowed {
+				t.Fatalf("expect allowed %v, got %v", tt.allowed, allowed)
+			}
+		})
+	}
+}
+
+func TestIsMutating(t *testing.T) {
+	for method, mutating := range map[string]bool{
+		"GetCluster":     false,
+		"ListKubernetes": false,
+		"CreateCluster":  true,
+		"DeleteBackup":   true,
+		"PauseCluster":   true,
+	} {
+		if IsMutating(method) != mutating {
+			t.Errorf("expect IsMutating(%s) to be %v", method, mutating)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	authnv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// the results of TokenReview are cached for a short time to not send a TokenReview for each request
+	tokenReviewCacheTTL = 10 * time.Second
+	// the expired results are removed when the cache grows to this size
+	tokenReviewCacheCleanSize = 1024
+)
+
+// TokenReviewAuthenticator authenticates the bearer tokens by the TokenReview API of Kubernetes,
+// so the ServiceAccount tokens and any other tokens accepted by the API server can be used.
+type TokenReviewAuthenticator struct {
+	kubeCli   kubernetes.Interface
+	audiences []string
+
+	mu    sync.Mutex
+	cache map[[sha256.Size]byte]*tokenReviewResult
+}
+
+type tokenReviewResult struct {
+	principal *Principal
+	err       error
+	expiresAt time.Time
+}
+
+var _ Authenticator = &TokenReviewAuthenticator{}
+
+// NewTokenReviewAuthenticator creates a TokenReview authenticator, the audiences are the intended
+// audiences of the tokens and may be empty to use the audiences of the API server.
+func NewTokenReviewAuthenticator(kubeCli kubernetes.Interface, audiences []string) *TokenReviewAuthenticator {
+	return &TokenReviewAuthenticator{
+		kubeCli:   kubeCli,
+		audiences: audiences,
+		cache:     make(map[[sha256.Size]byte]*tokenReviewResult),
+	}
+}
+
+func (a *TokenReviewAuthenticator) Name() string {
+	return "tokenreview"
+}
+
+func (a *TokenReviewAuthenticator) Authenticate(r *http.Request) (*Principal, bool, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return nil, false, nil
+	}
+
+	key := sha256.Sum256([]byte(token))
+	now := time.Now()
+	a.mu.Lock()
+	if res, ok := a.cache[key]; ok && now.Before(res.expiresAt) {
+		a.mu.Unlock()
+		return res.principal, res.err == nil, res.err
+	}
+	a.mu.Unlock()
+
+	p, err := a.review(r, token)
+	if err != nil && !errors.Is(err, errTokenRejected) {
+		// do not cache the failures of the API server
+		return nil, false, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.cache) >= tokenReviewCacheCleanSize {
+		for k, res := range a.cache {
+			if now.After(res.expiresAt) {
+				delete(a.cache, k)
+			}
+		}
+	}
+	a.cache[key] = &tokenReviewResult{principal: p, err: err, expiresAt: now.Add(tokenReviewCacheTTL)}
+	return p, err == nil, err
+}
+
+var errTokenRejected = errors.New("token is rejected by TokenReview")
+
+func (a *TokenReviewAuthenticator) review(r *http.Request, token string) (*Principal, error) {
+	tr := &authnv1.TokenReview{
+		Spec: authnv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: a.audiences,
+		},
+	}
+	result, err := a.kubeCli.AuthenticationV1().TokenReviews().Create(r.Context(), tr, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("create TokenReview failed: %v", err)
+	}
+	if !result.Status.Authenticated {
+		if result.Status.Error != "" {
+			return nil, fmt.Errorf("%w: %s", errTokenRejected, result.Status.Error)
+		}
+		return nil, errTokenRejected
+	}
+	return &Principal{
+		Name:          result.Status.User.Username,
+		Groups:        result.Status.User.Groups,
+		Authenticator: a.Name(),
+	}, nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	authnv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newFakeTokenReviewClient returns a clientset whose TokenReview authenticates the tokens in users,
+// and fails with apiErr if it's not nil. The number of the TokenReviews is counted in calls.
+func newFakeTokenReviewClient(users map[string]authnv1.UserInfo, apiErr *error, calls *int, audiences *[]string) *fake.Clientset {
+	cli := fake.NewSimpleClientset()
+	cli.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		*calls++
+		if *apiErr != nil {
+			return true, nil, *apiErr
+		}
+		tr := action.(k8stesting.CreateAction).GetObject().(*authnv1.TokenReview).DeepCopy()
+		*audiences = tr.Spec.Audiences
+		if user, ok := users[tr.Spec.Token]; ok {
+			tr.Status.Authenticated = true
+			tr.Status.User = user
+		} else if tr.Spec.Token == "revoked" {
+			tr.Status.Error = "token has been invalidated"
+		}
+		return true, tr, nil
+	})
+	return cli
+}
+
+func TestTokenReviewAuthenticator(t *testing.T) {
+	users := map[string]authnv1.UserInfo{
+		"sa-token": {
+			Username: "system:serviceaccount:team-a:dashboard",
+			Groups:   []string{"system:serviceaccounts", "system:serviceaccounts:team-a"},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		apiErr        error
+		expected      *Principal
+		expectOK      bool
+		expectErr     bool
+		expectCalls   int
+	}{
+		{
+			name: "no authorization header",
+		},
+		{
+			name:          "empty bearer token",
+			authorization: "Bearer ",
+		},
+		{
+			name:          "authenticated token",
+			authorization: "Bearer sa-token",
+			expected: &Principal{
+				Name:          "system:serviceaccount:team-a:dashboard",
+				Groups:        []string{"system:serviceaccounts", "system:serviceaccounts:team-a"},
+				Authenticator: "tokenreview",
+			},
+			expectOK:    true,
+			expectCalls: 1,
+		},
+		{
+			name:          "case-insensitive scheme",
+			authorization: "bearer sa-token",
+			expected: &Principal{
+				Name:          "system:serviceaccount:team-a:dashboard",
+				Groups:        []string{"system:serviceaccounts", "system:serviceaccounts:team-a"},
+				Authenticator: "tokenreview",
+			},
+			expectOK:    true,
+			expectCalls: 1,
+		},
+		{
+			name:          "unknown token",
+			authorization: "Bearer unknown",
+			expectErr:     true,
+			expectCalls:   1,
+		},
+		{
+			name:          "token rejected with a reason",
+			authorization: "Bearer revoked",
+			expectErr:     true,
+			expectCalls:   1,
+		},
+		{
+			name:          "API server fails",
+			authorization: "Bearer sa-token",
+			apiErr:        errors.New("connection refused"),
+			expectErr:     true,
+			// the failures of the API server are not cached
+			expectCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			var audiences []string
+			apiErr := tt.apiErr
+			a := NewTokenReviewAuthenticator(newFakeTokenReviewClient(users, &apiErr, &calls, &audiences), []string{"tidb-operator"})
+
+			// authenticate twice, the result is cached for the second time
+			for i := 0; i < 2; i++ {
+				r := httptest.NewRequest("GET", "/api/v1/clusters", nil)
+				if tt.authorization != "" {
+					r.Header.Set("Authorization", tt.authorization)
+				}
+				p, ok, err := a.Authenticate(r)
+				if (err != nil) != tt.expectErr {
+					t.Fatalf("expect error %v, got %v", tt.expectErr, err)
+				}
+				if ok != tt.expectOK {
+					t.Fatalf("expect ok %v, got %v", tt.expectOK, ok)
+				}
+				if !reflect.DeepEqual(p, tt.expected) {
+					t.Fatalf("expect principal %+v, got %+v", tt.expected, p)
+				}
+			}
+			if calls != tt.expectCalls {
+				t.Fatalf("expect %d TokenReviews, got %d", tt.expectCalls, calls)
+			}
+			if calls > 0 && tt.apiErr == nil && !reflect.DeepEqual(audiences, []string{"tidb-operator"}) {
+				t.Fatalf("expect audiences [tidb-operator], got %v", audiences)
+			}
+		})
+	}
+}
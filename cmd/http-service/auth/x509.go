@@ -0,0 +1,50 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"errors"
+	"net/http"
+)
+
+// X509Authenticator authenticates the callers by their TLS client certificates, the certificates
+// are verified against the client CA by the TLS server. The common name is used as the name of
+// the principal and the organizations are used as the groups, the same as Kubernetes.
+type X509Authenticator struct{}
+
+var _ Authenticator = X509Authenticator{}
+
+func (X509Authenticator) Name() string {
+	return "x509"
+}
+
+func (a X509Authenticator) Authenticate(r *http.Request) (*Principal, bool, error) {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return nil, false, nil
+	}
+	// the client certificate is optional for the TLS server so that the bearer tokens can be used,
+	// a certificate which is presented but not verified must be rejected here
+	if len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, false, errors.New("the client certificate is not verified")
+	}
+	cert := r.TLS.VerifiedChains[0][0]
+	if cert.Subject.CommonName == "" {
+		return nil, false, errors.New("the common name of the client certificate is empty")
+	}
+	return &Principal{
+		Name:          cert.Subject.CommonName,
+		Groups:        cert.Subject.Organization,
+		Authenticator: a.Name(),
+	}, true, nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newCertificate returns a self-signed certificate of the subject
+func newCertificate(t *testing.T, subject pkix.Name) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      subject,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func TestX509Authenticator(t *testing.T) {
+	cert := newCertificate(t, pkix.Name{CommonName: "alice", Organization: []string{"team-a", "tidb-admins"}})
+	noCN := newCertificate(t, pkix.Name{Organization: []string{"tidb-admins"}})
+
+	tests := []struct {
+		name      string
+		tls       *tls.ConnectionState
+		expected  *Principal
+		expectOK  bool
+		expectErr bool
+	}{
+		{
+			name: "plain HTTP",
+		},
+		{
+			name: "no client certificate",
+			tls:  &tls.ConnectionState{},
+		},
+		{
+			name: "verified client certificate",
+			tls: &tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{cert},
+				VerifiedChains:   [][]*x509.Certificate{{cert}},
+			},
+			expected: &Principal{Name: "alice", Groups: []string{"team-a", "tidb-admins"}, Authenticator: "x509"},
+			expectOK: true,
+		},
+		{
+			name: "client certificate not verified",
+			tls: &tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{cert},
+			},
+			expectErr: true,
+		},
+		{
+			name: "empty common name",
+			tls: &tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{noCN},
+				VerifiedChains:   [][]*x509.Certificate{{noCN}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/api/v1/clusters", nil)
+			r.TLS = tt.tls
+			p, ok, err := X509Authenticator{}.Authenticate(r)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expect error %v, got %v", tt.expectErr, err)
+			}
+			if ok != tt.expectOK {
+				t.Fatalf("expect ok %v, got %v", tt.expectOK, ok)
+			}
+			if !reflect.DeepEqual(p, tt.expected) {
+				t.Fatalf("expect principal %+v, got %+v", tt.expected, p)
+			}
+		})
+	}
+}
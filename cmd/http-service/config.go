@@ -16,7 +16,9 @@ package main
 import (
 	"flag"
 	"fmt"
+	"time"
 
+	"github.com/pingcap/tidb-operator/http-service/kube"
 	"github.com/pingcap/tidb-operator/http-service/version"
 )
 
@@ -45,6 +47,53 @@ type Config struct {
 
 	// Kubeconfig is the path to Kubeconfig.
 	Kubeconfig string `toml:"kubeconfig" json:"kubeconfig"`
+	// KubeconfigDir is the directory of kubeconfig files, each file is a Kubernetes cluster.
+	KubeconfigDir string `toml:"kubeconfig-dir" json:"kubeconfig-dir"`
+	// KubeconfigSecretNamespace is the namespace of the Secrets containing kubeconfigs.
+	KubeconfigSecretNamespace string `toml:"kubeconfig-secret-namespace" json:"kubeconfig-secret-namespace"`
+	// KubeconfigSecretSelector is the label selector of the Secrets containing kubeconfigs.
+	KubeconfigSecretSelector string `toml:"kubeconfig-secret-selector" json:"kubeconfig-secret-selector"`
+	// KubernetesClusterNamespace is the namespace of the KubernetesCluster objects.
+	KubernetesClusterNamespace string `toml:"kubernetes-cluster-namespace" json:"kubernetes-cluster-namespace"`
+	// KubeResyncPeriod is the period to reload the Kubernetes clusters.
+	KubeResyncPeriod time.Duration `toml:"kube-resync-period" json:"kube-resync-period"`
+	// KubeHealthCheckInterval is the interval to check the health of the Kubernetes clusters.
+	KubeHealthCheckInterval time.Duration `toml:"kube-health-check-interval" json:"kube-health-check-interval"`
+	// KubeQPS and KubeBurst are the rate limit of the requests to each Kubernetes cluster.
+	KubeQPS   float64 `toml:"kube-qps" json:"kube-qps"`
+	KubeBurst int     `toml:"kube-burst" json:"kube-burst"`
+	// KubeMaxInflight is the max number of the in-flight requests to each Kubernetes cluster.
+	KubeMaxInflight int `toml:"kube-max-inflight" json:"kube-max-inflight"`
+	// KubeRequestTimeout is the timeout of each request to the Kubernetes clusters.
+	KubeRequestTimeout time.Duration `toml:"kube-request-timeout" json:"kube-request-timeout"`
+
+	// TLSCertFile and TLSKeyFile are the certificate and key to serve HTTPS, HTTP is served if they are not set.
+	TLSCertFile string `toml:"tls-cert-file" json:"tls-cert-file"`
+	TLSKeyFile  string `toml:"tls-key-file" json:"tls-key-file"`
+	// ClientCAFile is the CA to verify the client certificates, the callers presenting a client
+	// certificate signed by it are authenticated by the common name of the certificate.
+	ClientCAFile string `toml:"client-ca-file" json:"client-ca-file"`
+
+	// OIDCIssuerURL is the issuer of the bearer tokens, the OIDC authentication is enabled if it's set.
+	OIDCIssuerURL string `toml:"oidc-issuer-url" json:"oidc-issuer-url"`
+	// OIDCClientID is the audience of the bearer tokens.
+	OIDCClientID string `toml:"oidc-client-id" json:"oidc-client-id"`
+	// OIDCUsernameClaim is the claim used as the user name.
+	OIDCUsernameClaim string `toml:"oidc-username-claim" json:"oidc-username-claim"`
+	// OIDCGroupsClaim is the claim used as the groups.
+	OIDCGroupsClaim string `toml:"oidc-groups-claim" json:"oidc-groups-claim"`
+	// OIDCStaticKeysFile is a PEM file of the public keys to verify the bearer tokens instead of the JWKS of the issuer.
+	OIDCStaticKeysFile string `toml:"oidc-static-keys-file" json:"oidc-static-keys-file"`
+
+	// TokenReview enables authenticating the bearer tokens by the TokenReview API of Kubernetes.
+	TokenReview bool `toml:"token-review" json:"token-review"`
+	// TokenReviewKubernetesID is the `kubernetes-id` of the Kubernetes cluster to send TokenReview to.
+	TokenReviewKubernetesID string `toml:"token-review-kubernetes-id" json:"token-review-kubernetes-id"`
+	// TokenReviewAudiences is the comma-separated audiences of the bearer tokens.
+	TokenReviewAudiences string `toml:"token-review-audiences" json:"token-review-audiences"`
+
+	// PolicyFile is the path to the authorization policy, all calls are allowed if it's not set.
+	PolicyFile string `toml:"policy-file" json:"policy-file"`
 }
 
 func NewConfig() *Config {
@@ -61,6 +110,28 @@ func NewConfig() *Config {
 	cfg.flagSet.StringVar(&cfg.Addr, "addr", cfg.Addr, "address to listen on")
 	cfg.flagSet.StringVar(&cfg.InternalGRPCAddr, "internal-grpc-addr", cfg.InternalGRPCAddr, "address of internal grpc server")
 	cfg.flagSet.StringVar(&cfg.Kubeconfig, "kubeconfig", cfg.Kubeconfig, "path to kubeconfig")
+	cfg.flagSet.StringVar(&cfg.KubeconfigDir, "kubeconfig-dir", "", "directory of kubeconfig files, the file names without the extension are used as the kubernetes-id")
+	cfg.flagSet.StringVar(&cfg.KubeconfigSecretNamespace, "kubeconfig-secret-namespace", "", "namespace of the Secrets containing kubeconfigs, the Secrets are not loaded if it's empty")
+	cfg.flagSet.StringVar(&cfg.KubeconfigSecretSelector, "kubeconfig-secret-selector", kube.LabelKeyKubeconfig+"=true", "label selector of the Secrets containing kubeconfigs")
+	cfg.flagSet.StringVar(&cfg.KubernetesClusterNamespace, "kubernetes-cluster-namespace", "", "namespace of the KubernetesCluster objects, the objects are not loaded if it's empty")
+	cfg.flagSet.DurationVar(&cfg.KubeResyncPeriod, "kube-resync-period", kube.DefaultResyncPeriod, "period to reload the Kubernetes clusters")
+	cfg.flagSet.DurationVar(&cfg.KubeHealthCheckInterval, "kube-health-check-interval", kube.DefaultHealthCheckInterval, "interval to check the health of the Kubernetes clusters")
+	cfg.flagSet.Float64Var(&cfg.KubeQPS, "kube-qps", float64(kube.DefaultQPS), "max QPS of the requests to each Kubernetes cluster")
+	cfg.flagSet.IntVar(&cfg.KubeBurst, "kube-burst", kube.DefaultBurst, "max burst of the requests to each Kubernetes cluster")
+	cfg.flagSet.IntVar(&cfg.KubeMaxInflight, "kube-max-inflight", kube.DefaultMaxInflight, "max number of the in-flight requests to each Kubernetes cluster")
+	cfg.flagSet.DurationVar(&cfg.KubeRequestTimeout, "kube-request-timeout", kube.DefaultRequestTimeout, "timeout of each request to the Kubernetes clusters")
+	cfg.flagSet.StringVar(&cfg.TLSCertFile, "tls-cert-file", "", "path to the certificate to serve HTTPS")
+	cfg.flagSet.StringVar(&cfg.TLSKeyFile, "tls-key-file", "", "path to the key to serve HTTPS")
+	cfg.flagSet.StringVar(&cfg.ClientCAFile, "client-ca-file", "", "path to the CA to authenticate the client certificates")
+	cfg.flagSet.StringVar(&cfg.OIDCIssuerURL, "oidc-issuer-url", "", "URL of the OIDC issuer to authenticate the bearer tokens")
+	cfg.flagSet.StringVar(&cfg.OIDCClientID, "oidc-client-id", "", "audience of the OIDC tokens")
+	cfg.flagSet.StringVar(&cfg.OIDCUsernameClaim, "oidc-username-claim", "sub", "claim of the OIDC tokens used as the user name")
+	cfg.flagSet.StringVar(&cfg.OIDCGroupsClaim, "oidc-groups-claim", "groups", "claim of the OIDC tokens used as the groups")
+	cfg.flagSet.StringVar(&cfg.OIDCStaticKeysFile, "oidc-static-keys-file", "", "path to the PEM public keys to verify the OIDC tokens instead of the JWKS of the issuer")
+	cfg.flagSet.BoolVar(&cfg.TokenReview, "token-review", false, "authenticate the bearer tokens by the TokenReview API of Kubernetes")
+	cfg.flagSet.StringVar(&cfg.TokenReviewKubernetesID, "token-review-kubernetes-id", "", "kubernetes-id of the Kubernetes cluster to send TokenReview to")
+	cfg.flagSet.StringVar(&cfg.TokenReviewAudiences, "token-review-audiences", "", "comma-separated audiences of the tokens for TokenReview")
+	cfg.flagSet.StringVar(&cfg.PolicyFile, "policy-file", "", "path to the authorization policy, all calls are allowed if it's not set")
 
 	return cfg
 }
@@ -75,5 +146,32 @@ func (c *Config) Parse(args []string) error {
 		fmt.Println(version.GetRawInfo())
 		return flag.ErrHelp
 	}
+
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return fmt.Errorf("tls-cert-file and tls-key-file must be set together")
+	}
+	if c.ClientCAFile != "" && c.TLSCertFile == "" {
+		return fmt.Errorf("client-ca-file requires tls-cert-file and tls-key-file")
+	}
+	if c.OIDCStaticKeysFile != "" && c.OIDCIssuerURL == "" {
+		return fmt.Errorf("oidc-static-keys-file requires oidc-issuer-url")
+	}
 	return nil
 }
+
+// KubeOptions returns the options of the Kubernetes clusters
+func (c *Config) KubeOptions() kube.Options {
+	return kube.Options{
+		Kubeconfig:          c.Kubeconfig,
+		KubeconfigDir:       c.KubeconfigDir,
+		SecretNamespace:     c.KubeconfigSecretNamespace,
+		SecretSelector:      c.KubeconfigSecretSelector,
+		CRDNamespace:        c.KubernetesClusterNamespace,
+		ResyncPeriod:        c.KubeResyncPeriod,
+		HealthCheckInterval: c.KubeHealthCheckInterval,
+		QPS:                 float32(c.KubeQPS),
+		Burst:               c.KubeBurst,
+		MaxInflight:         c.KubeMaxInflight,
+		RequestTimeout:      c.KubeRequestTimeout,
+	}
+}
@@ -14,103 +14,371 @@
 package kube
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/flowcontrol"
 
 	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
 )
 
 const (
 	defaultContext = "default"
+
+	// we use the same QPS and Burst as for the API server which is running this manager now
+	DefaultQPS   = float32(100)
+	DefaultBurst = 100
+
+	DefaultMaxInflight         = 50
+	DefaultRequestTimeout      = 30 * time.Second
+	DefaultResyncPeriod        = 30 * time.Second
+	DefaultHealthCheckInterval = 30 * time.Second
+	defaultHealthCheckTimeout  = 5 * time.Second
 )
 
+// Options is the options of the target clusters
+type Options struct {
+	// Kubeconfig is the path to a kubeconfig, all the contexts in it are loaded.
+	// The cluster this service is running in is loaded as `default` if it's not set.
+	Kubeconfig string
+	// KubeconfigDir is the directory of the kubeconfig files, the file names without the extension are used as the IDs.
+	KubeconfigDir string
+	// SecretNamespace is the namespace of the Secrets containing the kubeconfigs, the Secrets are not loaded if it's empty.
+	SecretNamespace string
+	// SecretSelector is the label selector of the Secrets containing the kubeconfigs.
+	SecretSelector string
+	// CRDNamespace is the namespace of the KubernetesCluster objects, the objects are not loaded if it's empty.
+	CRDNamespace string
+
+	// ResyncPeriod is the period to reload the clusters from the sources.
+	ResyncPeriod time.Duration
+	// HealthCheckInterval is the interval to check the health of the API servers.
+	HealthCheckInterval time.Duration
+
+	// QPS and Burst are the default rate limit of the requests to each cluster.
+	QPS   float32
+	Burst int
+	// MaxInflight is the max number of the in-flight requests to each cluster.
+	MaxInflight int
+	// RequestTimeout is the timeout of each request to the API servers, including the time waiting for the limiters.
+	RequestTimeout time.Duration
+}
+
+// Health is the result of the last health check of a cluster
+type Health struct {
+	Healthy       bool
+	Version       string
+	Message       string
+	LastProbeTime time.Time
+	Latency       time.Duration
+}
+
+// ClusterInfo is the information of a target cluster
+type ClusterInfo struct {
+	ID          string
+	Source      string
+	QPS         float32
+	Burst       int
+	MaxInflight int
+	Inflight    int
+	Health
+}
+
+// cluster is a target cluster and its clients
+type cluster struct {
+	id     string
+	source string
+	hash   string
+	qps    float32
+	burst  int
+
+	opCli   versioned.Interface
+	kubeCli kubernetes.Interface
+	limiter *inflightLimiter
+
+	mu     sync.RWMutex
+	health Health
+}
+
+// KubeClient is the registry of the target clusters, the clusters are reloaded from the sources periodically.
 type KubeClient struct {
 	// inCluster indicates whether this app is running in k8s cluster.
 	// When it's true, no `kubenetes-id` for some APIs is needed.
 	inCluster bool
 
-	opClients   map[string]versioned.Interface  // context --> TiDB Operator client
-	kubeClients map[string]kubernetes.Interface // context --> k8s client
+	opts    Options
+	sources []Source
+	// lastTargets are the targets of each source in the last successful load,
+	// they are kept if a source fails so that a transient error does not remove the clusters.
+	lastTargets map[string][]*Target
+
+	mu       sync.RWMutex
+	clusters map[string]*cluster // kubernetes-id --> cluster
 }
 
-func (kc *KubeClient) GetOperatorClient(context string) versioned.Interface {
+func (kc *KubeClient) getCluster(context string) *cluster {
 	if context == "" && kc.inCluster {
 		context = defaultContext
 	}
-	return kc.opClients[context]
+	kc.mu.RLock()
+	defer kc.mu.RUnlock()
+	return kc.clusters[context]
 }
 
-func (kc *KubeClient) GetKubeClient(context string) kubernetes.Interface {
-	if context == "" && kc.inCluster {
-		context = defaultContext
+func (kc *KubeClient) GetOperatorClient(context string) versioned.Interface {
+	c := kc.getCluster(context)
+	if c == nil {
+		return nil
 	}
-	return kc.kubeClients[context]
+	return c.opCli
 }
 
-func InitKubeClients(kubeconfigPath string) (*KubeClient, error) {
-	kc := &KubeClient{
-		opClients:   make(map[string]versioned.Interface),
-		kubeClients: make(map[string]kubernetes.Interface),
+func (kc *KubeClient) GetKubeClient(context string) kubernetes.Interface {
+	c := kc.getCluster(context)
+	if c == nil {
+		return nil
 	}
-	ctxNames := make([]string, 0)
+	return c.kubeCli
+}
 
-	if kubeconfigPath != "" {
-		kubeConfig, err := clientcmd.LoadFromFile(kubeconfigPath)
-		if err != nil {
-			return nil, err
-		}
+// List returns the information of all the target clusters sorted by ID
+func (kc *KubeClient) List() []ClusterInfo {
+	kc.mu.RLock()
+	infos := make([]ClusterInfo, 0, len(kc.clusters))
+	for _, c := range kc.clusters {
+		c.mu.RLock()
+		infos = append(infos, ClusterInfo{
+			ID:          c.id,
+			Source:      c.source,
+			QPS:         c.qps,
+			Burst:       c.burst,
+			MaxInflight: c.limiter.Max(),
+			Inflight:    c.limiter.Inflight(),
+			Health:      c.health,
+		})
+		c.mu.RUnlock()
+	}
+	kc.mu.RUnlock()
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+	return infos
+}
 
-		for contextName := range kubeConfig.Contexts {
-			cfg, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
-				&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfigPath},
-				&clientcmd.ConfigOverrides{CurrentContext: contextName}).ClientConfig()
-			if err != nil {
-				return nil, err // return error if any kube client init failed
-			}
+func InitKubeClients(opts Options) (*KubeClient, error) {
+	if opts.ResyncPeriod <= 0 {
+		opts.ResyncPeriod = DefaultResyncPeriod
+	}
+	if opts.HealthCheckInterval <= 0 {
+		opts.HealthCheckInterval = DefaultHealthCheckInterval
+	}
+	if opts.QPS <= 0 {
+		opts.QPS = DefaultQPS
+	}
+	if opts.Burst <= 0 {
+		opts.Burst = DefaultBurst
+	}
+	if opts.MaxInflight <= 0 {
+		opts.MaxInflight = DefaultMaxInflight
+	}
+	if opts.RequestTimeout <= 0 {
+		opts.RequestTimeout = DefaultRequestTimeout
+	}
 
-			// we use the same QPS and Burst as for the API server which is running this manager now
-			cfg.QPS = float32(100)
-			cfg.Burst = 100
+	kc := &KubeClient{
+		opts:        opts,
+		lastTargets: make(map[string][]*Target),
+		clusters:    make(map[string]*cluster),
+	}
 
-			opCli, err := versioned.NewForConfig(cfg)
+	// the Secrets and the KubernetesClusters are read from the cluster this service is running in,
+	// or from the current context of the kubeconfig if it's running out of a Kubernetes cluster
+	var mgmtConfig *rest.Config
+	if opts.Kubeconfig != "" {
+		kc.sources = append(kc.sources, &kubeconfigSource{path: opts.Kubeconfig})
+		if opts.SecretNamespace != "" || opts.CRDNamespace != "" {
+			cfg, err := clientcmd.BuildConfigFromFlags("", opts.Kubeconfig)
 			if err != nil {
 				return nil, err
 			}
-			kubeCli, err := kubernetes.NewForConfig(cfg)
-			if err != nil {
-				return nil, err
-			}
-
-			kc.opClients[contextName] = opCli
-			kc.kubeClients[contextName] = kubeCli
-			ctxNames = append(ctxNames, contextName)
+			mgmtConfig = cfg
 		}
 	} else {
 		cfg, err := rest.InClusterConfig()
+		switch {
+		case err == nil:
+			kc.sources = append(kc.sources, &inClusterSource{config: cfg})
+			kc.inCluster = true // in k8s cluster
+			mgmtConfig = cfg
+		case opts.KubeconfigDir == "":
+			// no other source can be used if it's running out of a Kubernetes cluster
+			return nil, err
+		}
+	}
+	if opts.KubeconfigDir != "" {
+		kc.sources = append(kc.sources, &kubeconfigDirSource{dir: opts.KubeconfigDir})
+	}
+	if opts.SecretNamespace != "" || opts.CRDNamespace != "" {
+		if mgmtConfig == nil {
+			return nil, errors.New("kubeconfig secrets and KubernetesClusters require running in a Kubernetes cluster or a kubeconfig")
+		}
+		kubeCli, err := kubernetes.NewForConfig(mgmtConfig)
 		if err != nil {
 			return nil, err
 		}
-		cfg.QPS = float32(100)
-		cfg.Burst = 100
+		if opts.SecretNamespace != "" {
+			kc.sources = append(kc.sources, &secretSource{kubeCli: kubeCli, namespace: opts.SecretNamespace, selector: opts.SecretSelector})
+		}
+		if opts.CRDNamespace != "" {
+			dynCli, err := dynamic.NewForConfig(mgmtConfig)
+			if err != nil {
+				return nil, err
+			}
+			kc.sources = append(kc.sources, &crdSource{kubeCli: kubeCli, dynCli: dynCli, namespace: opts.CRDNamespace})
+		}
+	}
+
+	// fail fast if any source is misconfigured at startup, the later errors are only logged
+	ctx, cancel := context.WithTimeout(context.Background(), opts.RequestTimeout)
+	defer cancel()
+	if err := kc.reload(ctx); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(kc.clusters))
+	for _, info := range kc.List() {
+		ids = append(ids, info.ID)
+	}
+	log.Info("kube clients init success", zap.Bool("inCluster", kc.inCluster), zap.Strings("k8s-contexts", ids))
+
+	return kc, nil
+}
+
+// Run reloads the clusters and checks their health periodically until the context is done
+func (kc *KubeClient) Run(ctx context.Context) {
+	kc.checkHealth(ctx)
+
+	resync := time.NewTicker(kc.opts.ResyncPeriod)
+	defer resync.Stop()
+	healthCheck := time.NewTicker(kc.opts.HealthCheckInterval)
+	defer healthCheck.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-resync.C:
+			if err := kc.reload(ctx); err != nil {
+				log.Warn("Failed to reload kube clients", zap.Error(err))
+			}
+		case <-healthCheck.C:
+			kc.checkHealth(ctx)
+		}
+	}
+}
 
-		opCli, err := versioned.NewForConfig(cfg)
+// reload loads the targets from all the sources and updates the clusters.
+// The clients of a cluster are reused if its config is not changed, so the in-flight requests are not affected.
+func (kc *KubeClient) reload(ctx context.Context) error {
+	var errs []error
+	targets := make(map[string]*Target)
+	for _, s := range kc.sources {
+		loaded, err := s.Load(ctx)
 		if err != nil {
-			return nil, err
+			errs = append(errs, fmt.Errorf("load kubernetes from %s failed: %v", s.Name(), err))
+			loaded = kc.lastTargets[s.Name()]
+		} else {
+			kc.lastTargets[s.Name()] = loaded
+		}
+		sortTargets(loaded)
+		for _, t := range loaded {
+			// the sources are in the order of priority
+			if exist, ok := targets[t.ID]; ok {
+				log.Warn("Duplicated kubernetes-id, ignore the latter one",
+					zap.String("k8sID", t.ID), zap.String("source", t.Source), zap.String("used-source", exist.Source))
+				continue
+			}
+			targets[t.ID] = t
 		}
-		kubeCli, err := kubernetes.NewForConfig(cfg)
+	}
+
+	kc.mu.RLock()
+	old := kc.clusters
+	kc.mu.RUnlock()
+
+	clusters := make(map[string]*cluster, len(targets))
+	for id, t := range targets {
+		if c, ok := old[id]; ok && c.hash == t.Hash && c.source == t.Source {
+			clusters[id] = c
+			continue
+		}
+		c, err := kc.newCluster(t)
 		if err != nil {
-			return nil, err
+			errs = append(errs, fmt.Errorf("create clients for kubernetes %s failed: %v", id, err))
+			if c, ok := old[id]; ok {
+				clusters[id] = c
+			}
+			continue
+		}
+		if _, ok := old[id]; ok {
+			log.Info("Kubernetes updated", zap.String("k8sID", id), zap.String("source", t.Source))
+		} else {
+			log.Info("Kubernetes added", zap.String("k8sID", id), zap.String("source", t.Source))
+		}
+		clusters[id] = c
+	}
+	for id, c := range old {
+		if _, ok := clusters[id]; !ok {
+			log.Info("Kubernetes removed", zap.String("k8sID", id), zap.String("source", c.source))
 		}
-		kc.opClients[defaultContext] = opCli
-		kc.kubeClients[defaultContext] = kubeCli
-		kc.inCluster = true // in k8s cluster
-		ctxNames = append(ctxNames, defaultContext)
 	}
 
-	log.Info("kube clients init success", zap.Bool("inCluster", kc.inCluster), zap.Strings("k8s-contexts", ctxNames))
+	kc.mu.Lock()
+	kc.clusters = clusters
+	kc.mu.Unlock()
+	return errors.Join(errs...)
+}
 
-	return kc, nil
+// newCluster creates the clients of a target cluster, the clients share the rate limiter and the in-flight limiter
+func (kc *KubeClient) newCluster(t *Target) (*cluster, error) {
+	c := &cluster{
+		id:      t.ID,
+		source:  t.Source,
+		hash:    t.Hash,
+		qps:     kc.opts.QPS,
+		burst:   kc.opts.Burst,
+		limiter: newInflightLimiter(t.ID, kc.opts.MaxInflight),
+	}
+	if t.QPS > 0 {
+		c.qps = t.QPS
+	}
+	if t.Burst > 0 {
+		c.burst = t.Burst
+	}
+
+	cfg := rest.CopyConfig(t.Config)
+	cfg.QPS = c.qps
+	cfg.Burst = c.burst
+	cfg.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(c.qps, c.burst)
+	cfg.Timeout = kc.opts.RequestTimeout
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper { return c.limiter.wrap(rt) })
+
+	opCli, err := versioned.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	kubeCli, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c.opCli, c.kubeCli = opCli, kubeCli
+	return c, nil
 }
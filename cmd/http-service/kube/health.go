@@ -0,0 +1,83 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// checkHealth checks the API servers of all the clusters concurrently,
+// so a slow API server does not delay the health checks of the others.
+func (kc *KubeClient) checkHealth(ctx context.Context) {
+	kc.mu.RLock()
+	clusters := make([]*cluster, 0, len(kc.clusters))
+	for _, c := range kc.clusters {
+		clusters = append(clusters, c)
+	}
+	kc.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, c := range clusters {
+		wg.Add(1)
+		go func(c *cluster) {
+			defer wg.Done()
+			c.checkHealth(ctx)
+		}(c)
+	}
+	wg.Wait()
+}
+
+func (c *cluster) checkHealth(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, defaultHealthCheckTimeout)
+	defer cancel()
+
+	startTime := time.Now()
+	health := Health{LastProbeTime: startTime}
+	body, err := c.kubeCli.Discovery().RESTClient().Get().AbsPath("/version").Do(ctx).Raw()
+	health.Latency = time.Since(startTime)
+	if err == nil {
+		info := version.Info{}
+		if err = json.Unmarshal(body, &info); err != nil {
+			err = fmt.Errorf("parse version failed: %v", err)
+		}
+		health.Version = info.GitVersion
+	}
+	if err != nil {
+		health.Message = err.Error()
+	} else {
+		health.Healthy = true
+	}
+
+	c.mu.Lock()
+	prev := c.health
+	c.health = health
+	c.mu.Unlock()
+
+	if prev.Healthy != health.Healthy || prev.LastProbeTime.IsZero() {
+		logger := log.L().With(zap.String("k8sID", c.id), zap.Bool("healthy", health.Healthy), zap.Duration("latency", health.Latency))
+		if health.Healthy {
+			logger.Info("Kubernetes health changed", zap.String("version", health.Version))
+		} else {
+			logger.Warn("Kubernetes health changed", zap.String("message", health.Message))
+		}
+	}
+}
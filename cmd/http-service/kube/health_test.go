@@ -0,0 +1,111 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCheckHealth(t *testing.T) {
+	// the response of `/version` of the fake API server
+	var status atomic.Int32
+	var body atomic.Value
+	status.Store(http.StatusOK)
+	body.Store(`{"gitVersion":"v1.28.14"}`)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/version" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(int(status.Load()))
+		_, _ = w.Write([]byte(body.Load().(string)))
+	}))
+	defer server.Close()
+
+	kc := newTestKubeClient(&fakeSource{name: "test", targets: []*Target{newTestTarget("prod", "test", server.URL)}})
+	if err := kc.reload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	health := func() Health {
+		infos := kc.List()
+		if len(infos) != 1 {
+			t.Fatalf("expect 1 cluster, got %d", len(infos))
+		}
+		return infos[0].Health
+	}
+	if h := health(); h.Healthy || !h.LastProbeTime.IsZero() {
+		t.Fatalf("expect the cluster not probed, got %+v", h)
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		healthy bool
+		version string
+		message string
+	}{
+		{
+			name:    "healthy",
+			status:  http.StatusOK,
+			body:    `{"gitVersion":"v1.28.14"}`,
+			healthy: true,
+			version: "v1.28.14",
+		},
+		{
+			name:    "API server fails",
+			status:  http.StatusServiceUnavailable,
+			body:    `etcd unavailable`,
+			message: "the server is currently unable to handle the request",
+		},
+		{
+			name:    "recovered",
+			status:  http.StatusOK,
+			body:    `{"gitVersion":"v1.29.0"}`,
+			healthy: true,
+			version: "v1.29.0",
+		},
+		{
+			name:    "invalid version",
+			status:  http.StatusOK,
+			body:    `not json`,
+			message: "parse version failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status.Store(int32(tt.status))
+			body.Store(tt.body)
+			kc.checkHealth(context.Background())
+
+			h := health()
+			if h.Healthy != tt.healthy || h.Version != tt.version {
+				t.Fatalf("expect healthy %v and version %q, got %+v", tt.healthy, tt.version, h)
+			}
+			if !strings.Contains(h.Message, tt.message) || (tt.message == "" && h.Message != "") {
+				t.Fatalf("expect message %q, got %q", tt.message, h.Message)
+			}
+			if h.LastProbeTime.IsZero() {
+				t.Fatal("expect the last probe time set")
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+// inflightLimiter limits the number of the in-flight requests to the API server of a cluster,
+// so that the requests to a slow API server wait for the limiter of its own cluster
+// instead of exhausting the resources of the whole service.
+type inflightLimiter struct {
+	id       string
+	sem      chan struct{}
+	inflight atomic.Int32
+}
+
+func newInflightLimiter(id string, maxInflight int) *inflightLimiter {
+	return &inflightLimiter{id: id, sem: make(chan struct{}, maxInflight)}
+}
+
+func (l *inflightLimiter) Inflight() int {
+	return int(l.inflight.Load())
+}
+
+func (l *inflightLimiter) Max() int {
+	return cap(l.sem)
+}
+
+// wrap returns a RoundTripper which waits for a slot before sending the request,
+// the wait is canceled by the context or the timeout of the request.
+func (l *inflightLimiter) wrap(rt http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		select {
+		case l.sem <- struct{}{}:
+		case <-req.Context().Done():
+			return nil, fmt.Errorf("too many in-flight requests to kubernetes %s: %w", l.id, req.Context().Err())
+		}
+		l.inflight.Add(1)
+		resp, err := rt.RoundTrip(req)
+		if err != nil || resp.Body == nil {
+			l.release()
+			return resp, err
+		}
+		// the slot is released when the body is closed, since the watch and the large list are streamed
+		resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: l.release}
+		return resp, nil
+	})
+}
+
+func (l *inflightLimiter) release() {
+	l.inflight.Add(-1)
+	<-l.sem
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// releaseOnClose releases the slot of the limiter once the body is closed
+type releaseOnClose struct {
+	io.ReadCloser
+	closed  atomic.Bool
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	if r.closed.CompareAndSwap(false, true) {
+		r.release()
+	}
+	return err
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInflightLimiter(t *testing.T) {
+	l := newInflightLimiter("prod", 2)
+	if l.Max() != 2 {
+		t.Fatalf("expect max 2, got %d", l.Max())
+	}
+
+	var rtErr error
+	rt := l.wrap(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		if rtErr != nil {
+			return nil, rtErr
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("ok"))}, nil
+	}))
+	roundTrip := func(timeout time.Duration) (*http.Response, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return rt.RoundTrip(httptest.NewRequest("GET", "/api/v1/pods", nil).WithContext(ctx))
+	}
+
+	// the slots are held until the bodies are closed
+	resp1, err := roundTrip(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp2, err := roundTrip(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Inflight() != 2 {
+		t.Fatalf("expect 2 in-flight requests, got %d", l.Inflight())
+	}
+
+	// the request waiting for a slot is canceled by its context
+	_, err = roundTrip(50 * time.Millisecond)
+	if err == nil || !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "too many in-flight requests to kubernetes prod") {
+		t.Fatalf("expect the request canceled, got %v", err)
+	}
+
+	// closing a body twice only releases the slot once
+	if err = resp1.Body.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err = resp1.Body.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if l.Inflight() != 1 {
+		t.Fatalf("expect 1 in-flight request, got %d", l.Inflight())
+	}
+
+	// the waiting request gets the slot once it's released
+	done := make(chan error, 1)
+	resp3, err := roundTrip(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		resp, err := roundTrip(time.Second)
+		if err == nil {
+			err = resp.Body.Close()
+		}
+		done <- err
+	}()
+	select {
+	case err = <-done:
+		t.Fatalf("expect the request waiting for a slot, got %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if err = resp2.Body.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+	if err = resp3.Body.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if l.Inflight() != 0 {
+		t.Fatalf("expect no in-flight request, got %d", l.Inflight())
+	}
+
+	// the slot is released if the request fails
+	rtErr = errors.New("connection refused")
+	for i := 0; i < 3; i++ {
+		if _, err = roundTrip(time.Second); err == nil {
+			t.Fatal("expect error of the round tripper")
+		}
+	}
+	if l.Inflight() != 0 {
+		t.Fatalf("expect no in-flight request, got %d", l.Inflight())
+	}
+}
@@ -0,0 +1,316 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	SourceInCluster     = "in-cluster"
+	SourceKubeconfig    = "kubeconfig"
+	SourceKubeconfigDir = "kubeconfig-dir"
+	SourceSecret        = "secret"
+	SourceCRD           = "crd"
+
+	// LabelKeyKubeconfig is the label of the Secrets which contain a kubeconfig of a target cluster
+	LabelKeyKubeconfig = "http-service.pingcap.com/kubeconfig"
+	// AnnotationKeyKubernetesID overrides the `kubernetes-id` of the cluster in a Secret, default to the name of the Secret
+	AnnotationKeyKubernetesID = "http-service.pingcap.com/kubernetes-id"
+	// AnnotationKeyContext is the context used in the kubeconfig of a Secret, default to the current context
+	AnnotationKeyContext = "http-service.pingcap.com/context"
+	// the key of the kubeconfig in the data of the Secrets
+	secretKeyKubeconfig = "kubeconfig"
+)
+
+// kubernetesClusterGVR is the resource of the KubernetesCluster CRD, see `kubernetescluster.crd.yaml`
+var kubernetesClusterGVR = schema.GroupVersionResource{
+	Group:    "http-service.pingcap.com",
+	Version:  "v1alpha1",
+	Resource: "kubernetesclusters",
+}
+
+// Target is a target Kubernetes cluster loaded from a source
+type Target struct {
+	// ID is the `kubernetes-id` of the cluster
+	ID string
+	// Source is the name of the source which loads the cluster
+	Source string
+	// Config is the config to access the cluster
+	Config *rest.Config
+	// QPS and Burst override the default rate limit of the cluster if they are not zero
+	QPS   float32
+	Burst int
+	// Hash is the hash of the config, the clients of the cluster are recreated only when it changes
+	Hash string
+}
+
+// Source loads the target clusters, it's called periodically to reload the clusters
+type Source interface {
+	Name() string
+	Load(ctx context.Context) ([]*Target, error)
+}
+
+// inClusterSource loads the cluster which this service is running in as the `default` cluster
+type inClusterSource struct {
+	config *rest.Config
+}
+
+func (s *inClusterSource) Name() string {
+	return SourceInCluster
+}
+
+func (s *inClusterSource) Load(_ context.Context) ([]*Target, error) {
+	// the token file of the ServiceAccount is reloaded by the client itself
+	return []*Target{{ID: defaultContext, Source: s.Name(), Config: rest.CopyConfig(s.config), Hash: SourceInCluster}}, nil
+}
+
+// kubeconfigSource loads all the contexts in a kubeconfig file, the context names are used as the IDs
+type kubeconfigSource struct {
+	path string
+}
+
+func (s *kubeconfigSource) Name() string {
+	return SourceKubeconfig
+}
+
+func (s *kubeconfigSource) Load(_ context.Context) ([]*Target, error) {
+	kubeConfig, err := clientcmd.LoadFromFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]*Target, 0, len(kubeConfig.Contexts))
+	for contextName := range kubeConfig.Contexts {
+		t, err := newTarget(contextName, s.Name(), kubeConfig, contextName)
+		if err != nil {
+			return nil, fmt.Errorf("load context %s in %s failed: %v", contextName, s.path, err)
+		}
+		targets = append(targets, t)
+	}
+	return targets, nil
+}
+
+// kubeconfigDirSource loads the kubeconfig files in a directory, e.g. a mounted Secret or ConfigMap.
+// The file names without the extension are used as the IDs and the current contexts are used.
+type kubeconfigDirSource struct {
+	dir string
+}
+
+func (s *kubeconfigDirSource) Name() string {
+	return SourceKubeconfigDir
+}
+
+func (s *kubeconfigDirSource) Load(_ context.Context) ([]*Target, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]*Target, 0, len(entries))
+	for _, entry := range entries {
+		// skip the hidden files and the `..data` directories of the mounted volumes
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(s.dir, entry.Name())
+		// the files of the mounted volumes are symlinks, so stat them instead of checking the entries
+		if fi, err := os.Stat(path); err != nil || !fi.Mode().IsRegular() {
+			continue
+		}
+		kubeConfig, err := clientcmd.LoadFromFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("load kubeconfig %s failed: %v", path, err)
+		}
+		id := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+		t, err := newTarget(id, s.Name(), kubeConfig, "")
+		if err != nil {
+			return nil, fmt.Errorf("load kubeconfig %s failed: %v", path, err)
+		}
+		targets = append(targets, t)
+	}
+	return targets, nil
+}
+
+// secretSource loads the kubeconfigs in the Secrets with the label `http-service.pingcap.com/kubeconfig=true`
+type secretSource struct {
+	kubeCli   kubernetes.Interface
+	namespace string
+	selector  string
+}
+
+func (s *secretSource) Name() string {
+	return SourceSecret
+}
+
+func (s *secretSource) Load(ctx context.Context) ([]*Target, error) {
+	secrets, err := s.kubeCli.CoreV1().Secrets(s.namespace).List(ctx, metav1.ListOptions{LabelSelector: s.selector})
+	if err != nil {
+		return nil, fmt.Errorf("list kubeconfig secrets in namespace %s failed: %v", s.namespace, err)
+	}
+	targets := make([]*Target, 0, len(secrets.Items))
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		id := secret.Name
+		if v := secret.Annotations[AnnotationKeyKubernetesID]; v != "" {
+			id = v
+		}
+		data, ok := secret.Data[secretKeyKubeconfig]
+		if !ok {
+			return nil, fmt.Errorf("key %s not found in secret %s/%s", secretKeyKubeconfig, secret.Namespace, secret.Name)
+		}
+		kubeConfig, err := clientcmd.Load(data)
+		if err != nil {
+			return nil, fmt.Errorf("load kubeconfig in secret %s/%s failed: %v", secret.Namespace, secret.Name, err)
+		}
+		t, err := newTarget(id, s.Name(), kubeConfig, secret.Annotations[AnnotationKeyContext])
+		if err != nil {
+			return nil, fmt.Errorf("load kubeconfig in secret %s/%s failed: %v", secret.Namespace, secret.Name, err)
+		}
+		targets = append(targets, t)
+	}
+	return targets, nil
+}
+
+// crdSource loads the KubernetesCluster objects, each of them refers to a Secret containing the kubeconfig.
+// The names of the objects are used as the IDs.
+type crdSource struct {
+	kubeCli   kubernetes.Interface
+	dynCli    dynamic.Interface
+	namespace string
+}
+
+// kubernetesClusterSpec is the spec of the KubernetesCluster CRD
+type kubernetesClusterSpec struct {
+	KubeconfigSecretRef struct {
+		Name string `json:"name"`
+		Key  string `json:"key,omitempty"`
+	} `json:"kubeconfigSecretRef"`
+	Context string  `json:"context,omitempty"`
+	QPS     float32 `json:"qps,omitempty"`
+	Burst   int     `json:"burst,omitempty"`
+}
+
+func (s *crdSource) Name() string {
+	return SourceCRD
+}
+
+func (s *crdSource) Load(ctx context.Context) ([]*Target, error) {
+	list, err := s.dynCli.Resource(kubernetesClusterGVR).Namespace(s.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list KubernetesClusters in namespace %s failed: %v", s.namespace, err)
+	}
+	targets := make([]*Target, 0, len(list.Items))
+	for i := range list.Items {
+		obj := &list.Items[i]
+		spec := kubernetesClusterSpec{}
+		raw, err := json.Marshal(obj.Object["spec"])
+		if err == nil {
+			err = json.Unmarshal(raw, &spec)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse KubernetesCluster %s/%s failed: %v", obj.GetNamespace(), obj.GetName(), err)
+		}
+		ref := spec.KubeconfigSecretRef
+		if ref.Name == "" {
+			return nil, fmt.Errorf("kubeconfigSecretRef of KubernetesCluster %s/%s is not set", obj.GetNamespace(), obj.GetName())
+		}
+		if ref.Key == "" {
+			ref.Key = secretKeyKubeconfig
+		}
+		secret, err := s.kubeCli.CoreV1().Secrets(obj.GetNamespace()).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("get kubeconfig secret of KubernetesCluster %s/%s failed: %v", obj.GetNamespace(), obj.GetName(), err)
+		}
+		data, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("key %s not found in secret %s/%s", ref.Key, secret.Namespace, secret.Name)
+		}
+		kubeConfig, err := clientcmd.Load(data)
+		if err != nil {
+			return nil, fmt.Errorf("load kubeconfig of KubernetesCluster %s/%s failed: %v", obj.GetNamespace(), obj.GetName(), err)
+		}
+		t, err := newTarget(obj.GetName(), s.Name(), kubeConfig, spec.Context)
+		if err != nil {
+			return nil, fmt.Errorf("load kubeconfig of KubernetesCluster %s/%s failed: %v", obj.GetNamespace(), obj.GetName(), err)
+		}
+		t.QPS, t.Burst = spec.QPS, spec.Burst
+		t.Hash = hashStrings(t.Hash, fmt.Sprint(spec.QPS), fmt.Sprint(spec.Burst))
+		targets = append(targets, t)
+	}
+	return targets, nil
+}
+
+// newTarget creates a target by a context of the kubeconfig, the current context is used if the context is empty
+func newTarget(id, source string, kubeConfig *clientcmdapi.Config, contextName string) (*Target, error) {
+	if contextName == "" {
+		contextName = kubeConfig.CurrentContext
+	}
+	if _, ok := kubeConfig.Contexts[contextName]; !ok {
+		return nil, fmt.Errorf("context %q not found", contextName)
+	}
+	cfg, err := clientcmd.NewNonInteractiveClientConfig(*kubeConfig, contextName, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	// the raw kubeconfig may contain the other contexts, so only hash the parts used by this context
+	data, err := clientcmd.Write(*minifyConfig(kubeConfig, contextName))
+	if err != nil {
+		return nil, err
+	}
+	return &Target{ID: id, Source: source, Config: cfg, Hash: hashStrings(string(data))}, nil
+}
+
+// minifyConfig returns a kubeconfig with only the context and the cluster and user it refers to
+func minifyConfig(kubeConfig *clientcmdapi.Config, contextName string) *clientcmdapi.Config {
+	ctx := kubeConfig.Contexts[contextName]
+	ret := clientcmdapi.NewConfig()
+	ret.CurrentContext = contextName
+	ret.Contexts[contextName] = ctx
+	if c, ok := kubeConfig.Clusters[ctx.Cluster]; ok {
+		ret.Clusters[ctx.Cluster] = c
+	}
+	if u, ok := kubeConfig.AuthInfos[ctx.AuthInfo]; ok {
+		ret.AuthInfos[ctx.AuthInfo] = u
+	}
+	return ret
+}
+
+func hashStrings(strs ...string) string {
+	h := sha256.New()
+	for _, s := range strs {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// sortTargets sorts the targets by ID so that the conflicts are resolved in a stable order
+func sortTargets(targets []*Target) {
+	sort.Slice(targets, func(i, j int) bool { return targets[i].ID < targets[j].ID })
+}
@@ -0,0 +1,353 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// newKubeconfig returns a kubeconfig with a context for each of the servers, the context names are the keys
+func newKubeconfig(t *testing.T, current string, servers map[string]string) []byte {
+	t.Helper()
+	cfg := clientcmdapi.NewConfig()
+	for name, server := range servers {
+		cfg.Clusters[name] = &clientcmdapi.Cluster{Server: server}
+		cfg.AuthInfos[name] = &clientcmdapi.AuthInfo{Token: name + "-token"}
+		cfg.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: name}
+	}
+	cfg.CurrentContext = current
+	data, err := clientcmd.Write(*cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// targetsByID returns the targets indexed by their IDs
+func targetsByID(targets []*Target) map[string]*Target {
+	ret := make(map[string]*Target, len(targets))
+	for _, t := range targets {
+		ret[t.ID] = t
+	}
+	return ret
+}
+
+func TestKubeconfigSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	writeFile(t, path, newKubeconfig(t, "prod", map[string]string{
+		"prod":    "https://prod.example.com",
+		"staging": "https://staging.example.com",
+	}))
+	s := &kubeconfigSource{path: path}
+
+	targets, err := s.Load(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	byID := targetsByID(targets)
+	if len(byID) != 2 {
+		t.Fatalf("expect 2 targets, got %d", len(targets))
+	}
+	for id, host := range map[string]string{"prod": "https://prod.example.com", "staging": "https://staging.example.com"} {
+		target, ok := byID[id]
+		if !ok {
+			t.Fatalf("target %s not found", id)
+		}
+		if target.Source != SourceKubeconfig || target.Config.Host != host || target.Config.BearerToken != id+"-token" {
+			t.Fatalf("unexpected target %s: %+v", id, target)
+		}
+	}
+
+	// reload after the server of staging is changed, only the hash of staging is changed
+	writeFile(t, path, newKubeconfig(t, "prod", map[string]string{
+		"prod":    "https://prod.example.com",
+		"staging": "https://staging-2.example.com",
+	}))
+	targets, err = s.Load(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	reloaded := targetsByID(targets)
+	if reloaded["prod"].Hash != byID["prod"].Hash {
+		t.Fatal("expect the hash of prod not changed")
+	}
+	if reloaded["staging"].Hash == byID["staging"].Hash || reloaded["staging"].Config.Host != "https://staging-2.example.com" {
+		t.Fatalf("expect staging reloaded, got %+v", reloaded["staging"])
+	}
+
+	writeFile(t, path, []byte("not a kubeconfig"))
+	if _, err = s.Load(context.Background()); err == nil {
+		t.Fatal("expect error for an invalid kubeconfig")
+	}
+	if _, err = (&kubeconfigSource{path: filepath.Join(t.TempDir(), "not-found")}).Load(context.Background()); err == nil {
+		t.Fatal("expect error for a kubeconfig not found")
+	}
+}
+
+func TestKubeconfigDirSource(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "prod.yaml"), newKubeconfig(t, "b", map[string]string{
+		"a": "https://a.example.com",
+		"b": "https://b.example.com",
+	}))
+	writeFile(t, filepath.Join(dir, "staging"), newKubeconfig(t, "staging", map[string]string{
+		"staging": "https://staging.example.com",
+	}))
+	// the hidden files and the directories of the mounted volumes are skipped
+	writeFile(t, filepath.Join(dir, ".hidden"), []byte("not a kubeconfig"))
+	if err := os.Mkdir(filepath.Join(dir, "..data"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "subdir"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &kubeconfigDirSource{dir: dir}
+	targets, err := s.Load(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	byID := targetsByID(targets)
+	if len(byID) != 2 {
+		t.Fatalf("expect 2 targets, got %d", len(targets))
+	}
+	// the current context is used
+	if byID["prod"].Config.Host != "https://b.example.com" || byID["prod"].Source != SourceKubeconfigDir {
+		t.Fatalf("unexpected target prod: %+v", byID["prod"])
+	}
+	if byID["staging"].Config.Host != "https://staging.example.com" {
+		t.Fatalf("unexpected target staging: %+v", byID["staging"])
+	}
+
+	// the current context is not found
+	writeFile(t, filepath.Join(dir, "staging"), newKubeconfig(t, "other", map[string]string{
+		"staging": "https://staging.example.com",
+	}))
+	if _, err = s.Load(context.Background()); err == nil {
+		t.Fatal("expect error for a current context not found")
+	}
+}
+
+func TestSecretSource(t *testing.T) {
+	newSecret := func(name string, annotations map[string]string, data []byte) *corev1.Secret {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "tidb-admin",
+				Labels:      map[string]string{LabelKeyKubeconfig: "true"},
+				Annotations: annotations,
+			},
+		}
+		if data != nil {
+			secret.Data = map[string][]byte{secretKeyKubeconfig: data}
+		}
+		return secret
+	}
+	kubeconfig := newKubeconfig(t, "a", map[string]string{
+		"a": "https://a.example.com",
+		"b": "https://b.example.com",
+	})
+	unlabeled := newSecret("unlabeled", nil, kubeconfig)
+	unlabeled.Labels = nil
+
+	kubeCli := fake.NewSimpleClientset(
+		newSecret("prod", nil, kubeconfig),
+		newSecret("secret-b", map[string]string{AnnotationKeyKubernetesID: "staging", AnnotationKeyContext: "b"}, kubeconfig),
+		unlabeled,
+	)
+	s := &secretSource{kubeCli: kubeCli, namespace: "tidb-admin", selector: LabelKeyKubeconfig + "=true"}
+	targets, err := s.Load(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	byID := targetsByID(targets)
+	if len(byID) != 2 {
+		t.Fatalf("expect 2 targets, got %d", len(targets))
+	}
+	if byID["prod"].Config.Host != "https://a.example.com" || byID["prod"].Source != SourceSecret {
+		t.Fatalf("unexpected target prod: %+v", byID["prod"])
+	}
+	if byID["staging"].Config.Host != "https://b.example.com" {
+		t.Fatalf("unexpected target staging: %+v", byID["staging"])
+	}
+
+	// a secret without the kubeconfig fails the source
+	if _, err = kubeCli.CoreV1().Secrets("tidb-admin").Create(context.Background(), newSecret("empty", nil, nil), metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.Load(context.Background()); err == nil {
+		t.Fatal("expect error for a secret without kubeconfig")
+	}
+}
+
+func TestCRDSource(t *testing.T) {
+	newKubernetesCluster := func(name string, spec map[string]interface{}) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+		obj.SetAPIVersion(kubernetesClusterGVR.GroupVersion().String())
+		obj.SetKind("KubernetesCluster")
+		obj.SetNamespace("tidb-admin")
+		obj.SetName(name)
+		return obj
+	}
+	kubeCli := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "kubeconfigs", Namespace: "tidb-admin"},
+		Data: map[string][]byte{
+			"prod": newKubeconfig(t, "prod", map[string]string{"prod": "https://prod.example.com"}),
+		},
+	})
+	dynCli := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{kubernetesClusterGVR: "KubernetesClusterList"},
+		newKubernetesCluster("prod", map[string]interface{}{
+			"kubeconfigSecretRef": map[string]interface{}{"name": "kubeconfigs", "key": "prod"},
+			"qps":                 float64(20),
+			"burst":               int64(40),
+		}),
+	)
+	s := &crdSource{kubeCli: kubeCli, dynCli: dynCli, namespace: "tidb-admin"}
+	targets, err := s.Load(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(targets) != 1 {
+		t.Fatalf("expect 1 target, got %d", len(targets))
+	}
+	target := targets[0]
+	if target.ID != "prod" || target.Source != SourceCRD || target.Config.Host != "https://prod.example.com" ||
+		target.QPS != 20 || target.Burst != 40 {
+		t.Fatalf("unexpected target: %+v", target)
+	}
+
+	// the secret referred by the KubernetesCluster is not found
+	if _, err = dynCli.Resource(kubernetesClusterGVR).Namespace("tidb-admin").Create(context.Background(), newKubernetesCluster("staging", map[string]interface{}{
+		"kubeconfigSecretRef": map[string]interface{}{"name": "not-found"},
+	}), metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.Load(context.Background()); err == nil {
+		t.Fatal("expect error for a kubeconfig secret not found")
+	}
+}
+
+// fakeSource returns the targets or the error set by the tests
+type fakeSource struct {
+	name    string
+	targets []*Target
+	err     error
+}
+
+func (s *fakeSource) Name() string {
+	return s.name
+}
+
+func (s *fakeSource) Load(context.Context) ([]*Target, error) {
+	return s.targets, s.err
+}
+
+func newTestKubeClient(sources ...Source) *KubeClient {
+	return &KubeClient{
+		opts: Options{
+			QPS:            DefaultQPS,
+			Burst:          DefaultBurst,
+			MaxInflight:    DefaultMaxInflight,
+			RequestTimeout: DefaultRequestTimeout,
+		},
+		sources:     sources,
+		lastTargets: make(map[string][]*Target),
+		clusters:    make(map[string]*cluster),
+	}
+}
+
+func newTestTarget(id, source, host string) *Target {
+	return &Target{ID: id, Source: source, Config: &rest.Config{Host: host}, Hash: hashStrings(host)}
+}
+
+func TestReload(t *testing.T) {
+	primary := &fakeSource{name: "primary", targets: []*Target{
+		newTestTarget("prod", "primary", "https://prod.example.com"),
+		newTestTarget("staging", "primary", "https://staging.example.com"),
+	}}
+	secondary := &fakeSource{name: "secondary", targets: []*Target{
+		// conflicts with the primary source, which has the higher priority
+		newTestTarget("prod", "secondary", "https://other.example.com"),
+		newTestTarget("dev", "secondary", "https://dev.example.com"),
+	}}
+	kc := newTestKubeClient(primary, secondary)
+	if err := kc.reload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	infos := kc.List()
+	if len(infos) != 3 || infos[0].ID != "dev" || infos[1].ID != "prod" || infos[2].ID != "staging" {
+		t.Fatalf("unexpected clusters: %+v", infos)
+	}
+	if c := kc.getCluster("prod"); c.source != "primary" {
+		t.Fatalf("expect prod loaded from the primary source, got %s", c.source)
+	}
+	prod, staging := kc.getCluster("prod"), kc.getCluster("staging")
+
+	// the clients are reused if the config is not changed, and recreated if it's changed
+	primary.targets = []*Target{
+		newTestTarget("prod", "primary", "https://prod.example.com"),
+		newTestTarget("staging", "primary", "https://staging-2.example.com"),
+	}
+	if err := kc.reload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if kc.getCluster("prod") != prod {
+		t.Fatal("expect the clients of prod reused")
+	}
+	if kc.getCluster("staging") == staging {
+		t.Fatal("expect the clients of staging recreated")
+	}
+
+	// the targets of a failed source are kept
+	secondary.err = errors.New("connection refused")
+	secondary.targets = nil
+	if err := kc.reload(context.Background()); err == nil {
+		t.Fatal("expect error for the failed source")
+	}
+	if kc.getCluster("dev") == nil {
+		t.Fatal("expect dev kept when its source fails")
+	}
+
+	// the targets removed from a source are removed
+	secondary.err = nil
+	if err := kc.reload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if kc.getCluster("dev") != nil {
+		t.Fatal("expect dev removed")
+	}
+}
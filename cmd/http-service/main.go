@@ -15,6 +15,8 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"flag"
 	"fmt"
@@ -24,6 +26,7 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -34,8 +37,10 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/pingcap/tidb-operator/http-service/auth"
 	"github.com/pingcap/tidb-operator/http-service/kube"
 	"github.com/pingcap/tidb-operator/http-service/middlewares"
 	"github.com/pingcap/tidb-operator/http-service/pbgen/api"
@@ -57,6 +62,7 @@ func main() {
 	case flag.ErrHelp:
 		os.Exit(0)
 	default:
+		fmt.Fprintln(os.Stderr, err)
 		os.Exit(2)
 	}
 
@@ -79,18 +85,40 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	kubeClient, err := kube.InitKubeClients(cfg.KubeOptions())
+	if err != nil {
+		log.Fatal("Failed to init kube client", zap.Error(err))
+	}
+	authenticator, err := newAuthenticator(ctx, cfg, kubeClient)
+	if err != nil {
+		log.Fatal("Failed to init authenticator", zap.Error(err))
+	}
+	var policy *auth.Policy
+	if cfg.PolicyFile != "" {
+		if policy, err = auth.LoadPolicy(cfg.PolicyFile); err != nil {
+			log.Fatal("Failed to load authorization policy", zap.Error(err))
+		}
+		log.Info("Authorization policy loaded", zap.String("file", cfg.PolicyFile), zap.Int("rules", len(policy.Rules)))
+	}
+
 	var wg sync.WaitGroup
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		setupAndRunGRPCServer(ctx, log.L(), cfg.InternalGRPCAddr, cfg.Kubeconfig)
+		kubeClient.Run(ctx)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		setupAndRunGRPCServer(ctx, log.L(), cfg.InternalGRPCAddr, kubeClient, policy)
 	}()
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		setupAndRunGRPCGateway(ctx, log.L(), cfg.Addr, cfg.InternalGRPCAddr)
+		setupAndRunGRPCGateway(ctx, log.L(), cfg, authenticator)
 	}()
 
 	wg.Wait()
@@ -99,17 +127,52 @@ func main() {
 	log.Info("HTTP service exiting")
 }
 
-func setupAndRunGRPCServer(ctx context.Context, logger *zap.Logger, addr, kubeconfigPath string) {
-	kubeClient, err := kube.InitKubeClients(kubeconfigPath)
-	if err != nil {
-		log.Fatal("Failed to init kube client", zap.Error(err))
+// newAuthenticator returns the authenticator of the callers, or nil if no authentication is configured
+func newAuthenticator(ctx context.Context, cfg *Config, kubeClient *kube.KubeClient) (auth.Authenticator, error) {
+	var authenticators []auth.Authenticator
+	if cfg.ClientCAFile != "" {
+		authenticators = append(authenticators, auth.X509Authenticator{})
+	}
+	if cfg.OIDCIssuerURL != "" {
+		a, err := auth.NewOIDCAuthenticator(ctx, auth.OIDCOptions{
+			IssuerURL:      cfg.OIDCIssuerURL,
+			ClientID:       cfg.OIDCClientID,
+			UsernameClaim:  cfg.OIDCUsernameClaim,
+			GroupsClaim:    cfg.OIDCGroupsClaim,
+			StaticKeysFile: cfg.OIDCStaticKeysFile,
+		})
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, a)
+	}
+	if cfg.TokenReview {
+		kubeCli := kubeClient.GetKubeClient(cfg.TokenReviewKubernetesID)
+		if kubeCli == nil {
+			return nil, fmt.Errorf("k8s client for TokenReview not found, kubernetes-id: %s", cfg.TokenReviewKubernetesID)
+		}
+		var audiences []string
+		if cfg.TokenReviewAudiences != "" {
+			audiences = strings.Split(cfg.TokenReviewAudiences, ",")
+		}
+		authenticators = append(authenticators, auth.NewTokenReviewAuthenticator(kubeCli, audiences))
 	}
 
+	if len(authenticators) == 0 {
+		log.Warn("No authenticator is configured, all callers are anonymous")
+		return nil, nil
+	}
+	authenticator := auth.NewUnion(authenticators...)
+	log.Info("Authenticators configured", zap.String("authenticators", authenticator.Name()))
+	return authenticator, nil
+}
+
+func setupAndRunGRPCServer(ctx context.Context, logger *zap.Logger, addr string, kubeClient *kube.KubeClient, policy *auth.Policy) {
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
 		log.Fatal("Failed to listen for gRPC server", zap.String("addr", addr), zap.Error(err))
 	}
-	s := grpc.NewServer()
+	s := grpc.NewServer(grpc.UnaryInterceptor(server.AuthzInterceptor(policy)))
 	api.RegisterClusterServer(s, &server.ClusterServer{KubeClient: kubeClient})
 	log.Info("Starting internal gRPC server", zap.String("addr", addr))
 	go func() {
@@ -125,10 +188,12 @@ func setupAndRunGRPCServer(ctx context.Context, logger *zap.Logger, addr, kubeco
 	log.Info("Internal gRPC server stopped", zap.String("addr", addr))
 }
 
-func setupAndRunGRPCGateway(ctx context.Context, logger *zap.Logger, addr, grpcAddr string) {
+func setupAndRunGRPCGateway(ctx context.Context, logger *zap.Logger, cfg *Config, authenticator auth.Authenticator) {
+	addr, grpcAddr := cfg.Addr, cfg.InternalGRPCAddr
 	mux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(customHeaderMatcher),
 		runtime.WithForwardResponseOption(httpResponseModifier),
+		runtime.WithMetadata(principalMetadata),
 	)
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	if err := api.RegisterClusterHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
@@ -142,15 +207,37 @@ func setupAndRunGRPCGateway(ctx context.Context, logger *zap.Logger, addr, grpcA
 
 	router := gin.New()
 	router.Use(middlewares.LoggingMiddleware(), gin.Recovery()) // log with custom format
-	router.Group("/v1beta/*{grpc_gateway}").Any("", gin.WrapH(mux))
+	router.Group("/v1beta/*{grpc_gateway}", middlewares.AuthnMiddleware(authenticator)).Any("", gin.WrapH(mux))
 
 	srv := &http.Server{
 		Addr:    addr,
 		Handler: router,
 	}
-	log.Info("Starting gRPC gateway", zap.String("addr", addr))
+	if cfg.ClientCAFile != "" {
+		ca, err2 := os.ReadFile(cfg.ClientCAFile)
+		if err2 != nil {
+			log.Fatal("Failed to read client CA", zap.String("file", cfg.ClientCAFile), zap.Error(err2))
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			log.Fatal("No certificate found in client CA", zap.String("file", cfg.ClientCAFile))
+		}
+		srv.TLSConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			ClientCAs:  pool,
+			// the client certificate is optional so that the callers can be authenticated by the bearer tokens
+			ClientAuth: tls.VerifyClientCertIfGiven,
+		}
+	}
+	log.Info("Starting gRPC gateway", zap.String("addr", addr), zap.Bool("tls", cfg.TLSCertFile != ""))
 	go func() {
-		if err2 := srv.Serve(lis); err2 != nil && !errors.Is(err2, http.ErrServerClosed) {
+		var err2 error
+		if cfg.TLSCertFile != "" {
+			err2 = srv.ServeTLS(lis, cfg.TLSCertFile, cfg.TLSKeyFile)
+		} else {
+			err2 = srv.Serve(lis)
+		}
+		if err2 != nil && !errors.Is(err2, http.ErrServerClosed) {
 			log.Info("The gRPC gateway returned with error", zap.String("addr", addr), zap.Error(err2))
 		}
 	}()
@@ -170,6 +257,11 @@ func setupAndRunGRPCGateway(ctx context.Context, logger *zap.Logger, addr, grpcA
 }
 
 func customHeaderMatcher(key string) (string, bool) {
+	// the principal is set by the authentication middleware only, never by the callers
+	if strings.HasPrefix(key, runtime.MetadataHeaderPrefix) &&
+		auth.IsReservedMetadataKey(strings.TrimPrefix(key, runtime.MetadataHeaderPrefix)) {
+		return "", false
+	}
 	switch key {
 	case textproto.CanonicalMIMEHeaderKey(server.HeaderKeyKubernetesID):
 		return key, true
@@ -178,6 +270,15 @@ func customHeaderMatcher(key string) (string, bool) {
 	}
 }
 
+// principalMetadata forwards the principal authenticated by the middleware to the internal gRPC server
+func principalMetadata(_ context.Context, r *http.Request) metadata.MD {
+	p, ok := auth.PrincipalFromContext(r.Context())
+	if !ok {
+		return nil
+	}
+	return auth.ToMetadata(p)
+}
+
 func httpResponseModifier(ctx context.Context, w http.ResponseWriter, p proto.Message) error {
 	md, ok := runtime.ServerMetadataFromContext(ctx)
 	if !ok {
@@ -0,0 +1,53 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tidb-operator/http-service/auth"
+)
+
+// AuthnMiddleware authenticates the callers and stores the principal in the context of the request.
+// If the authenticator is nil, all callers are treated as the anonymous principal.
+func AuthnMiddleware(authenticator auth.Authenticator) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if authenticator == nil {
+			ctx.Request = ctx.Request.WithContext(auth.WithPrincipal(ctx.Request.Context(), auth.Anonymous()))
+			ctx.Next()
+			return
+		}
+
+		p, ok, err := authenticator.Authenticate(ctx.Request)
+		if !ok {
+			message := "unauthenticated"
+			if err != nil {
+				// do not expose the details of the failure to the callers
+				log.L().Info("Failed to authenticate the request",
+					zap.String("uri", ctx.Request.RequestURI), zap.String("client", ctx.ClientIP()), zap.Error(err))
+				_ = ctx.Error(err)
+				message = "invalid credentials"
+			}
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"success": false, "message": message})
+			return
+		}
+
+		ctx.Request = ctx.Request.WithContext(auth.WithPrincipal(ctx.Request.Context(), p))
+		ctx.Next()
+	}
+}
@@ -20,20 +20,27 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Cluster_CreateCluster_FullMethodName  = "/api.Cluster/CreateCluster"
-	Cluster_UpdateCluster_FullMethodName  = "/api.Cluster/UpdateCluster"
-	Cluster_GetCluster_FullMethodName     = "/api.Cluster/GetCluster"
-	Cluster_DeleteCluster_FullMethodName  = "/api.Cluster/DeleteCluster"
-	Cluster_RestartCluster_FullMethodName = "/api.Cluster/RestartCluster"
-	Cluster_PauseCluster_FullMethodName   = "/api.Cluster/PauseCluster"
-	Cluster_ResumeCluster_FullMethodName  = "/api.Cluster/ResumeCluster"
-	Cluster_CreateBackup_FullMethodName   = "/api.Cluster/CreateBackup"
-	Cluster_CreateRestore_FullMethodName  = "/api.Cluster/CreateRestore"
-	Cluster_GetBackup_FullMethodName      = "/api.Cluster/GetBackup"
-	Cluster_GetRestore_FullMethodName     = "/api.Cluster/GetRestore"
-	Cluster_StopBackup_FullMethodName     = "/api.Cluster/StopBackup"
-	Cluster_StopRestore_FullMethodName    = "/api.Cluster/StopRestore"
-	Cluster_DeleteBackup_FullMethodName   = "/api.Cluster/DeleteBackup"
+	Cluster_CreateCluster_FullMethodName      = "/api.Cluster/CreateCluster"
+	Cluster_UpdateCluster_FullMethodName      = "/api.Cluster/UpdateCluster"
+	Cluster_GetCluster_FullMethodName         = "/api.Cluster/GetCluster"
+	Cluster_DeleteCluster_FullMethodName      = "/api.Cluster/DeleteCluster"
+	Cluster_RestartCluster_FullMethodName     = "/api.Cluster/RestartCluster"
+	Cluster_PauseCluster_FullMethodName       = "/api.Cluster/PauseCluster"
+	Cluster_ResumeCluster_FullMethodName      = "/api.Cluster/ResumeCluster"
+	Cluster_ScaleComponent_FullMethodName     = "/api.Cluster/ScaleComponent"
+	Cluster_StartUpgrade_FullMethodName       = "/api.Cluster/StartUpgrade"
+	Cluster_GetUpgradeProgress_FullMethodName = "/api.Cluster/GetUpgradeProgress"
+	Cluster_GetClusterTopology_FullMethodName = "/api.Cluster/GetClusterTopology"
+	Cluster_CreateBackup_FullMethodName       = "/api.Cluster/CreateBackup"
+	Cluster_CreateRestore_FullMethodName      = "/api.Cluster/CreateRestore"
+	Cluster_GetBackup_FullMethodName          = "/api.Cluster/GetBackup"
+	Cluster_GetRestore_FullMethodName         = "/api.Cluster/GetRestore"
+	Cluster_StopBackup_FullMethodName         = "/api.Cluster/StopBackup"
+	Cluster_StopRestore_FullMethodName        = "/api.Cluster/StopRestore"
+	Cluster_DeleteBackup_FullMethodName       = "/api.Cluster/DeleteBackup"
+	Cluster_ListBackups_FullMethodName        = "/api.Cluster/ListBackups"
+	Cluster_ScheduleBackup_FullMethodName     = "/api.Cluster/ScheduleBackup"
+	Cluster_ListKubernetes_FullMethodName     = "/api.Cluster/ListKubernetes"
 )
 
 // ClusterClient is the client API for Cluster service.
@@ -47,6 +54,10 @@ type ClusterClient interface {
 	RestartCluster(ctx context.Context, in *RestartClusterReq, opts ...grpc.CallOption) (*RestartClusterResp, error)
 	PauseCluster(ctx context.Context, in *PauseClusterReq, opts ...grpc.CallOption) (*PauseClusterResp, error)
 	ResumeCluster(ctx context.Context, in *ResumeClusterReq, opts ...grpc.CallOption) (*ResumeClusterResp, error)
+	ScaleComponent(ctx context.Context, in *ScaleComponentReq, opts ...grpc.CallOption) (*ScaleComponentResp, error)
+	StartUpgrade(ctx context.Context, in *StartUpgradeReq, opts ...grpc.CallOption) (*StartUpgradeResp, error)
+	GetUpgradeProgress(ctx context.Context, in *GetUpgradeProgressReq, opts ...grpc.CallOption) (*GetUpgradeProgressResp, error)
+	GetClusterTopology(ctx context.Context, in *GetClusterTopologyReq, opts ...grpc.CallOption) (*GetClusterTopologyResp, error)
 	CreateBackup(ctx context.Context, in *CreateBackupReq, opts ...grpc.CallOption) (*CreateBackupResp, error)
 	CreateRestore(ctx context.Context, in *CreateRestoreReq, opts ...grpc.CallOption) (*CreateRestoreResp, error)
 	GetBackup(ctx context.Context, in *GetBackupReq, opts ...grpc.CallOption) (*GetBackupResp, error)
@@ -54,6 +65,9 @@ type ClusterClient interface {
 	StopBackup(ctx context.Context, in *StopBackupReq, opts ...grpc.CallOption) (*StopBackupResp, error)
 	StopRestore(ctx context.Context, in *StopRestoreReq, opts ...grpc.CallOption) (*StopRestoreResp, error)
 	DeleteBackup(ctx context.Context, in *DeleteBackupReq, opts ...grpc.CallOption) (*DeleteBackupResp, error)
+	ListBackups(ctx context.Context, in *ListBackupsReq, opts ...grpc.CallOption) (*ListBackupsResp, error)
+	ScheduleBackup(ctx context.Context, in *ScheduleBackupReq, opts ...grpc.CallOption) (*ScheduleBackupResp, error)
+	ListKubernetes(ctx context.Context, in *ListKubernetesReq, opts ...grpc.CallOption) (*ListKubernetesResp, error)
 }
 
 type clusterClient struct {
@@ -127,6 +141,42 @@ func (c *clusterClient) ResumeCluster(ctx context.Context, in *ResumeClusterReq,
 	return out, nil
 }
 
+func (c *clusterClient) ScaleComponent(ctx context.Context, in *ScaleComponentReq, opts ...grpc.CallOption) (*ScaleComponentResp, error) {
+	out := new(ScaleComponentResp)
+	err := c.cc.Invoke(ctx, Cluster_ScaleComponent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) StartUpgrade(ctx context.Context, in *StartUpgradeReq, opts ...grpc.CallOption) (*StartUpgradeResp, error) {
+	out := new(StartUpgradeResp)
+	err := c.cc.Invoke(ctx, Cluster_StartUpgrade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) GetUpgradeProgress(ctx context.Context, in *GetUpgradeProgressReq, opts ...grpc.CallOption) (*GetUpgradeProgressResp, error) {
+	out := new(GetUpgradeProgressResp)
+	err := c.cc.Invoke(ctx, Cluster_GetUpgradeProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) GetClusterTopology(ctx context.Context, in *GetClusterTopologyReq, opts ...grpc.CallOption) (*GetClusterTopologyResp, error) {
+	out := new(GetClusterTopologyResp)
+	err := c.cc.Invoke(ctx, Cluster_GetClusterTopology_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterClient) CreateBackup(ctx context.Context, in *CreateBackupReq, opts ...grpc.CallOption) (*CreateBackupResp, error) {
 	out := new(CreateBackupResp)
 	err := c.cc.Invoke(ctx, Cluster_CreateBackup_FullMethodName, in, out, opts...)
@@ -190,6 +240,33 @@ func (c *clusterClient) DeleteBackup(ctx context.Context, in *DeleteBackupReq, o
 	return out, nil
 }
 
+func (c *clusterClient) ListBackups(ctx context.Context, in *ListBackupsReq, opts ...grpc.CallOption) (*ListBackupsResp, error) {
+	out := new(ListBackupsResp)
+	err := c.cc.Invoke(ctx, Cluster_ListBackups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) ScheduleBackup(ctx context.Context, in *ScheduleBackupReq, opts ...grpc.CallOption) (*ScheduleBackupResp, error) {
+	out := new(ScheduleBackupResp)
+	err := c.cc.Invoke(ctx, Cluster_ScheduleBackup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterClient) ListKubernetes(ctx context.Context, in *ListKubernetesReq, opts ...grpc.CallOption) (*ListKubernetesResp, error) {
+	out := new(ListKubernetesResp)
+	err := c.cc.Invoke(ctx, Cluster_ListKubernetes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterServer is the server API for Cluster service.
 // All implementations must embed UnimplementedClusterServer
 // for forward compatibility
@@ -201,6 +278,10 @@ type ClusterServer interface {
 	RestartCluster(context.Context, *RestartClusterReq) (*RestartClusterResp, error)
 	PauseCluster(context.Context, *PauseClusterReq) (*PauseClusterResp, error)
 	ResumeCluster(context.Context, *ResumeClusterReq) (*ResumeClusterResp, error)
+	ScaleComponent(context.Context, *ScaleComponentReq) (*ScaleComponentResp, error)
+	StartUpgrade(context.Context, *StartUpgradeReq) (*StartUpgradeResp, error)
+	GetUpgradeProgress(context.Context, *GetUpgradeProgressReq) (*GetUpgradeProgressResp, error)
+	GetClusterTopology(context.Context, *GetClusterTopologyReq) (*GetClusterTopologyResp, error)
 	CreateBackup(context.Context, *CreateBackupReq) (*CreateBackupResp, error)
 	CreateRestore(context.Context, *CreateRestoreReq) (*CreateRestoreResp, error)
 	GetBackup(context.Context, *GetBackupReq) (*GetBackupResp, error)
@@ -208,6 +289,9 @@ type ClusterServer interface {
 	StopBackup(context.Context, *StopBackupReq) (*StopBackupResp, error)
 	StopRestore(context.Context, *StopRestoreReq) (*StopRestoreResp, error)
 	DeleteBackup(context.Context, *DeleteBackupReq) (*DeleteBackupResp, error)
+	ListBackups(context.Context, *ListBackupsReq) (*ListBackupsResp, error)
+	ScheduleBackup(context.Context, *ScheduleBackupReq) (*ScheduleBackupResp, error)
+	ListKubernetes(context.Context, *ListKubernetesReq) (*ListKubernetesResp, error)
 	mustEmbedUnimplementedClusterServer()
 }
 
@@ -236,6 +320,18 @@ func (UnimplementedClusterServer) PauseCluster(context.Context, *PauseClusterReq
 func (UnimplementedClusterServer) ResumeCluster(context.Context, *ResumeClusterReq) (*ResumeClusterResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResumeCluster not implemented")
 }
+func (UnimplementedClusterServer) ScaleComponent(context.Context, *ScaleComponentReq) (*ScaleComponentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScaleComponent not implemented")
+}
+func (UnimplementedClusterServer) StartUpgrade(context.Context, *StartUpgradeReq) (*StartUpgradeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartUpgrade not implemented")
+}
+func (UnimplementedClusterServer) GetUpgradeProgress(context.Context, *GetUpgradeProgressReq) (*GetUpgradeProgressResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpgradeProgress not implemented")
+}
+func (UnimplementedClusterServer) GetClusterTopology(context.Context, *GetClusterTopologyReq) (*GetClusterTopologyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterTopology not implemented")
+}
 func (UnimplementedClusterServer) CreateBackup(context.Context, *CreateBackupReq) (*CreateBackupResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBackup not implemented")
 }
@@ -257,6 +353,15 @@ func (UnimplementedClusterServer) StopRestore(context.Context, *StopRestoreReq)
 func (UnimplementedClusterServer) DeleteBackup(context.Context, *DeleteBackupReq) (*DeleteBackupResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBackup not implemented")
 }
+func (UnimplementedClusterServer) ListBackups(context.Context, *ListBackupsReq) (*ListBackupsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackups not implemented")
+}
+func (UnimplementedClusterServer) ScheduleBackup(context.Context, *ScheduleBackupReq) (*ScheduleBackupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleBackup not implemented")
+}
+func (UnimplementedClusterServer) ListKubernetes(context.Context, *ListKubernetesReq) (*ListKubernetesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKubernetes not implemented")
+}
 func (UnimplementedClusterServer) mustEmbedUnimplementedClusterServer() {}
 
 // UnsafeClusterServer may be embedded to opt out of forward compatibility for this service.
@@ -396,6 +501,78 @@ func _Cluster_ResumeCluster_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cluster_ScaleComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleComponentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).ScaleComponent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_ScaleComponent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).ScaleComponent(ctx, req.(*ScaleComponentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_StartUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartUpgradeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).StartUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_StartUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).StartUpgrade(ctx, req.(*StartUpgradeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_GetUpgradeProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpgradeProgressReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).GetUpgradeProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_GetUpgradeProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).GetUpgradeProgress(ctx, req.(*GetUpgradeProgressReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_GetClusterTopology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterTopologyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).GetClusterTopology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_GetClusterTopology_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).GetClusterTopology(ctx, req.(*GetClusterTopologyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cluster_CreateBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateBackupReq)
 	if err := dec(in); err != nil {
@@ -522,6 +699,60 @@ func _Cluster_DeleteBackup_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cluster_ListBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBackupsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).ListBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_ListBackups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).ListBackups(ctx, req.(*ListBackupsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_ScheduleBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleBackupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).ScheduleBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_ScheduleBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).ScheduleBackup(ctx, req.(*ScheduleBackupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cluster_ListKubernetes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKubernetesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).ListKubernetes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cluster_ListKubernetes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).ListKubernetes(ctx, req.(*ListKubernetesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Cluster_ServiceDesc is the grpc.ServiceDesc for Cluster service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -557,6 +788,22 @@ var Cluster_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResumeCluster",
 			Handler:    _Cluster_ResumeCluster_Handler,
 		},
+		{
+			MethodName: "ScaleComponent",
+			Handler:    _Cluster_ScaleComponent_Handler,
+		},
+		{
+			MethodName: "StartUpgrade",
+			Handler:    _Cluster_StartUpgrade_Handler,
+		},
+		{
+			MethodName: "GetUpgradeProgress",
+			Handler:    _Cluster_GetUpgradeProgress_Handler,
+		},
+		{
+			MethodName: "GetClusterTopology",
+			Handler:    _Cluster_GetClusterTopology_Handler,
+		},
 		{
 			MethodName: "CreateBackup",
 			Handler:    _Cluster_CreateBackup_Handler,
@@ -585,6 +832,18 @@ var Cluster_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBackup",
 			Handler:    _Cluster_DeleteBackup_Handler,
 		},
+		{
+			MethodName: "ListBackups",
+			Handler:    _Cluster_ListBackups_Handler,
+		},
+		{
+			MethodName: "ScheduleBackup",
+			Handler:    _Cluster_ScheduleBackup_Handler,
+		},
+		{
+			MethodName: "ListKubernetes",
+			Handler:    _Cluster_ListKubernetes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/service.proto",
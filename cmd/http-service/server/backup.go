@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"reflect"
 	"time"
 
 	"github.com/pingcap/log"
@@ -26,15 +27,21 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/pingcap/tidb-operator/http-service/pbgen/api"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1/validation"
 )
 
 const (
 	backupManager  = "tidb-backup-manager"
 	s3StorageClass = "STANDARD"
+
+	defaultBackupPageSize = 20
+	maxBackupPageSize     = 100
 )
 
 func (s *ClusterServer) CreateBackup(ctx context.Context, req *api.CreateBackupReq) (*api.CreateBackupResp, error) {
@@ -605,3 +612,221 @@ func (s *ClusterServer) DeleteBackup(ctx context.Context, req *api.DeleteBackupR
 
 	return &api.DeleteBackupResp{Success: true}, nil
 }
+
+func (s *ClusterServer) ListBackups(ctx context.Context, req *api.ListBackupsReq) (*api.ListBackupsResp, error) {
+	k8sID := getKubernetesID(ctx)
+	opCli := s.KubeClient.GetOperatorClient(k8sID)
+	logger := log.L().With(zap.String("request", "ListBackups"), zap.String("k8sID", k8sID), zap.String("clusterID", req.ClusterId))
+	if opCli == nil {
+		logger.Error("K8s client not found")
+		message := fmt.Sprintf("no %s is specified in the request header or the kubeconfig context not exists", HeaderKeyKubernetesID)
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.ListBackupsResp{Success: false, Message: &message}, nil
+	}
+
+	// the page token is the continue token of the list API of Kubernetes
+	opts := metav1.ListOptions{Limit: defaultBackupPageSize}
+	if req.PageSize != nil && *req.PageSize > 0 {
+		opts.Limit = int64(*req.PageSize)
+		if opts.Limit > maxBackupPageSize {
+			opts.Limit = maxBackupPageSize
+		}
+	}
+	if req.PageToken != nil {
+		opts.Continue = *req.PageToken
+	}
+	if req.ScheduleId != nil && *req.ScheduleId != "" {
+		opts.LabelSelector = labels.SelectorFromSet(map[string]string{
+			label.BackupScheduleLabelKey: *req.ScheduleId,
+		}).String()
+	}
+
+	backupList, err := opCli.PingcapV1alpha1().Backups(req.ClusterId).List(ctx, opts)
+	if err != nil {
+		if apierrors.IsResourceExpired(err) || apierrors.IsBadRequest(err) {
+			logger.Warn("Invalid page token", zap.Error(err))
+			message := fmt.Sprintf("invalid page token: %s", err.Error())
+			setResponseStatusCodes(ctx, http.StatusBadRequest)
+			return &api.ListBackupsResp{Success: false, Message: &message}, nil
+		}
+		logger.Error("List backups failed", zap.Error(err))
+		message := fmt.Sprintf("list backups failed: %s", err.Error())
+		setResponseStatusCodes(ctx, http.StatusInternalServerError)
+		return &api.ListBackupsResp{Success: false, Message: &message}, nil
+	}
+
+	resp := &api.ListBackupsResp{
+		Success: true,
+		Data:    make([]*api.BackupInfo, 0, len(backupList.Items)),
+	}
+	for i := range backupList.Items {
+		resp.Data = append(resp.Data, convertToBackupInfo(&backupList.Items[i]))
+	}
+	if backupList.Continue != "" {
+		resp.NextPageToken = &backupList.Continue
+	}
+	return resp, nil
+}
+
+func (s *ClusterServer) ScheduleBackup(ctx context.Context, req *api.ScheduleBackupReq) (*api.ScheduleBackupResp, error) {
+	k8sID := getKubernetesID(ctx)
+	opCli := s.KubeClient.GetOperatorClient(k8sID)
+	kubeCli := s.KubeClient.GetKubeClient(k8sID)
+	logger := log.L().With(zap.String("request", "ScheduleBackup"), zap.String("k8sID", k8sID),
+		zap.String("clusterID", req.ClusterId), zap.String("scheduleID", req.ScheduleId))
+	if opCli == nil || kubeCli == nil {
+		logger.Error("K8s client not found")
+		message := fmt.Sprintf("no %s is specified in the request header or the kubeconfig context not exists", HeaderKeyKubernetesID)
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.ScheduleBackupResp{Success: false, Message: &message}, nil
+	}
+
+	// check whether the cluster exists first
+	_, err := opCli.PingcapV1alpha1().TidbClusters(req.ClusterId).Get(ctx, tidbClusterName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Warn("TidbCluster not found", zap.Error(err))
+			message := fmt.Sprintf("TidbCluster %s not found", req.ClusterId)
+			setResponseStatusCodes(ctx, http.StatusNotFound)
+			return &api.ScheduleBackupResp{Success: false, Message: &message}, nil
+		}
+		logger.Error("Get TidbCluster failed", zap.Error(err))
+		message := fmt.Sprintf("get TidbCluster failed: %s", err.Error())
+		setResponseStatusCodes(ctx, http.StatusInternalServerError)
+		return &api.ScheduleBackupResp{Success: false, Message: &message}, nil
+	}
+
+	if req.AccessKey == "" || req.SecretKey == "" {
+		logger.Error("Assemble backup schedule secret failed")
+		message := "assemble backup schedule secret failed: access_key and secret_key must be specified"
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.ScheduleBackupResp{Success: false, Message: &message}, nil
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: req.ClusterId,
+			Name:      req.ScheduleId, // same as backup schedule name
+		},
+		Data: map[string][]byte{
+			"access_key": []byte(req.AccessKey),
+			"secret_key": []byte(req.SecretKey),
+		},
+	}
+
+	bs, err := assembleBackupSchedule(req)
+	if err != nil {
+		logger.Error("Assemble backup schedule CR failed", zap.Error(err))
+		message := fmt.Sprintf("assemble backup schedule CR failed: %s", err.Error())
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.ScheduleBackupResp{Success: false, Message: &message}, nil
+	}
+
+	// ensure RBAC for the backups created by the schedule
+	if err = ensureBackupRestoreRBAC(ctx, kubeCli, req.ClusterId); err != nil {
+		logger.Error("Ensure backup RBAC failed", zap.Error(err))
+		message := fmt.Sprintf("ensure backup RBAC failed: %s", err.Error())
+		setResponseStatusCodes(ctx, http.StatusInternalServerError)
+		return &api.ScheduleBackupResp{Success: false, Message: &message}, nil
+	}
+
+	// create secret, an existing one is only reused if it has the same credentials
+	secretCreated := true
+	_, err = kubeCli.CoreV1().Secrets(req.ClusterId).Create(ctx, secret, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		secretCreated = false
+		var existing *corev1.Secret
+		existing, err = kubeCli.CoreV1().Secrets(req.ClusterId).Get(ctx, secret.Name, metav1.GetOptions{})
+		if err == nil && !reflect.DeepEqual(existing.Data, secret.Data) {
+			logger.Error("Backup schedule secret already exists with different credentials")
+			message := fmt.Sprintf("Secret %s already exists with different credentials", secret.Name)
+			setResponseStatusCodes(ctx, http.StatusConflict)
+			return &api.ScheduleBackupResp{Success: false, Message: &message}, nil
+		}
+	}
+	if err != nil {
+		logger.Error("Create backup schedule secret failed", zap.Error(err))
+		message := fmt.Sprintf("create backup schedule secret failed: %s", err.Error())
+		setResponseStatusCodes(ctx, http.StatusInternalServerError)
+		return &api.ScheduleBackupResp{Success: false, Message: &message}, nil
+	}
+
+	// create backup schedule
+	_, err = opCli.PingcapV1alpha1().BackupSchedules(req.ClusterId).Create(ctx, bs, metav1.CreateOptions{})
+	if err != nil {
+		// the secret created by this request is useless without the backup schedule
+		if secretCreated {
+			if delErr := kubeCli.CoreV1().Secrets(req.ClusterId).Delete(ctx, secret.Name, metav1.DeleteOptions{}); delErr != nil && !apierrors.IsNotFound(delErr) {
+				logger.Error("Delete backup schedule secret failed", zap.Error(delErr))
+			}
+		}
+		if apierrors.IsAlreadyExists(err) {
+			logger.Error("Backup schedule already exists", zap.Error(err))
+			message := fmt.Sprintf("BackupSchedule %s already exists", req.ScheduleId)
+			setResponseStatusCodes(ctx, http.StatusConflict)
+			return &api.ScheduleBackupResp{Success: false, Message: &message}, nil
+		}
+		logger.Error("Create backup schedule failed", zap.Error(err))
+		message := fmt.Sprintf("create backup schedule failed: %s", err.Error())
+		setResponseStatusCodes(ctx, http.StatusInternalServerError)
+		return &api.ScheduleBackupResp{Success: false, Message: &message}, nil
+	}
+
+	return &api.ScheduleBackupResp{Success: true}, nil
+}
+
+func assembleBackupSchedule(req *api.ScheduleBackupReq) (*v1alpha1.BackupSchedule, error) {
+	if req.Schedule == "" {
+		return nil, errors.New("schedule must be specified")
+	}
+	if req.Bucket == "" || req.Prefix == "" {
+		return nil, errors.New("bucket and prefix must be specified")
+	}
+	if req.MaxBackups != nil && req.MaxReservedTime != nil {
+		return nil, errors.New("only one of max_backups and max_reserved_time can be specified")
+	}
+
+	var endpoint string
+	if req.Endpoint != nil {
+		endpoint = *req.Endpoint
+	}
+
+	bs := &v1alpha1.BackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: req.ClusterId,
+			Name:      req.ScheduleId,
+		},
+		Spec: v1alpha1.BackupScheduleSpec{
+			Schedule:        req.Schedule,
+			MaxReservedTime: req.MaxReservedTime,
+			BackupTemplate: v1alpha1.BackupSpec{
+				CleanPolicy: v1alpha1.CleanPolicyTypeDelete, // always delete data when deleting backup
+				BR: &v1alpha1.BRConfig{
+					ClusterNamespace: req.ClusterId,
+					Cluster:          tidbClusterName,
+				},
+				StorageProvider: v1alpha1.StorageProvider{
+					S3: &v1alpha1.S3StorageProvider{
+						// NOTE: update these fields if necessary
+						Provider:     v1alpha1.S3StorageProviderTypeAWS,
+						StorageClass: s3StorageClass,
+						SecretName:   req.ScheduleId, // same as backup schedule name
+						Endpoint:     endpoint,
+						Bucket:       req.Bucket,
+						Prefix:       req.Prefix,
+					},
+				},
+			},
+		},
+	}
+	if req.MaxBackups != nil {
+		maxBackups := int32(*req.MaxBackups)
+		bs.Spec.MaxBackups = &maxBackups
+	}
+
+	// validate the schedule and the retention in the same way as the admission webhook
+	if errs := validation.ValidateBackupSchedule(bs); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	return bs, nil
+}
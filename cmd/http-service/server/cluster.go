@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"math/rand"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -26,6 +27,7 @@ import (
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/structpb"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -1354,3 +1356,315 @@ func setRestartAnnotation(tc *v1alpha1.TidbCluster, components []string) (*v1alp
 
 	return tc, nil
 }
+
+func (s *ClusterServer) ScaleComponent(ctx context.Context, req *api.ScaleComponentReq) (*api.ScaleComponentResp, error) {
+	k8sID := getKubernetesID(ctx)
+	opCli := s.KubeClient.GetOperatorClient(k8sID)
+	logger := log.L().With(zap.String("request", "ScaleComponent"), zap.String("k8sID", k8sID),
+		zap.String("clusterID", req.ClusterId), zap.String("component", req.Component))
+	if opCli == nil {
+		logger.Error("K8s client not found")
+		message := fmt.Sprintf("no %s is specified in the request header or the kubeconfig context not exists", HeaderKeyKubernetesID)
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.ScaleComponentResp{Success: false, Message: &message}, nil
+	}
+
+	tc, err := opCli.PingcapV1alpha1().TidbClusters(req.ClusterId).Get(ctx, tidbClusterName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Warn("TidbCluster not found", zap.Error(err))
+			message := fmt.Sprintf("TidbCluster %s not found", req.ClusterId)
+			setResponseStatusCodes(ctx, http.StatusNotFound)
+			return &api.ScaleComponentResp{Success: false, Message: &message}, nil
+		}
+		logger.Error("Get TidbCluster failed", zap.Error(err))
+		message := fmt.Sprintf("get TidbCluster failed: %s", err.Error())
+		setResponseStatusCodes(ctx, http.StatusInternalServerError)
+		return &api.ScaleComponentResp{Success: false, Message: &message}, nil
+	}
+
+	if err = setComponentReplicas(tc, req.Component, req.Replicas); err != nil {
+		logger.Error("Scale component failed", zap.Error(err))
+		message := fmt.Sprintf("scale component failed: %s", err.Error())
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.ScaleComponentResp{Success: false, Message: &message}, nil
+	}
+
+	if _, err = opCli.PingcapV1alpha1().TidbClusters(req.ClusterId).Update(ctx, tc, metav1.UpdateOptions{}); err != nil {
+		logger.Error("Update TidbCluster failed", zap.Error(err))
+		message := fmt.Sprintf("update TidbCluster failed: %s", err.Error())
+		setResponseStatusCodes(ctx, http.StatusInternalServerError)
+		return &api.ScaleComponentResp{Success: false, Message: &message}, nil
+	}
+
+	return &api.ScaleComponentResp{Success: true}, nil
+}
+
+func setComponentReplicas(tc *v1alpha1.TidbCluster, component string, replicas uint32) error {
+	if replicas == 0 {
+		return errors.New("replicas must be greater than 0")
+	}
+
+	switch strings.ToLower(component) {
+	case "pd":
+		tc.Spec.PD.Replicas = int32(replicas)
+	case "tikv":
+		tc.Spec.TiKV.Replicas = int32(replicas)
+	case "tidb":
+		tc.Spec.TiDB.Replicas = int32(replicas)
+	case "tiflash":
+		// NOTE: DO NOT support scale-out TiFlash from 0 replicas to non-zero replicas for now
+		if tc.Spec.TiFlash == nil || tc.Spec.TiFlash.Replicas == 0 {
+			return errors.New("TiFlash is not deployed in the cluster")
+		}
+		tc.Spec.TiFlash.Replicas = int32(replicas)
+	default:
+		return fmt.Errorf("invalid component: %s", component)
+	}
+	return nil
+}
+
+func (s *ClusterServer) StartUpgrade(ctx context.Context, req *api.StartUpgradeReq) (*api.StartUpgradeResp, error) {
+	k8sID := getKubernetesID(ctx)
+	opCli := s.KubeClient.GetOperatorClient(k8sID)
+	logger := log.L().With(zap.String("request", "StartUpgrade"), zap.String("k8sID", k8sID),
+		zap.String("clusterID", req.ClusterId), zap.String("version", req.Version))
+	if opCli == nil {
+		logger.Error("K8s client not found")
+		message := fmt.Sprintf("no %s is specified in the request header or the kubeconfig context not exists", HeaderKeyKubernetesID)
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.StartUpgradeResp{Success: false, Message: &message}, nil
+	}
+
+	if req.Version == "" {
+		logger.Error("Target version not specified")
+		message := "the target version must be specified"
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.StartUpgradeResp{Success: false, Message: &message}, nil
+	}
+
+	tc, err := opCli.PingcapV1alpha1().TidbClusters(req.ClusterId).Get(ctx, tidbClusterName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Warn("TidbCluster not found", zap.Error(err))
+			message := fmt.Sprintf("TidbCluster %s not found", req.ClusterId)
+			setResponseStatusCodes(ctx, http.StatusNotFound)
+			return &api.StartUpgradeResp{Success: false, Message: &message}, nil
+		}
+		logger.Error("Get TidbCluster failed", zap.Error(err))
+		message := fmt.Sprintf("get TidbCluster failed: %s", err.Error())
+		setResponseStatusCodes(ctx, http.StatusInternalServerError)
+		return &api.StartUpgradeResp{Success: false, Message: &message}, nil
+	}
+
+	if convertClusterStatus(tc) == ClusterStatusUpgrading && tc.Spec.Version != req.Version {
+		logger.Error("TidbCluster is upgrading to another version", zap.String("current", tc.Spec.Version))
+		message := fmt.Sprintf("TidbCluster %s is upgrading to %s", req.ClusterId, tc.Spec.Version)
+		setResponseStatusCodes(ctx, http.StatusConflict)
+		return &api.StartUpgradeResp{Success: false, Message: &message}, nil
+	}
+
+	setClusterVersion(tc, req.Version)
+	if _, err = opCli.PingcapV1alpha1().TidbClusters(req.ClusterId).Update(ctx, tc, metav1.UpdateOptions{}); err != nil {
+		logger.Error("Update TidbCluster failed", zap.Error(err))
+		message := fmt.Sprintf("update TidbCluster failed: %s", err.Error())
+		setResponseStatusCodes(ctx, http.StatusInternalServerError)
+		return &api.StartUpgradeResp{Success: false, Message: &message}, nil
+	}
+
+	return &api.StartUpgradeResp{Success: true}, nil
+}
+
+// setClusterVersion sets the version of the TidbCluster, the versions of the components are
+// reset so that all of them are upgraded to the version by the operator in order
+func setClusterVersion(tc *v1alpha1.TidbCluster, version string) {
+	tc.Spec.Version = version
+	tc.Spec.PD.Version = nil
+	tc.Spec.TiKV.Version = nil
+	tc.Spec.TiDB.Version = nil
+	if tc.Spec.TiFlash != nil {
+		tc.Spec.TiFlash.Version = nil
+	}
+}
+
+func (s *ClusterServer) GetUpgradeProgress(ctx context.Context, req *api.GetUpgradeProgressReq) (*api.GetUpgradeProgressResp, error) {
+	k8sID := getKubernetesID(ctx)
+	opCli := s.KubeClient.GetOperatorClient(k8sID)
+	logger := log.L().With(zap.String("request", "GetUpgradeProgress"), zap.String("k8sID", k8sID), zap.String("clusterID", req.ClusterId))
+	if opCli == nil {
+		logger.Error("K8s client not found")
+		message := fmt.Sprintf("no %s is specified in the request header or the kubeconfig context not exists", HeaderKeyKubernetesID)
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.GetUpgradeProgressResp{Success: false, Message: &message}, nil
+	}
+
+	tc, err := opCli.PingcapV1alpha1().TidbClusters(req.ClusterId).Get(ctx, tidbClusterName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Warn("TidbCluster not found", zap.Error(err))
+			message := fmt.Sprintf("TidbCluster %s not found", req.ClusterId)
+			setResponseStatusCodes(ctx, http.StatusNotFound)
+			return &api.GetUpgradeProgressResp{Success: false, Message: &message}, nil
+		}
+		logger.Error("Get TidbCluster failed", zap.Error(err))
+		message := fmt.Sprintf("get TidbCluster failed: %s", err.Error())
+		setResponseStatusCodes(ctx, http.StatusInternalServerError)
+		return &api.GetUpgradeProgressResp{Success: false, Message: &message}, nil
+	}
+
+	return &api.GetUpgradeProgressResp{Success: true, Data: convertToUpgradeProgress(tc)}, nil
+}
+
+// convertToUpgradeProgress returns the upgrade progress of the components in the order they are
+// upgraded by the operator
+func convertToUpgradeProgress(tc *v1alpha1.TidbCluster) *api.UpgradeProgress {
+	progress := &api.UpgradeProgress{
+		ClusterId:     tc.Namespace,
+		TargetVersion: tc.Spec.Version,
+		Completed:     true,
+	}
+
+	progress.Components = append(progress.Components, convertToComponentUpgradeProgress("pd",
+		tc.Spec.PD.Replicas, tc.PDImage(), tc.Status.PD.Image, tc.Status.PD.Phase, tc.Status.PD.StatefulSet))
+	if tc.Spec.TiFlash != nil && tc.Spec.TiFlash.Replicas > 0 {
+		progress.Components = append(progress.Components, convertToComponentUpgradeProgress("tiflash",
+			tc.Spec.TiFlash.Replicas, tc.TiFlashImage(), tc.Status.TiFlash.Image, tc.Status.TiFlash.Phase, tc.Status.TiFlash.StatefulSet))
+	}
+	progress.Components = append(progress.Components, convertToComponentUpgradeProgress("tikv",
+		tc.Spec.TiKV.Replicas, tc.TiKVImage(), tc.Status.TiKV.Image, tc.Status.TiKV.Phase, tc.Status.TiKV.StatefulSet))
+	progress.Components = append(progress.Components, convertToComponentUpgradeProgress("tidb",
+		tc.Spec.TiDB.Replicas, tc.TiDBImage(), tc.Status.TiDB.Image, tc.Status.TiDB.Phase, tc.Status.TiDB.StatefulSet))
+
+	for _, comp := range progress.Components {
+		if !comp.Completed {
+			progress.Completed = false
+		}
+	}
+	return progress
+}
+
+func convertToComponentUpgradeProgress(component string, replicas int32, targetImage, image string,
+	phase v1alpha1.MemberPhase, sts *appsv1.StatefulSetStatus) *api.ComponentUpgradeProgress {
+	progress := &api.ComponentUpgradeProgress{
+		Component: component,
+		Phase:     string(phase),
+		Replicas:  uint32(replicas),
+		Image:     targetImage,
+	}
+
+	// the image in the status is updated after the StatefulSet is updated by the operator,
+	// the pods at the update revision of the StatefulSet are running the target image then
+	if image != targetImage || sts == nil {
+		return progress
+	}
+	if sts.CurrentRevision == sts.UpdateRevision {
+		progress.UpgradedReplicas = uint32(sts.Replicas)
+	} else {
+		progress.UpgradedReplicas = uint32(sts.UpdatedReplicas)
+	}
+	progress.Completed = phase != v1alpha1.UpgradePhase && sts.CurrentRevision == sts.UpdateRevision &&
+		progress.UpgradedReplicas >= progress.Replicas
+	return progress
+}
+
+func (s *ClusterServer) GetClusterTopology(ctx context.Context, req *api.GetClusterTopologyReq) (*api.GetClusterTopologyResp, error) {
+	k8sID := getKubernetesID(ctx)
+	opCli := s.KubeClient.GetOperatorClient(k8sID)
+	kubeCli := s.KubeClient.GetKubeClient(k8sID)
+	logger := log.L().With(zap.String("request", "GetClusterTopology"), zap.String("k8sID", k8sID), zap.String("clusterID", req.ClusterId))
+	if opCli == nil || kubeCli == nil {
+		logger.Error("K8s client not found")
+		message := fmt.Sprintf("no %s is specified in the request header or the kubeconfig context not exists", HeaderKeyKubernetesID)
+		setResponseStatusCodes(ctx, http.StatusBadRequest)
+		return &api.GetClusterTopologyResp{Success: false, Message: &message}, nil
+	}
+
+	tc, err := opCli.PingcapV1alpha1().TidbClusters(req.ClusterId).Get(ctx, tidbClusterName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Warn("TidbCluster not found", zap.Error(err))
+			message := fmt.Sprintf("TidbCluster %s not found", req.ClusterId)
+			setResponseStatusCodes(ctx, http.StatusNotFound)
+			return &api.GetClusterTopologyResp{Success: false, Message: &message}, nil
+		}
+		logger.Error("Get TidbCluster failed", zap.Error(err))
+		message := fmt.Sprintf("get TidbCluster failed: %s", err.Error())
+		setResponseStatusCodes(ctx, http.StatusInternalServerError)
+		return &api.GetClusterTopologyResp{Success: false, Message: &message}, nil
+	}
+
+	podList, err := kubeCli.CoreV1().Pods(tc.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{
+			label.InstanceLabelKey: tc.Name,
+		}).String(),
+	})
+	if err != nil {
+		logger.Error("List pods failed", zap.Error(err))
+	}
+
+	topology := &api.ClusterTopology{ClusterId: tc.Namespace}
+	namePrefix := fmt.Sprintf("%s-", tc.Name)
+	for name, member := range tc.Status.PD.Members {
+		id, err := strconv.ParseUint(member.ID, 10, 64)
+		if err != nil {
+			logger.Error("Parse PD member ID failed", zap.String("id", member.ID), zap.Error(err))
+		}
+		topology.Pd = append(topology.Pd, &api.PDMember{
+			Name:      strings.TrimPrefix(name, namePrefix),
+			Id:        id,
+			StartTime: getPodStartTime(podList, name),
+			Health:    member.Health,
+		})
+	}
+	for name, member := range tc.Status.TiDB.Members {
+		topology.Tidb = append(topology.Tidb, &api.TiDBMember{
+			Name:      strings.TrimPrefix(name, namePrefix),
+			StartTime: getPodStartTime(podList, name),
+			Health:    member.Health,
+		})
+	}
+	sort.Slice(topology.Pd, func(i, j int) bool { return topology.Pd[i].Name < topology.Pd[j].Name })
+	sort.Slice(topology.Tidb, func(i, j int) bool { return topology.Tidb[i].Name < topology.Tidb[j].Name })
+
+	// the stores are got from PD through the API server proxy, which can not present the client
+	// certificate required by the TLS-enabled cluster, so the synced status is used instead
+	if tc.IsTLSClusterEnabled() {
+		topology.Stores = convertStatusStores(logger, tc)
+		topology.Source = topologySourceStatus
+	} else if stores, err := getStoresFromPD(ctx, kubeCli, tc); err != nil {
+		logger.Warn("Get stores from PD failed, use the stores in the status of TidbCluster", zap.Error(err))
+		topology.Stores = convertStatusStores(logger, tc)
+		topology.Source = topologySourceStatus
+	} else {
+		topology.Stores = stores
+		topology.Source = topologySourcePD
+	}
+	sort.Slice(topology.Stores, func(i, j int) bool { return topology.Stores[i].Id < topology.Stores[j].Id })
+
+	return &api.GetClusterTopologyResp{Success: true, Data: topology}, nil
+}
+
+// convertStatusStores returns the stores synced from PD to the status of the TidbCluster by the operator
+func convertStatusStores(logger *zap.Logger, tc *v1alpha1.TidbCluster) []*api.StoreInfo {
+	stores := make([]*api.StoreInfo, 0, len(tc.Status.TiKV.Stores)+len(tc.Status.TiFlash.Stores))
+	convert := func(engine string, statusStores map[string]v1alpha1.TiKVStore) {
+		for _, store := range statusStores {
+			id, err := strconv.ParseUint(store.ID, 10, 64)
+			if err != nil {
+				logger.Error("Parse store ID failed", zap.String("id", store.ID), zap.Error(err))
+			}
+			stores = append(stores, &api.StoreInfo{
+				Id:          id,
+				Address:     store.IP,
+				Engine:      engine,
+				State:       store.State,
+				LeaderCount: uint32(store.LeaderCount),
+			})
+		}
+	}
+	convert(storeEngineTiKV, tc.Status.TiKV.Stores)
+	convert(storeEngineTiKV, tc.Status.TiKV.TombstoneStores)
+	convert(storeEngineTiFlash, tc.Status.TiFlash.Stores)
+	convert(storeEngineTiFlash, tc.Status.TiFlash.TombstoneStores)
+	return stores
+}
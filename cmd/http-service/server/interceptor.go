@@ -0,0 +1,131 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/pingcap/tidb-operator/http-service/auth"
+	"github.com/pingcap/tidb-operator/http-service/pbgen/api"
+)
+
+// clusterIDGetter is implemented by all the requests of the RPCs on a cluster
+type clusterIDGetter interface {
+	GetClusterId() string
+}
+
+// successGetter is implemented by all the responses
+type successGetter interface {
+	GetSuccess() bool
+}
+
+// AuthzInterceptor returns a unary interceptor which checks the principal forwarded by the gateway
+// against the policy, and writes the audit logs for the mutating and denied calls.
+func AuthzInterceptor(policy *auth.Policy) grpc.UnaryServerInterceptor {
+	auditLogger := log.L().Named("audit")
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method := path.Base(info.FullMethod)
+		k8sID := getKubernetesID(ctx)
+		var clusterID string
+		if r, ok := req.(clusterIDGetter); ok {
+			clusterID = r.GetClusterId()
+		}
+
+		// the gateway always forwards a principal, a call without it does not come from the gateway and is denied
+		p, _ := auth.FromIncomingContext(ctx)
+		allowed := policy.Allowed(p, k8sID, clusterID, method)
+		mutating := auth.IsMutating(method)
+		if allowed && !mutating {
+			return handler(ctx, req)
+		}
+
+		logger := auditLogger.With(
+			zap.String("method", method),
+			zap.String("k8sID", k8sID),
+			zap.String("cluster", clusterID),
+			zap.Bool("allowed", allowed),
+		)
+		if p != nil {
+			logger = logger.With(
+				zap.String("principal", p.Name),
+				zap.Strings("groups", p.Groups),
+				zap.String("authenticator", p.Authenticator),
+			)
+		}
+
+		if !allowed {
+			logger.Warn("Call denied")
+			setResponseStatusCodes(ctx, http.StatusForbidden)
+			return newDeniedResponse(info.FullMethod, p, method, clusterID)
+		}
+
+		startTime := time.Now()
+		resp, err := handler(ctx, req)
+		logger = logger.With(zap.Duration("latency", time.Since(startTime)))
+		if r, ok := resp.(successGetter); ok {
+			logger = logger.With(zap.Bool("success", r.GetSuccess()))
+		}
+		if err != nil {
+			logger = logger.With(zap.Error(err))
+		}
+		logger.Info("Call finished")
+		return resp, err
+	}
+}
+
+// newDeniedResponse returns the response of the method with `success` set to false and the `message`
+// set to the reason, the same as the responses of the other failed calls.
+func newDeniedResponse(fullMethod string, p *auth.Principal, method, clusterID string) (interface{}, error) {
+	sd, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(api.Cluster_ServiceDesc.ServiceName))
+	if err != nil {
+		return nil, fmt.Errorf("service of %s not found: %v", fullMethod, err)
+	}
+	md := sd.(protoreflect.ServiceDescriptor).Methods().ByName(protoreflect.Name(method))
+	if md == nil {
+		return nil, fmt.Errorf("method %s not found", fullMethod)
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+	if err != nil {
+		return nil, err
+	}
+
+	name := auth.AnonymousName
+	if p != nil {
+		name = p.Name
+	}
+	message := fmt.Sprintf("%s is not allowed to call %s", name, method)
+	if clusterID != "" {
+		message = fmt.Sprintf("%s on cluster %s", message, clusterID)
+	}
+
+	resp := mt.New()
+	fields := resp.Descriptor().Fields()
+	if f := fields.ByName("success"); f != nil {
+		resp.Set(f, protoreflect.ValueOfBool(false))
+	}
+	if f := fields.ByName("message"); f != nil {
+		resp.Set(f, protoreflect.ValueOfString(message))
+	}
+	return resp.Interface(), nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tidb-operator/http-service/kube"
+	"github.com/pingcap/tidb-operator/http-service/pbgen/api"
+)
+
+func (s *ClusterServer) ListKubernetes(ctx context.Context, req *api.ListKubernetesReq) (*api.ListKubernetesResp, error) {
+	logger := log.L().With(zap.String("request", "ListKubernetes"))
+
+	infos := s.KubeClient.List()
+	data := make([]*api.KubernetesInfo, 0, len(infos))
+	for _, info := range infos {
+		data = append(data, convertToKubernetesInfo(info))
+	}
+
+	logger.Info("List kubernetes success", zap.Int("count", len(data)))
+	return &api.ListKubernetesResp{Success: true, Data: data}, nil
+}
+
+func convertToKubernetesInfo(info kube.ClusterInfo) *api.KubernetesInfo {
+	ret := &api.KubernetesInfo{
+		Id:          info.ID,
+		Source:      info.Source,
+		Healthy:     info.Healthy,
+		Version:     info.Version,
+		LatencyMs:   uint32(info.Latency.Milliseconds()),
+		Qps:         info.QPS,
+		Burst:       uint32(info.Burst),
+		MaxInflight: uint32(info.MaxInflight),
+		Inflight:    uint32(info.Inflight),
+	}
+	if info.Message != "" {
+		message := info.Message
+		ret.Message = &message
+	}
+	if !info.LastProbeTime.IsZero() {
+		ret.LastProbeTime = info.LastProbeTime.Format(time.RFC3339)
+	}
+	return ret
+}
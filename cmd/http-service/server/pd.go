@@ -0,0 +1,107 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/pingcap/tidb-operator/http-service/pbgen/api"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+)
+
+const (
+	topologySourcePD     = "pd"
+	topologySourceStatus = "status"
+
+	storeEngineTiKV    = "tikv"
+	storeEngineTiFlash = "tiflash"
+
+	pdStoresPath = "/pd/api/v1/stores"
+	// the store label set by TiFlash, `tiflash_compute` is used by the compute nodes of disaggregated TiFlash
+	pdEngineLabelKey = "engine"
+)
+
+// pdStoresInfo is the response of the stores API of PD
+type pdStoresInfo struct {
+	Count  int            `json:"count"`
+	Stores []*pdStoreInfo `json:"stores"`
+}
+
+type pdStoreInfo struct {
+	Store struct {
+		ID        uint64 `json:"id"`
+		Address   string `json:"address"`
+		Version   string `json:"version"`
+		StateName string `json:"state_name"`
+		Labels    []struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		} `json:"labels"`
+	} `json:"store"`
+	Status struct {
+		Capacity        string    `json:"capacity"`
+		Available       string    `json:"available"`
+		LeaderCount     int       `json:"leader_count"`
+		RegionCount     int       `json:"region_count"`
+		LastHeartbeatTS time.Time `json:"last_heartbeat_ts"`
+	} `json:"status"`
+}
+
+// getStoresFromPD gets the stores from PD through the service proxy of the API server,
+// so it works whether the http-service runs in the Kubernetes cluster or not
+func getStoresFromPD(ctx context.Context, kubeCli kubernetes.Interface, tc *v1alpha1.TidbCluster) ([]*api.StoreInfo, error) {
+	body, err := kubeCli.CoreV1().Services(tc.Namespace).ProxyGet("http", fmt.Sprintf("%s-pd", tc.Name),
+		strconv.Itoa(int(v1alpha1.DefaultPDClientPort)), pdStoresPath, nil).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &pdStoresInfo{}
+	if err = json.Unmarshal(body, info); err != nil {
+		return nil, fmt.Errorf("parse stores from PD failed: %v", err)
+	}
+
+	stores := make([]*api.StoreInfo, 0, len(info.Stores))
+	for _, s := range info.Stores {
+		store := &api.StoreInfo{
+			Id:          s.Store.ID,
+			Address:     s.Store.Address,
+			Engine:      storeEngineTiKV,
+			State:       s.Store.StateName,
+			Version:     s.Store.Version,
+			Labels:      make(map[string]string, len(s.Store.Labels)),
+			LeaderCount: uint32(s.Status.LeaderCount),
+			RegionCount: uint32(s.Status.RegionCount),
+			Capacity:    s.Status.Capacity,
+			Available:   s.Status.Available,
+		}
+		for _, l := range s.Store.Labels {
+			store.Labels[l.Key] = l.Value
+			if l.Key == pdEngineLabelKey && l.Value != "" {
+				store.Engine = l.Value
+			}
+		}
+		if !s.Status.LastHeartbeatTS.IsZero() {
+			store.LastHeartbeat = s.Status.LastHeartbeatTS.Format(time.RFC3339)
+		}
+		stores = append(stores, store)
+	}
+	return stores, nil
+}
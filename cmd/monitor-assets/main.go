@@ -0,0 +1,110 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// tidb-monitor-assets uploads the alert rules and the dashboards rendered by the assets Job of
+// TidbMonitor in prometheus-operator mode into the ConfigMap read by tidb-controller-manager.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/pingcap/tidb-operator/pkg/version"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/component-base/logs"
+	"k8s.io/klog/v2"
+
+	// Enable FIPS when necessary
+	_ "github.com/pingcap/tidb-operator/pkg/fips"
+)
+
+var (
+	printVersion  bool
+	namespace     string
+	configMap     string
+	file          string
+	key           string
+	annotation    string
+	assetsVersion string
+)
+
+func init() {
+	klog.InitFlags(nil)
+	flag.BoolVar(&printVersion, "V", false, "Show version and quit")
+	flag.BoolVar(&printVersion, "version", false, "Show version and quit")
+	flag.StringVar(&namespace, "namespace", "", "The namespace of the ConfigMap")
+	flag.StringVar(&configMap, "configmap", "", "The name of the ConfigMap which the assets are uploaded to")
+	flag.StringVar(&file, "file", "/assets/assets.tar.gz", "The tarball of the assets")
+	flag.StringVar(&key, "key", "assets.tar.gz", "The key of the binary data of the ConfigMap")
+	flag.StringVar(&annotation, "annotation", "tidb.pingcap.com/monitor-assets-version", "The annotation of the ConfigMap which records the version of the assets")
+	flag.StringVar(&assetsVersion, "assets-version", "", "The version of the assets")
+	flag.Parse()
+}
+
+func main() {
+	if printVersion {
+		version.PrintVersionInfo()
+		os.Exit(0)
+	}
+	version.LogVersionInfo()
+
+	logs.InitLogs()
+	defer logs.FlushLogs()
+
+	flag.CommandLine.VisitAll(func(flag *flag.Flag) {
+		klog.V(1).Infof("FLAG: --%s=%q", flag.Name, flag.Value)
+	})
+
+	if namespace == "" || configMap == "" {
+		klog.Fatal("--namespace and --configmap must be set")
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		klog.Fatalf("failed to read %s: %v", file, err)
+	}
+
+	cfg, err := rest.InClusterConfig()
+	if err != nil {
+		klog.Fatalf("failed to get config: %v", err)
+	}
+	kubeCli, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		klog.Fatalf("failed to get kubernetes Clientset: %v", err)
+	}
+
+	// the ConfigMap is created by tidb-controller-manager before the Job, only its data is updated here
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := kubeCli.CoreV1().ConfigMaps(namespace).Get(context.TODO(), configMap, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if cm.BinaryData == nil {
+			cm.BinaryData = map[string][]byte{}
+		}
+		cm.BinaryData[key] = data
+		if cm.Annotations == nil {
+			cm.Annotations = map[string]string{}
+		}
+		cm.Annotations[annotation] = assetsVersion
+		_, err = kubeCli.CoreV1().ConfigMaps(namespace).Update(context.TODO(), cm, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		klog.Fatalf("failed to upload the assets to configmap %s/%s: %v", namespace, configMap, err)
+	}
+	klog.Infof("uploaded %d bytes of assets to configmap %s/%s", len(data), namespace, configMap)
+}
@@ -30,14 +30,30 @@ help() {
 Supported flags:
     --block <files_to_be_warmed_up_by_fio>
     --fs <files_to_be_warmed_up_by_filesystem>
+    --raw <files_to_be_warmed_up_by_reading_raw_block_device>
     --debug enable \`set -x\`
     --exit-on-corruption
 EOF
 }
 
+run_warmup() {
+    # Report the progress to the restore when `spec.reportWarmupProgress` of the restore is set.
+    if [ -n "${WARMUP_RESTORE:-}" ]; then
+        set -- "$@" --progress.restore="$WARMUP_RESTORE" --progress.key="${WARMUP_PROGRESS_KEY:-}"
+    fi
+    # WARMUP_OPTIONS are extra flags from `spec.warmupOptions` of the restore, which may override the flags above.
+    # shellcheck disable=SC2086
+    /warmup "$@" ${WARMUP_OPTIONS:-}
+}
+
 warmup_by_file() {
     checkpoint=.com.pingcap.tidb.operator.ebs.warmup.checkpoint
-    /warmup --type=whole --files="$1" -P256 --direct --checkpoint.at="$1/$checkpoint"
+    run_warmup --type=whole --files="$1" -P256 --direct --checkpoint.at="$1/$checkpoint"
+}
+
+# All devices are warmed up by one process, so the progress of them can be reported together.
+warmup_by_block() {
+    run_warmup --type=block --files="$1" -P256 --direct
 }
 
 
@@ -56,6 +72,7 @@ trap cleanup EXIT
 operation=none
 exit_on_corruption=false
 bg_works=""
+raw_devices=""
 
 for arg in "$@"; do
     case $arg in
@@ -74,6 +91,8 @@ while [ $# -gt 0 ]; do
             ;;
         --fs) operation=fs
             ;;
+        --raw) operation=raw
+            ;;
         --debug) set -x
             ;;
         --exit-on-corruption)
@@ -100,7 +119,15 @@ while [ $# -gt 0 ]; do
                 fs) warmup_by_file "$1" &
                     bg_works="$! $bg_works"
                     ;;
-                *) die "internal error: unsupported operation $1; forgot to call --block or --fs?"
+                raw)
+                    device=$(dev_name_by_mount_point "$1")
+                    if [ -z "$device" ]; then
+                        echo "$1 isn't a mount point, skipping."
+                    else
+                        raw_devices="${raw_devices:+$raw_devices,}/dev/$device"
+                    fi
+                    ;;
+                *) die "internal error: unsupported operation $1; forgot to call --block, --fs or --raw?"
                     ;;
             esac
 
@@ -121,4 +148,8 @@ while [ $# -gt 0 ]; do
     shift
 done
 
+if [ -n "$raw_devices" ]; then
+    warmup_by_block "$raw_devices" &
+fi
+
 wait
@@ -13,6 +13,7 @@ require (
 	github.com/pingcap/tiproxy/lib v0.0.0-20230907130944-eb5b4b9c9e79
 	github.com/prometheus/common v0.45.0
 	github.com/prometheus/prometheus v0.49.1
+	github.com/robfig/cron v1.2.0
 	k8s.io/api v0.28.14
 	k8s.io/apiextensions-apiserver v0.28.14
 	k8s.io/apimachinery v0.28.14
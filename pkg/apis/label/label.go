@@ -47,6 +47,9 @@ const (
 	// MemberIDLabelKey is member id label key
 	MemberIDLabelKey string = "tidb.pingcap.com/member-id"
 
+	// ComponentGroupLabelKey is the label key of the named component group which the pod belongs to
+	ComponentGroupLabelKey string = "tidb.pingcap.com/component-group"
+
 	// InitLabelKey is the key for TiDB initializer
 	InitLabelKey string = "tidb.pingcap.com/initializer"
 
@@ -175,12 +178,16 @@ const (
 	TiKVLabelVal string = "tikv"
 	// TiFlashLabelVal is TiFlash label value
 	TiFlashLabelVal string = "tiflash"
+	// TiFlashComputeLabelVal is TiFlash compute node label value
+	TiFlashComputeLabelVal string = "tiflash-compute"
 	// TiCDCLabelVal is TiCDC label value
 	TiCDCLabelVal string = "ticdc"
 	// TiProxyLabelVal is TiProxy label value
 	TiProxyLabelVal string = "tiproxy"
 	// PumpLabelVal is Pump label value
 	PumpLabelVal string = "pump"
+	// DrainerLabelVal is Drainer label value
+	DrainerLabelVal string = "drainer"
 	// DiscoveryLabelVal is Discovery label value
 	DiscoveryLabelVal string = "discovery"
 	// TiDBMonitorVal is Monitor label value
@@ -189,6 +196,12 @@ const (
 	// TiDBMonitorProtectionFinalizer is the name of finalizer on TidbMonitors
 	TiDBMonitorProtectionFinalizer string = "tidb.pingcap.com/monitor-protection"
 
+	// TiDBUserProtectionFinalizer is the name of finalizer on TidbUsers, it ensures the user is dropped from TiDB
+	TiDBUserProtectionFinalizer string = "tidb.pingcap.com/user-protection"
+	// DrainerProtectionFinalizer is the name of finalizer on Drainers, it ensures the drainer is offline
+	// before the Drainer is deleted
+	DrainerProtectionFinalizer string = "tidb.pingcap.com/drainer-protection"
+
 	// CleanJobLabelVal is clean job label value
 	CleanJobLabelVal string = "clean"
 	// RestoreJobLabelVal is restore job label value
@@ -316,6 +329,14 @@ func NewTiDBDashboard() Label {
 	}
 }
 
+// NewDrainer initialize a new Label for the drainers of Drainer
+func NewDrainer() Label {
+	return Label{
+		NameLabelKey:      "tidb-drainer",
+		ManagedByLabelKey: TiDBOperator,
+	}
+}
+
 func NewGroup() Label {
 	return Label{
 		NameLabelKey:      "tidb-cluster-group",
@@ -460,6 +481,11 @@ func (l Label) IsPump() bool {
 	return l[ComponentLabelKey] == PumpLabelVal
 }
 
+// Drainer assigns drainer to component key in label
+func (l Label) Drainer() Label {
+	return l.Component(DrainerLabelVal)
+}
+
 // DMMaster assigns dm-master to component key in label
 func (l Label) DMMaster() Label {
 	return l.Component(DMMasterLabelVal)
@@ -550,6 +576,16 @@ func (l Label) IsTiFlash() bool {
 	return l[ComponentLabelKey] == TiFlashLabelVal
 }
 
+// TiFlashCompute assigns tiflash-compute to component key in label
+func (l Label) TiFlashCompute() Label {
+	return l.Component(TiFlashComputeLabelVal)
+}
+
+// IsTiFlashCompute returns whether label is a TiFlash compute component
+func (l Label) IsTiFlashCompute() bool {
+	return l[ComponentLabelKey] == TiFlashComputeLabelVal
+}
+
 // TiCDC assigns ticdc to component key in label
 func (l Label) TiCDC() Label {
 	return l.Component(TiCDCLabelVal)
@@ -608,3 +644,9 @@ func (l Label) IsTidbClusterPod() bool {
 func AnnoKeyOfConfigMapNameForNewSTS(compType string) string {
 	return AnnoPrefixConfigMapNameBeforeDelete + compType
 }
+
+// AnnoKeyOfConfigMapNameForNewGroupSTS returns the key of AnnoPrefixConfigMapNameBeforeDelete for the named group
+// of the component, it is stored in the TidbCluster which the group belongs to.
+func AnnoKeyOfConfigMapNameForNewGroupSTS(compType, group string) string {
+	return AnnoPrefixConfigMapNameBeforeDelete + compType + "." + group
+}
@@ -79,6 +79,11 @@ func (bk *Backup) GetBackupPVCName() string {
 	return fmt.Sprintf("backup-pvc-%s", bk.GetTidbEndpointHash())
 }
 
+// IsDumplingStreaming returns whether dumpling writes the backup data to the external storage directly
+func (bk *Backup) IsDumplingStreaming() bool {
+	return bk.Spec.BR == nil && bk.Spec.Dumpling != nil && bk.Spec.Dumpling.Streaming
+}
+
 // GetInstanceName return the backup instance name
 func (bk *Backup) GetInstanceName() string {
 	if bk.Labels != nil {
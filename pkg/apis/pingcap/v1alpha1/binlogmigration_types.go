@@ -0,0 +1,210 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/util/config"
+)
+
+// BinlogMigration migrates the replication of a TidbCluster from TiDB Binlog to TiCDC step by step:
+// it creates a changefeed, waits for the changefeed to catch up with the drainers, switches the
+// downstream consumers, disables the binlog of TiDB and takes the Pumps offline.
+//
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName="blm"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.cluster.name`,description="The TidbCluster to migrate"
+// +kubebuilder:printcolumn:name="Step",type=string,JSONPath=`.status.currentStep`,description="The step in progress"
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`,description="The phase of the migration"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type BinlogMigration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	// Spec defines the desired state of the migration
+	Spec BinlogMigrationSpec `json:"spec"`
+
+	// +k8s:openapi-gen=false
+	// Most recently observed status of the migration
+	Status BinlogMigrationStatus `json:"status,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BinlogMigrationList is a BinlogMigration list
+type BinlogMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ListMeta `json:"metadata"`
+
+	Items []BinlogMigration `json:"items"`
+}
+
+// +k8s:openapi-gen=true
+// BinlogMigrationSpec describes the migration from TiDB Binlog to TiCDC
+type BinlogMigrationSpec struct {
+	// Cluster is the TidbCluster to migrate, both Pump and TiCDC must be deployed in it.
+	Cluster TidbClusterRef `json:"cluster"`
+
+	// Drainer is the name of the Drainer in the namespace of the BinlogMigration which the changefeed
+	// takes over from. All the drainers registered by the cluster are waited for if it is not set.
+	// +optional
+	Drainer string `json:"drainer,omitempty"`
+
+	// NoDrainer confirms that no drainer replicates the binlog of the cluster. The migration waits for
+	// an online drainer to get the checkpoint that the changefeed must catch up with, unless it is set.
+	// +optional
+	NoDrainer bool `json:"noDrainer,omitempty"`
+
+	// Changefeed is the TiCDC changefeed which takes over the replication.
+	Changefeed BinlogMigrationChangefeed `json:"changefeed"`
+
+	// ConsumerService is the Service through which the downstream consumers connect to the downstream,
+	// it is switched to the downstream of the changefeed after the changefeed catches up.
+	// +optional
+	ConsumerService *BinlogMigrationConsumerService `json:"consumerService,omitempty"`
+
+	// Abort aborts the migration and rolls back the steps that are done. The migration can only be
+	// aborted before the binlog of TiDB is disabled, since the binlog written by TiDB is incomplete after that.
+	// +optional
+	Abort bool `json:"abort,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// BinlogMigrationChangefeed is the changefeed created by the migration
+type BinlogMigrationChangefeed struct {
+	// ID is the id of the changefeed. An existing changefeed with the id is taken over by the migration.
+	ID string `json:"id"`
+
+	// SinkURI is the sink URI of the changefeed.
+	// +optional
+	SinkURI string `json:"sinkURI,omitempty"`
+
+	// SinkURISecret refers to the key of a Secret in the namespace of the BinlogMigration which holds
+	// the sink URI, it is used if the sink URI contains the password of the downstream.
+	// +optional
+	SinkURISecret *corev1.SecretKeySelector `json:"sinkURISecret,omitempty"`
+
+	// Config is the replica config of the changefeed in the format of the TiCDC open API,
+	// e.g. `force_replicate` and `filter`.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:XPreserveUnknownFields
+	Config *config.GenericConfig `json:"config,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// BinlogMigrationConsumerService is an ExternalName Service used by the downstream consumers
+type BinlogMigrationConsumerService struct {
+	// Name is the name of the Service in the namespace of the BinlogMigration, it must be of the type ExternalName.
+	Name string `json:"name"`
+
+	// ExternalName is the downstream of the changefeed which the Service is switched to.
+	ExternalName string `json:"externalName"`
+}
+
+// BinlogMigrationPhase is the phase of the migration
+type BinlogMigrationPhase string
+
+const (
+	// BinlogMigrationRunning represents the migration is in progress
+	BinlogMigrationRunning BinlogMigrationPhase = "Running"
+	// BinlogMigrationComplete represents all the steps are done
+	BinlogMigrationComplete BinlogMigrationPhase = "Complete"
+	// BinlogMigrationAborted represents the migration is aborted and rolled back
+	BinlogMigrationAborted BinlogMigrationPhase = "Aborted"
+)
+
+// BinlogMigrationStepType is a step of the migration
+type BinlogMigrationStepType string
+
+const (
+	// BinlogMigrationCreateChangefeed creates the changefeed from the current TSO
+	BinlogMigrationCreateChangefeed BinlogMigrationStepType = "CreateChangefeed"
+	// BinlogMigrationWaitCatchUp waits for the drainers to replicate the binlog before the start ts
+	// of the changefeed, and for the changefeed to catch up with the checkpoint of the drainers
+	BinlogMigrationWaitCatchUp BinlogMigrationStepType = "WaitCatchUp"
+	// BinlogMigrationSwitchConsumers switches the consumer Service to the downstream of the changefeed
+	BinlogMigrationSwitchConsumers BinlogMigrationStepType = "SwitchConsumers"
+	// BinlogMigrationDisableBinlog disables the binlog of TiDB and waits for TiDB to be rolling restarted
+	BinlogMigrationDisableBinlog BinlogMigrationStepType = "DisableBinlog"
+	// BinlogMigrationOfflinePump scales in the Pumps, which makes each pump offline before its pod is deleted
+	BinlogMigrationOfflinePump BinlogMigrationStepType = "OfflinePump"
+)
+
+// BinlogMigrationSteps are the steps of the migration in order
+var BinlogMigrationSteps = []BinlogMigrationStepType{
+	BinlogMigrationCreateChangefeed,
+	BinlogMigrationWaitCatchUp,
+	BinlogMigrationSwitchConsumers,
+	BinlogMigrationDisableBinlog,
+	BinlogMigrationOfflinePump,
+}
+
+// BinlogMigrationStepState is the state of a step
+type BinlogMigrationStepState string
+
+const (
+	// BinlogMigrationStepPending represents the step is not started
+	BinlogMigrationStepPending BinlogMigrationStepState = "Pending"
+	// BinlogMigrationStepRunning represents the step is in progress
+	BinlogMigrationStepRunning BinlogMigrationStepState = "Running"
+	// BinlogMigrationStepComplete represents the step is done
+	BinlogMigrationStepComplete BinlogMigrationStepState = "Complete"
+	// BinlogMigrationStepRolledBack represents the step is rolled back after the migration is aborted
+	BinlogMigrationStepRolledBack BinlogMigrationStepState = "RolledBack"
+)
+
+// +k8s:openapi-gen=true
+// BinlogMigrationStep is the progress of a step
+type BinlogMigrationStep struct {
+	// Type is the type of the step
+	Type BinlogMigrationStepType `json:"type"`
+	// State is the state of the step
+	State BinlogMigrationStepState `json:"state"`
+	// Message is what the step is waiting for or the error of the last attempt
+	Message string `json:"message,omitempty"`
+	// StartTime is the time the step is started
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the step is done or rolled back
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// BinlogMigrationStatus is the progress of the migration
+type BinlogMigrationStatus struct {
+	// Phase is the phase of the migration
+	Phase BinlogMigrationPhase `json:"phase,omitempty"`
+	// CurrentStep is the step in progress
+	CurrentStep BinlogMigrationStepType `json:"currentStep,omitempty"`
+	// Steps are the progress of the steps in order
+	Steps []BinlogMigrationStep `json:"steps,omitempty"`
+	// ChangefeedStartTS is the start ts of the changefeed
+	ChangefeedStartTS string `json:"changefeedStartTS,omitempty"`
+	// ChangefeedCheckpointTS is the last observed checkpoint ts of the changefeed
+	ChangefeedCheckpointTS string `json:"changefeedCheckpointTS,omitempty"`
+	// DrainerCheckpointTS is the last observed checkpoint ts of the drainers
+	DrainerCheckpointTS string `json:"drainerCheckpointTS,omitempty"`
+	// ConsumerServiceExternalName is the external name of the consumer Service before it is switched,
+	// it is restored if the migration is aborted
+	ConsumerServiceExternalName string `json:"consumerServiceExternalName,omitempty"`
+}
@@ -78,6 +78,7 @@ type ComponentAccessor interface {
 	PodManagementPolicy() apps.PodManagementPolicyType
 	TopologySpreadConstraints() []corev1.TopologySpreadConstraint
 	SuspendAction() *SuspendAction
+	LogShipper() *LogShipperSpec
 }
 
 func (tc *TidbCluster) AllComponentSpec() []ComponentAccessor {
@@ -179,6 +180,7 @@ type componentAccessorImpl struct {
 	podSecurityContext        *corev1.PodSecurityContext
 	topologySpreadConstraints []TopologySpreadConstraint
 	suspendAction             *SuspendAction
+	logShipper                *LogShipperSpec
 
 	// ComponentSpec is the Component Spec
 	ComponentSpec *ComponentSpec
@@ -492,6 +494,13 @@ func (a *componentAccessorImpl) SuspendAction() *SuspendAction {
 	return action
 }
 
+func (a *componentAccessorImpl) LogShipper() *LogShipperSpec {
+	if a.ComponentSpec != nil && a.ComponentSpec.LogShipper != nil {
+		return a.ComponentSpec.LogShipper
+	}
+	return a.logShipper
+}
+
 func getComponentLabelValue(c MemberType) string {
 	switch c {
 	case PDMemberType:
@@ -506,6 +515,8 @@ func getComponentLabelValue(c MemberType) string {
 		return label.TiKVLabelVal
 	case TiFlashMemberType:
 		return label.TiFlashLabelVal
+	case TiFlashComputeMemberType:
+		return label.TiFlashComputeLabelVal
 	case TiProxyMemberType:
 		return label.TiProxyLabelVal
 	case TiCDCMemberType:
@@ -550,6 +561,7 @@ func buildTidbClusterComponentAccessor(c MemberType, tc *TidbCluster, componentS
 		podSecurityContext:        spec.PodSecurityContext,
 		topologySpreadConstraints: spec.TopologySpreadConstraints,
 		suspendAction:             spec.SuspendAction,
+		logShipper:                spec.LogShipper,
 
 		ComponentSpec: componentSpec,
 	}
@@ -646,6 +658,37 @@ func buildTiDBDashboardComponentAccessor(c MemberType, td *TidbDashboard, compon
 	return impl
 }
 
+func buildDrainerComponentAccessor(c MemberType, dr *Drainer, componentSpec *ComponentSpec) ComponentAccessor {
+	commonSpec := dr.Spec.ComponentSpec
+	impl := &componentAccessorImpl{
+		name:                      dr.GetName(),
+		kind:                      DrainerKind,
+		component:                 c,
+		imagePullSecrets:          commonSpec.ImagePullSecrets,
+		hostNetwork:               commonSpec.HostNetwork,
+		affinity:                  commonSpec.Affinity,
+		priorityClassName:         commonSpec.PriorityClassName,
+		clusterNodeSelector:       commonSpec.NodeSelector,
+		clusterLabels:             commonSpec.Labels,
+		clusterAnnotations:        commonSpec.Annotations,
+		tolerations:               commonSpec.Tolerations,
+		configUpdateStrategy:      ConfigUpdateStrategyRollingUpdate,
+		podSecurityContext:        commonSpec.PodSecurityContext,
+		topologySpreadConstraints: commonSpec.TopologySpreadConstraints,
+		dnsConfig:                 commonSpec.DNSConfig,
+		dnsPolicy:                 commonSpec.DNSPolicy,
+
+		ComponentSpec: componentSpec,
+	}
+	if commonSpec.ImagePullPolicy != nil {
+		impl.imagePullPolicy = *commonSpec.ImagePullPolicy
+	}
+	if commonSpec.SchedulerName != nil {
+		impl.schedulerName = *commonSpec.SchedulerName
+	}
+	return impl
+}
+
 // BaseDiscoverySpec returns the base spec of discovery component
 func (tc *TidbCluster) BaseDiscoverySpec() ComponentAccessor {
 	return buildTidbClusterComponentAccessor(DiscoveryMemberType, tc, tc.Spec.Discovery.ComponentSpec)
@@ -681,6 +724,16 @@ func (tc *TidbCluster) BaseTiFlashSpec() ComponentAccessor {
 	return buildTidbClusterComponentAccessor(TiFlashMemberType, tc, spec)
 }
 
+// BaseTiFlashComputeSpec returns the base spec of TiFlash compute nodes in the disaggregated mode
+func (tc *TidbCluster) BaseTiFlashComputeSpec() ComponentAccessor {
+	var spec *ComponentSpec
+	if tc.TiFlashDisaggregated() {
+		spec = &tc.Spec.TiFlash.Disaggregated.Compute.ComponentSpec
+	}
+
+	return buildTidbClusterComponentAccessor(TiFlashComputeMemberType, tc, spec)
+}
+
 // BaseTiProxySpec returns the base spec of TiProxy servers
 func (tc *TidbCluster) BaseTiProxySpec() ComponentAccessor {
 	var spec *ComponentSpec
@@ -756,3 +809,8 @@ func (tngm *TidbNGMonitoring) BaseNGMonitoringSpec() ComponentAccessor {
 func (td *TidbDashboard) BaseTidbDashboardSpec() ComponentAccessor {
 	return buildTiDBDashboardComponentAccessor(TiDBDashboardMemberType, td, &td.Spec.ComponentSpec)
 }
+
+// BaseDrainerSpec returns the base spec of the drainer
+func (dr *Drainer) BaseDrainerSpec() ComponentAccessor {
+	return buildDrainerComponentAccessor(DrainerMemberType, dr, &dr.Spec.ComponentSpec)
+}
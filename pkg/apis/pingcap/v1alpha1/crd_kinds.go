@@ -57,6 +57,18 @@ const (
 	TiDBDashboardKind    = "TidbDashboard"
 	TiDBDashboardKindKey = "tidbdashboard"
 
+	TiDBUserName    = "tidbusers"
+	TiDBUserKind    = "TidbUser"
+	TiDBUserKindKey = "tidbuser"
+
+	DrainerName    = "drainers"
+	DrainerKind    = "Drainer"
+	DrainerKindKey = "drainer"
+
+	BinlogMigrationName    = "binlogmigrations"
+	BinlogMigrationKind    = "BinlogMigration"
+	BinlogMigrationKindKey = "binlogmigration"
+
 	SpecPath = "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1."
 )
 
@@ -0,0 +1,72 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaulting
+
+import "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+
+const defaultCompactConcurrency = 4
+
+// SetBackupDefault sets the default values of a Backup
+func SetBackupDefault(backup *v1alpha1.Backup) {
+	setBackupSpecDefault(backup.Namespace, &backup.Spec)
+}
+
+// SetRestoreDefault sets the default values of a Restore
+func SetRestoreDefault(restore *v1alpha1.Restore) {
+	if restore.Spec.Mode == "" {
+		restore.Spec.Mode = v1alpha1.RestoreModeSnapshot
+	}
+	setBRConfigDefault(restore.Namespace, restore.Spec.BR)
+}
+
+// SetBackupScheduleDefault sets the default values of the templates of a BackupSchedule
+func SetBackupScheduleDefault(bs *v1alpha1.BackupSchedule) {
+	setBackupSpecDefault(bs.Namespace, &bs.Spec.BackupTemplate)
+	if bs.Spec.LogBackupTemplate != nil {
+		setBackupSpecDefault(bs.Namespace, bs.Spec.LogBackupTemplate)
+	}
+	if bs.Spec.CompactBackupTemplate != nil {
+		setCompactSpecDefault(bs.Namespace, bs.Spec.CompactBackupTemplate)
+	}
+}
+
+// SetCompactBackupDefault sets the default values of a CompactBackup
+func SetCompactBackupDefault(compact *v1alpha1.CompactBackup) {
+	setCompactSpecDefault(compact.Namespace, &compact.Spec)
+}
+
+func setBackupSpecDefault(ns string, spec *v1alpha1.BackupSpec) {
+	if spec.Mode == "" {
+		spec.Mode = v1alpha1.BackupModeSnapshot
+	}
+	if spec.CleanPolicy == "" {
+		spec.CleanPolicy = v1alpha1.CleanPolicyTypeRetain
+	}
+	setBRConfigDefault(ns, spec.BR)
+}
+
+func setCompactSpecDefault(ns string, spec *v1alpha1.CompactSpec) {
+	if spec.Concurrency == 0 {
+		spec.Concurrency = defaultCompactConcurrency
+	}
+	setBRConfigDefault(ns, spec.BR)
+}
+
+// setBRConfigDefault sets the namespace of the target cluster to the namespace of the object,
+// which is the same as what the controllers assume when it is empty
+func setBRConfigDefault(ns string, br *v1alpha1.BRConfig) {
+	if br != nil && br.ClusterNamespace == "" {
+		br.ClusterNamespace = ns
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaulting
+
+import (
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	defaultDrainerBaseImage = "pingcap/tidb-binlog"
+	defaultDrainerLogLevel  = "info"
+	defaultDrainerSinkPort  = 3306
+	defaultDrainerSinkUser  = "root"
+	defaultDrainerFileDir   = "/data/pb"
+)
+
+func SetDrainerDefault(dr *v1alpha1.Drainer) {
+	if dr.Spec.Cluster.Namespace == "" {
+		dr.Spec.Cluster.Namespace = dr.Namespace
+	}
+	if dr.Spec.BaseImage == "" {
+		dr.Spec.BaseImage = defaultDrainerBaseImage
+	}
+	if dr.Spec.PVReclaimPolicy == nil {
+		retain := corev1.PersistentVolumeReclaimRetain
+		dr.Spec.PVReclaimPolicy = &retain
+	}
+	if dr.Spec.LogLevel == "" {
+		dr.Spec.LogLevel = defaultDrainerLogLevel
+	}
+
+	sink := &dr.Spec.Sink
+	switch sink.Type {
+	case v1alpha1.DrainerSinkTypeMySQL, v1alpha1.DrainerSinkTypeTiDB:
+		if sink.MySQL != nil {
+			if sink.MySQL.Port == 0 {
+				sink.MySQL.Port = defaultDrainerSinkPort
+			}
+			if sink.MySQL.User == "" {
+				sink.MySQL.User = defaultDrainerSinkUser
+			}
+		}
+	case v1alpha1.DrainerSinkTypeFile:
+		if sink.File == nil {
+			sink.File = &v1alpha1.DrainerFileSink{}
+		}
+		if sink.File.Dir == "" {
+			sink.File.Dir = defaultDrainerFileDir
+		}
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"github.com/pingcap/tidb-operator/pkg/apis/util/config"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Drainer is a TiDB Binlog drainer which replicates the binlog collected by the Pumps
+// of a TidbCluster to a downstream.
+//
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName="dr"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.cluster.name`,description="The TidbCluster whose binlog is replicated"
+// +kubebuilder:printcolumn:name="Sink",type=string,JSONPath=`.spec.sink.type`,description="The type of the downstream"
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`,description="The phase of the drainer"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type Drainer struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	// Spec contains all spec about the drainer.
+	Spec DrainerSpec `json:"spec"`
+
+	// Status is most recently observed status of the drainer.
+	//
+	// +k8s:openapi-gen=false
+	Status DrainerStatus `json:"status,omitempty"`
+}
+
+// DrainerList is a Drainer list.
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type DrainerList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +k8s:openapi-gen=false
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Drainer `json:"items"`
+}
+
+// DrainerSpec is spec of the drainer.
+//
+// +k8s:openapi-gen=true
+type DrainerSpec struct {
+	// ComponentSpec is common spec.
+	ComponentSpec               `json:",inline"`
+	corev1.ResourceRequirements `json:",inline"`
+
+	// Cluster is the TidbCluster whose binlog is replicated, the Pumps of it must be deployed.
+	Cluster TidbClusterRef `json:"cluster"`
+
+	// Base image of the component, image tag is now allowed during validation.
+	// The version of the TidbCluster is used if the version is not set.
+	//
+	// +kubebuilder:default=pingcap/tidb-binlog
+	// +optional
+	BaseImage string `json:"baseImage,omitempty"`
+
+	// Persistent volume reclaim policy applied to the PV consumed by the drainer.
+	//
+	// +kubebuilder:default=Retain
+	PVReclaimPolicy *corev1.PersistentVolumeReclaimPolicy `json:"pvReclaimPolicy,omitempty"`
+
+	// The storageClassName of the persistent volume for the drainer data storage,
+	// which holds the checkpoint and the binlog files of the file sink.
+	// Defaults to Kubernetes default storage class.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// Sink is the downstream of the drainer.
+	Sink DrainerSinkSpec `json:"sink"`
+
+	// InitialCommitTS is the commit ts from which the drainer starts to replicate, it only
+	// takes effect when the drainer is started for the first time.
+	// Optional: Defaults to -1, which starts from the latest ts of the cluster
+	// +optional
+	InitialCommitTS *int64 `json:"initialCommitTS,omitempty"`
+
+	// DisableDetect disables the detection of the causality of the transactions. It can be
+	// disabled only when the downstream tables have no unique keys other than the primary key.
+	// Optional: Defaults to false
+	// +optional
+	DisableDetect bool `json:"disableDetect,omitempty"`
+
+	// LogLevel is the log level of the drainer.
+	//
+	// +kubebuilder:default=info
+	// +optional
+	LogLevel string `json:"logLevel,omitempty"`
+
+	// The configuration of the drainer, the sections generated from the spec, such as
+	// `syncer.db-type` and `syncer.to`, override the ones in it.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:XPreserveUnknownFields
+	Config *config.GenericConfig `json:"config,omitempty"`
+}
+
+// DrainerSinkType is the type of the downstream of the drainer.
+type DrainerSinkType string
+
+const (
+	// DrainerSinkTypeMySQL replicates the binlog to MySQL.
+	DrainerSinkTypeMySQL DrainerSinkType = "mysql"
+	// DrainerSinkTypeTiDB replicates the binlog to TiDB.
+	DrainerSinkTypeTiDB DrainerSinkType = "tidb"
+	// DrainerSinkTypeFile saves the binlog as files in the data volume of the drainer.
+	DrainerSinkTypeFile DrainerSinkType = "file"
+)
+
+// DrainerSinkSpec is the downstream of the drainer.
+//
+// +k8s:openapi-gen=true
+type DrainerSinkSpec struct {
+	// Type is the type of the downstream.
+	//
+	// +kubebuilder:validation:Enum=mysql;tidb;file
+	Type DrainerSinkType `json:"type"`
+
+	// MySQL is the downstream of the type mysql or tidb.
+	// +optional
+	MySQL *DrainerMySQLSink `json:"mysql,omitempty"`
+
+	// File is the downstream of the type file.
+	// +optional
+	File *DrainerFileSink `json:"file,omitempty"`
+}
+
+// DrainerMySQLSink is a MySQL compatible downstream of the drainer.
+//
+// +k8s:openapi-gen=true
+type DrainerMySQLSink struct {
+	// Host is the address of the downstream.
+	Host string `json:"host"`
+
+	// Port is the port of the downstream.
+	//
+	// +kubebuilder:default=3306
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// User is the user to connect to the downstream.
+	//
+	// +kubebuilder:default=root
+	// +optional
+	User string `json:"user,omitempty"`
+
+	// PasswordSecret refers to the key of a Secret in the namespace of the drainer which holds the password of the user.
+	// +optional
+	PasswordSecret *corev1.SecretKeySelector `json:"passwordSecret,omitempty"`
+
+	// TLSClientSecretName is the name of the Secret which contains the client certificate to connect to the downstream
+	// with TLS, the keys are `ca.crt`, `tls.crt` and `tls.key`.
+	// +optional
+	TLSClientSecretName *string `json:"tlsClientSecretName,omitempty"`
+}
+
+// DrainerFileSink saves the binlog as files.
+//
+// +k8s:openapi-gen=true
+type DrainerFileSink struct {
+	// Dir is the directory to save the binlog files, it must be in the data volume mounted at `/data`.
+	// Optional: Defaults to /data/pb
+	// +optional
+	Dir string `json:"dir,omitempty"`
+}
+
+// DrainerStatus is status of the drainer.
+type DrainerStatus struct {
+	Phase       MemberPhase             `json:"phase,omitempty"`
+	StatefulSet *apps.StatefulSetStatus `json:"statefulSet,omitempty"`
+	// Members are the drainer nodes of this Drainer registered in PD.
+	Members []*PumpNodeStatus `json:"members,omitempty"`
+}
@@ -92,7 +92,7 @@ func GetMaxReplicaCountAndDeleteSlots(replicas int32, deleteSlots sets.Int32) (i
 func GetStorageVolumeName(storageVolumeName string, memberType MemberType) StorageVolumeName {
 	if storageVolumeName == "" {
 		switch memberType {
-		case PumpMemberType:
+		case PumpMemberType, DrainerMemberType:
 			return StorageVolumeName("data")
 		default:
 			return StorageVolumeName(memberType.String())
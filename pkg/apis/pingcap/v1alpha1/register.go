@@ -71,6 +71,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&TidbNGMonitoringList{},
 		&TidbDashboard{},
 		&TidbDashboardList{},
+		&TidbUser{},
+		&TidbUserList{},
+		&Drainer{},
+		&DrainerList{},
+		&BinlogMigration{},
+		&BinlogMigrationList{},
 	)
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -49,6 +49,24 @@ func (rs *Restore) GetRestorePVCName() string {
 	return fmt.Sprintf("restore-pvc-%s", rs.GetTidbEndpointHash())
 }
 
+// GetLightningSortedKVPVCName return the pvc name for the sorted KV files of TiDB Lightning
+func (rs *Restore) GetLightningSortedKVPVCName() string {
+	return fmt.Sprintf("restore-sorted-kv-%s", rs.GetName())
+}
+
+// GetLightningSortedKVStorageSize return the storage size of the pvc for the sorted KV files of TiDB Lightning
+func (rs *Restore) GetLightningSortedKVStorageSize() string {
+	if rs.Spec.Lightning != nil && rs.Spec.Lightning.SortedKVStorageSize != "" {
+		return rs.Spec.Lightning.SortedKVStorageSize
+	}
+	return rs.Spec.StorageSize
+}
+
+// IsLightningPhysicalImport returns whether the restore imports the Dumpling export by the physical import mode of TiDB Lightning
+func (rs *Restore) IsLightningPhysicalImport() bool {
+	return rs.Spec.BR == nil && rs.Spec.Lightning != nil && rs.Spec.Lightning.Backend == LightningBackendLocal
+}
+
 // GetRestoreCondition get the specify type's RestoreCondition from the given RestoreStatus
 func GetRestoreCondition(status *RestoreStatus, conditionType RestoreConditionType) (int, *RestoreCondition) {
 	if status == nil {
@@ -236,6 +236,21 @@ func (tc *TidbCluster) TiFlashVersion() string {
 	return getImageVersion(tc.TiFlashImage())
 }
 
+// TiFlashDisaggregated returns whether TiFlash runs in the disaggregated storage and compute mode.
+func (tc *TidbCluster) TiFlashDisaggregated() bool {
+	return tc.Spec.TiFlash != nil && tc.Spec.TiFlash.Disaggregated != nil
+}
+
+// TiFlashComputeReplicas returns the desired replicas of TiFlash compute nodes.
+//
+// If TiFlash isn't in the disaggregated mode, return 0.
+func (tc *TidbCluster) TiFlashComputeReplicas() int32 {
+	if !tc.TiFlashDisaggregated() {
+		return 0
+	}
+	return tc.Spec.TiFlash.Disaggregated.Compute.Replicas
+}
+
 func (tc *TidbCluster) TiFlashContainerPrivilege() *bool {
 	if tc.Spec.TiFlash == nil || tc.Spec.TiFlash.Privileged == nil {
 		pri := false
@@ -1057,6 +1072,22 @@ func (tc *TidbCluster) Timezone() string {
 	return tz
 }
 
+// DiscoveryReplicas returns the number of the discovery pods
+func (tc *TidbCluster) DiscoveryReplicas() int32 {
+	if tc.Spec.Discovery.Replicas == nil || *tc.Spec.Discovery.Replicas < 1 {
+		return 1
+	}
+	return *tc.Spec.Discovery.Replicas
+}
+
+// DiscoveryAddressResolver returns the address resolver of the discovery
+func (tc *TidbCluster) DiscoveryAddressResolver() DiscoveryAddressResolver {
+	if tc.Spec.Discovery.AddressResolver == "" {
+		return DiscoveryAddressResolverFQDN
+	}
+	return tc.Spec.Discovery.AddressResolver
+}
+
 func (tc *TidbCluster) IsPVReclaimEnabled() bool {
 	enabled := tc.Spec.EnablePVReclaim
 	if enabled == nil {
@@ -1337,6 +1368,13 @@ func (tc *TidbCluster) TiCDCLogLevel() string {
 	return "info"
 }
 
+// IsComponentGroup returns whether the TidbCluster represents a named group of a component
+// in another TidbCluster, see ComponentGroupSpec
+func (tc *TidbCluster) IsComponentGroup() bool {
+	_, ok := tc.Labels[label.ComponentGroupLabelKey]
+	return ok
+}
+
 func (tc *TidbCluster) Heterogeneous() bool {
 	return tc.Spec.Cluster != nil && len(tc.Spec.Cluster.Name) > 0
 }
@@ -33,6 +33,31 @@ const (
 	InitializePhaseFailed InitializePhase = "Failed"
 )
 
+type MigrationPhase string
+
+const (
+	// MigrationPhasePending indicates that the migrations are waiting for the initialization to complete
+	MigrationPhasePending MigrationPhase = "Pending"
+	// MigrationPhaseRunning indicates that some migrations are not applied yet, they are retried
+	// with backoff if TiDB is unavailable, and the error is in the message
+	MigrationPhaseRunning MigrationPhase = "Running"
+	// MigrationPhaseCompleted indicates that all the migrations are applied
+	MigrationPhaseCompleted MigrationPhase = "Completed"
+	// MigrationPhaseFailed indicates that a migration failed for a SQL error or an applied migration
+	// is changed, the migrations are retried when the ConfigMaps change
+	MigrationPhaseFailed MigrationPhase = "Failed"
+)
+
+// ChecksumMismatchPolicy is the action when the checksum of an applied migration changes
+type ChecksumMismatchPolicy string
+
+const (
+	// ChecksumMismatchPolicyFail stops applying the migrations and reports the mismatch in the status
+	ChecksumMismatchPolicyFail ChecksumMismatchPolicy = "Fail"
+	// ChecksumMismatchPolicyIgnore reports the mismatch in the status and continues to apply the new migrations
+	ChecksumMismatchPolicyIgnore ChecksumMismatchPolicy = "Ignore"
+)
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -108,6 +133,38 @@ type TidbInitializerSpec struct {
 	// Node selectors of TiDB initializer Pod
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Migrations are the versioned SQL migrations applied after the initialization is completed.
+	// Unlike initSql which is executed only once, the new migrations are applied whenever the ConfigMaps change.
+	// +optional
+	Migrations *TidbMigrationSpec `json:"migrations,omitempty"`
+}
+
+// TidbMigrationSpec describes the versioned SQL migrations of a TiDB cluster
+// +k8s:openapi-gen=true
+type TidbMigrationSpec struct {
+	// ConfigMaps are the names of the ConfigMaps containing the migrations.
+	// Each key named `V<version>__<description>.sql` is a migration, e.g. `V1.2__create_orders.sql`,
+	// the other keys are ignored. The migrations are applied in the order of the versions,
+	// which are compared by the numeric parts separated by `.` or `_`.
+	ConfigMaps []string `json:"configMaps"`
+
+	// Database is the database of the table recording the applied migrations,
+	// the table is named `schema_migrations`.
+	// +kubebuilder:default=tidb_operator
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// OnChecksumMismatch is the action when an applied migration is changed, `Fail` or `Ignore`.
+	// +kubebuilder:validation:Enum=Fail;Ignore
+	// +kubebuilder:default=Fail
+	// +optional
+	OnChecksumMismatch ChecksumMismatchPolicy `json:"onChecksumMismatch,omitempty"`
+
+	// OutOfOrder allows applying a migration whose version is older than the latest applied one,
+	// e.g. a migration is added in a branch merged later. The migrations are rejected by default.
+	// +optional
+	OutOfOrder bool `json:"outOfOrder,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -116,6 +173,40 @@ type TidbInitializerStatus struct {
 
 	// Phase is a user readable state inferred from the underlying Job status and TidbCluster status
 	Phase InitializePhase `json:"phase,omitempty"`
+
+	// Migration is the status of the versioned SQL migrations
+	// +optional
+	Migration *TidbMigrationStatus `json:"migration,omitempty"`
+}
+
+// TidbMigrationStatus is the status of the versioned SQL migrations
+// +k8s:openapi-gen=true
+type TidbMigrationStatus struct {
+	// Phase is the state of the migrations
+	Phase MigrationPhase `json:"phase,omitempty"`
+	// Checksum is the checksum of all the migrations in the ConfigMaps when they were last synced,
+	// the migrations are checked against TiDB again only when it changes.
+	// +optional
+	Checksum string `json:"checksum,omitempty"`
+	// AppliedVersion is the latest applied version
+	// +optional
+	AppliedVersion string `json:"appliedVersion,omitempty"`
+	// AppliedCount is the number of the applied migrations
+	AppliedCount int32 `json:"appliedCount"`
+	// PendingCount is the number of the migrations not applied yet
+	PendingCount int32 `json:"pendingCount"`
+	// FailedVersion is the version of the migration which failed
+	// +optional
+	FailedVersion string `json:"failedVersion,omitempty"`
+	// MismatchedVersions are the versions of the applied migrations whose checksums are changed
+	// +optional
+	MismatchedVersions []string `json:"mismatchedVersions,omitempty"`
+	// Message is the reason of the failure
+	// +optional
+	Message string `json:"message,omitempty"`
+	// LastSyncTime is the last time the migrations were checked against TiDB
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -188,6 +188,137 @@ type TidbMonitorSpec struct {
 
 	// PreferIPv6 indicates whether to prefer IPv6 addresses for all components.
 	PreferIPv6 bool `json:"preferIPv6,omitempty"`
+
+	// PrometheusOperator makes the TidbMonitor emit the objects of prometheus-operator for an existing
+	// Prometheus, e.g. the one of kube-prometheus-stack, instead of deploying Prometheus and Grafana.
+	// +optional
+	PrometheusOperator *PrometheusOperatorSpec `json:"prometheusOperator,omitempty"`
+
+	// SLO generates alert rules for each monitored TidbCluster from the service level objectives,
+	// the thresholds are tuned to the topology of the cluster, e.g. the replica count and the zone count.
+	// +optional
+	SLO *SLOSpec `json:"slo,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// SLOSpec is the service level objectives of the monitored TidbClusters.
+// An objective is alerted only if it is configured.
+// The durations are in the format of Prometheus, e.g. `30s`, `5m`, `1h`.
+type SLOSpec struct {
+	// Labels are added to all the generated alerts, e.g. `team: dba`
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// QueryLatency alerts when the p99 latency of the queries exceeds the objective
+	// +optional
+	QueryLatency *QueryLatencySLO `json:"queryLatency,omitempty"`
+
+	// StoreDown alerts when TiKV stores are down, and when more stores are down than the
+	// cluster tolerates with its replica count and zone count
+	// +optional
+	StoreDown *StoreDownSLO `json:"storeDown,omitempty"`
+
+	// RegionHealth alerts when regions miss replicas or have down replicas
+	// +optional
+	RegionHealth *RegionHealthSLO `json:"regionHealth,omitempty"`
+
+	// BackupFreshness alerts when the checkpoint of the log backup falls behind the objective
+	// +optional
+	BackupFreshness *BackupFreshnessSLO `json:"backupFreshness,omitempty"`
+
+	// CDCLag alerts when the checkpoint lag of a TiCDC changefeed exceeds the objective
+	// +optional
+	CDCLag *CDCLagSLO `json:"cdcLag,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// QueryLatencySLO is the objective of the query latency
+type QueryLatencySLO struct {
+	// P99 is the objective of the 99th percentile of the query latency.
+	// Defaults to 1s.
+	// +optional
+	P99 string `json:"p99,omitempty"`
+
+	// For is how long the objective is violated before alerting.
+	// Defaults to 5m.
+	// +optional
+	For string `json:"for,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// StoreDownSLO is the objective of the availability of TiKV stores
+type StoreDownSLO struct {
+	// For is how long a store is disconnected before alerting.
+	// Defaults to 5m.
+	// +optional
+	For string `json:"for,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// RegionHealthSLO is the objective of the health of regions
+type RegionHealthSLO struct {
+	// For is how long regions are unhealthy before alerting.
+	// Defaults to 30m.
+	// +optional
+	For string `json:"for,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// BackupFreshnessSLO is the objective of the freshness of the log backup
+type BackupFreshnessSLO struct {
+	// MaxAge is the max age of the checkpoint of the log backup, i.e. the recovery point objective.
+	// Defaults to 10m.
+	// +optional
+	MaxAge string `json:"maxAge,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// CDCLagSLO is the objective of the replication lag of TiCDC
+type CDCLagSLO struct {
+	// MaxLag is the max checkpoint lag of a changefeed.
+	// Defaults to 1m.
+	// +optional
+	MaxLag string `json:"maxLag,omitempty"`
+
+	// For is how long the objective is violated before alerting.
+	// Defaults to 5m.
+	// +optional
+	For string `json:"for,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// PrometheusOperatorSpec is the desired state of the prometheus-operator mode of TidbMonitor.
+// A PodMonitor is created for each component of the monitored clusters, the alert rules are
+// created as a PrometheusRule and the Grafana dashboards are created as ConfigMaps for the Grafana sidecar.
+// The alert rules and the dashboards are rendered by the initializer image, in the version of `alertManagerRulesVersion`.
+type PrometheusOperatorSpec struct {
+	// Labels are added to the PodMonitors and the PrometheusRule so that they are selected by
+	// the Prometheus, e.g. `release: kube-prometheus-stack`
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// ScrapeInterval is the interval to scrape the components.
+	// Defaults to 15s.
+	// +optional
+	ScrapeInterval string `json:"scrapeInterval,omitempty"`
+
+	// DisableAlertRules disables creating the PrometheusRule of the alert rules
+	// +optional
+	DisableAlertRules bool `json:"disableAlertRules,omitempty"`
+
+	// DisableDashboards disables creating the ConfigMaps of the Grafana dashboards
+	// +optional
+	DisableDashboards bool `json:"disableDashboards,omitempty"`
+
+	// DashboardLabels are the labels of the ConfigMaps of the Grafana dashboards that the Grafana sidecar watches.
+	// Defaults to `grafana_dashboard: "1"`.
+	// +optional
+	DashboardLabels map[string]string `json:"dashboardLabels,omitempty"`
+
+	// DashboardAnnotations are the annotations of the ConfigMaps of the Grafana dashboards,
+	// e.g. `grafana_folder` to put the dashboards into a folder.
+	// +optional
+	DashboardAnnotations map[string]string `json:"dashboardAnnotations,omitempty"`
 }
 
 // PrometheusReloaderSpec is the desired state of prometheus configuration reloader
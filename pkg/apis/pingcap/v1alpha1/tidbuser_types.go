@@ -0,0 +1,156 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TidbUser is a user or a role of a TiDB cluster, the operator creates it in TiDB,
+// keeps its password, roles, privileges and resource group in sync with the spec
+// and drops it when the TidbUser is deleted.
+//
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName="tu"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="User",type=string,JSONPath=`.status.user`,description="The user name in TiDB"
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`,description="The sync phase of the user"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type TidbUser struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	// Spec defines the desired state of the user
+	Spec TidbUserSpec `json:"spec"`
+
+	// +k8s:openapi-gen=false
+	// Most recently observed status of the user
+	Status TidbUserStatus `json:"status,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TidbUserList is a TidbUser list
+type TidbUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ListMeta `json:"metadata"`
+
+	Items []TidbUser `json:"items"`
+}
+
+// +k8s:openapi-gen=true
+// TidbUserSpec describes the user or the role in TiDB
+type TidbUserSpec struct {
+	// Cluster is the TiDB cluster the user belongs to, the namespace defaults to the namespace of the TidbUser.
+	// AdminSecretName must be set if the cluster is in another namespace.
+	Cluster TidbClusterRef `json:"cluster"`
+
+	// UserName is the name of the user in TiDB, defaults to the name of the TidbUser
+	// +optional
+	UserName string `json:"userName,omitempty"`
+
+	// Hosts are the hosts the user is allowed to connect from, an account is created for each of them.
+	// Defaults to ["%"]
+	// +optional
+	Hosts []string `json:"hosts,omitempty"`
+
+	// Role creates a role instead of a user, a role can't login and has no password
+	// +optional
+	Role bool `json:"role,omitempty"`
+
+	// PasswordSecret references the key of the Secret that contains the password of the user,
+	// the password is changed in TiDB when the Secret changes.
+	// The user is created without password if it is not set.
+	// +optional
+	PasswordSecret *corev1.SecretKeySelector `json:"passwordSecret,omitempty"`
+
+	// Roles are the roles granted to the user, in the form of `name` or `name@host`, the host defaults to `%`.
+	// All of them are activated by default when the user logins.
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+
+	// Grants are the privileges granted to the user, the privileges granted before but no longer in the list are revoked
+	// +optional
+	Grants []TidbUserGrant `json:"grants,omitempty"`
+
+	// ResourceGroup binds the user to the resource group, the user is bound to the `default` resource group if it is empty
+	// +optional
+	ResourceGroup string `json:"resourceGroup,omitempty"`
+
+	// AdminSecretName is the name of the Secret that contains the account used by the operator to manage the user,
+	// the `user` key (defaults to `root`) and the `password` key are used.
+	// Defaults to the root password in the `<cluster>-init` Secret created by `spec.tidb.initializer.createPassword`.
+	// +optional
+	AdminSecretName *string `json:"adminSecretName,omitempty"`
+
+	// TLSClientSecretName is the name of secret which stores tidb server client certificate
+	// used by the operator to connect to TiDB, defaults to `<cluster>-tidb-client-secret`
+	// +optional
+	TLSClientSecretName *string `json:"tlsClientSecretName,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// TidbUserGrant grants privileges on a database object to the user
+type TidbUserGrant struct {
+	// Privileges to grant, e.g. `SELECT`, `INSERT` or `ALL PRIVILEGES`
+	// +kubebuilder:validation:MinItems=1
+	Privileges []string `json:"privileges"`
+
+	// On is the database object in the form of `db.table`, `db.*` or `*.*`
+	On string `json:"on"`
+}
+
+// TidbUserPhase is the current state of the user
+type TidbUserPhase string
+
+const (
+	// TidbUserPending represents the user is not synced yet
+	TidbUserPending TidbUserPhase = "Pending"
+	// TidbUserSynced represents the user in TiDB is consistent with the spec
+	TidbUserSynced TidbUserPhase = "Synced"
+	// TidbUserFailed represents the user failed to sync
+	TidbUserFailed TidbUserPhase = "Failed"
+)
+
+// +k8s:openapi-gen=true
+// TidbUserStatus records what is applied in TiDB, it is used to compute what to revoke when the spec changes
+type TidbUserStatus struct {
+	// Phase is the sync phase of the user
+	Phase TidbUserPhase `json:"phase,omitempty"`
+	// ObservedGeneration is the generation of the spec that is applied
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// User is the name of the user in TiDB
+	User string `json:"user,omitempty"`
+	// Hosts are the hosts of the accounts in TiDB
+	Hosts []string `json:"hosts,omitempty"`
+	// Roles are the roles granted to the user
+	Roles []string `json:"roles,omitempty"`
+	// Grants are the privileges granted to the user
+	Grants []TidbUserGrant `json:"grants,omitempty"`
+	// ResourceGroup is the resource group the user is bound to
+	ResourceGroup string `json:"resourceGroup,omitempty"`
+	// PasswordSecretVersion is the resource version of the password Secret that is applied
+	PasswordSecretVersion string `json:"passwordSecretVersion,omitempty"`
+	// Message is the error message of the last sync
+	Message string `json:"message,omitempty"`
+	// LastSyncTime is the last time the user is synced to TiDB
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
@@ -80,12 +80,16 @@ const (
 	TiKVMemberType MemberType = "tikv"
 	// TiFlashMemberType is tiflash member type
 	TiFlashMemberType MemberType = "tiflash"
+	// TiFlashComputeMemberType is tiflash compute member type in the disaggregated mode
+	TiFlashComputeMemberType MemberType = "tiflash-compute"
 	// TiCDCMemberType is ticdc member type
 	TiCDCMemberType MemberType = "ticdc"
 	// TiProxyMemberType is ticdc member type
 	TiProxyMemberType MemberType = "tiproxy"
 	// PumpMemberType is pump member type
 	PumpMemberType MemberType = "pump"
+	// DrainerMemberType is drainer member type
+	DrainerMemberType MemberType = "drainer"
 
 	// DMDiscoveryMemberType is discovery member type
 	DMDiscoveryMemberType MemberType = "dm-discovery"
@@ -432,6 +436,11 @@ type TidbClusterSpec struct {
 	// - WaitForDnsNameIpMatch indicates whether PD and TiKV has to wait until local IP address matches the one published to external DNS
 	// - PreferPDAddressesOverDiscovery advises start script to use TidbClusterSpec.PDAddresses (if supplied) as argument for pd-server, tikv-server and tidb-server commands
 	StartScriptV2FeatureFlags []StartScriptV2FeatureFlag `json:"startScriptV2FeatureFlags,omitempty"`
+
+	// LogShipper ships the logs of PD, TiDB, TiKV, TiFlash and TiCDC to the sinks.
+	// Optional: Defaults to nil, the logs are not shipped
+	// +optional
+	LogShipper *LogShipperSpec `json:"logShipper,omitempty"`
 }
 
 // TidbClusterStatus represents the current status of a tidb cluster.
@@ -445,6 +454,15 @@ type TidbClusterStatus struct {
 	TiFlash   TiFlashStatus          `json:"tiflash,omitempty"`
 	TiProxy   TiProxyStatus          `json:"tiproxy,omitempty"`
 	TiCDC     TiCDCStatus            `json:"ticdc,omitempty"`
+	// TiKVGroups is the status of the named groups of TiKV, indexed by the group name
+	// +optional
+	TiKVGroups map[string]TiKVStatus `json:"tikvGroups,omitempty"`
+	// TiDBGroups is the status of the named groups of TiDB, indexed by the group name
+	// +optional
+	TiDBGroups map[string]TiDBStatus `json:"tidbGroups,omitempty"`
+	// TiFlashGroups is the status of the named groups of TiFlash, indexed by the group name
+	// +optional
+	TiFlashGroups map[string]TiFlashStatus `json:"tiflashGroups,omitempty"`
 	// Represents the latest available observations of a tidb cluster's state.
 	// +optional
 	// +nullable
@@ -498,6 +516,21 @@ type DiscoverySpec struct {
 	*ComponentSpec              `json:",inline"`
 	corev1.ResourceRequirements `json:",inline"`
 
+	// Replicas is the number of the discovery pods. The discovery runs with leader election and keeps
+	// the bootstrap state of PD in a ConfigMap if it is greater than 1.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// AddressResolver is how the discovery resolves the advertised peer URLs which are sent by PD
+	// without the structured registration. "fqdn" expects the FQDN of the pod in the PD peer service,
+	// "pod-ip" looks up the PD pod by the IP in the URL, which is used if PD advertises IP addresses.
+	// Defaults to "fqdn".
+	// +kubebuilder:validation:Enum=fqdn;pod-ip
+	// +optional
+	AddressResolver DiscoveryAddressResolver `json:"addressResolver,omitempty"`
+
 	// LivenessProbe describes actions that probe the discovery's liveness.
 	// the default behavior is like setting type as "tcp"
 	// NOTE: only used for TiDB Operator discovery now,
@@ -506,6 +539,16 @@ type DiscoverySpec struct {
 	LivenessProbe *Probe `json:"livenessProbe,omitempty"`
 }
 
+// DiscoveryAddressResolver is the resolver of the advertised peer URLs of PD in the discovery
+type DiscoveryAddressResolver string
+
+const (
+	// DiscoveryAddressResolverFQDN resolves the URLs in the form of <pod>.<tc>-pd-peer.<ns>.svc
+	DiscoveryAddressResolverFQDN DiscoveryAddressResolver = "fqdn"
+	// DiscoveryAddressResolverPodIP resolves the URLs by the IPs of the PD pods
+	DiscoveryAddressResolverPodIP DiscoveryAddressResolver = "pod-ip"
+)
+
 // +k8s:openapi-gen=true
 // PDSpec contains details of PD members
 type PDSpec struct {
@@ -601,6 +644,161 @@ type PDSpec struct {
 	// +kubebuilder:validation:Minimum=0
 	// +optional
 	SpareVolReplaceReplicas *int32 `json:"spareVolReplaceReplicas,omitempty"`
+
+	// PlacementRules are the placement rule bundles reconciled to PD by the operator.
+	// The bundles in PD which are not listed here and never managed by the operator are left untouched,
+	// and a bundle removed from here is deleted from PD.
+	// +optional
+	// +listType=map
+	// +listMapKey=groupID
+	PlacementRules []PlacementRuleBundle `json:"placementRules,omitempty"`
+
+	// PlacementPolicies are the named placement policies which can be referenced by the placement rule bundles.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	PlacementPolicies []PlacementPolicy `json:"placementPolicies,omitempty"`
+
+	// LeaderPreference is the preferred placement of the PD leader. The operator sets the leader priority
+	// of the PD members in this cluster according to the preference, and transfers the leader to the
+	// healthy member with the highest priority.
+	// If a member matches multiple preferences, the highest priority is used.
+	// +optional
+	LeaderPreference []PDLeaderPreference `json:"leaderPreference,omitempty"`
+}
+
+// PDLeaderPreference is a preferred placement of the PD leader.
+// Exactly one of zone and ordinals should be set.
+// +k8s:openapi-gen=true
+type PDLeaderPreference struct {
+	// Zone matches the PD members running on the nodes in the zone, the zone of a node is read from
+	// the node labels `zone`, `topology.kubernetes.io/zone` or `failure-domain.beta.kubernetes.io/zone`.
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// Ordinals matches the PD members by the ordinals of their pods.
+	// +optional
+	Ordinals []int32 `json:"ordinals,omitempty"`
+
+	// Priority is the leader priority of the matched members, the member with higher priority is preferred.
+	// +kubebuilder:validation:Minimum=1
+	Priority int `json:"priority"`
+}
+
+// PlacementRuleRole is the role of the peers selected by a placement rule
+type PlacementRuleRole string
+
+const (
+	// PlacementRuleRoleVoter means the peers can vote and can be elected as the leader
+	PlacementRuleRoleVoter PlacementRuleRole = "voter"
+	// PlacementRuleRoleLeader means the peer is the leader
+	PlacementRuleRoleLeader PlacementRuleRole = "leader"
+	// PlacementRuleRoleFollower means the peers can vote but can not be elected as the leader
+	PlacementRuleRoleFollower PlacementRuleRole = "follower"
+	// PlacementRuleRoleLearner means the peers can not vote
+	PlacementRuleRoleLearner PlacementRuleRole = "learner"
+)
+
+// PlacementLabelConstraintOp is the operator of a label constraint
+type PlacementLabelConstraintOp string
+
+const (
+	PlacementLabelConstraintOpIn        PlacementLabelConstraintOp = "in"
+	PlacementLabelConstraintOpNotIn     PlacementLabelConstraintOp = "notIn"
+	PlacementLabelConstraintOpExists    PlacementLabelConstraintOp = "exists"
+	PlacementLabelConstraintOpNotExists PlacementLabelConstraintOp = "notExists"
+)
+
+// PlacementRuleBundle is a group of placement rules of PD
+// +k8s:openapi-gen=true
+type PlacementRuleBundle struct {
+	// GroupID is the ID of the rule group in PD
+	GroupID string `json:"groupID"`
+
+	// Index is the order of the rule group, the groups with higher index are applied later
+	// +optional
+	Index int `json:"index,omitempty"`
+
+	// Override indicates whether the rule group overrides the groups with lower index
+	// +optional
+	Override bool `json:"override,omitempty"`
+
+	// Policy is the name of a placement policy whose rules are used by the bundle.
+	// It must not be set together with `rules`.
+	// +optional
+	Policy string `json:"policy,omitempty"`
+
+	// Rules are the placement rules of the group
+	// +optional
+	Rules []PlacementRule `json:"rules,omitempty"`
+}
+
+// PlacementPolicy is a named set of placement rules
+// +k8s:openapi-gen=true
+type PlacementPolicy struct {
+	// Name of the policy
+	Name string `json:"name"`
+
+	// Rules are the placement rules of the policy
+	Rules []PlacementRule `json:"rules"`
+}
+
+// PlacementRule is a placement rule of PD, see https://docs.pingcap.com/tidb/stable/configure-placement-rules
+// +k8s:openapi-gen=true
+type PlacementRule struct {
+	// ID of the rule, it must be unique in the group
+	ID string `json:"id"`
+
+	// Index is the order of the rule in the group
+	// +optional
+	Index int `json:"index,omitempty"`
+
+	// Override indicates whether the rule overrides the rules with lower index in the group
+	// +optional
+	Override bool `json:"override,omitempty"`
+
+	// StartKey is the hex encoded start key of the key range, empty means the minimum key
+	// +optional
+	StartKey string `json:"startKey,omitempty"`
+
+	// EndKey is the hex encoded end key of the key range, empty means the maximum key
+	// +optional
+	EndKey string `json:"endKey,omitempty"`
+
+	// Role of the peers selected by the rule
+	// +kubebuilder:validation:Enum:="voter";"leader";"follower";"learner"
+	Role PlacementRuleRole `json:"role"`
+
+	// Count is the number of the peers
+	// +kubebuilder:validation:Minimum=1
+	Count int `json:"count"`
+
+	// LabelConstraints select the stores of the peers by the store labels
+	// +optional
+	LabelConstraints []PlacementLabelConstraint `json:"labelConstraints,omitempty"`
+
+	// LocationLabels are the store labels used to spread the peers
+	// +optional
+	LocationLabels []string `json:"locationLabels,omitempty"`
+
+	// IsolationLevel is the minimum isolation level of the peers, it must be one of the location labels
+	// +optional
+	IsolationLevel string `json:"isolationLevel,omitempty"`
+}
+
+// PlacementLabelConstraint is a constraint on the store labels
+// +k8s:openapi-gen=true
+type PlacementLabelConstraint struct {
+	// Key of the store label
+	Key string `json:"key"`
+
+	// Op is the operator of the constraint
+	// +kubebuilder:validation:Enum:="in";"notIn";"exists";"notExists"
+	Op PlacementLabelConstraintOp `json:"op"`
+
+	// Values of the store label, required by the `in` and `notIn` operators
+	// +optional
+	Values []string `json:"values,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -630,12 +828,18 @@ type PDMSSpec struct {
 	// +optional
 	Service *ServiceSpec `json:"service,omitempty"`
 
-	// MaxFailoverCount limit the max replicas could be added in failover, 0 means no failover.
+	// MaxFailoverCount limit the max failure members could be replaced in failover, 0 means no failover.
 	// Optional: Defaults to 3
 	// +kubebuilder:validation:Minimum=0
 	// +optional
 	MaxFailoverCount *int32 `json:"maxFailoverCount,omitempty"`
 
+	// FailoverDeletePVC indicates whether the PVCs of a failure member are deleted together with
+	// its pod in failover, so that the replacement pod starts with new volumes.
+	// Optional: Defaults to false
+	// +optional
+	FailoverDeletePVC bool `json:"failoverDeletePVC,omitempty"`
+
 	// Config is the configuration of PD microservice servers
 	// +optional
 	// +kubebuilder:validation:Schemaless
@@ -794,6 +998,95 @@ type TiKVSpec struct {
 	// +kubebuilder:validation:Minimum=0
 	// +optional
 	SpareVolReplaceReplicas *int32 `json:"spareVolReplaceReplicas,omitempty"`
+
+	// Groups are the named groups of TiKV in addition to the default one described by this spec.
+	// Every group is deployed as a separated StatefulSet `<cluster>-<group>-tikv`, and the non-empty
+	// fields of a group override the ones of this spec.
+	// +optional
+	Groups []TiKVGroupSpec `json:"groups,omitempty"`
+}
+
+// ComponentGroupSpec contains the settings of a named group of a component.
+// The servers of a group join the cluster in the same way as a heterogeneous cluster,
+// so the TLS certificates of a group should be issued for `<cluster>-<group>` if TLS is enabled.
+// The delete slots annotations and the leader eviction on pod deletion only apply to the default group.
+// +k8s:openapi-gen=true
+type ComponentGroupSpec struct {
+	// Name of the group, it must be unique among the groups of a component and
+	// `<cluster>-<name>` must not be the name of another TidbCluster in the namespace
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	ComponentSpec               `json:",inline"`
+	corev1.ResourceRequirements `json:",inline"`
+
+	// The desired ready replicas of the group.
+	// A group must be scaled in to 0 and all of its pods and stores must be removed before
+	// the group is removed, its StatefulSet, Services and ConfigMaps are deleted after that.
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// ServerLabels are the labels set on the servers of the group, e.g. the store labels of TiKV
+	// and TiFlash which can be used by placement rules, or the server labels of TiDB.
+	// +optional
+	ServerLabels map[string]string `json:"serverLabels,omitempty"`
+}
+
+// TiKVGroupSpec contains details of a named group of TiKV
+// +k8s:openapi-gen=true
+type TiKVGroupSpec struct {
+	ComponentGroupSpec `json:",inline"`
+
+	// The storageClassName of the persistent volume for TiKV data storage of the group.
+	// Defaults to the one of TiKV
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// StorageVolumes configure additional storage for TiKV pods of the group.
+	// +optional
+	StorageVolumes []StorageVolume `json:"storageVolumes,omitempty"`
+
+	// Config is merged on top of the config of TiKV
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:XPreserveUnknownFields
+	Config *TiKVConfigWraper `json:"config,omitempty"`
+}
+
+// TiDBGroupSpec contains details of a named group of TiDB
+// +k8s:openapi-gen=true
+type TiDBGroupSpec struct {
+	ComponentGroupSpec `json:",inline"`
+
+	// The storageClassName of the persistent volume for TiDB data storage of the group.
+	// Defaults to the one of TiDB
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// StorageVolumes configure additional storage for TiDB pods of the group.
+	// +optional
+	StorageVolumes []StorageVolume `json:"storageVolumes,omitempty"`
+
+	// Config is merged on top of the config of TiDB
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:XPreserveUnknownFields
+	Config *TiDBConfigWraper `json:"config,omitempty"`
+}
+
+// TiFlashGroupSpec contains details of a named group of TiFlash
+// +k8s:openapi-gen=true
+type TiFlashGroupSpec struct {
+	ComponentGroupSpec `json:",inline"`
+
+	// The persistent volume claims of the TiFlash data storages of the group.
+	// Defaults to the ones of TiFlash
+	// +optional
+	StorageClaims []StorageClaim `json:"storageClaims,omitempty"`
+
+	// Config is merged on top of the config of TiFlash
+	// +optional
+	Config *TiFlashConfigWraper `json:"config,omitempty"`
 }
 
 // TiFlashSpec contains details of TiFlash members
@@ -857,6 +1150,59 @@ type TiFlashSpec struct {
 	// ScalePolicy is the scale configuration for TiFlash
 	// +optional
 	ScalePolicy ScalePolicy `json:"scalePolicy,omitempty"`
+
+	// Disaggregated enables the disaggregated storage and compute architecture of TiFlash.
+	// When it is set, the TiFlash pods managed by this spec run as write nodes, which
+	// upload data to the S3 compatible storage, and an additional group of stateless
+	// compute nodes is deployed to serve the queries.
+	// Requires TiFlash v7.0.0 or later.
+	// +optional
+	Disaggregated *TiFlashDisaggregatedSpec `json:"disaggregated,omitempty"`
+
+	// Groups are the named groups of TiFlash in addition to the default one described by this spec.
+	// Every group is deployed as a separated StatefulSet `<cluster>-<group>-tiflash`, and the non-empty
+	// fields of a group override the ones of this spec.
+	// Groups are not supported in the disaggregated mode.
+	// +optional
+	Groups []TiFlashGroupSpec `json:"groups,omitempty"`
+}
+
+// TiFlashDisaggregatedSpec contains details of TiFlash in the disaggregated mode
+// +k8s:openapi-gen=true
+type TiFlashDisaggregatedSpec struct {
+	// S3 is the S3 compatible storage shared by the write nodes and the compute nodes.
+	// The access key and secret key are read from the secret referred by `secretName`
+	// with keys `access_key` and `secret_key`. If `secretName` is not set, the
+	// credentials should be provided by other means, such as IAM role for service accounts.
+	S3 *S3StorageProvider `json:"s3"`
+
+	// Compute is the configurations of the TiFlash compute nodes
+	// +optional
+	Compute TiFlashComputeSpec `json:"compute,omitempty"`
+}
+
+// TiFlashComputeSpec contains details of TiFlash compute nodes in the disaggregated mode.
+// The compute nodes always use the same image as the write nodes.
+// +k8s:openapi-gen=true
+type TiFlashComputeSpec struct {
+	ComponentSpec               `json:",inline"`
+	corev1.ResourceRequirements `json:",inline"`
+
+	// The desired ready replicas
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Config is the Configuration of TiFlash compute nodes, it is merged on top of
+	// the config of TiFlash write nodes.
+	// +optional
+	Config *TiFlashConfigWraper `json:"config,omitempty"`
+
+	// CacheCapacity is the capacity of the local cache of the data read from S3.
+	// The cache is stored in an emptyDir volume of the compute nodes.
+	// Optional: Defaults to no limit
+	// +optional
+	CacheCapacity *resource.Quantity `json:"cacheCapacity,omitempty"`
 }
 
 // TiCDCSpec contains details of TiCDC members
@@ -1008,6 +1354,104 @@ type LogTailerSpec struct {
 	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
 }
 
+// LogShipperType is the log shipping agent
+type LogShipperType string
+
+const (
+	// LogShipperTypeVector ships logs with Vector
+	LogShipperTypeVector LogShipperType = "vector"
+	// LogShipperTypeFluentBit ships logs with Fluent Bit
+	LogShipperTypeFluentBit LogShipperType = "fluent-bit"
+)
+
+// LogShipperSpec represents an optional log shipping sidecar, which reads the log files of the component,
+// parses them into structured records with the cluster, component and pod labels and forwards them to the sinks.
+// The shipped logs are:
+//   - the slow log of TiDB if `separateSlowLog` is enabled
+//   - the RocksDB and Raft logs of TiKV if `separateRocksDBLog` and `separateRaftLog` are enabled
+//   - the server, error and cluster manager logs of TiFlash
+//   - the log file of PD, TiDB, TiKV and TiCDC if it is configured in the config of the component
+//
+// +k8s:openapi-gen=true
+type LogShipperSpec struct {
+	corev1.ResourceRequirements `json:",inline"`
+
+	// Type is the log shipping agent, defaults to vector
+	// +kubebuilder:validation:Enum:="";"vector";"fluent-bit"
+	// +optional
+	Type LogShipperType `json:"type,omitempty"`
+
+	// Image of the log shipping agent, defaults to the official image of the agent
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// ImagePullPolicy of the log shipping agent. Override the cluster-level imagePullPolicy if present
+	// +optional
+	ImagePullPolicy *corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// If true, we use native sidecar feature to ship log
+	// It requires enable feature gate "SidecarContainers"
+	// This feature is introduced at 1.28, default enabled at 1.29, and GA at 1.33
+	// See https://kubernetes.io/docs/concepts/workloads/pods/sidecar-containers/
+	// +optional
+	UseSidecar bool `json:"useSidecar,omitempty"`
+
+	// SecurityContext defines the security options the log shipping container should be run with.
+	// +optional
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+
+	// Labels are the additional fields added to each log record, in addition to
+	// `namespace`, `cluster`, `component` and `pod`
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Sinks are where the log records are forwarded to
+	// +kubebuilder:validation:MinItems=1
+	Sinks []LogSinkSpec `json:"sinks"`
+}
+
+// LogSinkSpec is a destination of the log records, exactly one of the sinks should be set
+// +k8s:openapi-gen=true
+type LogSinkSpec struct {
+	// Name of the sink, it must be unique in the sinks
+	Name string `json:"name"`
+
+	// File writes the records as JSON lines to a local file
+	// +optional
+	File *FileLogSink `json:"file,omitempty"`
+
+	// HTTP posts the records as JSON to an HTTP endpoint
+	// +optional
+	HTTP *HTTPLogSink `json:"http,omitempty"`
+}
+
+// FileLogSink writes the log records to a local file
+// +k8s:openapi-gen=true
+type FileLogSink struct {
+	// Path of the file, it should be on a volume mounted by `additionalVolumeMounts` of the component
+	// to be kept across restarts
+	Path string `json:"path"`
+}
+
+// HTTPLogSink posts the log records to an HTTP endpoint
+// +k8s:openapi-gen=true
+type HTTPLogSink struct {
+	// URL of the endpoint, e.g. `https://logs.example.com/ingest`
+	URL string `json:"url"`
+
+	// Headers are added to the requests
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// AuthorizationSecret references the key of the Secret whose value is sent as the `Authorization` header
+	// +optional
+	AuthorizationSecret *corev1.SecretKeySelector `json:"authorizationSecret,omitempty"`
+
+	// InsecureSkipVerify skips the verification of the server certificate
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
 // InitContainerSpec contains basic spec about a init container
 //
 // +k8s:openapi-gen=true
@@ -1151,6 +1595,12 @@ type TiDBSpec struct {
 	//  - zone, topology.kubernetes.io/zone
 	//  - host
 	ServerLabels map[string]string `json:"serverLabels,omitempty"`
+
+	// Groups are the named groups of TiDB in addition to the default one described by this spec.
+	// Every group is deployed as a separated StatefulSet `<cluster>-<group>-tidb` with its own
+	// Service, and the non-empty fields of a group override the ones of this spec.
+	// +optional
+	Groups []TiDBGroupSpec `json:"groups,omitempty"`
 }
 
 type CustomizedProbe struct {
@@ -1464,6 +1914,11 @@ type ComponentSpec struct {
 	// the default behavior is like setting type as "tcp"
 	// +optional
 	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
+
+	// LogShipper ships the logs of the component to the sinks, it overrides the cluster-level setting.
+	// It is supported by PD, TiDB, TiKV, TiFlash and TiCDC.
+	// +optional
+	LogShipper *LogShipperSpec `json:"logShipper,omitempty"`
 }
 
 // ServiceSpec specifies the service object in k8s
@@ -1593,6 +2048,34 @@ type PDStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// Indicates that a Volume replace using VolumeReplacing feature is in progress.
 	VolReplaceInProgress bool `json:"volReplaceInProgress,omitempty"`
+	// PlacementRules contains the status of the placement rule bundles managed by the operator, keyed by the group ID
+	// +optional
+	PlacementRules map[string]PlacementRuleBundleStatus `json:"placementRules,omitempty"`
+	// LeaderZone is the zone of the node that the PD leader runs on
+	// +optional
+	LeaderZone string `json:"leaderZone,omitempty"`
+	// LeaderPriorities contains the leader priorities set to the PD members by the operator, keyed by the member name
+	// +optional
+	LeaderPriorities map[string]int `json:"leaderPriorities,omitempty"`
+}
+
+// PlacementRuleBundleStatus is the status of a placement rule bundle managed by the operator
+type PlacementRuleBundleStatus struct {
+	// Version is the hash of the last applied bundle
+	// +optional
+	Version string `json:"version,omitempty"`
+	// LastAppliedTime is the time when the bundle was applied to PD last time
+	// +optional
+	// +nullable
+	LastAppliedTime *metav1.Time `json:"lastAppliedTime,omitempty"`
+	// LastDriftTime is the time when the bundle in PD was found different from the last applied one,
+	// e.g. it is changed by pd-ctl or SQL. The drifted bundle is overwritten by the operator.
+	// +optional
+	// +nullable
+	LastDriftTime *metav1.Time `json:"lastDriftTime,omitempty"`
+	// Message describes why the bundle is not applied, it is empty if the bundle is applied successfully
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // PDMSStatus is PD microservice status
@@ -1606,13 +2089,38 @@ type PDMSStatus struct {
 	Volumes map[StorageVolumeName]*StorageVolumeStatus `json:"volumes,omitempty"`
 	// Members contains other service in current TidbCluster
 	Members []string `json:"members,omitempty"`
-	Image   string   `json:"image,omitempty"`
+	// Primary is the address of the primary of the service
+	Primary string `json:"primary,omitempty"`
+	// MemberHealth contains the health of the pods of the service, keyed by pod name
+	MemberHealth   map[string]PDMSMemberHealth  `json:"memberHealth,omitempty"`
+	FailureMembers map[string]PDMSFailureMember `json:"failureMembers,omitempty"`
+	Image          string                       `json:"image,omitempty"`
 	// Represents the latest available observations of a component's state.
 	// +optional
 	// +nullable
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// PDMSMemberHealth is the health of a PD microservice pod.
+// A pod is healthy if it is ready and registered in the service.
+type PDMSMemberHealth struct {
+	Name   string `json:"name"`
+	Health bool   `json:"health"`
+	// Last time the health transitioned from one to another.
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// PDMSFailureMember is the PD microservice failure member information
+type PDMSFailureMember struct {
+	PodName       string                    `json:"podName,omitempty"`
+	PVCUIDSet     map[types.UID]EmptyStruct `json:"pvcUIDSet,omitempty"`
+	MemberDeleted bool                      `json:"memberDeleted,omitempty"`
+	HostDown      bool                      `json:"hostDown,omitempty"`
+	// +nullable
+	CreatedAt metav1.Time `json:"createdAt,omitempty"`
+}
+
 // PDMember is PD member
 type PDMember struct {
 	Name string `json:"name"`
@@ -1796,6 +2304,19 @@ type TiFlashStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// Indicates that a Volume replace using VolumeReplacing feature is in progress.
 	VolReplaceInProgress bool `json:"volReplaceInProgress,omitempty"`
+	// Compute is the status of the TiFlash compute nodes in the disaggregated mode
+	// +optional
+	Compute *TiFlashComputeStatus `json:"compute,omitempty"`
+}
+
+// TiFlashComputeStatus is the status of TiFlash compute nodes
+type TiFlashComputeStatus struct {
+	Synced          bool                    `json:"synced,omitempty"`
+	Phase           MemberPhase             `json:"phase,omitempty"`
+	StatefulSet     *apps.StatefulSetStatus `json:"statefulSet,omitempty"`
+	Stores          map[string]TiKVStore    `json:"stores,omitempty"`
+	TombstoneStores map[string]TiKVStore    `json:"tombstoneStores,omitempty"`
+	Image           string                  `json:"image,omitempty"`
 }
 
 // TiProxyMember is TiProxy member
@@ -1895,6 +2416,8 @@ type PumpStatus struct {
 	Phase       MemberPhase             `json:"phase,omitempty"`
 	StatefulSet *apps.StatefulSetStatus `json:"statefulSet,omitempty"`
 	Members     []*PumpNodeStatus       `json:"members,omitempty"`
+	// Drainers are the drainer nodes registered in PD, including the ones deployed by Drainers or Helm.
+	Drainers []*PumpNodeStatus `json:"drainers,omitempty"`
 	// Volumes contains the status of all volumes.
 	Volumes map[StorageVolumeName]*StorageVolumeStatus `json:"volumes,omitempty"`
 	// Represents the latest available observations of a component's state.
@@ -2372,6 +2895,11 @@ type DumplingConfig struct {
 	Options []string `json:"options,omitempty"`
 	// Deprecated. Please use `Spec.TableFilter` instead. TableFilter means Table filter expression for 'db.table' matching
 	TableFilter []string `json:"tableFilter,omitempty"`
+	// Streaming makes dumpling write the backup data to the external storage directly instead of
+	// dumping to the PVC and uploading the archive, so the backup doesn't need a PVC as large as the data.
+	// The backup data is kept as a directory instead of a .tgz archive. Only S3, GCS and Azure Blob are supported.
+	// +optional
+	Streaming bool `json:"streaming,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -2407,6 +2935,87 @@ type BRConfig struct {
 	Options []string `json:"options,omitempty"`
 }
 
+// LightningBackend is the backend of TiDB Lightning
+type LightningBackend string
+
+const (
+	// LightningBackendTiDB is the logical import mode, which imports the data by executing SQL statements
+	LightningBackendTiDB LightningBackend = "tidb"
+	// LightningBackendLocal is the physical import mode, which encodes the data into sorted KV pairs
+	// on the local disk and ingests them into TiKV directly
+	LightningBackendLocal LightningBackend = "local"
+)
+
+// LightningCheckpointStorage is where the checkpoint of TiDB Lightning is stored
+type LightningCheckpointStorage string
+
+const (
+	// LightningCheckpointStoragePVC stores the checkpoint in the PVC of the restore
+	LightningCheckpointStoragePVC LightningCheckpointStorage = "pvc"
+	// LightningCheckpointStorageRemote stores the checkpoint next to the export in the storage of the restore
+	LightningCheckpointStorageRemote LightningCheckpointStorage = "remote"
+)
+
+// LightningConflictStrategy is how TiDB Lightning resolves the conflicting data
+type LightningConflictStrategy string
+
+const (
+	// LightningConflictStrategyError fails the import when there is conflicting data
+	LightningConflictStrategyError LightningConflictStrategy = "error"
+	// LightningConflictStrategyReplace replaces the existing data with the conflicting data
+	LightningConflictStrategyReplace LightningConflictStrategy = "replace"
+	// LightningConflictStrategyIgnore keeps the existing data and ignores the conflicting data,
+	// it is only supported by the logical import mode
+	LightningConflictStrategyIgnore LightningConflictStrategy = "ignore"
+)
+
+// +k8s:openapi-gen=true
+// LightningConfig contains config for TiDB Lightning
+type LightningConfig struct {
+	// Backend is the backend of TiDB Lightning, `tidb` for the logical import mode and `local` for the physical
+	// import mode. In the physical import mode, the sorted KV files are written into a PVC owned by the restore,
+	// which is deleted once the restore completes or fails.
+	// +kubebuilder:default=tidb
+	// +kubebuilder:validation:Enum=tidb;local
+	Backend LightningBackend `json:"backend,omitempty"`
+	// SortedKVStorageSize is the size of the PVC of the sorted KV files in the physical import mode,
+	// it defaults to `StorageSize`.
+	// +optional
+	SortedKVStorageSize string `json:"sortedKVStorageSize,omitempty"`
+	// DiskQuota is the disk quota of the sorted KV files in the physical import mode,
+	// it defaults to 80% of `SortedKVStorageSize` to leave room for the files being written.
+	// +optional
+	DiskQuota *resource.Quantity `json:"diskQuota,omitempty"`
+	// Checkpoint is where the checkpoint is stored to resume the import after the restore job fails,
+	// `pvc` for the PVC of the restore and `remote` for the storage of the restore. The checkpoint is
+	// disabled if it is not set.
+	// +optional
+	// +kubebuilder:validation:Enum=pvc;remote
+	Checkpoint LightningCheckpointStorage `json:"checkpoint,omitempty"`
+	// Conflict configures how the conflicting data is resolved
+	// +optional
+	Conflict *LightningConflictConfig `json:"conflict,omitempty"`
+	// Options means options for TiDB Lightning. These options has highest priority.
+	// +optional
+	Options []string `json:"options,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// LightningConflictConfig contains config for the conflict resolution of TiDB Lightning
+type LightningConflictConfig struct {
+	// Strategy is how the conflicting data is resolved, one of `error`, `replace` and `ignore`.
+	// `ignore` is only supported by the logical import mode.
+	// +kubebuilder:validation:Enum=error;replace;ignore
+	Strategy LightningConflictStrategy `json:"strategy"`
+	// Threshold is the max number of the conflicting rows tolerated by `replace` and `ignore`,
+	// the import fails if it is exceeded.
+	// +optional
+	Threshold *int64 `json:"threshold,omitempty"`
+	// MaxRecordRows is the max number of the conflicting rows recorded in the conflict table
+	// +optional
+	MaxRecordRows *int64 `json:"maxRecordRows,omitempty"`
+}
+
 // BackoffRetryPolicy is the backoff retry policy, currently only valid for snapshot backup.
 // When backup job or pod failed, it will retry in the following way:
 // first time: retry after MinRetryDuration
@@ -2862,6 +3471,9 @@ type RestoreSpec struct {
 	StorageSize string `json:"storageSize,omitempty"`
 	// BR is the configs for BR.
 	BR *BRConfig `json:"br,omitempty"`
+	// Lightning is the configs for TiDB Lightning, which imports the Dumpling export if BR is not set.
+	// +optional
+	Lightning *LightningConfig `json:"lightning,omitempty"`
 	// Base tolerations of restore Pods, components may add more tolerations upon this respectively
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
@@ -2888,9 +3500,20 @@ type RestoreSpec struct {
 	// WarmupImage represents using what image to initialize TiKV volumes
 	// +optional
 	WarmupImage string `json:"warmupImage,omitempty"`
-	// WarmupStrategy
-	// +kubebuilder:default=hybrid
+	// WarmupStrategy is how the TiKV volumes are warmed up, which must be supported by the snapshotter of the cloud.
+	// The cloud is GCP if the backup is stored in GCS, Azure if it is stored in Azure Blob Storage, and AWS otherwise.
+	// It defaults to `hybrid` for AWS, which supports all the strategies, and `block` for GCP and Azure, which only support `block`.
+	// +optional
 	WarmupStrategy RestoreWarmupStrategy `json:"warmupStrategy,omitempty"`
+	// WarmupOptions are the extra options of the warmup which reads the files of the TiKV data volume (hybrid)
+	// or the raw block devices of the TiKV volumes (block), e.g. `--adaptive --adaptive.target-throughput=250` adjusts the read concurrency to the IO budget in MiB/s.
+	// +optional
+	WarmupOptions []string `json:"warmupOptions,omitempty"`
+	// ReportWarmupProgress makes the warmup jobs report their progress to `status.warmupProgress`.
+	// The warmup jobs then run with `serviceAccount`, which defaults to `tidb-backup-manager` and
+	// needs the `get` and `update` permissions on `restores`.
+	// +optional
+	ReportWarmupProgress bool `json:"reportWarmupProgress,omitempty"`
 
 	// PodSecurityContext of the component
 	// +optional
@@ -2946,6 +3569,9 @@ const (
 	RestoreWarmupStrategyFsr RestoreWarmupStrategy = "fsr"
 	// RestoreWarmupStrategyCheckOnly warm up none data volumes and check wal consistency
 	RestoreWarmupStrategyCheckOnly RestoreWarmupStrategy = "check-wal-only"
+	// RestoreWarmupStrategyBlock warms up all volumes by reading the raw block devices with direct I/O.
+	// It doesn't rely on EBS features, so it also fits the disks restored from snapshots of other clouds, e.g. GCP or Azure.
+	RestoreWarmupStrategyBlock RestoreWarmupStrategy = "block"
 )
 
 // RestoreStatus represents the current status of a tidb cluster restore.
@@ -2967,6 +3593,40 @@ type RestoreStatus struct {
 	// Progresses is the progress of restore.
 	// +nullable
 	Progresses []Progress `json:"progresses,omitempty"`
+	// WarmupProgress is the progress of warming up the TiKV volumes by reading files, keyed by the name of the TiKV pod.
+	// It's reported by the warmup jobs periodically if `reportWarmupProgress` is set.
+	// +nullable
+	// +optional
+	WarmupProgress map[string]WarmupProgress `json:"warmupProgress,omitempty"`
+}
+
+// WarmupProgress is the progress of warming up the volumes of a TiKV
+type WarmupProgress struct {
+	// FilesDone is the number of the files warmed up
+	FilesDone int64 `json:"filesDone"`
+	// FilesTotal is the number of the files to be warmed up
+	FilesTotal int64 `json:"filesTotal"`
+	// BytesDone is the number of the bytes warmed up
+	BytesDone int64 `json:"bytesDone"`
+	// BytesTotal is the number of the bytes to be warmed up
+	BytesTotal int64 `json:"bytesTotal"`
+	// Throughput is the read throughput in bytes per second
+	// +optional
+	Throughput int64 `json:"throughput,omitempty"`
+	// Concurrency is the number of the concurrent reads, which is adjusted in the adaptive mode
+	// +optional
+	Concurrency int32 `json:"concurrency,omitempty"`
+	// Complete means all the files are warmed up
+	// +optional
+	Complete bool `json:"complete,omitempty"`
+	// EstimatedCompletionTime is the estimated time at which all the files are warmed up
+	// +nullable
+	// +optional
+	EstimatedCompletionTime *metav1.Time `json:"estimatedCompletionTime,omitempty"`
+	// LastUpdateTime is the time at which the progress is reported
+	// +nullable
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -3711,6 +4371,36 @@ type CompactStatus struct {
 	EndTs string `json:"endTs,omitempty"`
 	// RetryStatus is status of the backoff retry, it will be used when backup pod or job exited unexpectedly
 	RetryStatus []CompactRetryRecord `json:"backoffRetryStatus,omitempty"`
+	// Coverage is the report of the log backup ranges compacted by the last run of the compaction
+	// +optional
+	Coverage *CompactCoverage `json:"coverage,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// CompactCoverage is the coverage report of a compaction
+type CompactCoverage struct {
+	// CompactedRanges are the ts ranges of the compacted log files, merged and in ascending order.
+	// They are only reported if the compaction is complete, since the subcompactions finished in
+	// an incomplete compaction only compact the log files of some regions.
+	CompactedRanges []CompactTSRange `json:"compactedRanges,omitempty"`
+	// Gaps are the ts ranges between the compacted ranges. There is no log file in them if the
+	// compaction is complete, otherwise the whole range processed is a gap.
+	Gaps []CompactTSRange `json:"gaps,omitempty"`
+	// InputBytes is the size of the log files compacted
+	InputBytes int64 `json:"inputBytes,omitempty"`
+	// OutputBytes is the size of the compacted files written
+	OutputBytes int64 `json:"outputBytes,omitempty"`
+	// BytesReduced is the size reduced by the compaction, i.e. InputBytes - OutputBytes
+	BytesReduced int64 `json:"bytesReduced,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// CompactTSRange is a ts range of the log backup
+type CompactTSRange struct {
+	// StartTs is the start ts of the range in TSO
+	StartTs string `json:"startTs"`
+	// EndTs is the end ts of the range in TSO
+	EndTs string `json:"endTs"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
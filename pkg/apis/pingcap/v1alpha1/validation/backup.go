@@ -0,0 +1,567 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/util/config"
+	"github.com/robfig/cron"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateBackup validates a Backup. The checks which depend on the runtime state, e.g. the version of TiKV,
+// are left to the backup controller.
+func ValidateBackup(backup *v1alpha1.Backup) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validateBackupSpec(&backup.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateUpdateBackup validates a new Backup against an existing Backup to be updated
+func ValidateUpdateBackup(old, backup *v1alpha1.Backup) field.ErrorList {
+	allErrs := field.ErrorList{}
+	// the status is updated by the controller without the status subresource, only validate the spec
+	// when it is changed, so that an existing object is not blocked by the checks added later
+	if !reflect.DeepEqual(old.Spec, backup.Spec) {
+		allErrs = append(allErrs, ValidateBackup(backup)...)
+	}
+	if backupStarted(old) {
+		allErrs = append(allErrs, disallowMutateBackupSpec(old, &old.Spec, &backup.Spec, field.NewPath("spec"))...)
+	}
+	return allErrs
+}
+
+// ValidateRestore validates a Restore. The checks which depend on the runtime state are left to the
+// restore controller.
+func ValidateRestore(restore *v1alpha1.Restore) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validateRestoreSpec(&restore.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateUpdateRestore validates a new Restore against an existing Restore to be updated
+func ValidateUpdateRestore(old, restore *v1alpha1.Restore) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !reflect.DeepEqual(old.Spec, restore.Spec) {
+		allErrs = append(allErrs, ValidateRestore(restore)...)
+	}
+	if restoreStarted(old) {
+		allErrs = append(allErrs, disallowMutateRestoreSpec(old, &old.Spec, &restore.Spec, field.NewPath("spec"))...)
+	}
+	return allErrs
+}
+
+// ValidateBackupSchedule validates a BackupSchedule
+func ValidateBackupSchedule(bs *v1alpha1.BackupSchedule) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validateBackupScheduleSpec(&bs.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateUpdateBackupSchedule validates a new BackupSchedule against an existing BackupSchedule to be updated
+func ValidateUpdateBackupSchedule(old, bs *v1alpha1.BackupSchedule) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !reflect.DeepEqual(old.Spec, bs.Spec) {
+		allErrs = append(allErrs, ValidateBackupSchedule(bs)...)
+	}
+	// the log backup is created only once and is kept running, changing its template does not take effect
+	if old.Status.LogBackup != nil && old.Spec.LogBackupTemplate != nil {
+		fldPath := field.NewPath("spec", "logBackupTemplate")
+		if bs.Spec.LogBackupTemplate == nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "logBackupTemplate can not be removed when the log backup is created"))
+		} else {
+			allErrs = append(allErrs, disallowMutateStorageProvider(&old.Spec.LogBackupTemplate.StorageProvider, &bs.Spec.LogBackupTemplate.StorageProvider, fldPath)...)
+			allErrs = append(allErrs, disallowMutateBRCluster(bs.Namespace, old.Spec.LogBackupTemplate.BR, bs.Spec.LogBackupTemplate.BR, fldPath.Child("br"))...)
+		}
+	}
+	return allErrs
+}
+
+// ValidateCompactBackup validates a CompactBackup
+func ValidateCompactBackup(compact *v1alpha1.CompactBackup) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validateCompactSpec(&compact.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateUpdateCompactBackup validates a new CompactBackup against an existing CompactBackup to be updated
+func ValidateUpdateCompactBackup(old, compact *v1alpha1.CompactBackup) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !reflect.DeepEqual(old.Spec, compact.Spec) {
+		allErrs = append(allErrs, ValidateCompactBackup(compact)...)
+	}
+	if old.Status.State != "" {
+		fldPath := field.NewPath("spec")
+		allErrs = append(allErrs, disallowMutateStorageProvider(&old.Spec.StorageProvider, &compact.Spec.StorageProvider, fldPath)...)
+		allErrs = append(allErrs, disallowMutateBRCluster(compact.Namespace, old.Spec.BR, compact.Spec.BR, fldPath.Child("br"))...)
+		if old.Spec.StartTs != compact.Spec.StartTs {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("startTs"), "startTs can not be changed after the compact backup is started"))
+		}
+		if old.Spec.EndTs != compact.Spec.EndTs {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("endTs"), "endTs can not be changed after the compact backup is started"))
+		}
+	}
+	return allErrs
+}
+
+func validateBackupSpec(spec *v1alpha1.BackupSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validateEnv(spec.Env, fldPath.Child("env"))...)
+	allErrs = append(allErrs, validateStorageProvider(&spec.StorageProvider, spec.BR != nil, fldPath)...)
+	allErrs = append(allErrs, validateTimeDurationStr(spec.TikvGCLifeTime, fldPath.Child("tikvGCLifeTime"))...)
+
+	switch spec.Mode {
+	case "", v1alpha1.BackupModeSnapshot, v1alpha1.BackupModeLog, v1alpha1.BackupModeVolumeSnapshot:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("backupMode"), spec.Mode,
+			[]string{string(v1alpha1.BackupModeSnapshot), string(v1alpha1.BackupModeLog), string(v1alpha1.BackupModeVolumeSnapshot)}))
+	}
+	switch spec.CleanPolicy {
+	case "", v1alpha1.CleanPolicyTypeRetain, v1alpha1.CleanPolicyTypeOnFailure, v1alpha1.CleanPolicyTypeDelete:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("cleanPolicy"), spec.CleanPolicy,
+			[]string{string(v1alpha1.CleanPolicyTypeRetain), string(v1alpha1.CleanPolicyTypeOnFailure), string(v1alpha1.CleanPolicyTypeDelete)}))
+	}
+
+	if spec.BR == nil {
+		allErrs = append(allErrs, validateTiDBAccessConfig(spec.From, fldPath.Child("from"))...)
+		streaming := spec.Dumpling != nil && spec.Dumpling.Streaming
+		if spec.StorageSize == "" && !streaming {
+			allErrs = append(allErrs, field.Required(fldPath.Child("storageSize"), "storageSize must be set when backing up by dumpling"))
+		}
+		if streaming && spec.S3 == nil && spec.Gcs == nil && spec.Azblob == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("dumpling", "streaming"), spec.Dumpling.Streaming, "only s3, gcs and azblob are supported when streaming"))
+		}
+		if spec.Mode == v1alpha1.BackupModeLog || spec.Mode == v1alpha1.BackupModeVolumeSnapshot {
+			allErrs = append(allErrs, field.Required(fldPath.Child("br"), "br must be set for "+string(spec.Mode)+" backup"))
+		}
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateBRConfig(spec.BR, spec.Type, spec.TableFilter, fldPath)...)
+	if spec.Mode == v1alpha1.BackupModeLog {
+		allErrs = append(allErrs, validateTSString(spec.CommitTs, fldPath.Child("commitTs"))...)
+		allErrs = append(allErrs, validateTSString(spec.LogTruncateUntil, fldPath.Child("logTruncateUntil"))...)
+	}
+	allErrs = append(allErrs, validateDurationStr(spec.BackoffRetryPolicy.MinRetryDuration, fldPath.Child("backoffRetryPolicy", "minRetryDuration"))...)
+	allErrs = append(allErrs, validateDurationStr(spec.BackoffRetryPolicy.RetryTimeout, fldPath.Child("backoffRetryPolicy", "retryTimeout"))...)
+	if spec.BackoffRetryPolicy.MaxRetryTimes < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("backoffRetryPolicy", "maxRetryTimes"), spec.BackoffRetryPolicy.MaxRetryTimes, "must be greater than or equal to 0"))
+	}
+	return allErrs
+}
+
+func validateLightningConfig(config *v1alpha1.LightningConfig, storageSize string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch config.Backend {
+	case "", v1alpha1.LightningBackendTiDB, v1alpha1.LightningBackendLocal:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("backend"), config.Backend,
+			[]string{string(v1alpha1.LightningBackendTiDB), string(v1alpha1.LightningBackendLocal)}))
+	}
+	switch config.Checkpoint {
+	case "", v1alpha1.LightningCheckpointStoragePVC, v1alpha1.LightningCheckpointStorageRemote:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("checkpoint"), config.Checkpoint,
+			[]string{string(v1alpha1.LightningCheckpointStoragePVC), string(v1alpha1.LightningCheckpointStorageRemote)}))
+	}
+
+	if config.SortedKVStorageSize != "" {
+		if config.Backend != v1alpha1.LightningBackendLocal {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("sortedKVStorageSize"), "sortedKVStorageSize can only be set in the physical import mode"))
+		} else if _, err := resource.ParseQuantity(config.SortedKVStorageSize); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sortedKVStorageSize"), config.SortedKVStorageSize, err.Error()))
+		} else {
+			storageSize = config.SortedKVStorageSize
+		}
+	}
+
+	if config.DiskQuota != nil {
+		if config.Backend != v1alpha1.LightningBackendLocal {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("diskQuota"), "diskQuota can only be set in the physical import mode"))
+		} else if size, err := resource.ParseQuantity(storageSize); err == nil && config.DiskQuota.Cmp(size) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("diskQuota"), config.DiskQuota.String(), "must not be greater than the size of the sorted KV PVC"))
+		}
+	}
+
+	if config.Conflict != nil {
+		conflictPath := fldPath.Child("conflict")
+		switch config.Conflict.Strategy {
+		case v1alpha1.LightningConflictStrategyError, v1alpha1.LightningConflictStrategyReplace:
+		case v1alpha1.LightningConflictStrategyIgnore:
+			if config.Backend == v1alpha1.LightningBackendLocal {
+				allErrs = append(allErrs, field.Invalid(conflictPath.Child("strategy"), config.Conflict.Strategy, "ignore is not supported in the physical import mode"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(conflictPath.Child("strategy"), config.Conflict.Strategy,
+				[]string{string(v1alpha1.LightningConflictStrategyError), string(v1alpha1.LightningConflictStrategyReplace), string(v1alpha1.LightningConflictStrategyIgnore)}))
+		}
+		if config.Conflict.Threshold != nil && *config.Conflict.Threshold < 0 {
+			allErrs = append(allErrs, field.Invalid(conflictPath.Child("threshold"), *config.Conflict.Threshold, "must be greater than or equal to 0"))
+		}
+		if config.Conflict.MaxRecordRows != nil && *config.Conflict.MaxRecordRows < 0 {
+			allErrs = append(allErrs, field.Invalid(conflictPath.Child("maxRecordRows"), *config.Conflict.MaxRecordRows, "must be greater than or equal to 0"))
+		}
+	}
+	return allErrs
+}
+
+func validateRestoreSpec(spec *v1alpha1.RestoreSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validateEnv(spec.Env, fldPath.Child("env"))...)
+	allErrs = append(allErrs, validateStorageProvider(&spec.StorageProvider, spec.BR != nil, fldPath)...)
+	allErrs = append(allErrs, validateTimeDurationStr(spec.TikvGCLifeTime, fldPath.Child("tikvGCLifeTime"))...)
+	if spec.BackoffLimit < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("backoffLimit"), spec.BackoffLimit, "must be greater than or equal to 0"))
+	}
+
+	switch spec.Mode {
+	case "", v1alpha1.RestoreModeSnapshot, v1alpha1.RestoreModePiTR, v1alpha1.RestoreModeVolumeSnapshot:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("restoreMode"), spec.Mode,
+			[]string{string(v1alpha1.RestoreModeSnapshot), string(v1alpha1.RestoreModePiTR), string(v1alpha1.RestoreModeVolumeSnapshot)}))
+	}
+	switch spec.Warmup {
+	case "", v1alpha1.RestoreWarmupModeSync, v1alpha1.RestoreWarmupModeASync:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("warmup"), spec.Warmup,
+			[]string{string(v1alpha1.RestoreWarmupModeSync), string(v1alpha1.RestoreWarmupModeASync)}))
+	}
+
+	if spec.BR == nil {
+		allErrs = append(allErrs, validateTiDBAccessConfig(spec.To, fldPath.Child("to"))...)
+		if spec.StorageSize == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("storageSize"), "storageSize must be set when restoring by lightning"))
+		}
+		if spec.Mode == v1alpha1.RestoreModePiTR || spec.Mode == v1alpha1.RestoreModeVolumeSnapshot {
+			allErrs = append(allErrs, field.Required(fldPath.Child("br"), "br must be set for "+string(spec.Mode)+" restore"))
+		}
+		if spec.Lightning != nil {
+			allErrs = append(allErrs, validateLightningConfig(spec.Lightning, spec.StorageSize, fldPath.Child("lightning"))...)
+		}
+		return allErrs
+	}
+	if spec.Lightning != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("lightning"), "lightning can not be set when restoring by br"))
+	}
+
+	allErrs = append(allErrs, validateBRConfig(spec.BR, spec.Type, spec.TableFilter, fldPath)...)
+	if spec.Mode == v1alpha1.RestoreModePiTR {
+		allErrs = append(allErrs, validateTSString(spec.PitrRestoredTs, fldPath.Child("pitrRestoredTs"))...)
+		allErrs = append(allErrs, validateTSString(spec.LogRestoreStartTs, fldPath.Child("logRestoreStartTs"))...)
+		hasFullBackup := storageProviderCount(&spec.PitrFullBackupStorageProvider) > 0
+		if hasFullBackup && spec.LogRestoreStartTs != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("logRestoreStartTs"), "pitrFullBackupStorageProvider and logRestoreStartTs can not be set at the same time"))
+		}
+		if !hasFullBackup && spec.LogRestoreStartTs == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("pitrFullBackupStorageProvider"), "either pitrFullBackupStorageProvider or logRestoreStartTs must be set in pitr mode"))
+		}
+		if hasFullBackup {
+			allErrs = append(allErrs, validateStorageProvider(&spec.PitrFullBackupStorageProvider, true, fldPath.Child("pitrFullBackupStorageProvider"))...)
+		}
+	}
+	return allErrs
+}
+
+func validateBackupScheduleSpec(spec *v1alpha1.BackupScheduleSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.Schedule == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("schedule"), "schedule must be set"))
+	} else if _, err := cron.ParseStandard(spec.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("schedule"), spec.Schedule, "must be a valid cron expression: "+err.Error()))
+	}
+	if spec.MaxBackups != nil && *spec.MaxBackups < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxBackups"), *spec.MaxBackups, "must be greater than or equal to 0"))
+	}
+	allErrs = append(allErrs, validateTimeDurationStr(spec.MaxReservedTime, fldPath.Child("maxReservedTime"))...)
+	allErrs = append(allErrs, validateTimeDurationStr(spec.CompactInterval, fldPath.Child("compactInterval"))...)
+	if spec.MinCompactStartTs != nil {
+		allErrs = append(allErrs, validateTSString(*spec.MinCompactStartTs, fldPath.Child("minCompactStartTs"))...)
+	}
+	allErrs = append(allErrs, validateStorageProvider(&spec.StorageProvider, false, fldPath)...)
+
+	// the storage size of the dumpling backup can be inherited from the schedule
+	template := spec.BackupTemplate.DeepCopy()
+	if template.BR == nil && template.StorageSize == "" {
+		template.StorageSize = "inherited"
+	}
+	allErrs = append(allErrs, validateBackupSpec(template, fldPath.Child("backupTemplate"))...)
+	if spec.LogBackupTemplate != nil {
+		logPath := fldPath.Child("logBackupTemplate")
+		allErrs = append(allErrs, validateBackupSpec(spec.LogBackupTemplate, logPath)...)
+		if spec.LogBackupTemplate.Mode != v1alpha1.BackupModeLog {
+			allErrs = append(allErrs, field.Invalid(logPath.Child("backupMode"), spec.LogBackupTemplate.Mode, "must be log"))
+		}
+	}
+	if spec.CompactBackupTemplate != nil {
+		compactPath := fldPath.Child("compactBackupTemplate")
+		// the ts range of the compact backup is generated by the schedule
+		compact := spec.CompactBackupTemplate.DeepCopy()
+		compact.StartTs, compact.EndTs = "0", "0"
+		allErrs = append(allErrs, validateCompactSpec(compact, compactPath)...)
+		if spec.LogBackupTemplate == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("logBackupTemplate"), "logBackupTemplate must be set to compact log backups"))
+		}
+		if spec.CompactInterval == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("compactInterval"), "compactInterval must be set to compact log backups"))
+		}
+	}
+	return allErrs
+}
+
+func validateCompactSpec(spec *v1alpha1.CompactSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validateEnv(spec.Env, fldPath.Child("env"))...)
+	allErrs = append(allErrs, validateStorageProvider(&spec.StorageProvider, true, fldPath)...)
+	if spec.StartTs == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("startTs"), "startTs must be set"))
+	} else {
+		allErrs = append(allErrs, validateTSString(spec.StartTs, fldPath.Child("startTs"))...)
+	}
+	if spec.EndTs == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("endTs"), "endTs must be set"))
+	} else {
+		allErrs = append(allErrs, validateTSString(spec.EndTs, fldPath.Child("endTs"))...)
+	}
+	if spec.Concurrency <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("concurrency"), spec.Concurrency, "must be greater than 0"))
+	}
+	if spec.MaxRetryTimes < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxRetryTimes"), spec.MaxRetryTimes, "must be greater than or equal to 0"))
+	}
+	if spec.BR != nil && spec.BR.Cluster == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("br", "cluster"), "cluster must be set"))
+	}
+	return allErrs
+}
+
+// validateBRConfig validates the br config together with the backup type and the table filter,
+// which are shared by Backup and Restore
+func validateBRConfig(br *v1alpha1.BRConfig, backupType v1alpha1.BackupType, tableFilter []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	brPath := fldPath.Child("br")
+	if br.Cluster == "" {
+		allErrs = append(allErrs, field.Required(brPath.Child("cluster"), "cluster must be set"))
+	}
+	switch backupType {
+	case "", v1alpha1.BackupTypeFull, v1alpha1.BackupTypeDB, v1alpha1.BackupTypeTable:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("backupType"), backupType,
+			[]string{string(v1alpha1.BackupTypeFull), string(v1alpha1.BackupTypeDB), string(v1alpha1.BackupTypeTable)}))
+	}
+	if (backupType == v1alpha1.BackupTypeDB || backupType == v1alpha1.BackupTypeTable) && br.DB == "" {
+		allErrs = append(allErrs, field.Required(brPath.Child("db"), "db must be set for backup type "+string(backupType)))
+	}
+	if backupType == v1alpha1.BackupTypeTable && br.Table == "" {
+		allErrs = append(allErrs, field.Required(brPath.Child("table"), "table must be set for backup type table"))
+	}
+	if len(tableFilter) > 0 && (br.DB != "" || br.Table != "") {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("tableFilter"), "tableFilter can not be used together with br.db or br.table"))
+	}
+	if br.TimeAgo != "" {
+		allErrs = append(allErrs, validateDurationStr(br.TimeAgo, brPath.Child("timeAgo"))...)
+	}
+	return allErrs
+}
+
+// validateStorageProvider validates that at most one storage is set, the storage is required if requireStorage is true
+func validateStorageProvider(provider *v1alpha1.StorageProvider, requireStorage bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	count := storageProviderCount(provider)
+	if count > 1 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only one of s3, gcs, azblob and local can be set"))
+	} else if count == 0 && requireStorage {
+		allErrs = append(allErrs, field.Required(fldPath, "one of s3, gcs, azblob and local must be set"))
+	}
+
+	if provider.S3 != nil {
+		s3Path := fldPath.Child("s3")
+		if provider.S3.Bucket == "" {
+			allErrs = append(allErrs, field.Required(s3Path.Child("bucket"), "bucket must be set"))
+		}
+		if provider.S3.Endpoint != "" {
+			u, err := url.Parse(provider.S3.Endpoint)
+			if err != nil || u.Scheme == "" || u.Host == "" {
+				allErrs = append(allErrs, field.Invalid(s3Path.Child("endpoint"), provider.S3.Endpoint, "must be a valid url with scheme and host"))
+			}
+		}
+	}
+	if provider.Gcs != nil {
+		gcsPath := fldPath.Child("gcs")
+		if provider.Gcs.ProjectId == "" {
+			allErrs = append(allErrs, field.Required(gcsPath.Child("projectId"), "projectId must be set"))
+		}
+		if provider.Gcs.Bucket == "" {
+			allErrs = append(allErrs, field.Required(gcsPath.Child("bucket"), "bucket must be set"))
+		}
+	}
+	if provider.Local != nil {
+		localPath := fldPath.Child("local")
+		if provider.Local.VolumeMount.Name != provider.Local.Volume.Name {
+			allErrs = append(allErrs, field.Invalid(localPath.Child("volumeMount", "name"), provider.Local.VolumeMount.Name, "must be the same as the name of the volume"))
+		}
+		if provider.Local.VolumeMount.MountPath == "" {
+			allErrs = append(allErrs, field.Required(localPath.Child("volumeMount", "mountPath"), "mountPath must be set"))
+		} else if strings.Contains(provider.Local.VolumeMount.MountPath, ":") {
+			allErrs = append(allErrs, field.Invalid(localPath.Child("volumeMount", "mountPath"), provider.Local.VolumeMount.MountPath, "must not contain ':'"))
+		}
+	}
+	return allErrs
+}
+
+func storageProviderCount(provider *v1alpha1.StorageProvider) int {
+	count := 0
+	if provider.S3 != nil {
+		count++
+	}
+	if provider.Gcs != nil {
+		count++
+	}
+	if provider.Azblob != nil {
+		count++
+	}
+	if provider.Local != nil {
+		count++
+	}
+	return count
+}
+
+func validateTiDBAccessConfig(config *v1alpha1.TiDBAccessConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if config == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "the tidb access config must be set"))
+		return allErrs
+	}
+	if config.Host == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("host"), "host must be set"))
+	}
+	if config.SecretName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretName"), "secretName must be set"))
+	}
+	return allErrs
+}
+
+// validateTSString validates a TSO or a datetime string, the empty string is allowed
+func validateTSString(ts string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if _, err := config.ParseTSString(ts); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, ts, "must be a TSO or a datetime, e.g. '400036290571534337' or '2006-01-02 15:04:05'"))
+	}
+	return allErrs
+}
+
+// validateDurationStr validates a Go time duration string, the empty string is allowed
+func validateDurationStr(d string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if d == "" {
+		return allErrs
+	}
+	if _, err := time.ParseDuration(d); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, d, "must be a valid Go time duration string, e.g. 3m"))
+	}
+	return allErrs
+}
+
+func backupStarted(backup *v1alpha1.Backup) bool {
+	return backup.Status.Phase != "" && backup.Status.Phase != v1alpha1.BackupInvalid
+}
+
+func restoreStarted(restore *v1alpha1.Restore) bool {
+	return restore.Status.Phase != "" && restore.Status.Phase != v1alpha1.RestoreInvalid
+}
+
+// disallowMutateBackupSpec forbids changing the fields which determine what and where to back up
+// after the backup is started. The log backup subcommands are still allowed to change.
+func disallowMutateBackupSpec(old *v1alpha1.Backup, oldSpec, spec *v1alpha1.BackupSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if oldSpec.Mode != spec.Mode {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("backupMode"), "backupMode can not be changed after the backup is started"))
+	}
+	if oldSpec.Type != spec.Type {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("backupType"), "backupType can not be changed after the backup is started"))
+	}
+	if !reflect.DeepEqual(oldSpec.TableFilter, spec.TableFilter) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("tableFilter"), "tableFilter can not be changed after the backup is started"))
+	}
+	if oldSpec.Mode == v1alpha1.BackupModeLog && oldSpec.CommitTs != spec.CommitTs {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("commitTs"), "commitTs can not be changed after the log backup is started"))
+	}
+	allErrs = append(allErrs, disallowMutateStorageProvider(&oldSpec.StorageProvider, &spec.StorageProvider, fldPath)...)
+	allErrs = append(allErrs, disallowMutateBRCluster(old.Namespace, oldSpec.BR, spec.BR, fldPath.Child("br"))...)
+	return allErrs
+}
+
+// disallowMutateRestoreSpec forbids changing the fields which determine what and where to restore
+// after the restore is started
+func disallowMutateRestoreSpec(old *v1alpha1.Restore, oldSpec, spec *v1alpha1.RestoreSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if oldSpec.Mode != spec.Mode {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("restoreMode"), "restoreMode can not be changed after the restore is started"))
+	}
+	if oldSpec.Type != spec.Type {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("backupType"), "backupType can not be changed after the restore is started"))
+	}
+	if !reflect.DeepEqual(oldSpec.TableFilter, spec.TableFilter) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("tableFilter"), "tableFilter can not be changed after the restore is started"))
+	}
+	if oldSpec.PitrRestoredTs != spec.PitrRestoredTs {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("pitrRestoredTs"), "pitrRestoredTs can not be changed after the restore is started"))
+	}
+	if oldSpec.LogRestoreStartTs != spec.LogRestoreStartTs {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("logRestoreStartTs"), "logRestoreStartTs can not be changed after the restore is started"))
+	}
+	allErrs = append(allErrs, disallowMutateStorageProvider(&oldSpec.StorageProvider, &spec.StorageProvider, fldPath)...)
+	allErrs = append(allErrs, disallowMutateStorageProvider(&oldSpec.PitrFullBackupStorageProvider, &spec.PitrFullBackupStorageProvider, fldPath.Child("pitrFullBackupStorageProvider"))...)
+	allErrs = append(allErrs, disallowMutateBRCluster(old.Namespace, oldSpec.BR, spec.BR, fldPath.Child("br"))...)
+	return allErrs
+}
+
+func disallowMutateStorageProvider(old, provider *v1alpha1.StorageProvider, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !reflect.DeepEqual(old, provider) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "the storage can not be changed after started"))
+	}
+	return allErrs
+}
+
+// disallowMutateBRCluster forbids changing the target cluster and the db/table of br, an empty cluster
+// namespace is treated as the namespace of the object
+func disallowMutateBRCluster(ns string, old, br *v1alpha1.BRConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if old == nil || br == nil {
+		if (old == nil) != (br == nil) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "br can not be added or removed after started"))
+		}
+		return allErrs
+	}
+	clusterNamespace := func(br *v1alpha1.BRConfig) string {
+		if br.ClusterNamespace == "" {
+			return ns
+		}
+		return br.ClusterNamespace
+	}
+	if old.Cluster != br.Cluster || clusterNamespace(old) != clusterNamespace(br) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("cluster"), "the cluster can not be changed after started"))
+	}
+	if old.DB != br.DB || old.Table != br.Table {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("db"), "db and table can not be changed after started"))
+	}
+	return allErrs
+}
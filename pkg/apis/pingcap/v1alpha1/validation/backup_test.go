@@ -0,0 +1,479 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func newBRBackup() *v1alpha1.Backup {
+	return &v1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "backup"},
+		Spec: v1alpha1.BackupSpec{
+			BR: &v1alpha1.BRConfig{Cluster: "demo"},
+			StorageProvider: v1alpha1.StorageProvider{
+				S3: &v1alpha1.S3StorageProvider{Bucket: "bucket", Endpoint: "http://minio:9000"},
+			},
+		},
+	}
+}
+
+func TestValidateBackup(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		name           string
+		update         func(backup *v1alpha1.Backup)
+		expectedErrors int
+	}{
+		{
+			name:           "valid",
+			expectedErrors: 0,
+		},
+		{
+			name: "both s3 and gcs",
+			update: func(backup *v1alpha1.Backup) {
+				backup.Spec.Gcs = &v1alpha1.GcsStorageProvider{ProjectId: "project", Bucket: "bucket"}
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "invalid s3 endpoint",
+			update: func(backup *v1alpha1.Backup) {
+				backup.Spec.S3.Endpoint = "minio:9000"
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "table filter with db",
+			update: func(backup *v1alpha1.Backup) {
+				backup.Spec.Type = v1alpha1.BackupTypeDB
+				backup.Spec.BR.DB = "test"
+				backup.Spec.TableFilter = []string{"test.*"}
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "missing db and table",
+			update: func(backup *v1alpha1.Backup) {
+				backup.Spec.Type = v1alpha1.BackupTypeTable
+			},
+			expectedErrors: 2,
+		},
+		{
+			name: "invalid log truncate until",
+			update: func(backup *v1alpha1.Backup) {
+				backup.Spec.Mode = v1alpha1.BackupModeLog
+				backup.Spec.LogTruncateUntil = "yesterday"
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "invalid backoff retry policy",
+			update: func(backup *v1alpha1.Backup) {
+				backup.Spec.BackoffRetryPolicy.MinRetryDuration = "5"
+				backup.Spec.BackoffRetryPolicy.RetryTimeout = "30m"
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "dumpling without tidb access config",
+			update: func(backup *v1alpha1.Backup) {
+				backup.Spec.BR = nil
+			},
+			expectedErrors: 2,
+		},
+		{
+			name: "dumpling streaming without storage size",
+			update: func(backup *v1alpha1.Backup) {
+				backup.Spec.BR = nil
+				backup.Spec.From = &v1alpha1.TiDBAccessConfig{Host: "tidb", SecretName: "secret"}
+				backup.Spec.Dumpling = &v1alpha1.DumplingConfig{Streaming: true}
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "dumpling streaming to local storage",
+			update: func(backup *v1alpha1.Backup) {
+				backup.Spec.BR = nil
+				backup.Spec.From = &v1alpha1.TiDBAccessConfig{Host: "tidb", SecretName: "secret"}
+				backup.Spec.Dumpling = &v1alpha1.DumplingConfig{Streaming: true}
+				backup.Spec.S3 = nil
+				backup.Spec.Local = &v1alpha1.LocalStorageProvider{
+					Volume:      corev1.Volume{Name: "nfs"},
+					VolumeMount: corev1.VolumeMount{Name: "nfs", MountPath: "/nfs"},
+				}
+			},
+			expectedErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backup := newBRBackup()
+			if tt.update != nil {
+				tt.update(backup)
+			}
+			err := ValidateBackup(backup)
+			g.Expect(len(err)).Should(Equal(tt.expectedErrors), "%v", err)
+		})
+	}
+}
+
+func TestValidateUpdateBackup(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	old := newBRBackup()
+	old.Spec.Mode = v1alpha1.BackupModeLog
+	backup := old.DeepCopy()
+	backup.Spec.S3.Prefix = "other"
+	backup.Spec.BR.ClusterNamespace = "ns"
+	backup.Spec.LogSubcommand = v1alpha1.LogStopCommand
+	// the backup is not started
+	g.Expect(ValidateUpdateBackup(old, backup)).Should(BeEmpty())
+
+	old.Status.Phase = v1alpha1.BackupRunning
+	err := ValidateUpdateBackup(old, backup)
+	g.Expect(err).Should(HaveLen(1))
+	g.Expect(err[0].Field).Should(Equal("spec"))
+
+	// an empty cluster namespace is the same as the namespace of the backup
+	backup.Spec.S3.Prefix = ""
+	g.Expect(ValidateUpdateBackup(old, backup)).Should(BeEmpty())
+}
+
+func TestValidateRestore(t *testing.T) {
+	g := NewGomegaWithT(t)
+	newRestore := func() *v1alpha1.Restore {
+		return &v1alpha1.Restore{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "restore"},
+			Spec: v1alpha1.RestoreSpec{
+				BR: &v1alpha1.BRConfig{Cluster: "demo"},
+				StorageProvider: v1alpha1.StorageProvider{
+					Gcs: &v1alpha1.GcsStorageProvider{ProjectId: "project", Bucket: "bucket"},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name           string
+		update         func(restore *v1alpha1.Restore)
+		expectedErrors int
+	}{
+		{
+			name:           "valid",
+			expectedErrors: 0,
+		},
+		{
+			name: "pitr without full backup and start ts",
+			update: func(restore *v1alpha1.Restore) {
+				restore.Spec.Mode = v1alpha1.RestoreModePiTR
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "pitr with both full backup and start ts",
+			update: func(restore *v1alpha1.Restore) {
+				restore.Spec.Mode = v1alpha1.RestoreModePiTR
+				restore.Spec.LogRestoreStartTs = "400036290571534337"
+				restore.Spec.PitrFullBackupStorageProvider.S3 = &v1alpha1.S3StorageProvider{Bucket: "bucket"}
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "invalid pitr restored ts",
+			update: func(restore *v1alpha1.Restore) {
+				restore.Spec.Mode = v1alpha1.RestoreModePiTR
+				restore.Spec.LogRestoreStartTs = "400036290571534337"
+				restore.Spec.PitrRestoredTs = "now"
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "unsupported warmup",
+			update: func(restore *v1alpha1.Restore) {
+				restore.Spec.Warmup = "lazy"
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "lightning with br",
+			update: func(restore *v1alpha1.Restore) {
+				restore.Spec.Lightning = &v1alpha1.LightningConfig{}
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "lightning physical import",
+			update: func(restore *v1alpha1.Restore) {
+				setLightning(restore)
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "lightning disk quota greater than storage size",
+			update: func(restore *v1alpha1.Restore) {
+				setLightning(restore)
+				quota := resource.MustParse("200Gi")
+				restore.Spec.Lightning.DiskQuota = &quota
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "lightning disk quota within sorted KV storage size",
+			update: func(restore *v1alpha1.Restore) {
+				setLightning(restore)
+				quota := resource.MustParse("200Gi")
+				restore.Spec.Lightning.DiskQuota = &quota
+				restore.Spec.Lightning.SortedKVStorageSize = "250Gi"
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "lightning invalid sorted KV storage size",
+			update: func(restore *v1alpha1.Restore) {
+				setLightning(restore)
+				restore.Spec.Lightning.SortedKVStorageSize = "large"
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "lightning disk quota in logical import mode",
+			update: func(restore *v1alpha1.Restore) {
+				setLightning(restore)
+				restore.Spec.Lightning.Backend = v1alpha1.LightningBackendTiDB
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "lightning ignore conflicts in physical import mode",
+			update: func(restore *v1alpha1.Restore) {
+				setLightning(restore)
+				restore.Spec.Lightning.Conflict.Strategy = v1alpha1.LightningConflictStrategyIgnore
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "lightning invalid checkpoint and conflict",
+			update: func(restore *v1alpha1.Restore) {
+				setLightning(restore)
+				restore.Spec.Lightning.Checkpoint = "mysql"
+				restore.Spec.Lightning.Conflict.Threshold = pointer.Int64Ptr(-1)
+			},
+			expectedErrors: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restore := newRestore()
+			if tt.update != nil {
+				tt.update(restore)
+			}
+			err := ValidateRestore(restore)
+			g.Expect(len(err)).Should(Equal(tt.expectedErrors), "%v", err)
+		})
+	}
+
+	old := newRestore()
+	old.Status.Phase = v1alpha1.RestoreRunning
+	restore := old.DeepCopy()
+	restore.Spec.Mode = v1alpha1.RestoreModeVolumeSnapshot
+	restore.Spec.BR.Cluster = "other"
+	g.Expect(ValidateUpdateRestore(old, restore)).Should(HaveLen(2))
+}
+
+func setLightning(restore *v1alpha1.Restore) {
+	quota := resource.MustParse("80Gi")
+	restore.Spec.BR = nil
+	restore.Spec.To = &v1alpha1.TiDBAccessConfig{Host: "demo-tidb", SecretName: "secret"}
+	restore.Spec.StorageSize = "100Gi"
+	restore.Spec.Lightning = &v1alpha1.LightningConfig{
+		Backend:    v1alpha1.LightningBackendLocal,
+		DiskQuota:  &quota,
+		Checkpoint: v1alpha1.LightningCheckpointStorageRemote,
+		Conflict: &v1alpha1.LightningConflictConfig{
+			Strategy:  v1alpha1.LightningConflictStrategyReplace,
+			Threshold: pointer.Int64Ptr(100),
+		},
+	}
+}
+
+func TestValidateBackupSchedule(t *testing.T) {
+	g := NewGomegaWithT(t)
+	newBackupSchedule := func() *v1alpha1.BackupSchedule {
+		backup := newBRBackup()
+		logBackup := newBRBackup()
+		logBackup.Spec.Mode = v1alpha1.BackupModeLog
+		return &v1alpha1.BackupSchedule{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "schedule"},
+			Spec: v1alpha1.BackupScheduleSpec{
+				Schedule:          "*/5 * * * *",
+				MaxReservedTime:   pointer.String("72h"),
+				BackupTemplate:    backup.Spec,
+				LogBackupTemplate: &logBackup.Spec,
+			},
+		}
+	}
+	tests := []struct {
+		name           string
+		update         func(bs *v1alpha1.BackupSchedule)
+		expectedErrors int
+	}{
+		{
+			name:           "valid",
+			expectedErrors: 0,
+		},
+		{
+			name: "invalid cron",
+			update: func(bs *v1alpha1.BackupSchedule) {
+				bs.Spec.Schedule = "every 5 minutes"
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "invalid max reserved time and backup template",
+			update: func(bs *v1alpha1.BackupSchedule) {
+				bs.Spec.MaxReservedTime = pointer.String("3d")
+				bs.Spec.BackupTemplate.BR.Cluster = ""
+			},
+			expectedErrors: 2,
+		},
+		{
+			name: "log backup template is not in log mode",
+			update: func(bs *v1alpha1.BackupSchedule) {
+				bs.Spec.LogBackupTemplate.Mode = v1alpha1.BackupModeSnapshot
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "compact without interval",
+			update: func(bs *v1alpha1.BackupSchedule) {
+				bs.Spec.CompactBackupTemplate = &v1alpha1.CompactSpec{
+					Concurrency:     4,
+					StorageProvider: bs.Spec.LogBackupTemplate.StorageProvider,
+				}
+			},
+			expectedErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bs := newBackupSchedule()
+			if tt.update != nil {
+				tt.update(bs)
+			}
+			err := ValidateBackupSchedule(bs)
+			g.Expect(len(err)).Should(Equal(tt.expectedErrors), "%v", err)
+		})
+	}
+
+	old := newBackupSchedule()
+	old.Status.LogBackup = pointer.String("schedule-log")
+	bs := old.DeepCopy()
+	bs.Spec.Schedule = "0 0 * * *"
+	g.Expect(ValidateUpdateBackupSchedule(old, bs)).Should(BeEmpty())
+	bs.Spec.LogBackupTemplate.S3.Bucket = "other"
+	g.Expect(ValidateUpdateBackupSchedule(old, bs)).Should(HaveLen(1))
+}
+
+func TestValidateCompactBackup(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	compact := &v1alpha1.CompactBackup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "compact"},
+		Spec: v1alpha1.CompactSpec{
+			StorageProvider: v1alpha1.StorageProvider{
+				S3: &v1alpha1.S3StorageProvider{Bucket: "bucket"},
+			},
+			StartTs:     "2025-01-01 00:00:00",
+			EndTs:       "2025-01-02 00:00:00",
+			Concurrency: 4,
+		},
+	}
+	g.Expect(ValidateCompactBackup(compact)).Should(BeEmpty())
+
+	invalid := compact.DeepCopy()
+	invalid.Spec.EndTs = ""
+	invalid.Spec.Concurrency = 0
+	invalid.Spec.S3 = nil
+	g.Expect(ValidateCompactBackup(invalid)).Should(HaveLen(3))
+
+	old := compact.DeepCopy()
+	old.Status.State = "RUNNING"
+	updated := old.DeepCopy()
+	updated.Spec.EndTs = "2025-01-03 00:00:00"
+	g.Expect(ValidateUpdateCompactBackup(old, updated)).Should(HaveLen(1))
+}
+
+func TestValidateStatusOnlyUpdate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// the existing objects may be created before a check is added, updating their status is allowed
+	backup := newBRBackup()
+	backup.Spec.CleanPolicy = "Unknown"
+	g.Expect(ValidateBackup(backup)).Should(HaveLen(1))
+	updatedBackup := backup.DeepCopy()
+	updatedBackup.Status.Phase = v1alpha1.BackupComplete
+	g.Expect(ValidateUpdateBackup(backup, updatedBackup)).Should(BeEmpty())
+	// the spec is validated once it is changed
+	updatedBackup.Spec.TikvGCLifeTime = pointer.String("72h")
+	g.Expect(ValidateUpdateBackup(backup, updatedBackup)).Should(HaveLen(1))
+
+	restore := &v1alpha1.Restore{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "restore"},
+		Spec: v1alpha1.RestoreSpec{
+			BR:     &v1alpha1.BRConfig{Cluster: "demo"},
+			Warmup: "lazy",
+			StorageProvider: v1alpha1.StorageProvider{
+				S3: &v1alpha1.S3StorageProvider{Bucket: "bucket"},
+			},
+		},
+	}
+	g.Expect(ValidateRestore(restore)).Should(HaveLen(1))
+	updatedRestore := restore.DeepCopy()
+	updatedRestore.Status.Phase = v1alpha1.RestoreRunning
+	g.Expect(ValidateUpdateRestore(restore, updatedRestore)).Should(BeEmpty())
+
+	bs := &v1alpha1.BackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "schedule"},
+		Spec: v1alpha1.BackupScheduleSpec{
+			Schedule:       "every 5 minutes",
+			BackupTemplate: newBRBackup().Spec,
+		},
+	}
+	g.Expect(ValidateBackupSchedule(bs)).Should(HaveLen(1))
+	updatedBS := bs.DeepCopy()
+	updatedBS.Status.LastBackup = "schedule-1"
+	g.Expect(ValidateUpdateBackupSchedule(bs, updatedBS)).Should(BeEmpty())
+
+	compact := &v1alpha1.CompactBackup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "compact"},
+		Spec: v1alpha1.CompactSpec{
+			StorageProvider: v1alpha1.StorageProvider{
+				S3: &v1alpha1.S3StorageProvider{Bucket: "bucket"},
+			},
+			StartTs: "2025-01-01 00:00:00",
+			EndTs:   "2025-01-02 00:00:00",
+		},
+	}
+	g.Expect(ValidateCompactBackup(compact)).Should(HaveLen(1))
+	updatedCompact := compact.DeepCopy()
+	updatedCompact.Status.State = "RUNNING"
+	g.Expect(ValidateUpdateCompactBackup(compact, updatedCompact)).Should(BeEmpty())
+}
@@ -14,6 +14,7 @@
 package validation
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -21,6 +22,7 @@ import (
 	"path"
 	"path/filepath"
 	"reflect"
+	"regexp"
 	"strings"
 	"time"
 
@@ -28,6 +30,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/prometheus/common/model"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -88,6 +91,101 @@ func ValidateTiDBDashboard(td *v1alpha1.TidbDashboard) field.ErrorList {
 	return allErrs
 }
 
+// ValidateDrainer validates a Drainer.
+func ValidateDrainer(dr *v1alpha1.Drainer) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if dr.Spec.Cluster.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("cluster", "name"), "must specify the tidbcluster"))
+	}
+	allErrs = append(allErrs, validateComponentSpec(&dr.Spec.ComponentSpec, specPath)...)
+
+	sink, sinkPath := dr.Spec.Sink, specPath.Child("sink")
+	switch sink.Type {
+	case v1alpha1.DrainerSinkTypeMySQL, v1alpha1.DrainerSinkTypeTiDB:
+		if sink.MySQL == nil {
+			allErrs = append(allErrs, field.Required(sinkPath.Child("mysql"), fmt.Sprintf("must be set for the sink type %s", sink.Type)))
+		} else if sink.MySQL.Host == "" {
+			allErrs = append(allErrs, field.Required(sinkPath.Child("mysql", "host"), "must specify the host of the downstream"))
+		}
+		if sink.File != nil {
+			allErrs = append(allErrs, field.Forbidden(sinkPath.Child("file"), fmt.Sprintf("must not be set for the sink type %s", sink.Type)))
+		}
+	case v1alpha1.DrainerSinkTypeFile:
+		if sink.MySQL != nil {
+			allErrs = append(allErrs, field.Forbidden(sinkPath.Child("mysql"), "must not be set for the sink type file"))
+		}
+		if sink.File != nil && sink.File.Dir != "" {
+			dir := path.Clean(sink.File.Dir)
+			if !path.IsAbs(dir) || !strings.HasPrefix(dir, "/data/") {
+				allErrs = append(allErrs, field.Invalid(sinkPath.Child("file", "dir"), sink.File.Dir, "must be a directory in the data volume /data"))
+			}
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(sinkPath.Child("type"), sink.Type,
+			[]string{string(v1alpha1.DrainerSinkTypeMySQL), string(v1alpha1.DrainerSinkTypeTiDB), string(v1alpha1.DrainerSinkTypeFile)}))
+	}
+
+	return allErrs
+}
+
+// ValidateTidbUser validates a TidbUser.
+func ValidateTidbUser(tu *v1alpha1.TidbUser) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if tu.Spec.Cluster.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("cluster", "name"), "must specify the tidbcluster"))
+	}
+	// the root password of the cluster must not be used on behalf of the TidbUser in other namespaces
+	if ns := tu.Spec.Cluster.Namespace; ns != "" && ns != tu.Namespace && tu.Spec.AdminSecretName == nil {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("cluster", "namespace"),
+			"must specify adminSecretName to manage the user of the tidbcluster in another namespace"))
+	}
+
+	return allErrs
+}
+
+// changefeedIDPattern is the pattern of the changefeed id accepted by TiCDC
+var changefeedIDPattern = regexp.MustCompile(`^[a-zA-Z0-9]+(-[a-zA-Z0-9]+)*$`)
+
+// ValidateBinlogMigration validates a BinlogMigration
+func ValidateBinlogMigration(bm *v1alpha1.BinlogMigration) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if bm.Spec.Cluster.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("cluster", "name"), "must specify the tidbcluster"))
+	}
+
+	if bm.Spec.NoDrainer && bm.Spec.Drainer != "" {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("noDrainer"), "must not be set together with drainer"))
+	}
+
+	cf, cfPath := bm.Spec.Changefeed, specPath.Child("changefeed")
+	if !changefeedIDPattern.MatchString(cf.ID) {
+		allErrs = append(allErrs, field.Invalid(cfPath.Child("id"), cf.ID, fmt.Sprintf("must match the regex %s", changefeedIDPattern.String())))
+	}
+	if cf.SinkURI == "" && cf.SinkURISecret == nil {
+		allErrs = append(allErrs, field.Required(cfPath.Child("sinkURI"), "must specify either sinkURI or sinkURISecret"))
+	} else if cf.SinkURI != "" && cf.SinkURISecret != nil {
+		allErrs = append(allErrs, field.Forbidden(cfPath.Child("sinkURISecret"), "must not be set together with sinkURI"))
+	}
+
+	if svc := bm.Spec.ConsumerService; svc != nil {
+		svcPath := specPath.Child("consumerService")
+		if svc.Name == "" {
+			allErrs = append(allErrs, field.Required(svcPath.Child("name"), "must specify the name of the service"))
+		}
+		if svc.ExternalName == "" {
+			allErrs = append(allErrs, field.Required(svcPath.Child("externalName"), "must specify the downstream of the changefeed"))
+		}
+	}
+
+	return allErrs
+}
+
 func ValidateTidbMonitor(monitor *v1alpha1.TidbMonitor) field.ErrorList {
 	allErrs := field.ErrorList{}
 	// validate monitor service
@@ -101,6 +199,44 @@ func ValidateTidbMonitor(monitor *v1alpha1.TidbMonitor) field.ErrorList {
 	if monitor.Spec.Persistent {
 		allErrs = append(allErrs, validateStorageInfo(monitor.Spec.Storage, field.NewPath("spec"))...)
 	}
+	if po := monitor.Spec.PrometheusOperator; po != nil && po.ScrapeInterval != "" {
+		allErrs = append(allErrs, validatePromDurationStr(&po.ScrapeInterval, field.NewPath("spec", "prometheusOperator", "scrapeInterval"))...)
+	}
+	if monitor.Spec.SLO != nil {
+		allErrs = append(allErrs, validateSLO(monitor.Spec.SLO, field.NewPath("spec", "slo"))...)
+	}
+	return allErrs
+}
+
+func validateSLO(slo *v1alpha1.SLOSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	validateDuration := func(d string, fldPath *field.Path) {
+		if d != "" {
+			allErrs = append(allErrs, validatePromDurationStr(&d, fldPath)...)
+		}
+	}
+	if slo.QueryLatency != nil {
+		validateDuration(slo.QueryLatency.P99, fldPath.Child("queryLatency", "p99"))
+		validateDuration(slo.QueryLatency.For, fldPath.Child("queryLatency", "for"))
+	}
+	if slo.StoreDown != nil {
+		validateDuration(slo.StoreDown.For, fldPath.Child("storeDown", "for"))
+	}
+	if slo.RegionHealth != nil {
+		validateDuration(slo.RegionHealth.For, fldPath.Child("regionHealth", "for"))
+	}
+	if slo.BackupFreshness != nil {
+		validateDuration(slo.BackupFreshness.MaxAge, fldPath.Child("backupFreshness", "maxAge"))
+	}
+	if slo.CDCLag != nil {
+		validateDuration(slo.CDCLag.MaxLag, fldPath.Child("cdcLag", "maxLag"))
+		validateDuration(slo.CDCLag.For, fldPath.Child("cdcLag", "for"))
+	}
+	for k := range slo.Labels {
+		if !model.LabelName(k).IsValid() {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("labels"), k, "must be a valid Prometheus label name"))
+		}
+	}
 	return allErrs
 }
 
@@ -156,6 +292,9 @@ func validateTiDBClusterSpec(spec *v1alpha1.TidbClusterSpec, fldPath *field.Path
 	if spec.StartScriptV2FeatureFlags != nil {
 		allErrs = append(allErrs, validateStartScriptFeatureFlags(spec.StartScriptV2FeatureFlags, fldPath.Child("startScriptV2FeatureFlags"))...)
 	}
+	if spec.LogShipper != nil {
+		allErrs = append(allErrs, validateLogShipper(spec.LogShipper, fldPath.Child("logShipper"))...)
+	}
 	return allErrs
 }
 
@@ -177,6 +316,138 @@ func validatePDSpec(spec *v1alpha1.PDSpec, fldPath *field.Path) field.ErrorList
 	if spec.Service != nil {
 		allErrs = append(allErrs, validateService(spec.Service, fldPath)...)
 	}
+	allErrs = append(allErrs, validatePlacementRules(spec.PlacementRules, spec.PlacementPolicies, fldPath)...)
+	allErrs = append(allErrs, validatePDLeaderPreference(spec.LeaderPreference, fldPath.Child("leaderPreference"))...)
+	return allErrs
+}
+
+// validatePlacementRules validates the placement rule bundles and the placement policies of PD
+func validatePlacementRules(bundles []v1alpha1.PlacementRuleBundle, policies []v1alpha1.PlacementPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	policyNames := map[string]struct{}{}
+	for i := range policies {
+		policy := &policies[i]
+		idxPath := fldPath.Child("placementPolicies").Index(i)
+		if policy.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name of the placement policy must not be empty"))
+		} else if _, ok := policyNames[policy.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), policy.Name))
+		}
+		policyNames[policy.Name] = struct{}{}
+		if len(policy.Rules) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("rules"), "a placement policy must have at least one rule"))
+		}
+		allErrs = append(allErrs, validatePlacementRuleList(policy.Rules, idxPath.Child("rules"))...)
+	}
+
+	groupIDs := map[string]struct{}{}
+	for i := range bundles {
+		bundle := &bundles[i]
+		idxPath := fldPath.Child("placementRules").Index(i)
+		if bundle.GroupID == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("groupID"), "group ID of the placement rule bundle must not be empty"))
+		} else if _, ok := groupIDs[bundle.GroupID]; ok {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("groupID"), bundle.GroupID))
+		}
+		groupIDs[bundle.GroupID] = struct{}{}
+		if (bundle.Policy == "") == (len(bundle.Rules) == 0) {
+			allErrs = append(allErrs, field.Invalid(idxPath, bundle.Policy, "exactly one of policy and rules must be set"))
+		}
+		if bundle.Policy != "" {
+			if _, ok := policyNames[bundle.Policy]; !ok {
+				allErrs = append(allErrs, field.NotFound(idxPath.Child("policy"), bundle.Policy))
+			}
+		}
+		allErrs = append(allErrs, validatePlacementRuleList(bundle.Rules, idxPath.Child("rules"))...)
+	}
+	return allErrs
+}
+
+func validatePlacementRuleList(rules []v1alpha1.PlacementRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	ids := map[string]struct{}{}
+	for i := range rules {
+		rule := &rules[i]
+		idxPath := fldPath.Index(i)
+		if rule.ID == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("id"), "ID of the placement rule must not be empty"))
+		} else if _, ok := ids[rule.ID]; ok {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("id"), rule.ID))
+		}
+		ids[rule.ID] = struct{}{}
+
+		switch rule.Role {
+		case v1alpha1.PlacementRuleRoleVoter, v1alpha1.PlacementRuleRoleFollower, v1alpha1.PlacementRuleRoleLearner:
+		case v1alpha1.PlacementRuleRoleLeader:
+			if rule.Count != 1 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("count"), rule.Count, "count of the leader rule must be 1"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("role"), rule.Role,
+				[]string{string(v1alpha1.PlacementRuleRoleVoter), string(v1alpha1.PlacementRuleRoleLeader), string(v1alpha1.PlacementRuleRoleFollower), string(v1alpha1.PlacementRuleRoleLearner)}))
+		}
+		if rule.Count <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("count"), rule.Count, "count must be positive"))
+		}
+		for _, key := range []string{rule.StartKey, rule.EndKey} {
+			if _, err := hex.DecodeString(key); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath, key, "start key and end key must be hex encoded"))
+			}
+		}
+
+		for j, c := range rule.LabelConstraints {
+			cPath := idxPath.Child("labelConstraints").Index(j)
+			if c.Key == "" {
+				allErrs = append(allErrs, field.Required(cPath.Child("key"), "key of the label constraint must not be empty"))
+			}
+			switch c.Op {
+			case v1alpha1.PlacementLabelConstraintOpIn, v1alpha1.PlacementLabelConstraintOpNotIn:
+				if len(c.Values) == 0 {
+					allErrs = append(allErrs, field.Required(cPath.Child("values"), fmt.Sprintf("values are required by the %s operator", c.Op)))
+				}
+			case v1alpha1.PlacementLabelConstraintOpExists, v1alpha1.PlacementLabelConstraintOpNotExists:
+				if len(c.Values) != 0 {
+					allErrs = append(allErrs, field.Invalid(cPath.Child("values"), c.Values, fmt.Sprintf("values must be empty for the %s operator", c.Op)))
+				}
+			default:
+				allErrs = append(allErrs, field.NotSupported(cPath.Child("op"), c.Op,
+					[]string{string(v1alpha1.PlacementLabelConstraintOpIn), string(v1alpha1.PlacementLabelConstraintOpNotIn), string(v1alpha1.PlacementLabelConstraintOpExists), string(v1alpha1.PlacementLabelConstraintOpNotExists)}))
+			}
+		}
+
+		if rule.IsolationLevel != "" {
+			found := false
+			for _, l := range rule.LocationLabels {
+				if l == rule.IsolationLevel {
+					found = true
+					break
+				}
+			}
+			if !found {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("isolationLevel"), rule.IsolationLevel, "isolation level must be one of the location labels"))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validatePDLeaderPreference validates the leader preference of PD
+func validatePDLeaderPreference(preferences []v1alpha1.PDLeaderPreference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, pref := range preferences {
+		idxPath := fldPath.Index(i)
+		if (pref.Zone == "") == (len(pref.Ordinals) == 0) {
+			allErrs = append(allErrs, field.Invalid(idxPath, pref, "exactly one of zone and ordinals must be set"))
+		}
+		for j, ordinal := range pref.Ordinals {
+			if ordinal < 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("ordinals").Index(j), ordinal, "must be greater than or equal to 0"))
+			}
+		}
+		if pref.Priority <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("priority"), pref.Priority, "must be greater than 0"))
+		}
+	}
 	return allErrs
 }
 
@@ -234,6 +505,12 @@ func validateTiKVSpec(spec *v1alpha1.TiKVSpec, fldPath *field.Path) field.ErrorL
 		allErrs = append(allErrs, validateVolumeName(spec.RocksDBLogVolumeName, spec.StorageVolumes, spec.AdditionalVolumes, spec.AdditionalVolumeMounts, fldPath)...)
 	}
 	allErrs = append(allErrs, validateTimeDurationStr(spec.EvictLeaderTimeout, fldPath.Child("evictLeaderTimeout"))...)
+	for i, group := range spec.Groups {
+		if len(group.StorageVolumes) > 0 {
+			allErrs = append(allErrs, validateStorageVolumes(group.StorageVolumes, fldPath.Child("groups").Index(i).Child("storageVolumes"))...)
+		}
+	}
+	allErrs = append(allErrs, validateComponentGroups(tikvGroups(spec), fldPath.Child("groups"))...)
 	return allErrs
 }
 
@@ -254,6 +531,70 @@ func validateTiFlashSpec(spec *v1alpha1.TiFlashSpec, fldPath *field.Path) field.
 				spec.StorageClaims, "spec.tiflash.storageClaims.resources[storage]: Required value."))
 		}
 	}
+	if spec.Disaggregated != nil {
+		allErrs = append(allErrs, validateTiFlashDisaggregatedSpec(spec.Disaggregated, fldPath.Child("disaggregated"))...)
+		if len(spec.Groups) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("groups"), "groups are not supported in the disaggregated architecture of TiFlash"))
+		}
+	}
+	for i, group := range spec.Groups {
+		allErrs = append(allErrs, validateTiFlashConfig(group.Config, fldPath.Child("groups").Index(i))...)
+		for _, storageClaim := range group.StorageClaims {
+			if _, ok := storageClaim.Resources.Requests["storage"]; !ok {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("groups").Index(i).Child("storageClaims"),
+					group.StorageClaims, "resources[storage]: Required value."))
+			}
+		}
+	}
+	allErrs = append(allErrs, validateComponentGroups(tiflashGroups(spec), fldPath.Child("groups"))...)
+	return allErrs
+}
+
+// validateComponentGroups validates the common fields of the groups of a component
+func validateComponentGroups(groups []v1alpha1.ComponentGroupSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := map[string]struct{}{}
+	for i := range groups {
+		group := &groups[i]
+		idxPath := fldPath.Index(i)
+		if group.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name of the group must not be empty"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(group.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), group.Name, msg))
+			}
+			if _, ok := names[group.Name]; ok {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), group.Name))
+			}
+			names[group.Name] = struct{}{}
+		}
+		if group.Replicas < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("replicas"), group.Replicas, "replicas must not be negative"))
+		}
+		allErrs = append(allErrs, validateComponentSpec(&group.ComponentSpec, idxPath)...)
+		for k, v := range group.ServerLabels {
+			if k == "" || v == "" {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("serverLabels"), group.ServerLabels, "key and value of the server labels must not be empty"))
+				break
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateTiFlashDisaggregatedSpec(spec *v1alpha1.TiFlashDisaggregatedSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.S3 == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("s3"), "s3 storage must be configured in disaggregated mode"))
+	} else if spec.S3.Bucket == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("s3", "bucket"), "bucket must not be empty"))
+	}
+	computePath := fldPath.Child("compute")
+	allErrs = append(allErrs, validateComponentSpec(&spec.Compute.ComponentSpec, computePath)...)
+	allErrs = append(allErrs, validateTiFlashConfig(spec.Compute.Config, computePath)...)
+	if spec.Compute.Replicas < 0 {
+		allErrs = append(allErrs, field.Invalid(computePath.Child("replicas"), spec.Compute.Replicas, "replicas must not be negative"))
+	}
 	return allErrs
 }
 
@@ -330,6 +671,12 @@ func validateTiDBSpec(spec *v1alpha1.TiDBSpec, fldPath *field.Path) field.ErrorL
 	if spec.ShouldSeparateSlowLog() && spec.SlowLogVolumeName != "" {
 		allErrs = append(allErrs, validateVolumeName(spec.SlowLogVolumeName, spec.StorageVolumes, spec.AdditionalVolumes, spec.AdditionalVolumeMounts, fldPath)...)
 	}
+	for i, group := range spec.Groups {
+		if len(group.StorageVolumes) > 0 {
+			allErrs = append(allErrs, validateStorageVolumes(group.StorageVolumes, fldPath.Child("groups").Index(i).Child("storageVolumes"))...)
+		}
+	}
+	allErrs = append(allErrs, validateComponentGroups(tidbGroups(spec), fldPath.Child("groups"))...)
 	return allErrs
 }
 
@@ -412,6 +759,52 @@ func validateComponentSpec(spec *v1alpha1.ComponentSpec, fldPath *field.Path) fi
 	// TODO validate other fields
 	allErrs = append(allErrs, validateEnv(spec.Env, fldPath.Child("env"))...)
 	allErrs = append(allErrs, validateAdditionalContainers(spec.AdditionalContainers, fldPath.Child("additionalContainers"))...)
+	if spec.LogShipper != nil {
+		allErrs = append(allErrs, validateLogShipper(spec.LogShipper, fldPath.Child("logShipper"))...)
+	}
+	return allErrs
+}
+
+// validateLogShipper validates the sinks of the log shipper
+func validateLogShipper(spec *v1alpha1.LogShipperSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch spec.Type {
+	case "", v1alpha1.LogShipperTypeVector, v1alpha1.LogShipperTypeFluentBit:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), spec.Type,
+			[]string{string(v1alpha1.LogShipperTypeVector), string(v1alpha1.LogShipperTypeFluentBit)}))
+	}
+	if len(spec.Sinks) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("sinks"), "at least one sink is required"))
+	}
+	names := map[string]bool{}
+	for i, sink := range spec.Sinks {
+		sinkPath := fldPath.Child("sinks").Index(i)
+		for _, msg := range validation.IsDNS1123Label(sink.Name) {
+			allErrs = append(allErrs, field.Invalid(sinkPath.Child("name"), sink.Name, msg))
+		}
+		if names[sink.Name] {
+			allErrs = append(allErrs, field.Duplicate(sinkPath.Child("name"), sink.Name))
+		}
+		names[sink.Name] = true
+
+		switch {
+		case sink.File != nil && sink.HTTP != nil, sink.File == nil && sink.HTTP == nil:
+			allErrs = append(allErrs, field.Invalid(sinkPath, sink.Name, "exactly one of file and http should be set"))
+		case sink.File != nil:
+			if !filepath.IsAbs(sink.File.Path) {
+				allErrs = append(allErrs, field.Invalid(sinkPath.Child("file", "path"), sink.File.Path, "must be an absolute path"))
+			}
+		case sink.HTTP != nil:
+			u, err := url.Parse(sink.HTTP.URL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				allErrs = append(allErrs, field.Invalid(sinkPath.Child("http", "url"), sink.HTTP.URL, "must be an http or https URL"))
+			}
+			if sink.HTTP.AuthorizationSecret != nil {
+				allErrs = append(allErrs, validateSecretKeySelector(sink.HTTP.AuthorizationSecret, sinkPath.Child("http", "authorizationSecret"))...)
+			}
+		}
+	}
 	return allErrs
 }
 
@@ -577,6 +970,8 @@ func ValidateUpdateTidbCluster(old, tc *v1alpha1.TidbCluster) field.ErrorList {
 	allErrs = append(allErrs, validateUpdatePDConfig(old.Spec.PD, tc.Spec.PD, field.NewPath("spec.pd.config"))...)
 	allErrs = append(allErrs, disallowMutateBootstrapSQLConfigMapName(old.Spec.TiDB, tc.Spec.TiDB, field.NewPath("spec.tidb.bootstrapSQLConfigMapName"))...)
 	allErrs = append(allErrs, disallowUsingLegacyAPIInNewCluster(old, tc)...)
+	allErrs = append(allErrs, disallowMutateTiFlashDisaggregated(old.Spec.TiFlash, tc.Spec.TiFlash, field.NewPath("spec.tiflash.disaggregated"))...)
+	allErrs = append(allErrs, disallowRemovingComponentGroups(old, tc)...)
 
 	return allErrs
 }
@@ -700,6 +1095,93 @@ func disallowMutateBootstrapSQLConfigMapName(old, new *v1alpha1.TiDBSpec, p *fie
 	return allErrs
 }
 
+// disallowMutateTiFlashDisaggregated checks if user switches TiFlash between the
+// coupled and the disaggregated architecture, the data of the write nodes can not be converted.
+func disallowMutateTiFlashDisaggregated(old, new *v1alpha1.TiFlashSpec, p *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if old == nil || new == nil {
+		return allErrs
+	}
+	if (old.Disaggregated == nil) != (new.Disaggregated == nil) {
+		return append(allErrs, field.Forbidden(p, "switching between the coupled and the disaggregated architecture of TiFlash is not supported"))
+	}
+	return allErrs
+}
+
+// disallowRemovingComponentGroups checks if user removes a group which still has replicas,
+// a group must be scaled in to 0 first so that the stores are removed from the cluster safely.
+// Besides the desired replicas, the observed status of the group is checked, because the stores
+// may still be being scaled in after the replicas are set to 0.
+func disallowRemovingComponentGroups(old, tc *v1alpha1.TidbCluster) field.ErrorList {
+	allErrs := field.ErrorList{}
+	check := func(oldGroups, newGroups []v1alpha1.ComponentGroupSpec, p *field.Path, inUse func(name string) bool) {
+		names := map[string]struct{}{}
+		for _, group := range newGroups {
+			names[group.Name] = struct{}{}
+		}
+		for _, group := range oldGroups {
+			if _, ok := names[group.Name]; ok {
+				continue
+			}
+			if group.Replicas > 0 {
+				allErrs = append(allErrs, field.Forbidden(p, fmt.Sprintf("group %s must be scaled in to 0 before it is removed", group.Name)))
+			} else if inUse(group.Name) {
+				allErrs = append(allErrs, field.Forbidden(p, fmt.Sprintf("group %s is still being scaled in, it can be removed after all of its pods and stores are removed", group.Name)))
+			}
+		}
+	}
+	check(tikvGroups(old.Spec.TiKV), tikvGroups(tc.Spec.TiKV), field.NewPath("spec.tikv.groups"), func(name string) bool {
+		status, ok := old.Status.TiKVGroups[name]
+		return ok && (statefulSetReplicas(status.StatefulSet) > 0 || len(status.Stores) > 0)
+	})
+	check(tidbGroups(old.Spec.TiDB), tidbGroups(tc.Spec.TiDB), field.NewPath("spec.tidb.groups"), func(name string) bool {
+		status, ok := old.Status.TiDBGroups[name]
+		return ok && statefulSetReplicas(status.StatefulSet) > 0
+	})
+	check(tiflashGroups(old.Spec.TiFlash), tiflashGroups(tc.Spec.TiFlash), field.NewPath("spec.tiflash.groups"), func(name string) bool {
+		status, ok := old.Status.TiFlashGroups[name]
+		return ok && (statefulSetReplicas(status.StatefulSet) > 0 || len(status.Stores) > 0)
+	})
+	return allErrs
+}
+
+func statefulSetReplicas(status *appsv1.StatefulSetStatus) int32 {
+	if status == nil {
+		return 0
+	}
+	return status.Replicas
+}
+
+func tikvGroups(spec *v1alpha1.TiKVSpec) []v1alpha1.ComponentGroupSpec {
+	var groups []v1alpha1.ComponentGroupSpec
+	if spec != nil {
+		for _, group := range spec.Groups {
+			groups = append(groups, group.ComponentGroupSpec)
+		}
+	}
+	return groups
+}
+
+func tidbGroups(spec *v1alpha1.TiDBSpec) []v1alpha1.ComponentGroupSpec {
+	var groups []v1alpha1.ComponentGroupSpec
+	if spec != nil {
+		for _, group := range spec.Groups {
+			groups = append(groups, group.ComponentGroupSpec)
+		}
+	}
+	return groups
+}
+
+func tiflashGroups(spec *v1alpha1.TiFlashSpec) []v1alpha1.ComponentGroupSpec {
+	var groups []v1alpha1.ComponentGroupSpec
+	if spec != nil {
+		for _, group := range spec.Groups {
+			groups = append(groups, group.ComponentGroupSpec)
+		}
+	}
+	return groups
+}
+
 func validateDeleteSlots(annotations map[string]string, key string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if annotations != nil {
@@ -20,6 +20,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -492,6 +493,120 @@ func TestValidateTidbMonitor(t *testing.T) {
 	}
 }
 
+func TestValidateSLO(t *testing.T) {
+	g := NewGomegaWithT(t)
+	monitor := newTidbMonitor()
+	monitor.Spec.SLO = &v1alpha1.SLOSpec{
+		Labels:          map[string]string{"team": "dba"},
+		QueryLatency:    &v1alpha1.QueryLatencySLO{P99: "500ms"},
+		StoreDown:       &v1alpha1.StoreDownSLO{},
+		BackupFreshness: &v1alpha1.BackupFreshnessSLO{MaxAge: "15m"},
+		CDCLag:          &v1alpha1.CDCLagSLO{MaxLag: "30s", For: "10m"},
+	}
+	g.Expect(ValidateTidbMonitor(monitor)).To(BeEmpty())
+
+	monitor.Spec.SLO.Labels["app.kubernetes.io/name"] = "tidb"
+	monitor.Spec.SLO.QueryLatency.P99 = "0.5s"
+	monitor.Spec.SLO.CDCLag.For = "ten minutes"
+	errs := ValidateTidbMonitor(monitor)
+	g.Expect(errs).To(HaveLen(3))
+	g.Expect(errs[0].Field).To(Equal("spec.slo.queryLatency.p99"))
+	g.Expect(errs[1].Field).To(Equal("spec.slo.cdcLag.for"))
+	g.Expect(errs[2].Field).To(Equal("spec.slo.labels"))
+}
+
+func TestValidateDrainer(t *testing.T) {
+	g := NewGomegaWithT(t)
+	dr := &v1alpha1.Drainer{
+		Spec: v1alpha1.DrainerSpec{
+			Cluster: v1alpha1.TidbClusterRef{Name: "basic"},
+			Sink: v1alpha1.DrainerSinkSpec{
+				Type:  v1alpha1.DrainerSinkTypeMySQL,
+				MySQL: &v1alpha1.DrainerMySQLSink{Host: "mysql.default"},
+			},
+		},
+	}
+	g.Expect(ValidateDrainer(dr)).To(BeEmpty())
+
+	dr.Spec.Sink.MySQL.Host = ""
+	dr.Spec.Sink.File = &v1alpha1.DrainerFileSink{Dir: "/data/pb"}
+	errs := ValidateDrainer(dr)
+	g.Expect(errs).To(HaveLen(2))
+	g.Expect(errs[0].Field).To(Equal("spec.sink.mysql.host"))
+	g.Expect(errs[1].Field).To(Equal("spec.sink.file"))
+
+	dr.Spec.Sink.Type = v1alpha1.DrainerSinkTypeFile
+	dr.Spec.Sink.MySQL = nil
+	g.Expect(ValidateDrainer(dr)).To(BeEmpty())
+
+	dr.Spec.Sink.File.Dir = "/data/../etc"
+	errs = ValidateDrainer(dr)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Field).To(Equal("spec.sink.file.dir"))
+
+	dr.Spec.Cluster.Name = ""
+	dr.Spec.Sink.Type = "kafka"
+	errs = ValidateDrainer(dr)
+	g.Expect(errs).To(HaveLen(2))
+	g.Expect(errs[0].Field).To(Equal("spec.cluster.name"))
+	g.Expect(errs[1].Field).To(Equal("spec.sink.type"))
+}
+
+func TestValidateTidbUser(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tu := &v1alpha1.TidbUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "app"},
+		Spec:       v1alpha1.TidbUserSpec{Cluster: v1alpha1.TidbClusterRef{Name: "basic", Namespace: "app"}},
+	}
+	g.Expect(ValidateTidbUser(tu)).To(BeEmpty())
+
+	tu.Spec.Cluster.Namespace = "tidb"
+	errs := ValidateTidbUser(tu)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Field).To(Equal("spec.cluster.namespace"))
+
+	tu.Spec.AdminSecretName = pointer.StringPtr("app-admin")
+	g.Expect(ValidateTidbUser(tu)).To(BeEmpty())
+
+	tu.Spec.Cluster.Name = ""
+	errs = ValidateTidbUser(tu)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Field).To(Equal("spec.cluster.name"))
+}
+
+func TestValidateBinlogMigration(t *testing.T) {
+	g := NewGomegaWithT(t)
+	bm := &v1alpha1.BinlogMigration{
+		Spec: v1alpha1.BinlogMigrationSpec{
+			Cluster: v1alpha1.TidbClusterRef{Name: "basic"},
+			Changefeed: v1alpha1.BinlogMigrationChangefeed{
+				ID:      "binlog-to-ticdc",
+				SinkURI: "mysql://root@mysql.default:3306/",
+			},
+			ConsumerService: &v1alpha1.BinlogMigrationConsumerService{Name: "downstream", ExternalName: "mysql.ticdc"},
+		},
+	}
+	g.Expect(ValidateBinlogMigration(bm)).To(BeEmpty())
+
+	bm.Spec.Drainer = "to-mysql"
+	bm.Spec.NoDrainer = true
+	bm.Spec.Changefeed.ID = "binlog_to_ticdc"
+	bm.Spec.Changefeed.SinkURISecret = &corev1.SecretKeySelector{Key: "uri"}
+	bm.Spec.ConsumerService.ExternalName = ""
+	errs := ValidateBinlogMigration(bm)
+	g.Expect(errs).To(HaveLen(4))
+	g.Expect(errs[0].Field).To(Equal("spec.noDrainer"))
+	g.Expect(errs[1].Field).To(Equal("spec.changefeed.id"))
+	g.Expect(errs[2].Field).To(Equal("spec.changefeed.sinkURISecret"))
+	g.Expect(errs[3].Field).To(Equal("spec.consumerService.externalName"))
+
+	bm.Spec = v1alpha1.BinlogMigrationSpec{Changefeed: v1alpha1.BinlogMigrationChangefeed{ID: "binlog"}}
+	errs = ValidateBinlogMigration(bm)
+	g.Expect(errs).To(HaveLen(2))
+	g.Expect(errs[0].Field).To(Equal("spec.cluster.name"))
+	g.Expect(errs[1].Field).To(Equal("spec.changefeed.sinkURI"))
+}
+
 func TestValidateDMCluster(t *testing.T) {
 	g := NewGomegaWithT(t)
 	tests := []struct {
@@ -796,6 +911,113 @@ func TestValidatePDSpec(t *testing.T) {
 	}
 }
 
+func TestValidatePlacementRules(t *testing.T) {
+	g := NewGomegaWithT(t)
+	validRule := v1alpha1.PlacementRule{
+		ID:    "hot",
+		Role:  v1alpha1.PlacementRuleRoleVoter,
+		Count: 3,
+		LabelConstraints: []v1alpha1.PlacementLabelConstraint{
+			{Key: "disk", Op: v1alpha1.PlacementLabelConstraintOpIn, Values: []string{"nvme"}},
+		},
+		LocationLabels: []string{"zone", "host"},
+		IsolationLevel: "zone",
+	}
+	policies := []v1alpha1.PlacementPolicy{{Name: "hot", Rules: []v1alpha1.PlacementRule{validRule}}}
+	tests := []struct {
+		name           string
+		bundles        []v1alpha1.PlacementRuleBundle
+		expectedErrors int
+	}{
+		{
+			name: "valid",
+			bundles: []v1alpha1.PlacementRuleBundle{
+				{GroupID: "g1", Rules: []v1alpha1.PlacementRule{validRule}},
+				{GroupID: "g2", Policy: "hot"},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "duplicated group",
+			bundles: []v1alpha1.PlacementRuleBundle{
+				{GroupID: "g1", Policy: "hot"},
+				{GroupID: "g1", Policy: "hot"},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name:           "policy not found",
+			bundles:        []v1alpha1.PlacementRuleBundle{{GroupID: "g1", Policy: "cold"}},
+			expectedErrors: 1,
+		},
+		{
+			name:           "both policy and rules",
+			bundles:        []v1alpha1.PlacementRuleBundle{{GroupID: "g1", Policy: "hot", Rules: []v1alpha1.PlacementRule{validRule}}},
+			expectedErrors: 1,
+		},
+		{
+			name: "invalid rule",
+			bundles: []v1alpha1.PlacementRuleBundle{{GroupID: "g1", Rules: []v1alpha1.PlacementRule{{
+				ID:       "r1",
+				Role:     v1alpha1.PlacementRuleRoleLeader,
+				Count:    2,
+				StartKey: "not-hex",
+				LabelConstraints: []v1alpha1.PlacementLabelConstraint{
+					{Key: "disk", Op: v1alpha1.PlacementLabelConstraintOpIn},
+					{Key: "disk", Op: v1alpha1.PlacementLabelConstraintOpExists, Values: []string{"nvme"}},
+				},
+				IsolationLevel: "zone",
+			}}}},
+			expectedErrors: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePlacementRules(tt.bundles, policies, field.NewPath("spec", "pd"))
+			g.Expect(len(err)).Should(Equal(tt.expectedErrors), "%v", err)
+		})
+	}
+}
+
+func TestValidatePDLeaderPreference(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		name           string
+		preferences    []v1alpha1.PDLeaderPreference
+		expectedErrors int
+	}{
+		{
+			name: "valid",
+			preferences: []v1alpha1.PDLeaderPreference{
+				{Zone: "us-east-1a", Priority: 10},
+				{Ordinals: []int32{0, 1}, Priority: 5},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name:           "neither zone nor ordinals",
+			preferences:    []v1alpha1.PDLeaderPreference{{Priority: 10}},
+			expectedErrors: 1,
+		},
+		{
+			name:           "both zone and ordinals",
+			preferences:    []v1alpha1.PDLeaderPreference{{Zone: "us-east-1a", Ordinals: []int32{0}, Priority: 10}},
+			expectedErrors: 1,
+		},
+		{
+			name:           "invalid ordinal and priority",
+			preferences:    []v1alpha1.PDLeaderPreference{{Ordinals: []int32{-1}, Priority: 0}},
+			expectedErrors: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePDLeaderPreference(tt.preferences, field.NewPath("spec", "pd", "leaderPreference"))
+			g.Expect(len(err)).Should(Equal(tt.expectedErrors), "%v", err)
+		})
+	}
+}
+
 func TestValidateTiFlashSpec(t *testing.T) {
 	g := NewGomegaWithT(t)
 	tests := []struct {
@@ -852,6 +1074,218 @@ func TestValidateTiFlashSpec(t *testing.T) {
 	}
 }
 
+func TestValidateTiFlashDisaggregatedSpec(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		name           string
+		disaggregated  *v1alpha1.TiFlashDisaggregatedSpec
+		expectedErrors int
+	}{
+		{
+			name: "valid",
+			disaggregated: &v1alpha1.TiFlashDisaggregatedSpec{
+				S3:      &v1alpha1.S3StorageProvider{Bucket: "bucket"},
+				Compute: v1alpha1.TiFlashComputeSpec{Replicas: 1},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name:           "no s3",
+			disaggregated:  &v1alpha1.TiFlashDisaggregatedSpec{},
+			expectedErrors: 1,
+		},
+		{
+			name: "no bucket",
+			disaggregated: &v1alpha1.TiFlashDisaggregatedSpec{
+				S3: &v1alpha1.S3StorageProvider{},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "negative compute replicas",
+			disaggregated: &v1alpha1.TiFlashDisaggregatedSpec{
+				S3:      &v1alpha1.S3StorageProvider{Bucket: "bucket"},
+				Compute: v1alpha1.TiFlashComputeSpec{Replicas: -1},
+			},
+			expectedErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTiFlashDisaggregatedSpec(tt.disaggregated, field.NewPath("spec", "tiflash", "disaggregated"))
+			g.Expect(len(err)).Should(Equal(tt.expectedErrors))
+		})
+	}
+
+	old := newTidbClusterWithTiflash()
+	tc := old.DeepCopy()
+	tc.Spec.TiFlash.Disaggregated = &v1alpha1.TiFlashDisaggregatedSpec{
+		S3: &v1alpha1.S3StorageProvider{Bucket: "bucket"},
+	}
+	g.Expect(disallowMutateTiFlashDisaggregated(old.Spec.TiFlash, tc.Spec.TiFlash, field.NewPath("spec", "tiflash", "disaggregated"))).To(HaveLen(1))
+	g.Expect(disallowMutateTiFlashDisaggregated(tc.Spec.TiFlash, tc.Spec.TiFlash, field.NewPath("spec", "tiflash", "disaggregated"))).To(BeEmpty())
+	g.Expect(disallowMutateTiFlashDisaggregated(nil, tc.Spec.TiFlash, field.NewPath("spec", "tiflash", "disaggregated"))).To(BeEmpty())
+}
+
+func TestValidateComponentGroups(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		name           string
+		groups         []v1alpha1.ComponentGroupSpec
+		expectedErrors int
+	}{
+		{
+			name: "valid",
+			groups: []v1alpha1.ComponentGroupSpec{
+				{Name: "hot", Replicas: 3, ServerLabels: map[string]string{"disk": "nvme"}},
+				{Name: "cold", Replicas: 0},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name:           "empty name",
+			groups:         []v1alpha1.ComponentGroupSpec{{Replicas: 1}},
+			expectedErrors: 1,
+		},
+		{
+			name:           "invalid name",
+			groups:         []v1alpha1.ComponentGroupSpec{{Name: "Hot_1", Replicas: 1}},
+			expectedErrors: 1,
+		},
+		{
+			name:           "duplicated name",
+			groups:         []v1alpha1.ComponentGroupSpec{{Name: "hot"}, {Name: "hot"}},
+			expectedErrors: 1,
+		},
+		{
+			name:           "negative replicas",
+			groups:         []v1alpha1.ComponentGroupSpec{{Name: "hot", Replicas: -1}},
+			expectedErrors: 1,
+		},
+		{
+			name:           "empty server label",
+			groups:         []v1alpha1.ComponentGroupSpec{{Name: "hot", ServerLabels: map[string]string{"disk": ""}}},
+			expectedErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateComponentGroups(tt.groups, field.NewPath("spec", "tikv", "groups"))
+			g.Expect(len(err)).Should(Equal(tt.expectedErrors))
+		})
+	}
+
+	tc := newTidbClusterWithTiflash()
+	tc.Spec.TiFlash.Disaggregated = &v1alpha1.TiFlashDisaggregatedSpec{
+		S3: &v1alpha1.S3StorageProvider{Bucket: "bucket"},
+	}
+	tc.Spec.TiFlash.Groups = []v1alpha1.TiFlashGroupSpec{{ComponentGroupSpec: v1alpha1.ComponentGroupSpec{Name: "hot"}}}
+	errs := validateTiFlashSpec(tc.Spec.TiFlash, field.NewPath("spec", "tiflash"))
+	g.Expect(errs).To(ContainElement(field.Forbidden(field.NewPath("spec", "tiflash", "groups"), "groups are not supported in the disaggregated architecture of TiFlash")))
+}
+
+func TestValidateLogShipper(t *testing.T) {
+	g := NewGomegaWithT(t)
+	fileSink := v1alpha1.LogSinkSpec{Name: "local", File: &v1alpha1.FileLogSink{Path: "/var/log/shipped.json"}}
+	tests := []struct {
+		name           string
+		spec           v1alpha1.LogShipperSpec
+		expectedErrors int
+	}{
+		{
+			name: "valid",
+			spec: v1alpha1.LogShipperSpec{Sinks: []v1alpha1.LogSinkSpec{
+				fileSink,
+				{Name: "remote", HTTP: &v1alpha1.HTTPLogSink{URL: "https://logs.example.com/ingest"}},
+			}},
+			expectedErrors: 0,
+		},
+		{
+			name:           "no sink",
+			spec:           v1alpha1.LogShipperSpec{Type: v1alpha1.LogShipperTypeFluentBit},
+			expectedErrors: 1,
+		},
+		{
+			name:           "unknown type",
+			spec:           v1alpha1.LogShipperSpec{Type: "filebeat", Sinks: []v1alpha1.LogSinkSpec{fileSink}},
+			expectedErrors: 1,
+		},
+		{
+			name:           "duplicated sink",
+			spec:           v1alpha1.LogShipperSpec{Sinks: []v1alpha1.LogSinkSpec{fileSink, fileSink}},
+			expectedErrors: 1,
+		},
+		{
+			name:           "no destination",
+			spec:           v1alpha1.LogShipperSpec{Sinks: []v1alpha1.LogSinkSpec{{Name: "local"}}},
+			expectedErrors: 1,
+		},
+		{
+			name:           "relative path",
+			spec:           v1alpha1.LogShipperSpec{Sinks: []v1alpha1.LogSinkSpec{{Name: "local", File: &v1alpha1.FileLogSink{Path: "shipped.json"}}}},
+			expectedErrors: 1,
+		},
+		{
+			name: "invalid url",
+			spec: v1alpha1.LogShipperSpec{Sinks: []v1alpha1.LogSinkSpec{
+				{Name: "remote", HTTP: &v1alpha1.HTTPLogSink{URL: "tcp://logs.example.com"}},
+			}},
+			expectedErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLogShipper(&tt.spec, field.NewPath("spec", "logShipper"))
+			g.Expect(len(err)).Should(Equal(tt.expectedErrors))
+		})
+	}
+}
+
+func TestDisallowRemovingComponentGroups(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	old := newTidbClusterWithTiflash()
+	old.Spec.TiKV = &v1alpha1.TiKVSpec{
+		Groups: []v1alpha1.TiKVGroupSpec{
+			{ComponentGroupSpec: v1alpha1.ComponentGroupSpec{Name: "hot", Replicas: 3}},
+			{ComponentGroupSpec: v1alpha1.ComponentGroupSpec{Name: "cold", Replicas: 0}},
+		},
+	}
+
+	tc := old.DeepCopy()
+	tc.Spec.TiKV.Groups = tc.Spec.TiKV.Groups[:1]
+	g.Expect(disallowRemovingComponentGroups(old, tc)).To(BeEmpty())
+
+	tc.Spec.TiKV.Groups = tc.Spec.TiKV.Groups[1:]
+	g.Expect(disallowRemovingComponentGroups(old, tc)).To(HaveLen(1))
+
+	tc.Spec.TiKV = nil
+	g.Expect(disallowRemovingComponentGroups(old, tc)).To(HaveLen(1))
+
+	// the stores of the group are still being scaled in
+	old.Status.TiKVGroups = map[string]v1alpha1.TiKVStatus{
+		"cold": {
+			StatefulSet: &appsv1.StatefulSetStatus{Replicas: 1},
+			Stores:      map[string]v1alpha1.TiKVStore{"4": {ID: "4", State: v1alpha1.TiKVStateOffline}},
+		},
+	}
+	tc = old.DeepCopy()
+	tc.Spec.TiKV.Groups = tc.Spec.TiKV.Groups[:1]
+	g.Expect(disallowRemovingComponentGroups(old, tc)).To(HaveLen(1))
+
+	old.Status.TiKVGroups["cold"] = v1alpha1.TiKVStatus{
+		StatefulSet: &appsv1.StatefulSetStatus{Replicas: 0},
+		Stores:      map[string]v1alpha1.TiKVStore{"4": {ID: "4", State: v1alpha1.TiKVStateOffline}},
+	}
+	g.Expect(disallowRemovingComponentGroups(old, tc)).To(HaveLen(1))
+
+	old.Status.TiKVGroups["cold"] = v1alpha1.TiKVStatus{
+		StatefulSet:     &appsv1.StatefulSetStatus{Replicas: 0},
+		TombstoneStores: map[string]v1alpha1.TiKVStore{"4": {ID: "4", State: v1alpha1.TiKVStateTombstone}},
+	}
+	g.Expect(disallowRemovingComponentGroups(old, tc)).To(BeEmpty())
+}
+
 func Test_disallowMutateBootstrapSQLConfigMapName(t *testing.T) {
 	g := NewGomegaWithT(t)
 	tests := []struct {
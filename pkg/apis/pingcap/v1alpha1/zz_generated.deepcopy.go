@@ -188,6 +188,22 @@ func (in *BackupCondition) DeepCopy() *BackupCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupFreshnessSLO) DeepCopyInto(out *BackupFreshnessSLO) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupFreshnessSLO.
+func (in *BackupFreshnessSLO) DeepCopy() *BackupFreshnessSLO {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupFreshnessSLO)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupList) DeepCopyInto(out *BackupList) {
 	*out = *in
@@ -608,6 +624,178 @@ func (in *Binlog) DeepCopy() *Binlog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BinlogMigration) DeepCopyInto(out *BinlogMigration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BinlogMigration.
+func (in *BinlogMigration) DeepCopy() *BinlogMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(BinlogMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BinlogMigration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BinlogMigrationChangefeed) DeepCopyInto(out *BinlogMigrationChangefeed) {
+	*out = *in
+	if in.SinkURISecret != nil {
+		in, out := &in.SinkURISecret, &out.SinkURISecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BinlogMigrationChangefeed.
+func (in *BinlogMigrationChangefeed) DeepCopy() *BinlogMigrationChangefeed {
+	if in == nil {
+		return nil
+	}
+	out := new(BinlogMigrationChangefeed)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BinlogMigrationConsumerService) DeepCopyInto(out *BinlogMigrationConsumerService) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BinlogMigrationConsumerService.
+func (in *BinlogMigrationConsumerService) DeepCopy() *BinlogMigrationConsumerService {
+	if in == nil {
+		return nil
+	}
+	out := new(BinlogMigrationConsumerService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BinlogMigrationList) DeepCopyInto(out *BinlogMigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BinlogMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BinlogMigrationList.
+func (in *BinlogMigrationList) DeepCopy() *BinlogMigrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(BinlogMigrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BinlogMigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BinlogMigrationSpec) DeepCopyInto(out *BinlogMigrationSpec) {
+	*out = *in
+	out.Cluster = in.Cluster
+	in.Changefeed.DeepCopyInto(&out.Changefeed)
+	if in.ConsumerService != nil {
+		in, out := &in.ConsumerService, &out.ConsumerService
+		*out = new(BinlogMigrationConsumerService)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BinlogMigrationSpec.
+func (in *BinlogMigrationSpec) DeepCopy() *BinlogMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BinlogMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BinlogMigrationStatus) DeepCopyInto(out *BinlogMigrationStatus) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]BinlogMigrationStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BinlogMigrationStatus.
+func (in *BinlogMigrationStatus) DeepCopy() *BinlogMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BinlogMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BinlogMigrationStep) DeepCopyInto(out *BinlogMigrationStep) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BinlogMigrationStep.
+func (in *BinlogMigrationStep) DeepCopy() *BinlogMigrationStep {
+	if in == nil {
+		return nil
+	}
+	out := new(BinlogMigrationStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CDCConfigWraper) DeepCopyInto(out *CDCConfigWraper) {
 	*out = *in
@@ -628,6 +816,22 @@ func (in *CDCConfigWraper) DeepCopy() *CDCConfigWraper {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CDCLagSLO) DeepCopyInto(out *CDCLagSLO) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDCLagSLO.
+func (in *CDCLagSLO) DeepCopy() *CDCLagSLO {
+	if in == nil {
+		return nil
+	}
+	out := new(CDCLagSLO)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CleanOption) DeepCopyInto(out *CleanOption) {
 	*out = *in
@@ -848,6 +1052,32 @@ func (in *CompactBackupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompactCoverage) DeepCopyInto(out *CompactCoverage) {
+	*out = *in
+	if in.CompactedRanges != nil {
+		in, out := &in.CompactedRanges, &out.CompactedRanges
+		*out = make([]CompactTSRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.Gaps != nil {
+		in, out := &in.Gaps, &out.Gaps
+		*out = make([]CompactTSRange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompactCoverage.
+func (in *CompactCoverage) DeepCopy() *CompactCoverage {
+	if in == nil {
+		return nil
+	}
+	out := new(CompactCoverage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CompactRetryRecord) DeepCopyInto(out *CompactRetryRecord) {
 	*out = *in
@@ -941,6 +1171,11 @@ func (in *CompactStatus) DeepCopyInto(out *CompactStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Coverage != nil {
+		in, out := &in.Coverage, &out.Coverage
+		*out = new(CompactCoverage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -954,6 +1189,47 @@ func (in *CompactStatus) DeepCopy() *CompactStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompactTSRange) DeepCopyInto(out *CompactTSRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompactTSRange.
+func (in *CompactTSRange) DeepCopy() *CompactTSRange {
+	if in == nil {
+		return nil
+	}
+	out := new(CompactTSRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentGroupSpec) DeepCopyInto(out *ComponentGroupSpec) {
+	*out = *in
+	in.ComponentSpec.DeepCopyInto(&out.ComponentSpec)
+	in.ResourceRequirements.DeepCopyInto(&out.ResourceRequirements)
+	if in.ServerLabels != nil {
+		in, out := &in.ServerLabels, &out.ServerLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentGroupSpec.
+func (in *ComponentGroupSpec) DeepCopy() *ComponentGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentSpec) DeepCopyInto(out *ComponentSpec) {
 	*out = *in
@@ -1104,6 +1380,11 @@ func (in *ComponentSpec) DeepCopyInto(out *ComponentSpec) {
 		*out = new(Probe)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LogShipper != nil {
+		in, out := &in.LogShipper, &out.LogShipper
+		*out = new(LogShipperSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1733,6 +2014,11 @@ func (in *DiscoverySpec) DeepCopyInto(out *DiscoverySpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.ResourceRequirements.DeepCopyInto(&out.ResourceRequirements)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
 		*out = new(Probe)
@@ -1751,6 +2037,206 @@ func (in *DiscoverySpec) DeepCopy() *DiscoverySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Drainer) DeepCopyInto(out *Drainer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Drainer.
+func (in *Drainer) DeepCopy() *Drainer {
+	if in == nil {
+		return nil
+	}
+	out := new(Drainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Drainer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainerFileSink) DeepCopyInto(out *DrainerFileSink) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainerFileSink.
+func (in *DrainerFileSink) DeepCopy() *DrainerFileSink {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainerFileSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainerList) DeepCopyInto(out *DrainerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Drainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainerList.
+func (in *DrainerList) DeepCopy() *DrainerList {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DrainerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainerMySQLSink) DeepCopyInto(out *DrainerMySQLSink) {
+	*out = *in
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLSClientSecretName != nil {
+		in, out := &in.TLSClientSecretName, &out.TLSClientSecretName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainerMySQLSink.
+func (in *DrainerMySQLSink) DeepCopy() *DrainerMySQLSink {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainerMySQLSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainerSinkSpec) DeepCopyInto(out *DrainerSinkSpec) {
+	*out = *in
+	if in.MySQL != nil {
+		in, out := &in.MySQL, &out.MySQL
+		*out = new(DrainerMySQLSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(DrainerFileSink)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainerSinkSpec.
+func (in *DrainerSinkSpec) DeepCopy() *DrainerSinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainerSinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainerSpec) DeepCopyInto(out *DrainerSpec) {
+	*out = *in
+	in.ComponentSpec.DeepCopyInto(&out.ComponentSpec)
+	in.ResourceRequirements.DeepCopyInto(&out.ResourceRequirements)
+	out.Cluster = in.Cluster
+	if in.PVReclaimPolicy != nil {
+		in, out := &in.PVReclaimPolicy, &out.PVReclaimPolicy
+		*out = new(v1.PersistentVolumeReclaimPolicy)
+		**out = **in
+	}
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	in.Sink.DeepCopyInto(&out.Sink)
+	if in.InitialCommitTS != nil {
+		in, out := &in.InitialCommitTS, &out.InitialCommitTS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainerSpec.
+func (in *DrainerSpec) DeepCopy() *DrainerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DrainerStatus) DeepCopyInto(out *DrainerStatus) {
+	*out = *in
+	if in.StatefulSet != nil {
+		in, out := &in.StatefulSet, &out.StatefulSet
+		*out = new(appsv1.StatefulSetStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]*PumpNodeStatus, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(PumpNodeStatus)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DrainerStatus.
+func (in *DrainerStatus) DeepCopy() *DrainerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DrainerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DumplingConfig) DeepCopyInto(out *DumplingConfig) {
 	*out = *in
@@ -1894,6 +2380,22 @@ func (in *FileLogConfig) DeepCopy() *FileLogConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileLogSink) DeepCopyInto(out *FileLogSink) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileLogSink.
+func (in *FileLogSink) DeepCopy() *FileLogSink {
+	if in == nil {
+		return nil
+	}
+	out := new(FileLogSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Flash) DeepCopyInto(out *Flash) {
 	*out = *in
@@ -2340,6 +2842,34 @@ func (in *GrafanaSpec) DeepCopy() *GrafanaSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPLogSink) DeepCopyInto(out *HTTPLogSink) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AuthorizationSecret != nil {
+		in, out := &in.AuthorizationSecret, &out.AuthorizationSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPLogSink.
+func (in *HTTPLogSink) DeepCopy() *HTTPLogSink {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPLogSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelperSpec) DeepCopyInto(out *HelperSpec) {
 	*out = *in
@@ -2483,33 +3013,90 @@ func (in *Interval) DeepCopyInto(out *Interval) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Interval.
-func (in *Interval) DeepCopy() *Interval {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Interval.
+func (in *Interval) DeepCopy() *Interval {
+	if in == nil {
+		return nil
+	}
+	out := new(Interval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IsolationRead) DeepCopyInto(out *IsolationRead) {
+	*out = *in
+	if in.Engines != nil {
+		in, out := &in.Engines, &out.Engines
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IsolationRead.
+func (in *IsolationRead) DeepCopy() *IsolationRead {
 	if in == nil {
 		return nil
 	}
-	out := new(Interval)
+	out := new(IsolationRead)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IsolationRead) DeepCopyInto(out *IsolationRead) {
+func (in *LightningConfig) DeepCopyInto(out *LightningConfig) {
 	*out = *in
-	if in.Engines != nil {
-		in, out := &in.Engines, &out.Engines
+	if in.DiskQuota != nil {
+		in, out := &in.DiskQuota, &out.DiskQuota
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Conflict != nil {
+		in, out := &in.Conflict, &out.Conflict
+		*out = new(LightningConflictConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IsolationRead.
-func (in *IsolationRead) DeepCopy() *IsolationRead {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LightningConfig.
+func (in *LightningConfig) DeepCopy() *LightningConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(IsolationRead)
+	out := new(LightningConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LightningConflictConfig) DeepCopyInto(out *LightningConflictConfig) {
+	*out = *in
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxRecordRows != nil {
+		in, out := &in.MaxRecordRows, &out.MaxRecordRows
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LightningConflictConfig.
+func (in *LightningConflictConfig) DeepCopy() *LightningConflictConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LightningConflictConfig)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2608,6 +3195,73 @@ func (in *Log) DeepCopy() *Log {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogShipperSpec) DeepCopyInto(out *LogShipperSpec) {
+	*out = *in
+	in.ResourceRequirements.DeepCopyInto(&out.ResourceRequirements)
+	if in.ImagePullPolicy != nil {
+		in, out := &in.ImagePullPolicy, &out.ImagePullPolicy
+		*out = new(v1.PullPolicy)
+		**out = **in
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]LogSinkSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogShipperSpec.
+func (in *LogShipperSpec) DeepCopy() *LogShipperSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogShipperSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogSinkSpec) DeepCopyInto(out *LogSinkSpec) {
+	*out = *in
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileLogSink)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPLogSink)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogSinkSpec.
+func (in *LogSinkSpec) DeepCopy() *LogSinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogSinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogSubCommandStatus) DeepCopyInto(out *LogSubCommandStatus) {
 	*out = *in
@@ -3440,6 +4094,27 @@ func (in PDLabelPropertyConfig) DeepCopy() PDLabelPropertyConfig {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PDLeaderPreference) DeepCopyInto(out *PDLeaderPreference) {
+	*out = *in
+	if in.Ordinals != nil {
+		in, out := &in.Ordinals, &out.Ordinals
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PDLeaderPreference.
+func (in *PDLeaderPreference) DeepCopy() *PDLeaderPreference {
+	if in == nil {
+		return nil
+	}
+	out := new(PDLeaderPreference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PDLogConfig) DeepCopyInto(out *PDLogConfig) {
 	*out = *in
@@ -3496,6 +4171,47 @@ func (in *PDLogConfig) DeepCopy() *PDLogConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PDMSFailureMember) DeepCopyInto(out *PDMSFailureMember) {
+	*out = *in
+	if in.PVCUIDSet != nil {
+		in, out := &in.PVCUIDSet, &out.PVCUIDSet
+		*out = make(map[types.UID]EmptyStruct, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PDMSFailureMember.
+func (in *PDMSFailureMember) DeepCopy() *PDMSFailureMember {
+	if in == nil {
+		return nil
+	}
+	out := new(PDMSFailureMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PDMSMemberHealth) DeepCopyInto(out *PDMSMemberHealth) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PDMSMemberHealth.
+func (in *PDMSMemberHealth) DeepCopy() *PDMSMemberHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(PDMSMemberHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PDMSSpec) DeepCopyInto(out *PDMSSpec) {
 	*out = *in
@@ -3584,6 +4300,20 @@ func (in *PDMSStatus) DeepCopyInto(out *PDMSStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MemberHealth != nil {
+		in, out := &in.MemberHealth, &out.MemberHealth
+		*out = make(map[string]PDMSMemberHealth, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.FailureMembers != nil {
+		in, out := &in.FailureMembers, &out.FailureMembers
+		*out = make(map[string]PDMSFailureMember, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -4056,6 +4786,27 @@ func (in *PDSpec) DeepCopyInto(out *PDSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.PlacementRules != nil {
+		in, out := &in.PlacementRules, &out.PlacementRules
+		*out = make([]PlacementRuleBundle, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PlacementPolicies != nil {
+		in, out := &in.PlacementPolicies, &out.PlacementPolicies
+		*out = make([]PlacementPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LeaderPreference != nil {
+		in, out := &in.LeaderPreference, &out.LeaderPreference
+		*out = make([]PDLeaderPreference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4128,6 +4879,20 @@ func (in *PDStatus) DeepCopyInto(out *PDStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PlacementRules != nil {
+		in, out := &in.PlacementRules, &out.PlacementRules
+		*out = make(map[string]PlacementRuleBundleStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.LeaderPriorities != nil {
+		in, out := &in.LeaderPriorities, &out.LeaderPriorities
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -4280,38 +5045,157 @@ func (in *Performance) DeepCopyInto(out *Performance) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Performance.
-func (in *Performance) DeepCopy() *Performance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Performance.
+func (in *Performance) DeepCopy() *Performance {
+	if in == nil {
+		return nil
+	}
+	out := new(Performance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PessimisticTxn) DeepCopyInto(out *PessimisticTxn) {
+	*out = *in
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxRetryCount != nil {
+		in, out := &in.MaxRetryCount, &out.MaxRetryCount
+		*out = new(uint)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PessimisticTxn.
+func (in *PessimisticTxn) DeepCopy() *PessimisticTxn {
+	if in == nil {
+		return nil
+	}
+	out := new(PessimisticTxn)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementLabelConstraint) DeepCopyInto(out *PlacementLabelConstraint) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementLabelConstraint.
+func (in *PlacementLabelConstraint) DeepCopy() *PlacementLabelConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementLabelConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementPolicy) DeepCopyInto(out *PlacementPolicy) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]PlacementRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementPolicy.
+func (in *PlacementPolicy) DeepCopy() *PlacementPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementRule) DeepCopyInto(out *PlacementRule) {
+	*out = *in
+	if in.LabelConstraints != nil {
+		in, out := &in.LabelConstraints, &out.LabelConstraints
+		*out = make([]PlacementLabelConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LocationLabels != nil {
+		in, out := &in.LocationLabels, &out.LocationLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementRule.
+func (in *PlacementRule) DeepCopy() *PlacementRule {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementRuleBundle) DeepCopyInto(out *PlacementRuleBundle) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]PlacementRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementRuleBundle.
+func (in *PlacementRuleBundle) DeepCopy() *PlacementRuleBundle {
 	if in == nil {
 		return nil
 	}
-	out := new(Performance)
+	out := new(PlacementRuleBundle)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PessimisticTxn) DeepCopyInto(out *PessimisticTxn) {
+func (in *PlacementRuleBundleStatus) DeepCopyInto(out *PlacementRuleBundleStatus) {
 	*out = *in
-	if in.Enable != nil {
-		in, out := &in.Enable, &out.Enable
-		*out = new(bool)
-		**out = **in
+	if in.LastAppliedTime != nil {
+		in, out := &in.LastAppliedTime, &out.LastAppliedTime
+		*out = (*in).DeepCopy()
 	}
-	if in.MaxRetryCount != nil {
-		in, out := &in.MaxRetryCount, &out.MaxRetryCount
-		*out = new(uint)
-		**out = **in
+	if in.LastDriftTime != nil {
+		in, out := &in.LastDriftTime, &out.LastDriftTime
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PessimisticTxn.
-func (in *PessimisticTxn) DeepCopy() *PessimisticTxn {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementRuleBundleStatus.
+func (in *PlacementRuleBundleStatus) DeepCopy() *PlacementRuleBundleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PessimisticTxn)
+	out := new(PlacementRuleBundleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4519,6 +5403,43 @@ func (in *PrometheusConfiguration) DeepCopy() *PrometheusConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusOperatorSpec) DeepCopyInto(out *PrometheusOperatorSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DashboardLabels != nil {
+		in, out := &in.DashboardLabels, &out.DashboardLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DashboardAnnotations != nil {
+		in, out := &in.DashboardAnnotations, &out.DashboardAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusOperatorSpec.
+func (in *PrometheusOperatorSpec) DeepCopy() *PrometheusOperatorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusOperatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrometheusReloaderSpec) DeepCopyInto(out *PrometheusReloaderSpec) {
 	*out = *in
@@ -4771,6 +5692,17 @@ func (in *PumpStatus) DeepCopyInto(out *PumpStatus) {
 			}
 		}
 	}
+	if in.Drainers != nil {
+		in, out := &in.Drainers, &out.Drainers
+		*out = make([]*PumpNodeStatus, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(PumpNodeStatus)
+				**out = **in
+			}
+		}
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make(map[StorageVolumeName]*StorageVolumeStatus, len(*in))
@@ -4806,6 +5738,22 @@ func (in *PumpStatus) DeepCopy() *PumpStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryLatencySLO) DeepCopyInto(out *QueryLatencySLO) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryLatencySLO.
+func (in *QueryLatencySLO) DeepCopy() *QueryLatencySLO {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryLatencySLO)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QueueConfig) DeepCopyInto(out *QueueConfig) {
 	*out = *in
@@ -4858,6 +5806,22 @@ func (in *Quota) DeepCopy() *Quota {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionHealthSLO) DeepCopyInto(out *RegionHealthSLO) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionHealthSLO.
+func (in *RegionHealthSLO) DeepCopy() *RegionHealthSLO {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionHealthSLO)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RelabelConfig) DeepCopyInto(out *RelabelConfig) {
 	*out = *in
@@ -5063,6 +6027,11 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		*out = new(BRConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Lightning != nil {
+		in, out := &in.Lightning, &out.Lightning
+		*out = new(LightningConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
 		*out = make([]v1.Toleration, len(*in))
@@ -5085,6 +6054,11 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.WarmupOptions != nil {
+		in, out := &in.WarmupOptions, &out.WarmupOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.PodSecurityContext != nil {
 		in, out := &in.PodSecurityContext, &out.PodSecurityContext
 		*out = new(v1.PodSecurityContext)
@@ -5136,6 +6110,13 @@ func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WarmupProgress != nil {
+		in, out := &in.WarmupProgress, &out.WarmupProgress
+		*out = make(map[string]WarmupProgress, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -5175,6 +6156,54 @@ func (in *S3StorageProvider) DeepCopy() *S3StorageProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SLOSpec) DeepCopyInto(out *SLOSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.QueryLatency != nil {
+		in, out := &in.QueryLatency, &out.QueryLatency
+		*out = new(QueryLatencySLO)
+		**out = **in
+	}
+	if in.StoreDown != nil {
+		in, out := &in.StoreDown, &out.StoreDown
+		*out = new(StoreDownSLO)
+		**out = **in
+	}
+	if in.RegionHealth != nil {
+		in, out := &in.RegionHealth, &out.RegionHealth
+		*out = new(RegionHealthSLO)
+		**out = **in
+	}
+	if in.BackupFreshness != nil {
+		in, out := &in.BackupFreshness, &out.BackupFreshness
+		*out = new(BackupFreshnessSLO)
+		**out = **in
+	}
+	if in.CDCLag != nil {
+		in, out := &in.CDCLag, &out.CDCLag
+		*out = new(CDCLagSLO)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SLOSpec.
+func (in *SLOSpec) DeepCopy() *SLOSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SLOSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SafeTLSConfig) DeepCopyInto(out *SafeTLSConfig) {
 	*out = *in
@@ -5560,6 +6589,22 @@ func (in *StorageVolumeStatus) DeepCopy() *StorageVolumeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StoreDownSLO) DeepCopyInto(out *StoreDownSLO) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoreDownSLO.
+func (in *StoreDownSLO) DeepCopy() *StoreDownSLO {
+	if in == nil {
+		return nil
+	}
+	out := new(StoreDownSLO)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuspendAction) DeepCopyInto(out *SuspendAction) {
 	*out = *in
@@ -6106,6 +7151,40 @@ func (in *TiDBFailureMember) DeepCopy() *TiDBFailureMember {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiDBGroupSpec) DeepCopyInto(out *TiDBGroupSpec) {
+	*out = *in
+	in.ComponentGroupSpec.DeepCopyInto(&out.ComponentGroupSpec)
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageVolumes != nil {
+		in, out := &in.StorageVolumes, &out.StorageVolumes
+		*out = make([]StorageVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(TiDBConfigWraper)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiDBGroupSpec.
+func (in *TiDBGroupSpec) DeepCopy() *TiDBGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TiDBGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TiDBInitializer) DeepCopyInto(out *TiDBInitializer) {
 	*out = *in
@@ -6315,6 +7394,13 @@ func (in *TiDBSpec) DeepCopyInto(out *TiDBSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]TiDBGroupSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -6426,6 +7512,69 @@ func (in *TiFlashCommonConfigWraper) DeepCopy() *TiFlashCommonConfigWraper {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiFlashComputeSpec) DeepCopyInto(out *TiFlashComputeSpec) {
+	*out = *in
+	in.ComponentSpec.DeepCopyInto(&out.ComponentSpec)
+	in.ResourceRequirements.DeepCopyInto(&out.ResourceRequirements)
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(TiFlashConfigWraper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CacheCapacity != nil {
+		in, out := &in.CacheCapacity, &out.CacheCapacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiFlashComputeSpec.
+func (in *TiFlashComputeSpec) DeepCopy() *TiFlashComputeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TiFlashComputeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiFlashComputeStatus) DeepCopyInto(out *TiFlashComputeStatus) {
+	*out = *in
+	if in.StatefulSet != nil {
+		in, out := &in.StatefulSet, &out.StatefulSet
+		*out = new(appsv1.StatefulSetStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stores != nil {
+		in, out := &in.Stores, &out.Stores
+		*out = make(map[string]TiKVStore, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.TombstoneStores != nil {
+		in, out := &in.TombstoneStores, &out.TombstoneStores
+		*out = make(map[string]TiKVStore, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiFlashComputeStatus.
+func (in *TiFlashComputeStatus) DeepCopy() *TiFlashComputeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TiFlashComputeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TiFlashConfig) DeepCopyInto(out *TiFlashConfig) {
 	*out = *in
@@ -6460,20 +7609,71 @@ func (in *TiFlashConfigWraper) DeepCopyInto(out *TiFlashConfigWraper) {
 		*out = new(TiFlashCommonConfigWraper)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Proxy != nil {
-		in, out := &in.Proxy, &out.Proxy
-		*out = new(TiFlashProxyConfigWraper)
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(TiFlashProxyConfigWraper)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiFlashConfigWraper.
+func (in *TiFlashConfigWraper) DeepCopy() *TiFlashConfigWraper {
+	if in == nil {
+		return nil
+	}
+	out := new(TiFlashConfigWraper)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiFlashDisaggregatedSpec) DeepCopyInto(out *TiFlashDisaggregatedSpec) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3StorageProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Compute.DeepCopyInto(&out.Compute)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiFlashDisaggregatedSpec.
+func (in *TiFlashDisaggregatedSpec) DeepCopy() *TiFlashDisaggregatedSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TiFlashDisaggregatedSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiFlashGroupSpec) DeepCopyInto(out *TiFlashGroupSpec) {
+	*out = *in
+	in.ComponentGroupSpec.DeepCopyInto(&out.ComponentGroupSpec)
+	if in.StorageClaims != nil {
+		in, out := &in.StorageClaims, &out.StorageClaims
+		*out = make([]StorageClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(TiFlashConfigWraper)
 		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiFlashConfigWraper.
-func (in *TiFlashConfigWraper) DeepCopy() *TiFlashConfigWraper {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiFlashGroupSpec.
+func (in *TiFlashGroupSpec) DeepCopy() *TiFlashGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(TiFlashConfigWraper)
+	out := new(TiFlashGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -6541,6 +7741,18 @@ func (in *TiFlashSpec) DeepCopyInto(out *TiFlashSpec) {
 		**out = **in
 	}
 	in.ScalePolicy.DeepCopyInto(&out.ScalePolicy)
+	if in.Disaggregated != nil {
+		in, out := &in.Disaggregated, &out.Disaggregated
+		*out = new(TiFlashDisaggregatedSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]TiFlashGroupSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -6612,6 +7824,11 @@ func (in *TiFlashStatus) DeepCopyInto(out *TiFlashStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Compute != nil {
+		in, out := &in.Compute, &out.Compute
+		*out = new(TiFlashComputeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -7464,6 +8681,40 @@ func (in *TiKVGCConfig) DeepCopy() *TiKVGCConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiKVGroupSpec) DeepCopyInto(out *TiKVGroupSpec) {
+	*out = *in
+	in.ComponentGroupSpec.DeepCopyInto(&out.ComponentGroupSpec)
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageVolumes != nil {
+		in, out := &in.StorageVolumes, &out.StorageVolumes
+		*out = make([]StorageVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(TiKVConfigWraper)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiKVGroupSpec.
+func (in *TiKVGroupSpec) DeepCopy() *TiKVGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TiKVGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TiKVImportConfig) DeepCopyInto(out *TiKVImportConfig) {
 	*out = *in
@@ -8437,6 +9688,13 @@ func (in *TiKVSpec) DeepCopyInto(out *TiKVSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]TiKVGroupSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -9212,6 +10470,11 @@ func (in *TidbClusterSpec) DeepCopyInto(out *TidbClusterSpec) {
 		*out = make([]StartScriptV2FeatureFlag, len(*in))
 		copy(*out, *in)
 	}
+	if in.LogShipper != nil {
+		in, out := &in.LogShipper, &out.LogShipper
+		*out = new(LogShipperSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -9250,6 +10513,27 @@ func (in *TidbClusterStatus) DeepCopyInto(out *TidbClusterStatus) {
 	in.TiFlash.DeepCopyInto(&out.TiFlash)
 	in.TiProxy.DeepCopyInto(&out.TiProxy)
 	in.TiCDC.DeepCopyInto(&out.TiCDC)
+	if in.TiKVGroups != nil {
+		in, out := &in.TiKVGroups, &out.TiKVGroups
+		*out = make(map[string]TiKVStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.TiDBGroups != nil {
+		in, out := &in.TiDBGroups, &out.TiDBGroups
+		*out = make(map[string]TiDBStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.TiFlashGroups != nil {
+		in, out := &in.TiFlashGroups, &out.TiFlashGroups
+		*out = make(map[string]TiFlashStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]TidbClusterCondition, len(*in))
@@ -9542,6 +10826,11 @@ func (in *TidbInitializerSpec) DeepCopyInto(out *TidbInitializerSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Migrations != nil {
+		in, out := &in.Migrations, &out.Migrations
+		*out = new(TidbMigrationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -9559,6 +10848,11 @@ func (in *TidbInitializerSpec) DeepCopy() *TidbInitializerSpec {
 func (in *TidbInitializerStatus) DeepCopyInto(out *TidbInitializerStatus) {
 	*out = *in
 	in.JobStatus.DeepCopyInto(&out.JobStatus)
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(TidbMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -9572,6 +10866,52 @@ func (in *TidbInitializerStatus) DeepCopy() *TidbInitializerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TidbMigrationSpec) DeepCopyInto(out *TidbMigrationSpec) {
+	*out = *in
+	if in.ConfigMaps != nil {
+		in, out := &in.ConfigMaps, &out.ConfigMaps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TidbMigrationSpec.
+func (in *TidbMigrationSpec) DeepCopy() *TidbMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TidbMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TidbMigrationStatus) DeepCopyInto(out *TidbMigrationStatus) {
+	*out = *in
+	if in.MismatchedVersions != nil {
+		in, out := &in.MismatchedVersions, &out.MismatchedVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TidbMigrationStatus.
+func (in *TidbMigrationStatus) DeepCopy() *TidbMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TidbMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TidbMonitor) DeepCopyInto(out *TidbMonitor) {
 	*out = *in
@@ -9763,6 +11103,16 @@ func (in *TidbMonitorSpec) DeepCopyInto(out *TidbMonitorSpec) {
 		*out = new(v1.PodSecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PrometheusOperator != nil {
+		in, out := &in.PrometheusOperator, &out.PrometheusOperator
+		*out = new(PrometheusOperatorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(SLOSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -9908,6 +11258,174 @@ func (in *TidbNGMonitoringStatus) DeepCopy() *TidbNGMonitoringStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TidbUser) DeepCopyInto(out *TidbUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TidbUser.
+func (in *TidbUser) DeepCopy() *TidbUser {
+	if in == nil {
+		return nil
+	}
+	out := new(TidbUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TidbUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TidbUserGrant) DeepCopyInto(out *TidbUserGrant) {
+	*out = *in
+	if in.Privileges != nil {
+		in, out := &in.Privileges, &out.Privileges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TidbUserGrant.
+func (in *TidbUserGrant) DeepCopy() *TidbUserGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(TidbUserGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TidbUserList) DeepCopyInto(out *TidbUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TidbUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TidbUserList.
+func (in *TidbUserList) DeepCopy() *TidbUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(TidbUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TidbUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TidbUserSpec) DeepCopyInto(out *TidbUserSpec) {
+	*out = *in
+	out.Cluster = in.Cluster
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]TidbUserGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdminSecretName != nil {
+		in, out := &in.AdminSecretName, &out.AdminSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLSClientSecretName != nil {
+		in, out := &in.TLSClientSecretName, &out.TLSClientSecretName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TidbUserSpec.
+func (in *TidbUserSpec) DeepCopy() *TidbUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TidbUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TidbUserStatus) DeepCopyInto(out *TidbUserStatus) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]TidbUserGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TidbUserStatus.
+func (in *TidbUserStatus) DeepCopy() *TidbUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TidbUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopologySpreadConstraint) DeepCopyInto(out *TopologySpreadConstraint) {
 	*out = *in
@@ -10022,6 +11540,30 @@ func (in *User) DeepCopy() *User {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarmupProgress) DeepCopyInto(out *WarmupProgress) {
+	*out = *in
+	if in.EstimatedCompletionTime != nil {
+		in, out := &in.EstimatedCompletionTime, &out.EstimatedCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarmupProgress.
+func (in *WarmupProgress) DeepCopy() *WarmupProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(WarmupProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 	*out = *in
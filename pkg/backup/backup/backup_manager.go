@@ -526,29 +526,42 @@ func (bm *backupManager) makeExportJob(backup *v1alpha1.Backup) (*batchv1.Job, s
 	// set env vars specified in backup.Spec.Env
 	envVars = util.AppendOverwriteEnv(envVars, backup.Spec.Env)
 
-	// TODO: make pvc request storage size configurable
-	reason, err = bm.ensureBackupPVCExist(backup)
-	if err != nil {
-		return nil, reason, err
-	}
-
-	bucketName, reason, err := backuputil.GetBackupBucketName(backup)
-	if err != nil {
-		return nil, reason, err
-	}
-
 	args := []string{
 		"export",
 		fmt.Sprintf("--namespace=%s", ns),
 		fmt.Sprintf("--backupName=%s", name),
-		fmt.Sprintf("--bucket=%s", bucketName),
-		fmt.Sprintf("--storageType=%s", backuputil.GetStorageType(backup.Spec.StorageProvider)),
 	}
 
 	volumeMounts := []corev1.VolumeMount{}
 	volumes := []corev1.Volume{}
 	initContainers := []corev1.Container{}
 
+	// dumpling writes to the external storage directly when streaming, the backup data doesn't go through the PVC
+	if !backup.IsDumplingStreaming() {
+		// TODO: make pvc request storage size configurable
+		reason, err = bm.ensureBackupPVCExist(backup)
+		if err != nil {
+			return nil, reason, err
+		}
+
+		bucketName, reason, err := backuputil.GetBackupBucketName(backup)
+		if err != nil {
+			return nil, reason, err
+		}
+		args = append(args, fmt.Sprintf("--bucket=%s", bucketName))
+
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{Name: label.BackupJobLabelVal, MountPath: constants.BackupRootPath})
+		volumes = append(volumes, corev1.Volume{
+			Name: label.BackupJobLabelVal,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: backup.GetBackupPVCName(),
+				},
+			},
+		})
+	}
+	args = append(args, fmt.Sprintf("--storageType=%s", backuputil.GetStorageType(backup.Spec.StorageProvider)))
+
 	if len(backup.Spec.AdditionalVolumes) > 0 {
 		volumes = append(volumes, backup.Spec.AdditionalVolumes...)
 	}
@@ -624,27 +637,16 @@ func (bm *backupManager) makeExportJob(backup *v1alpha1.Backup) (*batchv1.Job, s
 					Image:           bm.deps.CLIConfig.TiDBBackupManagerImage,
 					Args:            args,
 					ImagePullPolicy: corev1.PullIfNotPresent,
-					VolumeMounts: append([]corev1.VolumeMount{
-						{Name: label.BackupJobLabelVal, MountPath: constants.BackupRootPath},
-					}, volumeMounts...),
-					Env:       util.AppendEnvIfPresent(envVars, "TZ"),
-					Resources: backup.Spec.ResourceRequirements,
+					VolumeMounts:    volumeMounts,
+					Env:             util.AppendEnvIfPresent(envVars, "TZ"),
+					Resources:       backup.Spec.ResourceRequirements,
 				},
 			},
-			RestartPolicy:    corev1.RestartPolicyNever,
-			Tolerations:      backup.Spec.Tolerations,
-			ImagePullSecrets: backup.Spec.ImagePullSecrets,
-			Affinity:         backup.Spec.Affinity,
-			Volumes: append([]corev1.Volume{
-				{
-					Name: label.BackupJobLabelVal,
-					VolumeSource: corev1.VolumeSource{
-						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-							ClaimName: backup.GetBackupPVCName(),
-						},
-					},
-				},
-			}, volumes...),
+			RestartPolicy:     corev1.RestartPolicyNever,
+			Tolerations:       backup.Spec.Tolerations,
+			ImagePullSecrets:  backup.Spec.ImagePullSecrets,
+			Affinity:          backup.Spec.Affinity,
+			Volumes:           volumes,
 			PriorityClassName: backup.Spec.PriorityClassName,
 		},
 	}
@@ -248,6 +248,45 @@ func TestBackupManagerDumpling(t *testing.T) {
 	g.Expect(job.Spec.Template.Spec.Containers[0].Env).NotTo(gomega.ContainElement(env2No))
 }
 
+func TestBackupManagerDumplingStreaming(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	helper := newHelper(t)
+	defer helper.Close()
+	deps := helper.Deps
+	var err error
+
+	bm := NewBackupManager(deps).(*backupManager)
+
+	// the storage size is not needed when streaming
+	backup := validDumplingBackup()
+	backup.Spec.StorageSize = ""
+	backup.Spec.Dumpling = &v1alpha1.DumplingConfig{Streaming: true}
+	_, err = deps.Clientset.PingcapV1alpha1().Backups(backup.Namespace).Create(context.TODO(), backup, metav1.CreateOptions{})
+	g.Expect(err).Should(BeNil())
+	helper.CreateSecret(backup)
+
+	err = bm.syncBackupJob(backup)
+	g.Expect(err).Should(BeNil())
+	helper.hasCondition(backup.Namespace, backup.Name, v1alpha1.BackupScheduled, "")
+	job, err := deps.KubeClientset.BatchV1().Jobs(backup.Namespace).Get(context.TODO(), backup.GetBackupJobName(), metav1.GetOptions{})
+	g.Expect(err).Should(BeNil())
+
+	// dumpling writes to the external storage directly, no PVC is created or mounted
+	_, err = deps.PVCLister.PersistentVolumeClaims(backup.Namespace).Get(backup.GetBackupPVCName())
+	g.Expect(errors.IsNotFound(err)).Should(BeTrue())
+	for _, vol := range job.Spec.Template.Spec.Volumes {
+		g.Expect(vol.PersistentVolumeClaim).Should(BeNil())
+	}
+	g.Expect(job.Spec.Template.Spec.Containers[0].Args).Should(Equal([]string{
+		"export",
+		"--namespace=ns",
+		"--backupName=dump_name",
+		"--storageType=s3",
+		"--client-tls=true",
+	}))
+}
+
 func TestBackupManagerBR(t *testing.T) {
 	g := NewGomegaWithT(t)
 	helper := newHelper(t)
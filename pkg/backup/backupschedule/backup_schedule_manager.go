@@ -15,7 +15,6 @@ package backupschedule
 
 import (
 	"fmt"
-	"math"
 	"path"
 	"sort"
 	"strings"
@@ -30,6 +29,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/util"
 	"github.com/robfig/cron"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
@@ -695,17 +695,24 @@ func (bm *backupScheduleManager) backupGCByMaxReservedTime(bs *v1alpha1.BackupSc
 		klog.Infof("backup schedule %s/%s gc backup %s success", ns, bsName, backup.GetName())
 	}
 
-	var compactProgress uint64
-	if bs.Spec.CompactBackupTemplate == nil {
-		compactProgress = math.MaxUint64
-	} else if bs.Status.LastCompactProgress == nil {
-		compactProgress = 0
-	} else {
-		compactProgress = config.GoTimeToTS(bs.Status.LastCompactProgress.Time)
-	}
-
-	if truncateTSO > compactProgress {
-		truncateTSO = compactProgress
+	// the log backup is only truncated over the ranges compacted if compact is enabled
+	if truncateTSO > 0 && bs.Spec.CompactBackupTemplate != nil {
+		compactList, err := bm.getCompactList(bs)
+		if err != nil {
+			klog.Errorf("backupGCByMaxReservedTime, err: %s", err)
+			return
+		}
+		compactedTSO, err := calCompactedTruncateTSO(bs, ascBackups, logBackup, compactList, truncateTSO)
+		if err != nil {
+			klog.Errorf("caculate compacted log backup tso which can be truncated, err: %s", err)
+			return
+		}
+		if compactedTSO < truncateTSO {
+			msg := fmt.Sprintf("refuse to truncate log backup %s to %d which is not compacted, truncate to %d instead", logBackup.GetName(), truncateTSO, compactedTSO)
+			klog.Warningf("backup schedule %s/%s %s", ns, bsName, msg)
+			bm.deps.Recorder.Event(bs, corev1.EventTypeWarning, "LogTruncateRefused", msg)
+			truncateTSO = compactedTSO
+		}
 	}
 
 	if truncateTSO > 0 {
@@ -749,6 +756,20 @@ func (bm *backupScheduleManager) compactGCByMaxReservedTime(bs *v1alpha1.BackupS
 		return
 	}
 
+	// the compacts over the log backup not truncated are kept, they tell whether the log backup is compacted before it is truncated
+	backupsList, err := bm.getBackupList(bs)
+	if err != nil {
+		klog.Errorf("compactGCByMaxReservedTime, err: %s", err)
+		return
+	}
+	if _, logBackup := separateSnapshotBackupsAndLogBackup(backupsList); logBackup != nil {
+		expired, err = excludeCompactsNotTruncated(expired, logBackup)
+		if err != nil {
+			klog.Errorf("caculate expired compact with log backup, err: %s", err)
+			return
+		}
+	}
+
 	for _, compact := range expired {
 		// delete the expired backup
 		if err = bm.deps.CompactControl.DeleteCompactBackup(compact); err != nil {
@@ -894,6 +915,145 @@ func calculateExpiredCompacts(compactsList []*v1alpha1.CompactBackup, reservedTi
 	return compactsList[:i], nil
 }
 
+// excludeCompactsNotTruncated returns the compacts in compactsList which end before the ts the log backup is truncated to.
+func excludeCompactsNotTruncated(compactsList []*v1alpha1.CompactBackup, logBackup *v1alpha1.Backup) ([]*v1alpha1.CompactBackup, error) {
+	truncatedTSO, err := calLogBackupTruncatedTSO(logBackup)
+	if err != nil {
+		return nil, err
+	}
+	i := 0
+	for ; i < len(compactsList); i++ {
+		endTs, err := config.ParseTSString(compactsList[i].Spec.EndTs)
+		if err != nil {
+			return nil, perrors.Annotatef(err, "parse end tso: %s", compactsList[i].Spec.EndTs)
+		}
+		if endTs > truncatedTSO {
+			break
+		}
+	}
+	return compactsList[:i], nil
+}
+
+// calLogBackupTruncatedTSO returns the ts the log backup is truncated to, or the start ts of the log backup if it is never truncated.
+func calLogBackupTruncatedTSO(logBackup *v1alpha1.Backup) (uint64, error) {
+	var truncatedTSO uint64
+	for _, ts := range []string{logBackup.Status.CommitTs, logBackup.Spec.LogTruncateUntil, logBackup.Status.LogSuccessTruncateUntil} {
+		if ts == "" {
+			continue
+		}
+		tso, err := config.ParseTSString(ts)
+		if err != nil {
+			return 0, perrors.Annotatef(err, "parse truncate ts of log backup %s/%s", logBackup.Namespace, logBackup.Name)
+		}
+		if tso > truncatedTSO {
+			truncatedTSO = tso
+		}
+	}
+	return truncatedTSO, nil
+}
+
+// calCompactedTruncateTSO limits truncateTSO to the ranges which are both covered by a snapshot backup and compacted.
+//
+// ---truncated------snapshot1----------snapshot2------------snapshot3-----> snapshot backups
+// ---[compact1]-[compact2]-[compact3]------------[compact4]---------------> compacts
+// -------------------------------------truncateTSO------------------------> time
+//
+// supposed that the log backup has been truncated to `truncated`, and the log backup is compacted contiguously
+// by compact1 to compact3 from there. The log backup can't be truncated over the gap between compact3 and compact4,
+// so the returned value is snapshot1, the latest snapshot backup before both the gap and truncateTSO.
+// Only a complete compact covers the range from its start ts to the end ts it processed. The compacted ranges
+// reported by a compact which is not complete are not counted, because its subcompactions are finished only
+// for some of the regions.
+//
+// The contiguity is checked from where the schedule starts to compact if the log backup is truncated before it,
+// since the log backup before it is never compacted, e.g. the log backup created before the compact is enabled.
+// The range before LastCompactProgress is compacted contiguously by the schedule even if the compacts are deleted.
+func calCompactedTruncateTSO(bs *v1alpha1.BackupSchedule, backupsList []*v1alpha1.Backup, logBackup *v1alpha1.Backup, compactsList []*v1alpha1.CompactBackup, truncateTSO uint64) (uint64, error) {
+	truncatedTSO, err := calLogBackupTruncatedTSO(logBackup)
+	if err != nil {
+		return 0, err
+	}
+	compactStartTSO, err := calCompactStartTSO(bs)
+	if err != nil {
+		return 0, err
+	}
+
+	type tsRange struct {
+		start, end uint64
+	}
+	var ranges []tsRange
+	for _, compact := range compactsList {
+		if compact.Status.State != string(v1alpha1.BackupComplete) {
+			continue
+		}
+		startTs, err := config.ParseTSString(compact.Spec.StartTs)
+		if err != nil {
+			return 0, perrors.Annotatef(err, "parse start ts of compact %s/%s", compact.Namespace, compact.Name)
+		}
+		endTs, err := config.ParseTSString(compact.Status.EndTs)
+		if err != nil {
+			return 0, perrors.Annotatef(err, "parse end ts of compact %s/%s", compact.Namespace, compact.Name)
+		}
+		ranges = append(ranges, tsRange{start: startTs, end: endTs})
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].start < ranges[j].start
+	})
+
+	// the log backup is compacted contiguously from truncatedTSO or compactStartTSO to compactedTSO
+	compactedTSO := truncatedTSO
+	if compactStartTSO > compactedTSO {
+		compactedTSO = compactStartTSO
+	}
+	if progress := bs.Status.LastCompactProgress; progress != nil {
+		if progressTSO := config.GoTimeToTS(progress.Time); progressTSO > compactedTSO {
+			compactedTSO = progressTSO
+		}
+	}
+	for _, r := range ranges {
+		if r.start > compactedTSO {
+			break
+		}
+		if r.end > compactedTSO {
+			compactedTSO = r.end
+		}
+	}
+	if truncateTSO > compactedTSO {
+		truncateTSO = compactedTSO
+	}
+
+	// truncate to the latest snapshot backup before truncateTSO
+	var compactedTruncateTSO uint64
+	for _, backup := range backupsList {
+		backupTSO, err := config.ParseTSString(backup.Status.CommitTs)
+		if err != nil {
+			return 0, perrors.Annotatef(err, "parse backup ts of backup %s/%s", backup.Namespace, backup.Name)
+		}
+		if backupTSO > truncatedTSO && backupTSO <= truncateTSO && backupTSO > compactedTruncateTSO {
+			compactedTruncateTSO = backupTSO
+		}
+	}
+	return compactedTruncateTSO, nil
+}
+
+// calCompactStartTSO returns the ts from which the schedule compacts the log backup.
+func calCompactStartTSO(bs *v1alpha1.BackupSchedule) (uint64, error) {
+	var startTSO uint64
+	if bs.Status.LogBackupStartTs != nil {
+		startTSO = config.GoTimeToTS(bs.Status.LogBackupStartTs.Time)
+	}
+	if bs.Spec.MinCompactStartTs != nil && *bs.Spec.MinCompactStartTs != "" {
+		minStartTime, err := config.ParseTSStringToGoTime(*bs.Spec.MinCompactStartTs)
+		if err != nil {
+			return 0, perrors.Annotatef(err, "parse min compact start ts of backup schedule %s/%s", bs.Namespace, bs.Name)
+		}
+		if tso := config.GoTimeToTS(minStartTime); tso > startTSO {
+			startTSO = tso
+		}
+	}
+	return startTSO, nil
+}
+
 // calculateLatestTSO calculate the latest tso in auto backups and log backups
 func calculateLatestTSO(backupsList []*v1alpha1.Backup, logBackup *v1alpha1.Backup) (uint64, error) {
 	var (
@@ -743,6 +743,172 @@ func TestCalculateExpiredBackupsWithLogBackup(t *testing.T) {
 	}
 }
 
+func TestCalCompactedTruncateTSO(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var (
+		now       = time.Now()
+		last10Min = now.Add(-time.Minute * 10).Unix()
+		last1Day  = now.Add(-time.Hour * 24 * 1).Unix()
+		last2Day  = now.Add(-time.Hour * 24 * 2).Unix()
+		last3Day  = now.Add(-time.Hour * 24 * 3).Unix()
+		last4Day  = now.Add(-time.Hour * 24 * 4).Unix()
+		last5Day  = now.Add(-time.Hour * 24 * 5).Unix()
+	)
+	backups := []*v1alpha1.Backup{
+		fakeBackup(&last4Day),
+		fakeBackup(&last3Day),
+		fakeBackup(&last2Day),
+		fakeBackup(&last1Day),
+	}
+	fakeCompact := func(start, end int64, state v1alpha1.BackupConditionType) *v1alpha1.CompactBackup {
+		compact := &v1alpha1.CompactBackup{}
+		compact.Spec.StartTs = getTSOStr(start)
+		compact.Spec.EndTs = getTSOStr(end)
+		compact.Status.State = string(state)
+		if state == v1alpha1.BackupComplete {
+			compact.Status.EndTs = getTSOStr(end)
+		}
+		return compact
+	}
+	truncateTSO := getTSO(last1Day)
+
+	type testCase struct {
+		name                string
+		compacts            []*v1alpha1.CompactBackup
+		truncateUntil       *int64
+		logBackupStartTs    *int64
+		minCompactStartTs   *int64
+		lastCompactProgress *int64
+		expectedTruncateTS  uint64
+	}
+	testCases := []*testCase{
+		{
+			name:               "no compact",
+			expectedTruncateTS: 0,
+		},
+		{
+			name: "compacted contiguously",
+			compacts: []*v1alpha1.CompactBackup{
+				fakeCompact(last5Day, last3Day, v1alpha1.BackupComplete),
+				fakeCompact(last3Day, last10Min, v1alpha1.BackupComplete),
+			},
+			expectedTruncateTS: getTSO(last1Day),
+		},
+		{
+			name: "the gap of a failed compact",
+			compacts: []*v1alpha1.CompactBackup{
+				fakeCompact(last5Day, last3Day, v1alpha1.BackupComplete),
+				fakeCompact(last3Day, last2Day, v1alpha1.BackupFailed),
+				fakeCompact(last2Day, last10Min, v1alpha1.BackupComplete),
+			},
+			expectedTruncateTS: getTSO(last3Day),
+		},
+		{
+			name: "the compacted ranges of a failed compact are not counted",
+			compacts: func() []*v1alpha1.CompactBackup {
+				failed := fakeCompact(last3Day, last1Day, v1alpha1.BackupFailed)
+				failed.Status.Coverage = &v1alpha1.CompactCoverage{
+					CompactedRanges: []v1alpha1.CompactTSRange{{StartTs: getTSOStr(last3Day), EndTs: getTSOStr(last2Day)}},
+					Gaps:            []v1alpha1.CompactTSRange{{StartTs: getTSOStr(last2Day), EndTs: getTSOStr(last1Day)}},
+				}
+				return []*v1alpha1.CompactBackup{
+					fakeCompact(last5Day, last3Day, v1alpha1.BackupComplete),
+					failed,
+					fakeCompact(last1Day, last10Min, v1alpha1.BackupComplete),
+				}
+			}(),
+			expectedTruncateTS: getTSO(last3Day),
+		},
+		{
+			name: "compacted from where the log backup is truncated to",
+			compacts: []*v1alpha1.CompactBackup{
+				fakeCompact(last3Day, last10Min, v1alpha1.BackupComplete),
+			},
+			truncateUntil:      &last3Day,
+			expectedTruncateTS: getTSO(last1Day),
+		},
+		{
+			name: "not compacted from the start of the log backup",
+			compacts: []*v1alpha1.CompactBackup{
+				fakeCompact(last3Day, last10Min, v1alpha1.BackupComplete),
+			},
+			expectedTruncateTS: 0,
+		},
+		{
+			// the log backup created before v1.6.3 is compacted from its checkpoint when the schedule is upgraded
+			name: "compacts begin after the start of the log backup",
+			compacts: []*v1alpha1.CompactBackup{
+				fakeCompact(last3Day, last2Day, v1alpha1.BackupComplete),
+				fakeCompact(last2Day, last10Min, v1alpha1.BackupComplete),
+			},
+			logBackupStartTs:   &last3Day,
+			expectedTruncateTS: getTSO(last1Day),
+		},
+		{
+			name: "compacts begin from the min compact start ts",
+			compacts: []*v1alpha1.CompactBackup{
+				fakeCompact(last4Day, last10Min, v1alpha1.BackupComplete),
+			},
+			minCompactStartTs:  &last4Day,
+			expectedTruncateTS: getTSO(last1Day),
+		},
+		{
+			name: "the gap after the start of compact",
+			compacts: []*v1alpha1.CompactBackup{
+				fakeCompact(last2Day, last10Min, v1alpha1.BackupComplete),
+			},
+			logBackupStartTs:   &last4Day,
+			expectedTruncateTS: getTSO(last4Day),
+		},
+		{
+			name: "the compacts before the last compact progress are deleted",
+			compacts: []*v1alpha1.CompactBackup{
+				fakeCompact(last2Day, last10Min, v1alpha1.BackupComplete),
+			},
+			lastCompactProgress: &last2Day,
+			expectedTruncateTS:  getTSO(last1Day),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logBackup := fakeLogBackup(&last5Day, &last10Min)
+			if tc.truncateUntil != nil {
+				logBackup.Status.LogSuccessTruncateUntil = getTSOStr(*tc.truncateUntil)
+			}
+			bs := &v1alpha1.BackupSchedule{}
+			logBackupStartTs := last5Day
+			if tc.logBackupStartTs != nil {
+				logBackupStartTs = *tc.logBackupStartTs
+			}
+			bs.Status.LogBackupStartTs = &metav1.Time{Time: time.Unix(logBackupStartTs, 0)}
+			if tc.minCompactStartTs != nil {
+				minCompactStartTs := getTSOStr(*tc.minCompactStartTs)
+				bs.Spec.MinCompactStartTs = &minCompactStartTs
+			}
+			if tc.lastCompactProgress != nil {
+				bs.Status.LastCompactProgress = &metav1.Time{Time: time.Unix(*tc.lastCompactProgress, 0)}
+			}
+			ts, err := calCompactedTruncateTSO(bs, backups, logBackup, tc.compacts, truncateTSO)
+			g.Expect(err).Should(BeNil())
+			g.Expect(ts).Should(Equal(tc.expectedTruncateTS))
+		})
+	}
+
+	// the compacts over the log backup not truncated are not expired
+	logBackup := fakeLogBackup(&last5Day, &last10Min)
+	logBackup.Spec.LogTruncateUntil = getTSOStr(last3Day)
+	compacts := []*v1alpha1.CompactBackup{
+		fakeCompact(last5Day, last4Day, v1alpha1.BackupComplete),
+		fakeCompact(last4Day, last3Day, v1alpha1.BackupComplete),
+		fakeCompact(last3Day, last2Day, v1alpha1.BackupComplete),
+	}
+	expired, err := excludeCompactsNotTruncated(compacts, logBackup)
+	g.Expect(err).Should(BeNil())
+	g.Expect(expired).Should(Equal(compacts[:2]))
+}
+
 type helper struct {
 	t    *testing.T
 	deps *controller.Dependencies
@@ -20,6 +20,9 @@ const (
 	// BackupRootPath is the root path to backup data
 	BackupRootPath = "/backup"
 
+	// LightningSortedKVPath is the path to the sorted KV files of TiDB Lightning in the physical import mode
+	LightningSortedKVPath = "/sorted-kv"
+
 	// DefaultStorageSize is the default pvc request storage size for backup and restore
 	DefaultStorageSize = "100Gi"
 
@@ -53,6 +56,15 @@ const (
 	// AzblobTenantID represents the Azure Directory (tenant) ID for the application using AAD credtentials in related secret
 	AzblobTenantID = "AZURE_TENANT_ID"
 
+	// WarmupRestoreEnv is the env of the warmup job which refers to the owning restore in the form of <namespace>/<name>
+	WarmupRestoreEnv = "WARMUP_RESTORE"
+
+	// WarmupProgressKeyEnv is the env of the warmup job which is the key of the progress in the status of the restore
+	WarmupProgressKeyEnv = "WARMUP_PROGRESS_KEY"
+
+	// WarmupOptionsEnv is the env of the warmup job which holds the extra options of the file warmup
+	WarmupOptionsEnv = "WARMUP_OPTIONS"
+
 	// BackupManagerEnvVarPrefix represents the environment variable used for tidb-backup-manager must include this prefix
 	BackupManagerEnvVarPrefix = "BACKUP_MANAGER"
 
@@ -74,6 +86,9 @@ const (
 	// the volumes provisioned by CSI driver on GCEPersistentDisk
 	PdCSIDriver = "pd.csi.storage.gke.io"
 
+	// the volumes provisioned by CSI driver on Azure Disk
+	AzureDiskCSIDriver = "disk.csi.azure.com"
+
 	// the mount path for TiKV data volume
 	TiKVDataVolumeMountPath = "/var/lib/tikv"
 
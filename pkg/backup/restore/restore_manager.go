@@ -175,6 +175,10 @@ func (rm *restoreManager) syncRestoreJob(restore *v1alpha1.Restore) error {
 		restoreNamespace string
 	)
 
+	if restore.IsLightningPhysicalImport() && (v1alpha1.IsRestoreComplete(restore) || v1alpha1.IsRestoreFailed(restore)) {
+		return rm.cleanLightningSortedKVPVC(restore)
+	}
+
 	if restore.Spec.BR == nil {
 		err = backuputil.ValidateRestore(restore, "", false)
 	} else {
@@ -474,6 +478,12 @@ func (rm *restoreManager) validateRestore(r *v1alpha1.Restore, tc *v1alpha1.Tidb
 	if err = rm.checkTiKVEncryption(r, tc); err != nil {
 		return fmt.Errorf("TiKV encryption missmatched with backup with error %v", err)
 	}
+
+	if r.Spec.Warmup != "" {
+		if _, err = rm.getWarmUpStrategy(r); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -661,7 +671,7 @@ func (rm *restoreManager) volumeSnapshotRestore(r *v1alpha1.Restore, tc *v1alpha
 			}
 		}
 
-		s, reason, err := snapshotter.NewSnapshotterForRestore(r.Spec.Mode, rm.deps)
+		s, reason, err := snapshotter.NewSnapshotterForRestore(r, rm.deps)
 		if err != nil {
 			return reason, err
 		}
@@ -688,7 +698,7 @@ func (rm *restoreManager) volumeSnapshotRestore(r *v1alpha1.Restore, tc *v1alpha
 		if err != nil {
 			return reason, err
 		}
-		s, reason, err := snapshotter.NewSnapshotterForRestore(r.Spec.Mode, rm.deps)
+		s, reason, err := snapshotter.NewSnapshotterForRestore(r, rm.deps)
 		if err != nil {
 			return reason, err
 		}
@@ -807,6 +817,21 @@ func (rm *restoreManager) makeImportJob(restore *v1alpha1.Restore) (*batchv1.Job
 		})
 	}
 
+	if restore.IsLightningPhysicalImport() {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "sorted-kv",
+			MountPath: constants.LightningSortedKVPath,
+		})
+		volumes = append(volumes, corev1.Volume{
+			Name: "sorted-kv",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: restore.GetLightningSortedKVPVCName(),
+				},
+			},
+		})
+	}
+
 	jobLabels := util.CombineStringMap(label.NewRestore().Instance(restore.GetInstanceName()).RestoreJob().Restore(name), restore.Labels)
 	podLabels := jobLabels
 	jobAnnotations := restore.Annotations
@@ -1187,7 +1212,8 @@ func (rm *restoreManager) warmUpTiKVVolumesSync(r *v1alpha1.Restore, tc *v1alpha
 			return fmt.Errorf("get warm up job %s/%s error: %s", ns, warmUpJobName, err.Error())
 		}
 
-		warmUpJob, err := rm.makeSyncWarmUpJob(r, tc, podPVCs, warmUpJobName, warmUpImage)
+		tikvPodName := fmt.Sprintf("%s-%d", stsName, number)
+		warmUpJob, err := rm.makeSyncWarmUpJob(r, tc, podPVCs, tikvPodName, warmUpJobName, warmUpImage)
 		if err != nil {
 			return err
 		}
@@ -1263,6 +1289,32 @@ func (rm *restoreManager) warmUpTiKVVolumesAsync(r *v1alpha1.Restore, tc *v1alph
 	}, nil)
 }
 
+// getWarmUpStrategy returns the warmup strategy of the restore, which must be supported by the snapshotter of the restore
+func (rm *restoreManager) getWarmUpStrategy(r *v1alpha1.Restore) (v1alpha1.RestoreWarmupStrategy, error) {
+	s, _, err := snapshotter.NewSnapshotterForRestore(r, rm.deps)
+	if err != nil {
+		return "", err
+	}
+	return selectWarmUpStrategy(r.Spec.WarmupStrategy, s.WarmupStrategies())
+}
+
+// selectWarmUpStrategy returns the strategy if it's one of the supported strategies,
+// the strategy defaults to the first supported strategy if it's not set.
+func selectWarmUpStrategy(strategy v1alpha1.RestoreWarmupStrategy, supported []v1alpha1.RestoreWarmupStrategy) (v1alpha1.RestoreWarmupStrategy, error) {
+	if len(supported) == 0 {
+		return "", fmt.Errorf("warmup is not supported by the snapshotter")
+	}
+	if strategy == "" {
+		return supported[0], nil
+	}
+	for _, s := range supported {
+		if s == strategy {
+			return strategy, nil
+		}
+	}
+	return "", fmt.Errorf("warmup strategy %q is not supported by the snapshotter, supported strategies: %v", strategy, supported)
+}
+
 func generateWarmUpArgs(strategy v1alpha1.RestoreWarmupStrategy, mountPoints []corev1.VolumeMount) ([]string, error) {
 	res := make([]string, 0, len(mountPoints))
 	if strategy == v1alpha1.RestoreWarmupStrategyCheckOnly {
@@ -1293,6 +1345,8 @@ func generateWarmUpArgs(strategy v1alpha1.RestoreWarmupStrategy, mountPoints []c
 			} else {
 				res = append(res, "--block", p.MountPath)
 			}
+		case v1alpha1.RestoreWarmupStrategyBlock:
+			res = append(res, "--raw", p.MountPath)
 		default:
 			return nil, fmt.Errorf("unknown warmup strategy %q", strategy)
 		}
@@ -1300,7 +1354,35 @@ func generateWarmUpArgs(strategy v1alpha1.RestoreWarmupStrategy, mountPoints []c
 	return res, nil
 }
 
-func (rm *restoreManager) makeSyncWarmUpJob(r *v1alpha1.Restore, tc *v1alpha1.TidbCluster, pvcs []*pvcInfo, warmUpJobName, warmUpImage string) (*batchv1.Job, error) {
+// makeWarmUpEnv returns the env of the warmup job, with which the warmup reports the progress of the volumes of the TiKV pod
+// to the status of the restore if it's enabled.
+func makeWarmUpEnv(r *v1alpha1.Restore, tikvPodName string) []corev1.EnvVar {
+	var env []corev1.EnvVar
+	if r.Spec.ReportWarmupProgress {
+		env = append(env,
+			corev1.EnvVar{Name: constants.WarmupRestoreEnv, Value: fmt.Sprintf("%s/%s", r.Namespace, r.Name)},
+			corev1.EnvVar{Name: constants.WarmupProgressKeyEnv, Value: tikvPodName},
+		)
+	}
+	if len(r.Spec.WarmupOptions) > 0 {
+		env = append(env, corev1.EnvVar{Name: constants.WarmupOptionsEnv, Value: strings.Join(r.Spec.WarmupOptions, " ")})
+	}
+	return env
+}
+
+// getWarmUpServiceAccount returns the service account of the warmup job, which needs to get and update the restore
+// to report the progress. The job keeps using the default service account of the namespace if it doesn't report.
+func getWarmUpServiceAccount(r *v1alpha1.Restore) string {
+	if !r.Spec.ReportWarmupProgress {
+		return ""
+	}
+	if r.Spec.ServiceAccount != "" {
+		return r.Spec.ServiceAccount
+	}
+	return constants.DefaultServiceAccountName
+}
+
+func (rm *restoreManager) makeSyncWarmUpJob(r *v1alpha1.Restore, tc *v1alpha1.TidbCluster, pvcs []*pvcInfo, tikvPodName, warmUpJobName, warmUpImage string) (*batchv1.Job, error) {
 	podVolumes := make([]corev1.Volume, 0, len(pvcs))
 	podVolumeMounts := make([]corev1.VolumeMount, 0, len(pvcs))
 	for _, pvc := range pvcs {
@@ -1348,7 +1430,11 @@ func (rm *restoreManager) makeSyncWarmUpJob(r *v1alpha1.Restore, tc *v1alpha1.Ti
 		}
 	}
 
-	args, err := generateWarmUpArgs(r.Spec.WarmupStrategy, podVolumeMounts)
+	strategy, err := rm.getWarmUpStrategy(r)
+	if err != nil {
+		return nil, err
+	}
+	args, err := generateWarmUpArgs(strategy, podVolumeMounts)
 	if err != nil {
 		return nil, err
 	}
@@ -1363,11 +1449,12 @@ func (rm *restoreManager) makeSyncWarmUpJob(r *v1alpha1.Restore, tc *v1alpha1.Ti
 			Labels:      podLabels,
 		},
 		Spec: corev1.PodSpec{
-			Volumes:       podVolumes,
-			RestartPolicy: corev1.RestartPolicyNever,
-			Affinity:      tc.Spec.TiKV.Affinity.DeepCopy(),
-			NodeSelector:  nodeSelector,
-			Tolerations:   tolerations,
+			ServiceAccountName: getWarmUpServiceAccount(r),
+			Volumes:            podVolumes,
+			RestartPolicy:      corev1.RestartPolicyNever,
+			Affinity:           tc.Spec.TiKV.Affinity.DeepCopy(),
+			NodeSelector:       nodeSelector,
+			Tolerations:        tolerations,
 			Containers: []corev1.Container{
 				{
 					Name:            "warm-up",
@@ -1375,6 +1462,7 @@ func (rm *restoreManager) makeSyncWarmUpJob(r *v1alpha1.Restore, tc *v1alpha1.Ti
 					ImagePullPolicy: corev1.PullIfNotPresent,
 					Command:         []string{"/warmup_steps"},
 					Args:            args,
+					Env:             makeWarmUpEnv(r, tikvPodName),
 					Resources:       *resourceRequirements,
 					VolumeMounts:    podVolumeMounts,
 					SecurityContext: &corev1.SecurityContext{
@@ -1445,7 +1533,11 @@ func (rm *restoreManager) makeAsyncWarmUpJob(r *v1alpha1.Restore, tikvPod *corev
 		warmUpVolumeMounts = append(warmUpVolumeMounts, r.Spec.AdditionalVolumeMounts...)
 	}
 
-	args, err := generateWarmUpArgs(r.Spec.WarmupStrategy, warmUpVolumeMounts)
+	strategy, err := rm.getWarmUpStrategy(r)
+	if err != nil {
+		return nil, err
+	}
+	args, err := generateWarmUpArgs(strategy, warmUpVolumeMounts)
 	if err != nil {
 		return nil, err
 	}
@@ -1459,9 +1551,10 @@ func (rm *restoreManager) makeAsyncWarmUpJob(r *v1alpha1.Restore, tikvPod *corev
 			Labels:      podLabels,
 		},
 		Spec: corev1.PodSpec{
-			Volumes:       warmUpVolumes,
-			RestartPolicy: corev1.RestartPolicyNever,
-			NodeName:      tikvPod.Spec.NodeName,
+			ServiceAccountName: getWarmUpServiceAccount(r),
+			Volumes:            warmUpVolumes,
+			RestartPolicy:      corev1.RestartPolicyNever,
+			NodeName:           tikvPod.Spec.NodeName,
 			Containers: []corev1.Container{
 				{
 					Name:            "warm-up",
@@ -1469,6 +1562,7 @@ func (rm *restoreManager) makeAsyncWarmUpJob(r *v1alpha1.Restore, tikvPod *corev
 					ImagePullPolicy: corev1.PullIfNotPresent,
 					Command:         []string{"/warmup_steps"},
 					Args:            args,
+					Env:             makeWarmUpEnv(r, podName),
 					VolumeMounts:    warmUpVolumeMounts,
 					SecurityContext: &corev1.SecurityContext{
 						Privileged: pointer.BoolPtr(true),
@@ -1561,29 +1655,64 @@ func (rm *restoreManager) waitWarmUpJobsFinished(r *v1alpha1.Restore) error {
 }
 
 func (rm *restoreManager) ensureRestorePVCExist(restore *v1alpha1.Restore) (string, error) {
+	if reason, err := rm.ensurePVCExist(restore, restore.GetRestorePVCName(), restore.Spec.StorageSize, nil); err != nil {
+		return reason, err
+	}
+	if restore.IsLightningPhysicalImport() {
+		// the sorted KV files are only used by this restore, so the pvc is owned by it
+		ownerRefs := []metav1.OwnerReference{controller.GetRestoreOwnerRef(restore)}
+		return rm.ensurePVCExist(restore, restore.GetLightningSortedKVPVCName(), restore.GetLightningSortedKVStorageSize(), ownerRefs)
+	}
+	return "", nil
+}
+
+// cleanLightningSortedKVPVC deletes the pvc of the sorted KV files after the physical import completes or fails,
+// kubernetes keeps the pvc until the import pod using it exits
+func (rm *restoreManager) cleanLightningSortedKVPVC(restore *v1alpha1.Restore) error {
+	ns := restore.GetNamespace()
+	name := restore.GetName()
+	pvcName := restore.GetLightningSortedKVPVCName()
+
+	pvc, err := rm.deps.PVCLister.PersistentVolumeClaims(ns).Get(pvcName)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("restore %s/%s get pvc %s failed, err: %v", ns, name, pvcName, err)
+	}
+	if pvc.DeletionTimestamp != nil {
+		return nil
+	}
+	if err := rm.deps.GeneralPVCControl.DeletePVC(restore, pvc); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("restore %s/%s delete pvc %s failed, err: %v", ns, name, pvcName, err)
+	}
+	return nil
+}
+
+// ensurePVCExist ensures the pvc of the storage size exists, the storage size defaults to DefaultStorageSize
+func (rm *restoreManager) ensurePVCExist(restore *v1alpha1.Restore, pvcName, storageSize string, ownerRefs []metav1.OwnerReference) (string, error) {
 	ns := restore.GetNamespace()
 	name := restore.GetName()
 
-	storageSize := constants.DefaultStorageSize
-	if restore.Spec.StorageSize != "" {
-		storageSize = restore.Spec.StorageSize
+	if storageSize == "" {
+		storageSize = constants.DefaultStorageSize
 	}
 	rs, err := resource.ParseQuantity(storageSize)
 	if err != nil {
-		errMsg := fmt.Errorf("backup %s/%s parse storage size %s failed, err: %v", ns, name, constants.DefaultStorageSize, err)
+		errMsg := fmt.Errorf("backup %s/%s parse storage size %s failed, err: %v", ns, name, storageSize, err)
 		return "ParseStorageSizeFailed", errMsg
 	}
 
-	restorePVCName := restore.GetRestorePVCName()
-	pvc, err := rm.deps.PVCLister.PersistentVolumeClaims(ns).Get(restorePVCName)
+	pvc, err := rm.deps.PVCLister.PersistentVolumeClaims(ns).Get(pvcName)
 	if err != nil {
 		// get the object from the local cache, the error can only be IsNotFound,
 		// so we need to create PVC for restore job
 		pvc := &corev1.PersistentVolumeClaim{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      restorePVCName,
-				Namespace: ns,
-				Labels:    label.NewRestore().Instance(restore.GetInstanceName()),
+				Name:            pvcName,
+				Namespace:       ns,
+				Labels:          label.NewRestore().Instance(restore.GetInstanceName()),
+				OwnerReferences: ownerRefs,
 			},
 			Spec: corev1.PersistentVolumeClaimSpec{
 				AccessModes: []corev1.PersistentVolumeAccessMode{
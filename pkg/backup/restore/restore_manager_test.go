@@ -27,11 +27,14 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	"github.com/pingcap/tidb-operator/pkg/backup/snapshotter"
 	"github.com/pingcap/tidb-operator/pkg/backup/testutils"
+	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/utils/pointer"
@@ -277,6 +280,61 @@ func TestLightningRestore(t *testing.T) {
 	g.Expect(job.Spec.Template.Spec.Containers[0].Env).NotTo(gomega.ContainElement(env2No))
 }
 
+func TestLightningPhysicalImportRestore(t *testing.T) {
+	g := NewGomegaWithT(t)
+	helper := newHelper(t)
+	defer helper.Close()
+	deps := helper.Deps
+
+	restore := validDumpRestore.DeepCopy()
+	restore.Namespace = "ns"
+	restore.Name = "physical"
+	restore.Spec.Lightning = &v1alpha1.LightningConfig{Backend: v1alpha1.LightningBackendLocal, SortedKVStorageSize: "2G"}
+	helper.createRestore(restore)
+	helper.CreateSecret(restore)
+
+	m := NewRestoreManager(deps)
+	g.Expect(m.Sync(restore)).Should(Succeed())
+	helper.hasCondition(restore.Namespace, restore.Name, v1alpha1.RestoreScheduled, "")
+
+	// the sorted KV pvc is sized separately and owned by the restore
+	pvc, err := deps.PVCLister.PersistentVolumeClaims(restore.Namespace).Get(restore.GetLightningSortedKVPVCName())
+	g.Expect(err).Should(BeNil())
+	g.Expect(pvc.Spec.Resources.Requests.Storage().String()).Should(Equal("2G"))
+	g.Expect(pvc.OwnerReferences).Should(Equal([]metav1.OwnerReference{controller.GetRestoreOwnerRef(restore)}))
+	pvc, err = deps.PVCLister.PersistentVolumeClaims(restore.Namespace).Get(restore.GetRestorePVCName())
+	g.Expect(err).Should(BeNil())
+	g.Expect(pvc.Spec.Resources.Requests.Storage().String()).Should(Equal(restore.Spec.StorageSize))
+	g.Expect(pvc.OwnerReferences).Should(BeEmpty())
+
+	job, err := deps.KubeClientset.BatchV1().Jobs(restore.Namespace).Get(context.TODO(), restore.GetRestoreJobName(), metav1.GetOptions{})
+	g.Expect(err).Should(BeNil())
+	g.Expect(job.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+		Name: "sorted-kv",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: restore.GetLightningSortedKVPVCName()},
+		},
+	}))
+	g.Expect(job.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+		Name:      "sorted-kv",
+		MountPath: constants.LightningSortedKVPath,
+	}))
+
+	// the sorted KV pvc is deleted once the restore completes, the restore pvc is kept
+	v1alpha1.UpdateRestoreCondition(&restore.Status, &v1alpha1.RestoreCondition{
+		Type:   v1alpha1.RestoreComplete,
+		Status: corev1.ConditionTrue,
+	})
+	g.Expect(m.Sync(restore)).Should(Succeed())
+	g.Eventually(func() bool {
+		_, err := deps.PVCLister.PersistentVolumeClaims(restore.Namespace).Get(restore.GetLightningSortedKVPVCName())
+		return errors.IsNotFound(err)
+	}, time.Second*10).Should(BeTrue())
+	_, err = deps.PVCLister.PersistentVolumeClaims(restore.Namespace).Get(restore.GetRestorePVCName())
+	g.Expect(err).Should(BeNil())
+	g.Expect(m.Sync(restore)).Should(Succeed())
+}
+
 func TestBRRestore(t *testing.T) {
 	g := NewGomegaWithT(t)
 	helper := newHelper(t)
@@ -1050,6 +1108,11 @@ func TestGenerateWarmUpArgs(t *testing.T) {
 			strategy: v1alpha1.RestoreWarmupStrategyCheckOnly,
 			expected: []string{"--exit-on-corruption", "--block", "/logs"},
 		},
+		{
+			name:     "all by raw block",
+			strategy: v1alpha1.RestoreWarmupStrategyBlock,
+			expected: []string{"--raw", constants.TiKVDataVolumeMountPath, "--raw", "/logs"},
+		},
 		{
 			name:     "unknown strategy",
 			strategy: "unknown",
@@ -1069,6 +1132,134 @@ func TestGenerateWarmUpArgs(t *testing.T) {
 	}
 }
 
+func TestSelectWarmUpStrategy(t *testing.T) {
+	aws := (&snapshotter.AWSSnapshotter{}).WarmupStrategies()
+	gcp := (&snapshotter.GCPSnapshotter{}).WarmupStrategies()
+	none := (&snapshotter.NoneSnapshotter{}).WarmupStrategies()
+	testCases := []struct {
+		name      string
+		strategy  v1alpha1.RestoreWarmupStrategy
+		supported []v1alpha1.RestoreWarmupStrategy
+		expected  v1alpha1.RestoreWarmupStrategy
+		errMsg    string
+	}{
+		{
+			name:      "aws defaults to hybrid",
+			supported: aws,
+			expected:  v1alpha1.RestoreWarmupStrategyHybrid,
+		},
+		{
+			name:      "aws supports block",
+			strategy:  v1alpha1.RestoreWarmupStrategyBlock,
+			supported: aws,
+			expected:  v1alpha1.RestoreWarmupStrategyBlock,
+		},
+		{
+			name:      "gcp defaults to block",
+			supported: gcp,
+			expected:  v1alpha1.RestoreWarmupStrategyBlock,
+		},
+		{
+			name:      "gcp doesn't support fsr",
+			strategy:  v1alpha1.RestoreWarmupStrategyFsr,
+			supported: gcp,
+			errMsg:    `warmup strategy "fsr" is not supported by the snapshotter, supported strategies: [block]`,
+		},
+		{
+			name:      "no warmup without snapshots",
+			supported: none,
+			errMsg:    "warmup is not supported by the snapshotter",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			strategy, err := selectWarmUpStrategy(tc.strategy, tc.supported)
+			if tc.errMsg != "" {
+				require.EqualError(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, strategy)
+		})
+	}
+}
+
+func TestMakeSyncWarmUpJobPerCloud(t *testing.T) {
+	rm := &restoreManager{deps: controller.NewFakeDependencies()}
+	tc := &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "basic"},
+		Spec:       v1alpha1.TidbClusterSpec{TiKV: &v1alpha1.TiKVSpec{}},
+	}
+	pvcs := []*pvcInfo{{
+		pvc:        &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "tikv-basic-tikv-0"}},
+		volumeName: "tikv",
+	}}
+	testCases := []struct {
+		name     string
+		provider v1alpha1.StorageProvider
+		expected []string
+	}{
+		{
+			name:     "aws",
+			provider: v1alpha1.StorageProvider{S3: &v1alpha1.S3StorageProvider{Bucket: "backup"}},
+			expected: []string{"--fs", constants.TiKVDataVolumeMountPath},
+		},
+		{
+			name:     "gcp",
+			provider: v1alpha1.StorageProvider{Gcs: &v1alpha1.GcsStorageProvider{Bucket: "backup"}},
+			expected: []string{"--raw", constants.TiKVDataVolumeMountPath},
+		},
+		{
+			name:     "azure",
+			provider: v1alpha1.StorageProvider{Azblob: &v1alpha1.AzblobStorageProvider{Container: "backup"}},
+			expected: []string{"--raw", constants.TiKVDataVolumeMountPath},
+		},
+	}
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			r := &v1alpha1.Restore{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "restore"},
+				Spec: v1alpha1.RestoreSpec{
+					Mode:            v1alpha1.RestoreModeVolumeSnapshot,
+					Warmup:          v1alpha1.RestoreWarmupModeSync,
+					StorageProvider: c.provider,
+				},
+			}
+			job, err := rm.makeSyncWarmUpJob(r, tc, pvcs, "basic-tikv-0", "warmup-basic-tikv-0", "warmup:latest")
+			require.NoError(t, err)
+			require.Equal(t, c.expected, job.Spec.Template.Spec.Containers[0].Args)
+		})
+	}
+}
+
+func TestMakeWarmUpEnv(t *testing.T) {
+	r := &v1alpha1.Restore{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "restore"},
+	}
+	// the progress isn't reported by default, so the job keeps the default service account
+	require.Empty(t, makeWarmUpEnv(r, "basic-tikv-0"))
+	require.Equal(t, "", getWarmUpServiceAccount(r))
+	r.Spec.ServiceAccount = "restore-sa"
+	require.Equal(t, "", getWarmUpServiceAccount(r))
+
+	r.Spec.ReportWarmupProgress = true
+	r.Spec.ServiceAccount = ""
+	require.Equal(t, []corev1.EnvVar{
+		{Name: constants.WarmupRestoreEnv, Value: "ns/restore"},
+		{Name: constants.WarmupProgressKeyEnv, Value: "basic-tikv-0"},
+	}, makeWarmUpEnv(r, "basic-tikv-0"))
+	require.Equal(t, constants.DefaultServiceAccountName, getWarmUpServiceAccount(r))
+
+	r.Spec.WarmupOptions = []string{"--adaptive", "--adaptive.target-throughput=200"}
+	r.Spec.ServiceAccount = "restore-sa"
+	require.Equal(t, []corev1.EnvVar{
+		{Name: constants.WarmupRestoreEnv, Value: "ns/restore"},
+		{Name: constants.WarmupProgressKeyEnv, Value: "basic-tikv-0"},
+		{Name: constants.WarmupOptionsEnv, Value: "--adaptive --adaptive.target-throughput=200"},
+	}, makeWarmUpEnv(r, "basic-tikv-0"))
+	require.Equal(t, "restore-sa", getWarmUpServiceAccount(r))
+}
+
 func TestPiTRRestore(t *testing.T) {
 	g := NewGomegaWithT(t)
 	helper := newHelper(t)
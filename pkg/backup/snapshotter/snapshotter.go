@@ -61,6 +61,10 @@ type Snapshotter interface {
 	AddVolumeTags(pvs []*corev1.PersistentVolume) error
 
 	CleanVolumes(r *v1alpha1.Restore, csb *CloudSnapBackup) error
+
+	// WarmupStrategies returns the warmup strategies supported by the volumes
+	// restored from the snapshots, the first one is the default.
+	WarmupStrategies() []v1alpha1.RestoreWarmupStrategy
 }
 
 type BaseSnapshotter struct {
@@ -94,15 +98,20 @@ func NewSnapshotterForBackup(m v1alpha1.BackupMode, d *controller.Dependencies)
 	return s, "", nil
 }
 
-func NewSnapshotterForRestore(m v1alpha1.RestoreMode, d *controller.Dependencies) (Snapshotter, string, error) {
+// NewSnapshotterForRestore returns the snapshotter of the cloud where the volume snapshots of the restore are,
+// which is inferred from the storage of the backup metadata, AWS is used if it is not GCS or Azure Blob Storage.
+// The warmup of the restore follows the strategies of the snapshotter picked here.
+func NewSnapshotterForRestore(r *v1alpha1.Restore, d *controller.Dependencies) (Snapshotter, string, error) {
 	var s Snapshotter
-	switch m {
-	case v1alpha1.RestoreModeVolumeSnapshot:
-		// Currently, we only support aws volume snapshot. If gcp volume snapshot is supported
-		// in the future, we can infer the provider from the storage class.
-		s = &AWSSnapshotter{}
-	default:
+	switch {
+	case r.Spec.Mode != v1alpha1.RestoreModeVolumeSnapshot:
 		s = &NoneSnapshotter{}
+	case r.Spec.Gcs != nil:
+		s = &GCPSnapshotter{}
+	case r.Spec.Azblob != nil:
+		s = &AzureSnapshotter{}
+	default:
+		s = &AWSSnapshotter{}
 	}
 	err := s.Init(d, nil)
 	if err != nil {
@@ -166,3 +166,15 @@ func (s *AWSSnapshotter) CleanVolumes(r *v1alpha1.Restore, csb *CloudSnapBackup)
 	}
 	return nil
 }
+
+// WarmupStrategies returns all the warmup strategies, the strategies except for block rely on
+// the lazy loading or Fast Snapshot Restore of EBS.
+func (s *AWSSnapshotter) WarmupStrategies() []v1alpha1.RestoreWarmupStrategy {
+	return []v1alpha1.RestoreWarmupStrategy{
+		v1alpha1.RestoreWarmupStrategyHybrid,
+		v1alpha1.RestoreWarmupStrategyFio,
+		v1alpha1.RestoreWarmupStrategyFsr,
+		v1alpha1.RestoreWarmupStrategyCheckOnly,
+		v1alpha1.RestoreWarmupStrategyBlock,
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshotter
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// The AzureSnapshotter for creating snapshots from volumes (during a backup)
+// and volumes from snapshots (during a restore) on Azure Managed Disks.
+type AzureSnapshotter struct {
+	BaseSnapshotter
+}
+
+func (s *AzureSnapshotter) Init(deps *controller.Dependencies, conf map[string]string) error {
+	err := s.BaseSnapshotter.Init(deps, conf)
+	s.volRegexp = regexp.MustCompile(`(?i)^\/subscriptions\/[^\/]+\/resourceGroups\/[^\/]+\/providers\/Microsoft\.Compute\/disks\/[^\/]+$`)
+	return err
+}
+
+func (s *AzureSnapshotter) GetVolumeID(pv *corev1.PersistentVolume) (string, error) {
+	if pv == nil {
+		return "", nil
+	}
+
+	if pv.Spec.CSI != nil {
+		driver := pv.Spec.CSI.Driver
+		if driver == constants.AzureDiskCSIDriver {
+			handle := pv.Spec.CSI.VolumeHandle
+			if !s.volRegexp.MatchString(handle) {
+				return "", fmt.Errorf("invalid volumeHandle for CSI driver:%s, expected /subscriptions/{subscription}/resourceGroups/{group}/providers/Microsoft.Compute/disks/{name}, got %s",
+					constants.AzureDiskCSIDriver, handle)
+			}
+			return handle[strings.LastIndex(handle, "/")+1:], nil
+		}
+		return "", fmt.Errorf("unable to handle CSI driver: %s", driver)
+	}
+
+	if pv.Spec.AzureDisk != nil {
+		if pv.Spec.AzureDisk.DiskName == "" {
+			return "", fmt.Errorf("spec.azureDisk.diskName not found")
+		}
+		return pv.Spec.AzureDisk.DiskName, nil
+	}
+
+	return "", nil
+}
+
+func (s *AzureSnapshotter) GenerateBackupMetadata(b *v1alpha1.Backup, tc *v1alpha1.TidbCluster) (*CloudSnapBackup, string, error) {
+	return s.BaseSnapshotter.generateBackupMetadata(b, tc, s)
+}
+
+func (s *AzureSnapshotter) SetVolumeID(pv *corev1.PersistentVolume, volumeID string) error {
+	if pv.Spec.CSI != nil {
+		// PV is provisioned by CSI driver
+		driver := pv.Spec.CSI.Driver
+		if driver == constants.AzureDiskCSIDriver {
+			handle := pv.Spec.CSI.VolumeHandle
+			// To restore in the same resource group, here we only replace the 'disk' chunk.
+			if !s.volRegexp.MatchString(handle) {
+				return fmt.Errorf("invalid volumeHandle for restore with CSI driver:%s, expected /subscriptions/{subscription}/resourceGroups/{group}/providers/Microsoft.Compute/disks/{name}, got %s",
+					constants.AzureDiskCSIDriver, handle)
+			}
+			pv.Spec.CSI.VolumeHandle = handle[:strings.LastIndex(handle, "/")+1] + volumeID
+		} else {
+			return fmt.Errorf("unable to handle CSI driver: %s", driver)
+		}
+	} else if pv.Spec.AzureDisk != nil {
+		// PV is provisioned by in-tree driver
+		disk := pv.Spec.AzureDisk
+		disk.DiskName = volumeID
+		if i := strings.LastIndex(disk.DataDiskURI, "/"); i >= 0 {
+			disk.DataDiskURI = disk.DataDiskURI[:i+1] + volumeID
+		}
+	} else {
+		return errors.New("spec.csi and spec.azureDisk not found")
+	}
+
+	return nil
+}
+
+func (s *AzureSnapshotter) PrepareRestoreMetadata(r *v1alpha1.Restore, csb *CloudSnapBackup) (string, error) {
+	return s.BaseSnapshotter.prepareRestoreMetadata(r, csb, s)
+}
+
+func (s *AzureSnapshotter) ResetPvAvailableZone(r *v1alpha1.Restore, pv *corev1.PersistentVolume) {
+	// TODO implement it if support to restore snapshots to another az on Azure
+}
+
+func (s *AzureSnapshotter) AddVolumeTags(pvs []*corev1.PersistentVolume) error {
+	// TODO implement it if support to restore snapshots to another az on Azure
+	return nil
+}
+
+func (s *AzureSnapshotter) CleanVolumes(r *v1alpha1.Restore, csb *CloudSnapBackup) error {
+	// TODO implement it if support to restore snapshots on Azure
+	return nil
+}
+
+// WarmupStrategies returns the block strategy only, the disks restored from snapshots are hydrated
+// in the background and every block is read once to hydrate them, the other strategies are tuned for EBS.
+func (s *AzureSnapshotter) WarmupStrategies() []v1alpha1.RestoreWarmupStrategy {
+	return []v1alpha1.RestoreWarmupStrategy{v1alpha1.RestoreWarmupStrategyBlock}
+}
@@ -112,3 +112,8 @@ func (s *GCPSnapshotter) CleanVolumes(r *v1alpha1.Restore, csb *CloudSnapBackup)
 	// TODO implement it if support to restore snapshots on GCP
 	return nil
 }
+
+// WarmupStrategies returns the block strategy only, the other strategies are tuned for EBS.
+func (s *GCPSnapshotter) WarmupStrategies() []v1alpha1.RestoreWarmupStrategy {
+	return []v1alpha1.RestoreWarmupStrategy{v1alpha1.RestoreWarmupStrategyBlock}
+}
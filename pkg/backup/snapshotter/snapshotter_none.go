@@ -52,3 +52,7 @@ func (s *NoneSnapshotter) AddVolumeTags(pvs []*corev1.PersistentVolume) error {
 func (s *NoneSnapshotter) CleanVolumes(r *v1alpha1.Restore, csb *CloudSnapBackup) error {
 	return nil
 }
+
+func (s *NoneSnapshotter) WarmupStrategies() []v1alpha1.RestoreWarmupStrategy {
+	return nil
+}
@@ -159,6 +159,8 @@ func TestGetVolumeIDForCSI(t *testing.T) {
 	sAWS.Init(nil, nil)
 	sGCP := &GCPSnapshotter{}
 	sGCP.Init(nil, nil)
+	sAzure := &AzureSnapshotter{}
+	sAzure.Init(nil, nil)
 
 	cases := []struct {
 		name    string
@@ -270,6 +272,46 @@ func TestGetVolumeIDForCSI(t *testing.T) {
 			want:    "",
 			wantErr: true,
 		},
+		{
+			name: "azure disk csi driver",
+			s:    sAzure,
+			csiPV: &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "pv-6",
+				},
+				Spec: corev1.PersistentVolumeSpec{
+					PersistentVolumeSource: corev1.PersistentVolumeSource{
+						CSI: &corev1.CSIPersistentVolumeSource{
+							Driver:       "disk.csi.azure.com",
+							VolumeHandle: "/subscriptions/sub/resourceGroups/mc_tidb/providers/Microsoft.Compute/disks/pvc-a970184f-6cc1-4769-85ad-61dcaf8bf51d",
+							FSType:       "ext4",
+						},
+					},
+				},
+			},
+			want:    "pvc-a970184f-6cc1-4769-85ad-61dcaf8bf51d",
+			wantErr: false,
+		},
+		{
+			name: "azure disk csi driver with invalid handle name",
+			s:    sAzure,
+			csiPV: &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "pv-7",
+				},
+				Spec: corev1.PersistentVolumeSpec{
+					PersistentVolumeSource: corev1.PersistentVolumeSource{
+						CSI: &corev1.CSIPersistentVolumeSource{
+							Driver:       "disk.csi.azure.com",
+							VolumeHandle: "pvc-a970184f-6cc1-4769-85ad-61dcaf8bf51d",
+							FSType:       "ext4",
+						},
+					},
+				},
+			},
+			want:    "",
+			wantErr: true,
+		},
 	}
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -743,6 +785,8 @@ func TestSetVolumeIDForCSI(t *testing.T) {
 	sAWS.Init(nil, nil)
 	sGCP := &GCPSnapshotter{}
 	sGCP.Init(nil, nil)
+	sAzure := &AzureSnapshotter{}
+	sAzure.Init(nil, nil)
 
 	cases := []struct {
 		name     string
@@ -850,6 +894,26 @@ func TestSetVolumeIDForCSI(t *testing.T) {
 			volumeID: "restore-fd9729b5-868b-4544-9568-1c5d9121dabc",
 			wantErr:  true,
 		},
+		{
+			name: "set ID to CSI with azure disk CSI driver",
+			s:    sAzure,
+			csiPV: &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "pv-6",
+				},
+				Spec: corev1.PersistentVolumeSpec{
+					PersistentVolumeSource: corev1.PersistentVolumeSource{
+						CSI: &corev1.CSIPersistentVolumeSource{
+							Driver:       "disk.csi.azure.com",
+							VolumeHandle: "/subscriptions/sub/resourceGroups/mc_tidb/providers/Microsoft.Compute/disks/pvc-a970184f-6cc1-4769-85ad-61dcaf8bf51d",
+							FSType:       "ext4",
+						},
+					},
+				},
+			},
+			volumeID: "restore-fd9729b5-868b-4544-9568-1c5d9121dabc",
+			wantErr:  false,
+		},
 	}
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -862,12 +926,13 @@ func TestSetVolumeIDForCSI(t *testing.T) {
 			// happy path
 			require.NoError(t, err)
 			newPV := tt.csiPV.DeepCopy()
-			if _, ok := tt.s.(*GCPSnapshotter); ok {
+			switch tt.s.(type) {
+			case *GCPSnapshotter, *AzureSnapshotter:
 				orilVolHandle := tt.csiPV.Spec.CSI.VolumeHandle
 				ind := strings.LastIndex(newPV.Spec.CSI.VolumeHandle, "/")
 				assert.Equal(t, tt.volumeID, newPV.Spec.CSI.VolumeHandle[ind+1:])
 				assert.Equal(t, orilVolHandle[:ind], newPV.Spec.CSI.VolumeHandle[:ind])
-			} else {
+			default:
 				assert.Equal(t, tt.volumeID, newPV.Spec.CSI.VolumeHandle)
 			}
 		})
@@ -893,7 +958,7 @@ func TestPrepareRestoreMetadata(t *testing.T) {
 		},
 	}
 
-	s, _, err := NewSnapshotterForRestore(restore.Spec.Mode, deps)
+	s, _, err := NewSnapshotterForRestore(restore, deps)
 	require.NoError(t, err)
 
 	// missing .annotation["tidb.pingcap.com/backup-cloud-snapshot"] as metadata
@@ -473,7 +473,7 @@ func ValidateBackup(backup *v1alpha1.Backup, tikvImage string, tc *v1alpha1.Tidb
 		if reason := validateAccessConfig(backup.Spec.From); reason != "" {
 			return fmt.Errorf(reason, ns, name)
 		}
-		if backup.Spec.StorageSize == "" {
+		if backup.Spec.StorageSize == "" && !backup.IsDumplingStreaming() {
 			return fmt.Errorf("missing StorageSize config in spec of %s/%s", ns, name)
 		}
 	} else {
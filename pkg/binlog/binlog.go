@@ -99,6 +99,14 @@ type StatusResp struct {
 	Message string `json:"message"`
 }
 
+// NodeCommitTS is the max commit ts registered by a pump or drainer, which is the checkpoint of a drainer.
+type NodeCommitTS struct {
+	NodeID      string `json:"nodeId"`
+	Host        string `json:"host"`
+	State       string `json:"state"`
+	MaxCommitTS int64  `json:"maxCommitTS"`
+}
+
 // IsPumpTombstone check if drainer is tombstone.
 func (c *Client) IsPumpTombstone(ctx context.Context, addr string) (bool, error) {
 	nodeID, err := c.nodeID(ctx, addr, "pumps")
@@ -139,11 +147,23 @@ func (c *Client) PumpNodeStatus(ctx context.Context) (status []*v1alpha1.PumpNod
 	return c.nodeStatus(ctx, "pumps")
 }
 
-// nolint (unused)
-func (c *Client) drainerNodeStatus(ctx context.Context) (status []*v1alpha1.PumpNodeStatus, err error) {
+func (c *Client) DrainerNodeStatus(ctx context.Context) (status []*v1alpha1.PumpNodeStatus, err error) {
 	return c.nodeStatus(ctx, "drainers")
 }
 
+// DrainerCommitTS returns the max commit ts of the drainers.
+func (c *Client) DrainerCommitTS(ctx context.Context) (commitTS []*NodeCommitTS, err error) {
+	err = c.listNodes(ctx, "drainers", func(data []byte) error {
+		var ts NodeCommitTS
+		if err := json.Unmarshal(data, &ts); err != nil {
+			return err
+		}
+		commitTS = append(commitTS, &ts)
+		return nil
+	})
+	return
+}
+
 func (c *Client) nodeID(ctx context.Context, addr, ty string) (string, error) {
 	nodes, err := c.nodeStatus(ctx, ty)
 	if err != nil {
@@ -220,26 +240,35 @@ func (c *Client) updateStatus(ctx context.Context, ty string, nodeID string, sta
 }
 
 func (c *Client) nodeStatus(ctx context.Context, ty string) (status []*v1alpha1.PumpNodeStatus, err error) {
+	err = c.listNodes(ctx, ty, func(data []byte) error {
+		var s v1alpha1.PumpNodeStatus
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		status = append(status, &s)
+		return nil
+	})
+	return
+}
+
+// listNodes calls fn with the data of each node of the type registered in PD.
+func (c *Client) listNodes(ctx context.Context, ty string, fn func(data []byte) error) error {
 	key := fmt.Sprintf("/tidb-binlog/v1/%s", ty)
 
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 	resp, err := c.etcdClient.KV.Get(ctx, key, clientv3.WithPrefix())
 	if err != nil {
-		return nil, errors.AddStack(err)
+		return errors.AddStack(err)
 	}
 
 	for _, kv := range resp.Kvs {
-		var s v1alpha1.PumpNodeStatus
-		err = json.Unmarshal(kv.Value, &s)
-		if err != nil {
-			return nil, errors.Annotatef(err, "key: %s, data: %s", string(kv.Key), string(kv.Value))
+		if err := fn(kv.Value); err != nil {
+			return errors.Annotatef(err, "key: %s, data: %s", string(kv.Key), string(kv.Value))
 		}
-
-		status = append(status, &s)
 	}
 
-	return
+	return nil
 }
 
 func (c *Client) offline(addr string, nodeID string) error {
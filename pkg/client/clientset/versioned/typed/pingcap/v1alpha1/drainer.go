@@ -0,0 +1,192 @@
+// Copyright PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	scheme "github.com/pingcap/tidb-operator/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// DrainersGetter has a method to return a DrainerInterface.
+// A group's client should implement this interface.
+type DrainersGetter interface {
+	Drainers(namespace string) DrainerInterface
+}
+
+// DrainerInterface has methods to work with Drainer resources.
+type DrainerInterface interface {
+	Create(ctx context.Context, drainer *v1alpha1.Drainer, opts v1.CreateOptions) (*v1alpha1.Drainer, error)
+	Update(ctx context.Context, drainer *v1alpha1.Drainer, opts v1.UpdateOptions) (*v1alpha1.Drainer, error)
+	UpdateStatus(ctx context.Context, drainer *v1alpha1.Drainer, opts v1.UpdateOptions) (*v1alpha1.Drainer, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.Drainer, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.DrainerList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Drainer, err error)
+	DrainerExpansion
+}
+
+// drainers implements DrainerInterface
+type drainers struct {
+	client rest.Interface
+	ns     string
+}
+
+// newDrainers returns a Drainers
+func newDrainers(c *PingcapV1alpha1Client, namespace string) *drainers {
+	return &drainers{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the drainer, and returns the corresponding drainer object, and an error if there is any.
+func (c *drainers) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.Drainer, err error) {
+	result = &v1alpha1.Drainer{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("drainers").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Drainers that match those selectors.
+func (c *drainers) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.DrainerList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.DrainerList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("drainers").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested drainers.
+func (c *drainers) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("drainers").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a drainer and creates it.  Returns the server's representation of the drainer, and an error, if there is any.
+func (c *drainers) Create(ctx context.Context, drainer *v1alpha1.Drainer, opts v1.CreateOptions) (result *v1alpha1.Drainer, err error) {
+	result = &v1alpha1.Drainer{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("drainers").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(drainer).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a drainer and updates it. Returns the server's representation of the drainer, and an error, if there is any.
+func (c *drainers) Update(ctx context.Context, drainer *v1alpha1.Drainer, opts v1.UpdateOptions) (result *v1alpha1.Drainer, err error) {
+	result = &v1alpha1.Drainer{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("drainers").
+		Name(drainer.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(drainer).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *drainers) UpdateStatus(ctx context.Context, drainer *v1alpha1.Drainer, opts v1.UpdateOptions) (result *v1alpha1.Drainer, err error) {
+	result = &v1alpha1.Drainer{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("drainers").
+		Name(drainer.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(drainer).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the drainer and deletes it. Returns an error if one occurs.
+func (c *drainers) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("drainers").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *drainers) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("drainers").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched drainer.
+func (c *drainers) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Drainer, err error) {
+	result = &v1alpha1.Drainer{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("drainers").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,138 @@
+// Copyright PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeBinlogMigrations implements BinlogMigrationInterface
+type FakeBinlogMigrations struct {
+	Fake *FakePingcapV1alpha1
+	ns   string
+}
+
+var binlogmigrationsResource = v1alpha1.SchemeGroupVersion.WithResource("binlogmigrations")
+
+var binlogmigrationsKind = v1alpha1.SchemeGroupVersion.WithKind("BinlogMigration")
+
+// Get takes name of the binlogMigration, and returns the corresponding binlogMigration object, and an error if there is any.
+func (c *FakeBinlogMigrations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.BinlogMigration, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(binlogmigrationsResource, c.ns, name), &v1alpha1.BinlogMigration{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BinlogMigration), err
+}
+
+// List takes label and field selectors, and returns the list of BinlogMigrations that match those selectors.
+func (c *FakeBinlogMigrations) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.BinlogMigrationList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(binlogmigrationsResource, binlogmigrationsKind, c.ns, opts), &v1alpha1.BinlogMigrationList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.BinlogMigrationList{ListMeta: obj.(*v1alpha1.BinlogMigrationList).ListMeta}
+	for _, item := range obj.(*v1alpha1.BinlogMigrationList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested binlogMigrations.
+func (c *FakeBinlogMigrations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(binlogmigrationsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a binlogMigration and creates it.  Returns the server's representation of the binlogMigration, and an error, if there is any.
+func (c *FakeBinlogMigrations) Create(ctx context.Context, binlogMigration *v1alpha1.BinlogMigration, opts v1.CreateOptions) (result *v1alpha1.BinlogMigration, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(binlogmigrationsResource, c.ns, binlogMigration), &v1alpha1.BinlogMigration{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BinlogMigration), err
+}
+
+// Update takes the representation of a binlogMigration and updates it. Returns the server's representation of the binlogMigration, and an error, if there is any.
+func (c *FakeBinlogMigrations) Update(ctx context.Context, binlogMigration *v1alpha1.BinlogMigration, opts v1.UpdateOptions) (result *v1alpha1.BinlogMigration, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(binlogmigrationsResource, c.ns, binlogMigration), &v1alpha1.BinlogMigration{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BinlogMigration), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeBinlogMigrations) UpdateStatus(ctx context.Context, binlogMigration *v1alpha1.BinlogMigration, opts v1.UpdateOptions) (*v1alpha1.BinlogMigration, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(binlogmigrationsResource, "status", c.ns, binlogMigration), &v1alpha1.BinlogMigration{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BinlogMigration), err
+}
+
+// Delete takes name of the binlogMigration and deletes it. Returns an error if one occurs.
+func (c *FakeBinlogMigrations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(binlogmigrationsResource, c.ns, name, opts), &v1alpha1.BinlogMigration{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeBinlogMigrations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(binlogmigrationsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.BinlogMigrationList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched binlogMigration.
+func (c *FakeBinlogMigrations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.BinlogMigration, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(binlogmigrationsResource, c.ns, name, pt, data, subresources...), &v1alpha1.BinlogMigration{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BinlogMigration), err
+}
@@ -0,0 +1,138 @@
+// Copyright PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeDrainers implements DrainerInterface
+type FakeDrainers struct {
+	Fake *FakePingcapV1alpha1
+	ns   string
+}
+
+var drainersResource = v1alpha1.SchemeGroupVersion.WithResource("drainers")
+
+var drainersKind = v1alpha1.SchemeGroupVersion.WithKind("Drainer")
+
+// Get takes name of the drainer, and returns the corresponding drainer object, and an error if there is any.
+func (c *FakeDrainers) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.Drainer, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(drainersResource, c.ns, name), &v1alpha1.Drainer{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Drainer), err
+}
+
+// List takes label and field selectors, and returns the list of Drainers that match those selectors.
+func (c *FakeDrainers) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.DrainerList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(drainersResource, drainersKind, c.ns, opts), &v1alpha1.DrainerList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.DrainerList{ListMeta: obj.(*v1alpha1.DrainerList).ListMeta}
+	for _, item := range obj.(*v1alpha1.DrainerList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested drainers.
+func (c *FakeDrainers) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(drainersResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a drainer and creates it.  Returns the server's representation of the drainer, and an error, if there is any.
+func (c *FakeDrainers) Create(ctx context.Context, drainer *v1alpha1.Drainer, opts v1.CreateOptions) (result *v1alpha1.Drainer, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(drainersResource, c.ns, drainer), &v1alpha1.Drainer{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Drainer), err
+}
+
+// Update takes the representation of a drainer and updates it. Returns the server's representation of the drainer, and an error, if there is any.
+func (c *FakeDrainers) Update(ctx context.Context, drainer *v1alpha1.Drainer, opts v1.UpdateOptions) (result *v1alpha1.Drainer, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(drainersResource, c.ns, drainer), &v1alpha1.Drainer{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Drainer), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeDrainers) UpdateStatus(ctx context.Context, drainer *v1alpha1.Drainer, opts v1.UpdateOptions) (*v1alpha1.Drainer, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(drainersResource, "status", c.ns, drainer), &v1alpha1.Drainer{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Drainer), err
+}
+
+// Delete takes name of the drainer and deletes it. Returns an error if one occurs.
+func (c *FakeDrainers) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(drainersResource, c.ns, name, opts), &v1alpha1.Drainer{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeDrainers) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(drainersResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.DrainerList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched drainer.
+func (c *FakeDrainers) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Drainer, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(drainersResource, c.ns, name, pt, data, subresources...), &v1alpha1.Drainer{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Drainer), err
+}
@@ -33,6 +33,10 @@ func (c *FakePingcapV1alpha1) BackupSchedules(namespace string) v1alpha1.BackupS
 	return &FakeBackupSchedules{c, namespace}
 }
 
+func (c *FakePingcapV1alpha1) BinlogMigrations(namespace string) v1alpha1.BinlogMigrationInterface {
+	return &FakeBinlogMigrations{c, namespace}
+}
+
 func (c *FakePingcapV1alpha1) CompactBackups(namespace string) v1alpha1.CompactBackupInterface {
 	return &FakeCompactBackups{c, namespace}
 }
@@ -45,6 +49,10 @@ func (c *FakePingcapV1alpha1) DataResources(namespace string) v1alpha1.DataResou
 	return &FakeDataResources{c, namespace}
 }
 
+func (c *FakePingcapV1alpha1) Drainers(namespace string) v1alpha1.DrainerInterface {
+	return &FakeDrainers{c, namespace}
+}
+
 func (c *FakePingcapV1alpha1) Restores(namespace string) v1alpha1.RestoreInterface {
 	return &FakeRestores{c, namespace}
 }
@@ -69,6 +77,10 @@ func (c *FakePingcapV1alpha1) TidbNGMonitorings(namespace string) v1alpha1.TidbN
 	return &FakeTidbNGMonitorings{c, namespace}
 }
 
+func (c *FakePingcapV1alpha1) TidbUsers(namespace string) v1alpha1.TidbUserInterface {
+	return &FakeTidbUsers{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakePingcapV1alpha1) RESTClient() rest.Interface {
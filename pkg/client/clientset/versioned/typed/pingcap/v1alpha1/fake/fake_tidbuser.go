@@ -0,0 +1,138 @@
+// Copyright PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeTidbUsers implements TidbUserInterface
+type FakeTidbUsers struct {
+	Fake *FakePingcapV1alpha1
+	ns   string
+}
+
+var tidbusersResource = v1alpha1.SchemeGroupVersion.WithResource("tidbusers")
+
+var tidbusersKind = v1alpha1.SchemeGroupVersion.WithKind("TidbUser")
+
+// Get takes name of the tidbUser, and returns the corresponding tidbUser object, and an error if there is any.
+func (c *FakeTidbUsers) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.TidbUser, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(tidbusersResource, c.ns, name), &v1alpha1.TidbUser{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TidbUser), err
+}
+
+// List takes label and field selectors, and returns the list of TidbUsers that match those selectors.
+func (c *FakeTidbUsers) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.TidbUserList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(tidbusersResource, tidbusersKind, c.ns, opts), &v1alpha1.TidbUserList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.TidbUserList{ListMeta: obj.(*v1alpha1.TidbUserList).ListMeta}
+	for _, item := range obj.(*v1alpha1.TidbUserList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested tidbUsers.
+func (c *FakeTidbUsers) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(tidbusersResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a tidbUser and creates it.  Returns the server's representation of the tidbUser, and an error, if there is any.
+func (c *FakeTidbUsers) Create(ctx context.Context, tidbUser *v1alpha1.TidbUser, opts v1.CreateOptions) (result *v1alpha1.TidbUser, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(tidbusersResource, c.ns, tidbUser), &v1alpha1.TidbUser{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TidbUser), err
+}
+
+// Update takes the representation of a tidbUser and updates it. Returns the server's representation of the tidbUser, and an error, if there is any.
+func (c *FakeTidbUsers) Update(ctx context.Context, tidbUser *v1alpha1.TidbUser, opts v1.UpdateOptions) (result *v1alpha1.TidbUser, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(tidbusersResource, c.ns, tidbUser), &v1alpha1.TidbUser{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TidbUser), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeTidbUsers) UpdateStatus(ctx context.Context, tidbUser *v1alpha1.TidbUser, opts v1.UpdateOptions) (*v1alpha1.TidbUser, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(tidbusersResource, "status", c.ns, tidbUser), &v1alpha1.TidbUser{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TidbUser), err
+}
+
+// Delete takes name of the tidbUser and deletes it. Returns an error if one occurs.
+func (c *FakeTidbUsers) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(tidbusersResource, c.ns, name, opts), &v1alpha1.TidbUser{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeTidbUsers) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(tidbusersResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.TidbUserList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched tidbUser.
+func (c *FakeTidbUsers) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.TidbUser, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(tidbusersResource, c.ns, name, pt, data, subresources...), &v1alpha1.TidbUser{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TidbUser), err
+}
@@ -19,12 +19,16 @@ type BackupExpansion interface{}
 
 type BackupScheduleExpansion interface{}
 
+type BinlogMigrationExpansion interface{}
+
 type CompactBackupExpansion interface{}
 
 type DMClusterExpansion interface{}
 
 type DataResourceExpansion interface{}
 
+type DrainerExpansion interface{}
+
 type RestoreExpansion interface{}
 
 type TidbClusterExpansion interface{}
@@ -36,3 +40,5 @@ type TidbInitializerExpansion interface{}
 type TidbMonitorExpansion interface{}
 
 type TidbNGMonitoringExpansion interface{}
+
+type TidbUserExpansion interface{}
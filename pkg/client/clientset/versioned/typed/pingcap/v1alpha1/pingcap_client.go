@@ -27,15 +27,18 @@ type PingcapV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	BackupsGetter
 	BackupSchedulesGetter
+	BinlogMigrationsGetter
 	CompactBackupsGetter
 	DMClustersGetter
 	DataResourcesGetter
+	DrainersGetter
 	RestoresGetter
 	TidbClustersGetter
 	TidbDashboardsGetter
 	TidbInitializersGetter
 	TidbMonitorsGetter
 	TidbNGMonitoringsGetter
+	TidbUsersGetter
 }
 
 // PingcapV1alpha1Client is used to interact with features provided by the pingcap.com group.
@@ -51,6 +54,10 @@ func (c *PingcapV1alpha1Client) BackupSchedules(namespace string) BackupSchedule
 	return newBackupSchedules(c, namespace)
 }
 
+func (c *PingcapV1alpha1Client) BinlogMigrations(namespace string) BinlogMigrationInterface {
+	return newBinlogMigrations(c, namespace)
+}
+
 func (c *PingcapV1alpha1Client) CompactBackups(namespace string) CompactBackupInterface {
 	return newCompactBackups(c, namespace)
 }
@@ -63,6 +70,10 @@ func (c *PingcapV1alpha1Client) DataResources(namespace string) DataResourceInte
 	return newDataResources(c, namespace)
 }
 
+func (c *PingcapV1alpha1Client) Drainers(namespace string) DrainerInterface {
+	return newDrainers(c, namespace)
+}
+
 func (c *PingcapV1alpha1Client) Restores(namespace string) RestoreInterface {
 	return newRestores(c, namespace)
 }
@@ -87,6 +98,10 @@ func (c *PingcapV1alpha1Client) TidbNGMonitorings(namespace string) TidbNGMonito
 	return newTidbNGMonitorings(c, namespace)
 }
 
+func (c *PingcapV1alpha1Client) TidbUsers(namespace string) TidbUserInterface {
+	return newTidbUsers(c, namespace)
+}
+
 // NewForConfig creates a new PingcapV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -25,12 +25,16 @@ type Interface interface {
 	Backups() BackupInformer
 	// BackupSchedules returns a BackupScheduleInformer.
 	BackupSchedules() BackupScheduleInformer
+	// BinlogMigrations returns a BinlogMigrationInformer.
+	BinlogMigrations() BinlogMigrationInformer
 	// CompactBackups returns a CompactBackupInformer.
 	CompactBackups() CompactBackupInformer
 	// DMClusters returns a DMClusterInformer.
 	DMClusters() DMClusterInformer
 	// DataResources returns a DataResourceInformer.
 	DataResources() DataResourceInformer
+	// Drainers returns a DrainerInformer.
+	Drainers() DrainerInformer
 	// Restores returns a RestoreInformer.
 	Restores() RestoreInformer
 	// TidbClusters returns a TidbClusterInformer.
@@ -43,6 +47,8 @@ type Interface interface {
 	TidbMonitors() TidbMonitorInformer
 	// TidbNGMonitorings returns a TidbNGMonitoringInformer.
 	TidbNGMonitorings() TidbNGMonitoringInformer
+	// TidbUsers returns a TidbUserInformer.
+	TidbUsers() TidbUserInformer
 }
 
 type version struct {
@@ -66,6 +72,11 @@ func (v *version) BackupSchedules() BackupScheduleInformer {
 	return &backupScheduleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// BinlogMigrations returns a BinlogMigrationInformer.
+func (v *version) BinlogMigrations() BinlogMigrationInformer {
+	return &binlogMigrationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // CompactBackups returns a CompactBackupInformer.
 func (v *version) CompactBackups() CompactBackupInformer {
 	return &compactBackupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -81,6 +92,11 @@ func (v *version) DataResources() DataResourceInformer {
 	return &dataResourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// Drainers returns a DrainerInformer.
+func (v *version) Drainers() DrainerInformer {
+	return &drainerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Restores returns a RestoreInformer.
 func (v *version) Restores() RestoreInformer {
 	return &restoreInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -110,3 +126,8 @@ func (v *version) TidbMonitors() TidbMonitorInformer {
 func (v *version) TidbNGMonitorings() TidbNGMonitoringInformer {
 	return &tidbNGMonitoringInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// TidbUsers returns a TidbUserInformer.
+func (v *version) TidbUsers() TidbUserInformer {
+	return &tidbUserInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
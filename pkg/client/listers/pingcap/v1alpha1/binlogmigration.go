@@ -0,0 +1,96 @@
+// Copyright PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// BinlogMigrationLister helps list BinlogMigrations.
+// All objects returned here must be treated as read-only.
+type BinlogMigrationLister interface {
+	// List lists all BinlogMigrations in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.BinlogMigration, err error)
+	// BinlogMigrations returns an object that can list and get BinlogMigrations.
+	BinlogMigrations(namespace string) BinlogMigrationNamespaceLister
+	BinlogMigrationListerExpansion
+}
+
+// binlogMigrationLister implements the BinlogMigrationLister interface.
+type binlogMigrationLister struct {
+	indexer cache.Indexer
+}
+
+// NewBinlogMigrationLister returns a new BinlogMigrationLister.
+func NewBinlogMigrationLister(indexer cache.Indexer) BinlogMigrationLister {
+	return &binlogMigrationLister{indexer: indexer}
+}
+
+// List lists all BinlogMigrations in the indexer.
+func (s *binlogMigrationLister) List(selector labels.Selector) (ret []*v1alpha1.BinlogMigration, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.BinlogMigration))
+	})
+	return ret, err
+}
+
+// BinlogMigrations returns an object that can list and get BinlogMigrations.
+func (s *binlogMigrationLister) BinlogMigrations(namespace string) BinlogMigrationNamespaceLister {
+	return binlogMigrationNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// BinlogMigrationNamespaceLister helps list and get BinlogMigrations.
+// All objects returned here must be treated as read-only.
+type BinlogMigrationNamespaceLister interface {
+	// List lists all BinlogMigrations in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.BinlogMigration, err error)
+	// Get retrieves the BinlogMigration from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.BinlogMigration, error)
+	BinlogMigrationNamespaceListerExpansion
+}
+
+// binlogMigrationNamespaceLister implements the BinlogMigrationNamespaceLister
+// interface.
+type binlogMigrationNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all BinlogMigrations in the indexer for a given namespace.
+func (s binlogMigrationNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.BinlogMigration, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.BinlogMigration))
+	})
+	return ret, err
+}
+
+// Get retrieves the BinlogMigration from the indexer for a given namespace and name.
+func (s binlogMigrationNamespaceLister) Get(name string) (*v1alpha1.BinlogMigration, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("binlogmigration"), name)
+	}
+	return obj.(*v1alpha1.BinlogMigration), nil
+}
@@ -31,6 +31,14 @@ type BackupScheduleListerExpansion interface{}
 // BackupScheduleNamespaceLister.
 type BackupScheduleNamespaceListerExpansion interface{}
 
+// BinlogMigrationListerExpansion allows custom methods to be added to
+// BinlogMigrationLister.
+type BinlogMigrationListerExpansion interface{}
+
+// BinlogMigrationNamespaceListerExpansion allows custom methods to be added to
+// BinlogMigrationNamespaceLister.
+type BinlogMigrationNamespaceListerExpansion interface{}
+
 // CompactBackupListerExpansion allows custom methods to be added to
 // CompactBackupLister.
 type CompactBackupListerExpansion interface{}
@@ -55,6 +63,14 @@ type DataResourceListerExpansion interface{}
 // DataResourceNamespaceLister.
 type DataResourceNamespaceListerExpansion interface{}
 
+// DrainerListerExpansion allows custom methods to be added to
+// DrainerLister.
+type DrainerListerExpansion interface{}
+
+// DrainerNamespaceListerExpansion allows custom methods to be added to
+// DrainerNamespaceLister.
+type DrainerNamespaceListerExpansion interface{}
+
 // RestoreListerExpansion allows custom methods to be added to
 // RestoreLister.
 type RestoreListerExpansion interface{}
@@ -102,3 +118,11 @@ type TidbNGMonitoringListerExpansion interface{}
 // TidbNGMonitoringNamespaceListerExpansion allows custom methods to be added to
 // TidbNGMonitoringNamespaceLister.
 type TidbNGMonitoringNamespaceListerExpansion interface{}
+
+// TidbUserListerExpansion allows custom methods to be added to
+// TidbUserLister.
+type TidbUserListerExpansion interface{}
+
+// TidbUserNamespaceListerExpansion allows custom methods to be added to
+// TidbUserNamespaceLister.
+type TidbUserNamespaceListerExpansion interface{}
@@ -0,0 +1,96 @@
+// Copyright PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// TidbUserLister helps list TidbUsers.
+// All objects returned here must be treated as read-only.
+type TidbUserLister interface {
+	// List lists all TidbUsers in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.TidbUser, err error)
+	// TidbUsers returns an object that can list and get TidbUsers.
+	TidbUsers(namespace string) TidbUserNamespaceLister
+	TidbUserListerExpansion
+}
+
+// tidbUserLister implements the TidbUserLister interface.
+type tidbUserLister struct {
+	indexer cache.Indexer
+}
+
+// NewTidbUserLister returns a new TidbUserLister.
+func NewTidbUserLister(indexer cache.Indexer) TidbUserLister {
+	return &tidbUserLister{indexer: indexer}
+}
+
+// List lists all TidbUsers in the indexer.
+func (s *tidbUserLister) List(selector labels.Selector) (ret []*v1alpha1.TidbUser, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.TidbUser))
+	})
+	return ret, err
+}
+
+// TidbUsers returns an object that can list and get TidbUsers.
+func (s *tidbUserLister) TidbUsers(namespace string) TidbUserNamespaceLister {
+	return tidbUserNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// TidbUserNamespaceLister helps list and get TidbUsers.
+// All objects returned here must be treated as read-only.
+type TidbUserNamespaceLister interface {
+	// List lists all TidbUsers in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.TidbUser, err error)
+	// Get retrieves the TidbUser from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.TidbUser, error)
+	TidbUserNamespaceListerExpansion
+}
+
+// tidbUserNamespaceLister implements the TidbUserNamespaceLister
+// interface.
+type tidbUserNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all TidbUsers in the indexer for a given namespace.
+func (s tidbUserNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.TidbUser, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.TidbUser))
+	})
+	return ret, err
+}
+
+// Get retrieves the TidbUser from the indexer for a given namespace and name.
+func (s tidbUserNamespaceLister) Get(name string) (*v1alpha1.TidbUser, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("tidbuser"), name)
+	}
+	return obj.(*v1alpha1.TidbUser), nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogmigration
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	v1alpha1validation "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1/validation"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager/member"
+)
+
+// ControlInterface reconciles BinlogMigration
+type ControlInterface interface {
+	// ReconcileBinlogMigration implements the reconcile logic of BinlogMigration
+	ReconcileBinlogMigration(bm *v1alpha1.BinlogMigration) error
+}
+
+// NewDefaultBinlogMigrationControl returns a new instance of the default BinlogMigration ControlInterface
+func NewDefaultBinlogMigrationControl(
+	deps *controller.Dependencies,
+	manager member.BinlogMigrationManager,
+	recorder record.EventRecorder,
+) ControlInterface {
+	return &defaultBinlogMigrationControl{
+		deps:     deps,
+		manager:  manager,
+		recorder: recorder,
+	}
+}
+
+type defaultBinlogMigrationControl struct {
+	deps     *controller.Dependencies
+	manager  member.BinlogMigrationManager
+	recorder record.EventRecorder
+}
+
+func (c *defaultBinlogMigrationControl) ReconcileBinlogMigration(bm *v1alpha1.BinlogMigration) error {
+	if bm.DeletionTimestamp != nil {
+		return nil
+	}
+	if !c.validate(bm) {
+		return nil
+	}
+
+	oldStatus := bm.Status.DeepCopy()
+	syncErr := c.manager.Sync(bm)
+	if !apiequality.Semantic.DeepEqual(&bm.Status, oldStatus) {
+		if err := c.updateStatus(bm); err != nil {
+			return err
+		}
+		if bm.Status.Phase != oldStatus.Phase {
+			c.recorder.Eventf(bm, corev1.EventTypeNormal, string(bm.Status.Phase), "binlog migration is %s", bm.Status.Phase)
+		}
+	}
+	return syncErr
+}
+
+func (c *defaultBinlogMigrationControl) validate(bm *v1alpha1.BinlogMigration) bool {
+	errs := v1alpha1validation.ValidateBinlogMigration(bm)
+	if len(errs) > 0 {
+		aggregatedErr := errs.ToAggregate()
+		klog.Errorf("BinlogMigration %s/%s is not valid and must be fixed first, aggregated error: %v", bm.Namespace, bm.Name, aggregatedErr)
+		c.recorder.Event(bm, corev1.EventTypeWarning, "FailedValidation", aggregatedErr.Error())
+		return false
+	}
+	return true
+}
+
+func (c *defaultBinlogMigrationControl) updateStatus(bm *v1alpha1.BinlogMigration) error {
+	var (
+		ns     = bm.GetNamespace()
+		name   = bm.GetName()
+		status = bm.Status.DeepCopy()
+	)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, updateErr := c.deps.Clientset.PingcapV1alpha1().BinlogMigrations(ns).UpdateStatus(context.TODO(), bm, metav1.UpdateOptions{})
+		if updateErr == nil {
+			klog.Infof("BinlogMigration: [%s/%s], update status successfully", ns, name)
+			return nil
+		}
+
+		klog.V(4).Infof("BinlogMigration: [%s/%s], update status failed, error: %v", ns, name, updateErr)
+
+		if updated, err := c.deps.BinlogMigrationLister.BinlogMigrations(ns).Get(name); err == nil {
+			// make a copy so we don't mutate the shared cache
+			bm = updated.DeepCopy()
+			bm.Status = *status
+		} else {
+			utilruntime.HandleError(fmt.Errorf("error getting updated BinlogMigration %s/%s from lister: %v", ns, name, err))
+		}
+
+		return updateErr
+	})
+	if err != nil {
+		klog.Errorf("BinlogMigration: [%s/%s], failed to updateStatus, error: %v", ns, name, err)
+	}
+	return err
+}
+
+var _ ControlInterface = &defaultBinlogMigrationControl{}
+
+// FakeBinlogMigrationControl is a fake BinlogMigration ControlInterface
+type FakeBinlogMigrationControl struct {
+	err error
+}
+
+// NewFakeBinlogMigrationControl returns a FakeBinlogMigrationControl
+func NewFakeBinlogMigrationControl() *FakeBinlogMigrationControl {
+	return &FakeBinlogMigrationControl{}
+}
+
+// SetReconcileBinlogMigrationError sets error for BinlogMigrationControl
+func (c *FakeBinlogMigrationControl) SetReconcileBinlogMigrationError(err error) {
+	c.err = err
+}
+
+// ReconcileBinlogMigration fake ReconcileBinlogMigration
+func (c *FakeBinlogMigrationControl) ReconcileBinlogMigration(_ *v1alpha1.BinlogMigration) error {
+	return c.err
+}
+
+var _ ControlInterface = &FakeBinlogMigrationControl{}
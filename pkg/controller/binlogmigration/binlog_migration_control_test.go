@@ -0,0 +1,59 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogmigration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager/member"
+)
+
+func TestReconcileBinlogMigration(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	manager := member.NewFakeBinlogMigrationManager()
+	recorder := record.NewFakeRecorder(10)
+	control := NewDefaultBinlogMigrationControl(deps, manager, recorder)
+	client := deps.Clientset.PingcapV1alpha1().BinlogMigrations(corev1.NamespaceDefault)
+
+	bm := &v1alpha1.BinlogMigration{
+		ObjectMeta: metav1.ObjectMeta{Name: "to-ticdc", Namespace: corev1.NamespaceDefault},
+		Spec: v1alpha1.BinlogMigrationSpec{
+			Cluster:    v1alpha1.TidbClusterRef{Name: "test"},
+			Changefeed: v1alpha1.BinlogMigrationChangefeed{ID: "binlog"},
+		},
+	}
+	_, err := client.Create(context.TODO(), bm, metav1.CreateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// the invalid BinlogMigration is not synced
+	manager.SetSyncError(fmt.Errorf("should not be synced"))
+	g.Expect(control.ReconcileBinlogMigration(bm.DeepCopy())).To(Succeed())
+	g.Expect(<-recorder.Events).To(ContainSubstring("FailedValidation"))
+
+	bm.Spec.Changefeed.SinkURI = "mysql://root@mysql.db:3306/"
+	g.Expect(control.ReconcileBinlogMigration(bm.DeepCopy())).To(MatchError("should not be synced"))
+	manager.SetSyncError(nil)
+	g.Expect(control.ReconcileBinlogMigration(bm.DeepCopy())).To(Succeed())
+}
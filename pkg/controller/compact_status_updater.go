@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
 	listers "github.com/pingcap/tidb-operator/pkg/client/listers/pingcap/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/record"
@@ -50,6 +51,7 @@ type CompactStatusUpdaterInterface interface {
 	OnCreateJob(ctx context.Context, compact *v1alpha1.CompactBackup, err error) error
 	OnStart(ctx context.Context, compact *v1alpha1.CompactBackup) error
 	OnProgress(ctx context.Context, compact *v1alpha1.CompactBackup, p *Progress, endTs string) error
+	OnCoverage(ctx context.Context, compact *v1alpha1.CompactBackup, coverage *v1alpha1.CompactCoverage) error
 	OnFinish(ctx context.Context, compact *v1alpha1.CompactBackup, err error) error
 	OnJobFailed(ctx context.Context, compact *v1alpha1.CompactBackup, reason string) error
 }
@@ -118,6 +120,10 @@ func (r *CompactStatusUpdater) UpdateStatus(compact *v1alpha1.CompactBackup, new
 			compact.Status.EndTs = newStatus.EndTs
 			updated = true
 		}
+		if newStatus.Coverage != nil && !apiequality.Semantic.DeepEqual(compact.Status.Coverage, newStatus.Coverage) {
+			compact.Status.Coverage = newStatus.Coverage
+			updated = true
+		}
 
 		// Apply the update if any field changed
 		if updated {
@@ -187,6 +193,13 @@ func (r *CompactStatusUpdater) OnProgress(ctx context.Context, compact *v1alpha1
 	return r.UpdateStatus(compact, newStatus)
 }
 
+func (r *CompactStatusUpdater) OnCoverage(ctx context.Context, compact *v1alpha1.CompactBackup, coverage *v1alpha1.CompactCoverage) error {
+	if coverage == nil {
+		return nil
+	}
+	return r.UpdateStatus(compact, v1alpha1.CompactStatus{Coverage: coverage})
+}
+
 func (r *CompactStatusUpdater) OnFinish(ctx context.Context, compact *v1alpha1.CompactBackup, err error) error {
 	newStatus := v1alpha1.CompactStatus{}
 	if err != nil {
@@ -67,6 +67,9 @@ var (
 	// tidbDashboardKind contains the schema.GroupVersionKind for TidbDashboard controller type.
 	tidbDashboardKind = v1alpha1.SchemeGroupVersion.WithKind("TidbDashboard")
 
+	// drainerKind contains the schema.GroupVersionKind for Drainer controller type.
+	drainerKind = v1alpha1.SchemeGroupVersion.WithKind("Drainer")
+
 	// FedVolumeBackupControllerKind contains the schema.GroupVersionKind for federation VolumeBackup controller type.
 	FedVolumeBackupControllerKind = fedv1alpha1.SchemeGroupVersion.WithKind("VolumeBackup")
 
@@ -119,6 +122,12 @@ func IsIgnoreError(err error) bool {
 
 // GetOwnerRef returns TidbCluster's OwnerReference
 func GetOwnerRef(tc *v1alpha1.TidbCluster) metav1.OwnerReference {
+	// the resources of a component group are owned by the TidbCluster which the group belongs to
+	if tc.IsComponentGroup() {
+		if ref := metav1.GetControllerOf(tc); ref != nil {
+			return *ref
+		}
+	}
 	controller := true
 	blockOwnerDeletion := true
 	return metav1.OwnerReference{
@@ -254,6 +263,20 @@ func GetTiDBDashboardOwnerRef(td *v1alpha1.TidbDashboard) metav1.OwnerReference
 	}
 }
 
+// GetDrainerOwnerRef returns Drainer's OwnerReference
+func GetDrainerOwnerRef(dr *v1alpha1.Drainer) metav1.OwnerReference {
+	controller := true
+	blockOwnerDeletion := true
+	return metav1.OwnerReference{
+		APIVersion:         drainerKind.GroupVersion().String(),
+		Kind:               drainerKind.Kind,
+		Name:               dr.GetName(),
+		UID:                dr.GetUID(),
+		Controller:         &controller,
+		BlockOwnerDeletion: &blockOwnerDeletion,
+	}
+}
+
 // GetServiceType returns member's service type
 func GetServiceType(services []v1alpha1.Service, serviceName string) corev1.ServiceType {
 	for _, svc := range services {
@@ -353,6 +376,16 @@ func TiFlashPeerMemberName(clusterName string) string {
 	return fmt.Sprintf("%s-tiflash-peer", clusterName)
 }
 
+// TiFlashComputeMemberName returns tiflash compute member name
+func TiFlashComputeMemberName(clusterName string) string {
+	return fmt.Sprintf("%s-tiflash-compute", clusterName)
+}
+
+// TiFlashComputePeerMemberName returns tiflash compute peer service name
+func TiFlashComputePeerMemberName(clusterName string) string {
+	return fmt.Sprintf("%s-tiflash-compute-peer", clusterName)
+}
+
 // TiProxyMemberName returns tiproxy member name
 func TiProxyMemberName(clusterName string) string {
 	return fmt.Sprintf("%s-tiproxy", clusterName)
@@ -388,6 +421,11 @@ func PumpMemberName(clusterName string) string {
 	return fmt.Sprintf("%s-pump", clusterName)
 }
 
+// DrainerMemberName returns the name of the drainer statefulset and headless service of a Drainer
+func DrainerMemberName(name string) string {
+	return fmt.Sprintf("%s-drainer", name)
+}
+
 // TiDBInitializerMemberName returns TiDBInitializer member name
 func TiDBInitializerMemberName(clusterName string) string {
 	return fmt.Sprintf("%s-tidb-initializer", clusterName)
@@ -69,6 +69,7 @@ type CLIConfig struct {
 
 	AutoFailover          bool
 	PDFailoverPeriod      time.Duration
+	PDMSFailoverPeriod    time.Duration
 	TiKVFailoverPeriod    time.Duration
 	TiDBFailoverPeriod    time.Duration
 	TiFlashFailoverPeriod time.Duration
@@ -106,6 +107,7 @@ func DefaultCLIConfig() *CLIConfig {
 		ClusterScoped:          true,
 		AutoFailover:           true,
 		PDFailoverPeriod:       5 * time.Minute,
+		PDMSFailoverPeriod:     5 * time.Minute,
 		TiKVFailoverPeriod:     5 * time.Minute,
 		TiDBFailoverPeriod:     5 * time.Minute,
 		TiFlashFailoverPeriod:  5 * time.Minute,
@@ -136,6 +138,7 @@ func (c *CLIConfig) AddFlag(_ *flag.FlagSet) {
 	flag.BoolVar(&c.ClusterPermissionSC, "cluster-permission-sc", c.ClusterPermissionSC, "Whether tidb-operator should have storage class permissions even if cluster-scoped is false")
 	flag.BoolVar(&c.AutoFailover, "auto-failover", c.AutoFailover, "Auto failover")
 	flag.DurationVar(&c.PDFailoverPeriod, "pd-failover-period", c.PDFailoverPeriod, "PD failover period default(5m)")
+	flag.DurationVar(&c.PDMSFailoverPeriod, "pdms-failover-period", c.PDMSFailoverPeriod, "PD microservices failover period default(5m)")
 	flag.DurationVar(&c.TiKVFailoverPeriod, "tikv-failover-period", c.TiKVFailoverPeriod, "TiKV failover period default(5m)")
 	flag.DurationVar(&c.TiFlashFailoverPeriod, "tiflash-failover-period", c.TiFlashFailoverPeriod, "TiFlash failover period default(5m)")
 	flag.DurationVar(&c.TiDBFailoverPeriod, "tidb-failover-period", c.TiDBFailoverPeriod, "TiDB failover period")
@@ -240,6 +243,9 @@ type Dependencies struct {
 	TiDBMonitorLister      listers.TidbMonitorLister
 	TiDBNGMonitoringLister listers.TidbNGMonitoringLister
 	TiDBDashboardLister    listers.TidbDashboardLister
+	TiDBUserLister         listers.TidbUserLister
+	DrainerLister          listers.DrainerLister
+	BinlogMigrationLister  listers.BinlogMigrationLister
 
 	// Controls
 	Controls
@@ -386,6 +392,9 @@ func newDependencies(
 		TiDBMonitorLister:      informerFactory.Pingcap().V1alpha1().TidbMonitors().Lister(),
 		TiDBNGMonitoringLister: informerFactory.Pingcap().V1alpha1().TidbNGMonitorings().Lister(),
 		TiDBDashboardLister:    informerFactory.Pingcap().V1alpha1().TidbDashboards().Lister(),
+		TiDBUserLister:         informerFactory.Pingcap().V1alpha1().TidbUsers().Lister(),
+		DrainerLister:          informerFactory.Pingcap().V1alpha1().Drainers().Lister(),
+		BinlogMigrationLister:  informerFactory.Pingcap().V1alpha1().BinlogMigrations().Lister(),
 
 		AWSConfig: cfg,
 	}, nil
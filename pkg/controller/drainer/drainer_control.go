@@ -0,0 +1,182 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drainer
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1/defaulting"
+	v1alpha1validation "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1/validation"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager/member"
+)
+
+// ReclaimPolicyManager abstracts the logic of reclaiming the pv object of Drainer.
+type ReclaimPolicyManager interface {
+	SyncDrainer(*v1alpha1.Drainer) error
+}
+
+// ControlInterface reconciles Drainer
+type ControlInterface interface {
+	// ReconcileDrainer implements the reconcile logic of Drainer
+	ReconcileDrainer(dr *v1alpha1.Drainer) error
+}
+
+// NewDefaultDrainerControl returns a new instance of the default Drainer ControlInterface
+func NewDefaultDrainerControl(
+	deps *controller.Dependencies,
+	drainerManager member.DrainerManager,
+	reclaimPolicyManager ReclaimPolicyManager,
+	recorder record.EventRecorder,
+) ControlInterface {
+	return &defaultDrainerControl{
+		deps:                 deps,
+		drainerManager:       drainerManager,
+		reclaimPolicyManager: reclaimPolicyManager,
+		recorder:             recorder,
+	}
+}
+
+type defaultDrainerControl struct {
+	deps                 *controller.Dependencies
+	drainerManager       member.DrainerManager
+	reclaimPolicyManager ReclaimPolicyManager
+	recorder             record.EventRecorder
+}
+
+func (c *defaultDrainerControl) ReconcileDrainer(dr *v1alpha1.Drainer) error {
+	if dr.DeletionTimestamp != nil {
+		return c.offlineAndRemoveProtectionFinalizerIfNeed(dr)
+	}
+
+	if !controllerutil.ContainsFinalizer(dr, label.DrainerProtectionFinalizer) {
+		controllerutil.AddFinalizer(dr, label.DrainerProtectionFinalizer)
+		updated, err := c.deps.Clientset.PingcapV1alpha1().Drainers(dr.Namespace).Update(context.TODO(), dr, metav1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+		dr = updated
+	}
+
+	defaulting.SetDrainerDefault(dr)
+	if !c.validate(dr) {
+		return nil
+	}
+
+	if err := c.reclaimPolicyManager.SyncDrainer(dr); err != nil {
+		return err
+	}
+
+	oldStatus := dr.Status.DeepCopy()
+	syncErr := c.drainerManager.Sync(dr)
+	if !apiequality.Semantic.DeepEqual(&dr.Status, oldStatus) {
+		if err := c.updateStatus(dr); err != nil {
+			return err
+		}
+	}
+	return syncErr
+}
+
+// offlineAndRemoveProtectionFinalizerIfNeed makes the drainer offline before the Drainer is deleted, so
+// that it is removed from the binlog topology instead of being left as a paused drainer.
+func (c *defaultDrainerControl) offlineAndRemoveProtectionFinalizerIfNeed(dr *v1alpha1.Drainer) error {
+	if !controllerutil.ContainsFinalizer(dr, label.DrainerProtectionFinalizer) {
+		return nil
+	}
+	if err := c.drainerManager.Offline(dr); err != nil {
+		return err
+	}
+	controllerutil.RemoveFinalizer(dr, label.DrainerProtectionFinalizer)
+	_, err := c.deps.Clientset.PingcapV1alpha1().Drainers(dr.Namespace).Update(context.TODO(), dr, metav1.UpdateOptions{})
+	return err
+}
+
+func (c *defaultDrainerControl) validate(dr *v1alpha1.Drainer) bool {
+	errs := v1alpha1validation.ValidateDrainer(dr)
+	if len(errs) > 0 {
+		aggregatedErr := errs.ToAggregate()
+		klog.Errorf("Drainer %s/%s is not valid and must be fixed first, aggregated error: %v", dr.Namespace, dr.Name, aggregatedErr)
+		c.recorder.Event(dr, corev1.EventTypeWarning, "FailedValidation", aggregatedErr.Error())
+		return false
+	}
+	return true
+}
+
+func (c *defaultDrainerControl) updateStatus(dr *v1alpha1.Drainer) error {
+	var (
+		ns     = dr.GetNamespace()
+		name   = dr.GetName()
+		status = dr.Status.DeepCopy()
+	)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, updateErr := c.deps.Clientset.PingcapV1alpha1().Drainers(ns).UpdateStatus(context.TODO(), dr, metav1.UpdateOptions{})
+		if updateErr == nil {
+			klog.Infof("Drainer: [%s/%s], update status successfully", ns, name)
+			return nil
+		}
+
+		klog.V(4).Infof("Drainer: [%s/%s], update status failed, error: %v", ns, name, updateErr)
+
+		if updated, err := c.deps.DrainerLister.Drainers(ns).Get(name); err == nil {
+			// make a copy so we don't mutate the shared cache
+			dr = updated.DeepCopy()
+			dr.Status = *status
+		} else {
+			utilruntime.HandleError(fmt.Errorf("error getting updated Drainer %s/%s from lister: %v", ns, name, err))
+		}
+
+		return updateErr
+	})
+	if err != nil {
+		klog.Errorf("Drainer: [%s/%s], failed to updateStatus, error: %v", ns, name, err)
+	}
+	return err
+}
+
+var _ ControlInterface = &defaultDrainerControl{}
+
+// FakeDrainerControl is a fake Drainer ControlInterface
+type FakeDrainerControl struct {
+	err error
+}
+
+// NewFakeDrainerControl returns a FakeDrainerControl
+func NewFakeDrainerControl() *FakeDrainerControl {
+	return &FakeDrainerControl{}
+}
+
+// SetReconcileDrainerError sets error for DrainerControl
+func (c *FakeDrainerControl) SetReconcileDrainerError(err error) {
+	c.err = err
+}
+
+// ReconcileDrainer fake ReconcileDrainer
+func (c *FakeDrainerControl) ReconcileDrainer(_ *v1alpha1.Drainer) error {
+	return c.err
+}
+
+var _ ControlInterface = &FakeDrainerControl{}
@@ -0,0 +1,82 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drainer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager/member"
+	"github.com/pingcap/tidb-operator/pkg/manager/meta"
+)
+
+func TestReconcileDrainer(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	manager := member.NewFakeDrainerManager()
+	recorder := record.NewFakeRecorder(10)
+	control := NewDefaultDrainerControl(deps, manager, meta.NewFakeReclaimPolicyManager(), recorder)
+	client := deps.Clientset.PingcapV1alpha1().Drainers(corev1.NamespaceDefault)
+
+	dr := &v1alpha1.Drainer{
+		ObjectMeta: metav1.ObjectMeta{Name: "to-file", Namespace: corev1.NamespaceDefault},
+		Spec: v1alpha1.DrainerSpec{
+			Cluster: v1alpha1.TidbClusterRef{Name: "test"},
+			Sink:    v1alpha1.DrainerSinkSpec{Type: v1alpha1.DrainerSinkTypeMySQL},
+		},
+	}
+	_, err := client.Create(context.TODO(), dr, metav1.CreateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// the finalizer is added, but the invalid Drainer is not synced
+	manager.SetSyncError(fmt.Errorf("should not be synced"))
+	g.Expect(control.ReconcileDrainer(dr.DeepCopy())).To(Succeed())
+	g.Expect(<-recorder.Events).To(ContainSubstring("FailedValidation"))
+	dr, err = client.Get(context.TODO(), dr.Name, metav1.GetOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(dr.Finalizers).To(ConsistOf(label.DrainerProtectionFinalizer))
+	// the defaults are not persisted
+	g.Expect(dr.Spec.BaseImage).To(BeEmpty())
+
+	dr.Spec.Sink.Type = v1alpha1.DrainerSinkTypeFile
+	g.Expect(control.ReconcileDrainer(dr.DeepCopy())).To(MatchError("should not be synced"))
+	manager.SetSyncError(nil)
+	g.Expect(control.ReconcileDrainer(dr.DeepCopy())).To(Succeed())
+
+	// the finalizer is kept until the drainer is offline
+	now := metav1.Now()
+	dr.DeletionTimestamp = &now
+	manager.SetOfflineError(controller.RequeueErrorf("waiting for drainer to be offline"))
+	g.Expect(control.ReconcileDrainer(dr.DeepCopy())).To(HaveOccurred())
+	dr, err = client.Get(context.TODO(), dr.Name, metav1.GetOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(dr.Finalizers).To(ConsistOf(label.DrainerProtectionFinalizer))
+
+	dr.DeletionTimestamp = &now
+	manager.SetOfflineError(nil)
+	g.Expect(control.ReconcileDrainer(dr.DeepCopy())).To(Succeed())
+	dr, err = client.Get(context.TODO(), dr.Name, metav1.GetOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(dr.Finalizers).To(BeEmpty())
+}
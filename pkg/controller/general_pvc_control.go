@@ -33,6 +33,7 @@ import (
 // GeneralPVCControlInterface manages PVCs used in backup and restore's pvc
 type GeneralPVCControlInterface interface {
 	CreatePVC(object runtime.Object, pvc *corev1.PersistentVolumeClaim) error
+	DeletePVC(object runtime.Object, pvc *corev1.PersistentVolumeClaim) error
 }
 
 type realGeneralPVCControl struct {
@@ -67,6 +68,22 @@ func (c *realGeneralPVCControl) CreatePVC(object runtime.Object, pvc *corev1.Per
 	return err
 }
 
+func (c *realGeneralPVCControl) DeletePVC(object runtime.Object, pvc *corev1.PersistentVolumeClaim) error {
+	ns := pvc.GetNamespace()
+	pvcName := pvc.GetName()
+	instanceName := pvc.GetLabels()[label.InstanceLabelKey]
+	kind := object.GetObjectKind().GroupVersionKind().Kind
+
+	err := c.kubeCli.CoreV1().PersistentVolumeClaims(ns).Delete(context.TODO(), pvcName, metav1.DeleteOptions{})
+	if err != nil {
+		klog.Errorf("failed to delete pvc: [%s/%s], %s: %s, %v", ns, pvcName, kind, instanceName, err)
+	} else {
+		klog.V(4).Infof("delete pvc: [%s/%s] successfully, %s: %s", ns, pvcName, kind, instanceName)
+	}
+	c.recordPVCEvent("delete", object, pvc, err)
+	return err
+}
+
 func (c *realGeneralPVCControl) recordPVCEvent(verb string, obj runtime.Object, pvc *corev1.PersistentVolumeClaim, err error) {
 	pvcName := pvc.GetName()
 	ns := pvc.GetNamespace()
@@ -92,6 +109,7 @@ type FakeGeneralPVCControl struct {
 	PVCLister        corelisters.PersistentVolumeClaimLister
 	PVCIndexer       cache.Indexer
 	createPVCTracker RequestTracker
+	deletePVCTracker RequestTracker
 }
 
 // NewFakeGeneralPVCControl returns a FakeGeneralPVCControl
@@ -100,6 +118,7 @@ func NewFakeGeneralPVCControl(pvcInformer coreinformers.PersistentVolumeClaimInf
 		pvcInformer.Lister(),
 		pvcInformer.Informer().GetIndexer(),
 		RequestTracker{},
+		RequestTracker{},
 	}
 }
 
@@ -119,4 +138,20 @@ func (c *FakeGeneralPVCControl) CreatePVC(_ runtime.Object, pvc *corev1.Persiste
 	return c.PVCIndexer.Add(pvc)
 }
 
+// SetDeletePVCError sets the error attributes of deletePVCTracker
+func (c *FakeGeneralPVCControl) SetDeletePVCError(err error, after int) {
+	c.deletePVCTracker.SetError(err).SetAfter(after)
+}
+
+// DeletePVC deletes the pvc from PVCIndexer
+func (c *FakeGeneralPVCControl) DeletePVC(_ runtime.Object, pvc *corev1.PersistentVolumeClaim) error {
+	defer c.deletePVCTracker.Inc()
+	if c.deletePVCTracker.ErrorReady() {
+		defer c.deletePVCTracker.Reset()
+		return c.deletePVCTracker.GetError()
+	}
+
+	return c.PVCIndexer.Delete(pvc)
+}
+
 var _ GeneralPVCControlInterface = &FakeGeneralPVCControl{}
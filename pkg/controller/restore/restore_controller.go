@@ -185,6 +185,12 @@ func (c *Controller) updateRestore(cur interface{}) {
 		return
 	}
 
+	if (v1alpha1.IsRestoreComplete(newRestore) || v1alpha1.IsRestoreFailed(newRestore)) && c.hasLightningSortedKVPVC(newRestore) {
+		klog.V(4).Infof("restore %s/%s is finished, enqueuing to clean the sorted KV files.", ns, name)
+		c.enqueueRestore(newRestore)
+		return
+	}
+
 	if v1alpha1.IsRestoreComplete(newRestore) {
 		if newRestore.Spec.Warmup == v1alpha1.RestoreWarmupModeASync {
 			if !v1alpha1.IsRestoreWarmUpComplete(newRestore) {
@@ -307,6 +313,15 @@ func (c *Controller) getTC(restore *v1alpha1.Restore) (*v1alpha1.TidbCluster, er
 	return c.deps.TiDBClusterLister.TidbClusters(restoreNamespace).Get(restore.Spec.BR.Cluster)
 }
 
+// hasLightningSortedKVPVC returns whether the pvc of the sorted KV files of the physical import is not deleted yet
+func (c *Controller) hasLightningSortedKVPVC(restore *v1alpha1.Restore) bool {
+	if !restore.IsLightningPhysicalImport() {
+		return false
+	}
+	pvc, err := c.deps.PVCLister.PersistentVolumeClaims(restore.GetNamespace()).Get(restore.GetLightningSortedKVPVCName())
+	return err == nil && pvc.DeletionTimestamp == nil
+}
+
 func (c *Controller) isRestoreJobFailed(restore *v1alpha1.Restore) (jobFailed bool, reason string, err error) {
 	ns := restore.GetNamespace()
 	name := restore.GetName()
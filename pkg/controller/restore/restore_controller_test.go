@@ -77,6 +77,20 @@ func TestRestoreControllerUpdateRestore(t *testing.T) {
 		g.Expect(condition.Reason).To(Equal("AlreadyFailed"))
 	}
 
+	// turn the restore into a physical import with the sorted KV pvc in the pvc informer.
+	createSortedKVPVC := func(g *GomegaWithT, rtc *Controller, restore *v1alpha1.Restore) {
+		restore.Spec.BR = nil
+		restore.Spec.Lightning = &v1alpha1.LightningConfig{Backend: v1alpha1.LightningBackendLocal}
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      restore.GetLightningSortedKVPVCName(),
+				Namespace: restore.Namespace,
+			},
+		}
+		err := rtc.deps.KubeInformerFactory.Core().V1().PersistentVolumeClaims().Informer().GetIndexer().Add(pvc)
+		g.Expect(err).To(Succeed())
+	}
+
 	tests := []struct {
 		name           string
 		conditionType  v1alpha1.RestoreConditionType // only one condition used now.
@@ -98,6 +112,22 @@ func TestRestoreControllerUpdateRestore(t *testing.T) {
 				g.Expect(rtc.queue.Len()).To(Equal(0))
 			},
 		},
+		{
+			name:           "physical import has been completed with the sorted KV pvc",
+			conditionType:  v1alpha1.RestoreComplete,
+			beforeUpdateFn: createSortedKVPVC,
+			expectFn: func(g *GomegaWithT, rtc *Controller) {
+				g.Expect(rtc.queue.Len()).To(Equal(1))
+			},
+		},
+		{
+			name:           "physical import has been failed with the sorted KV pvc",
+			conditionType:  v1alpha1.RestoreFailed,
+			beforeUpdateFn: createSortedKVPVC,
+			expectFn: func(g *GomegaWithT, rtc *Controller) {
+				g.Expect(rtc.queue.Len()).To(Equal(1))
+			},
+		},
 		{
 			name:          "restore has been scheduled",
 			conditionType: v1alpha1.RestoreScheduled,
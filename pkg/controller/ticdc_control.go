@@ -49,6 +49,33 @@ type drainCaptureResp struct {
 	CurrentTableCount int `json:"current_table_count"`
 }
 
+// ChangefeedConfig is the request to create a changefeed.
+type ChangefeedConfig struct {
+	ID      string `json:"changefeed_id"`
+	SinkURI string `json:"sink_uri"`
+	// StartTS is the ts from which the changefeed starts, TiCDC uses the current TSO if it is zero.
+	StartTS       uint64                 `json:"start_ts,omitempty"`
+	ReplicaConfig map[string]interface{} `json:"replica_config,omitempty"`
+}
+
+// ChangefeedInfo is the changefeed returned by TiCDC.
+type ChangefeedInfo struct {
+	ID           string           `json:"id"`
+	State        string           `json:"state"`
+	StartTS      uint64           `json:"start_ts"`
+	CheckpointTS uint64           `json:"checkpoint_ts"`
+	Error        *ChangefeedError `json:"error,omitempty"`
+}
+
+// ChangefeedError is the last error of a changefeed.
+type ChangefeedError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// errChangefeedNotExists is the error code returned by TiCDC if the changefeed does not exist.
+const errChangefeedNotExists = "ErrChangeFeedNotExists"
+
 // TiCDCControlInterface is the interface that knows how to manage ticdc captures
 type TiCDCControlInterface interface {
 	// GetStatus returns ticdc's status
@@ -65,6 +92,12 @@ type TiCDCControlInterface interface {
 	// IsHealthy gets the healthy status of TiCDC cluster.
 	// Returns true if the TiCDC cluster is heathy.
 	IsHealthy(tc *v1alpha1.TidbCluster, ordinal int32) (ok bool, err error)
+	// GetChangefeed gets the changefeed with the id, it returns nil if the changefeed does not exist.
+	GetChangefeed(tc *v1alpha1.TidbCluster, id string) (*ChangefeedInfo, error)
+	// CreateChangefeed creates a changefeed.
+	CreateChangefeed(tc *v1alpha1.TidbCluster, cfg *ChangefeedConfig) (*ChangefeedInfo, error)
+	// RemoveChangefeed removes the changefeed with the id, it does nothing if the changefeed does not exist.
+	RemoveChangefeed(tc *v1alpha1.TidbCluster, id string) error
 }
 
 // defaultTiCDCControl is default implementation of TiCDCControlInterface.
@@ -269,6 +302,98 @@ func (c *defaultTiCDCControl) IsHealthy(tc *v1alpha1.TidbCluster, ordinal int32)
 	return true, nil
 }
 
+func (c *defaultTiCDCControl) GetChangefeed(tc *v1alpha1.TidbCluster, id string) (*ChangefeedInfo, error) {
+	httpClient, err := c.getHTTPClient(tc)
+	if err != nil {
+		return nil, err
+	}
+
+	// the open API is served by every capture and forwarded to the owner
+	res, err := httpClient.Get(fmt.Sprintf("%s/api/v2/changefeeds/%s", c.getBaseURL(tc, 0), id))
+	if err != nil {
+		return nil, fmt.Errorf("ticdc get changefeed %s failed, request error: %v", id, err)
+	}
+	defer httputil.DeferClose(res.Body)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("ticdc get changefeed %s failed, read response error: %v", id, err)
+	}
+	if changefeedNotExists(res.StatusCode, body) {
+		return nil, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ticdc get changefeed %s failed, status code: %d, response: %s", id, res.StatusCode, string(body))
+	}
+
+	info := &ChangefeedInfo{}
+	if err := json.Unmarshal(body, info); err != nil {
+		return nil, fmt.Errorf("ticdc get changefeed %s failed, unmarshal response error: %v", id, err)
+	}
+	return info, nil
+}
+
+func (c *defaultTiCDCControl) CreateChangefeed(tc *v1alpha1.TidbCluster, cfg *ChangefeedConfig) (*ChangefeedInfo, error) {
+	httpClient, err := c.getHTTPClient(tc)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("ticdc create changefeed %s failed, marshal request error: %v", cfg.ID, err)
+	}
+	res, err := httpClient.Post(c.getBaseURL(tc, 0)+"/api/v2/changefeeds", "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("ticdc create changefeed %s failed, request error: %v", cfg.ID, err)
+	}
+	defer httputil.DeferClose(res.Body)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("ticdc create changefeed %s failed, read response error: %v", cfg.ID, err)
+	}
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("ticdc create changefeed %s failed, status code: %d, response: %s", cfg.ID, res.StatusCode, string(body))
+	}
+
+	info := &ChangefeedInfo{}
+	if err := json.Unmarshal(body, info); err != nil {
+		return nil, fmt.Errorf("ticdc create changefeed %s failed, unmarshal response error: %v", cfg.ID, err)
+	}
+	return info, nil
+}
+
+func (c *defaultTiCDCControl) RemoveChangefeed(tc *v1alpha1.TidbCluster, id string) error {
+	httpClient, err := c.getHTTPClient(tc)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v2/changefeeds/%s", c.getBaseURL(tc, 0), id), nil)
+	if err != nil {
+		return fmt.Errorf("ticdc remove changefeed %s failed, new request error: %v", id, err)
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("ticdc remove changefeed %s failed, request error: %v", id, err)
+	}
+	defer httputil.DeferClose(res.Body)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("ticdc remove changefeed %s failed, read response error: %v", id, err)
+	}
+	if res.StatusCode == http.StatusOK || changefeedNotExists(res.StatusCode, body) {
+		return nil
+	}
+	return fmt.Errorf("ticdc remove changefeed %s failed, status code: %d, response: %s", id, res.StatusCode, string(body))
+}
+
+// changefeedNotExists returns whether the response means the changefeed does not exist, TiCDC
+// responds 400 with the error code instead of 404 in this case.
+func changefeedNotExists(statusCode int, body []byte) bool {
+	return statusCode == http.StatusNotFound ||
+		(statusCode == http.StatusBadRequest && bytes.Contains(body, []byte(errChangefeedNotExists)))
+}
+
 func (c *defaultTiCDCControl) getBaseURL(tc *v1alpha1.TidbCluster, ordinal int32) string {
 	if c.testURL != "" {
 		return c.testURL
@@ -347,6 +472,10 @@ type FakeTiCDCControl struct {
 	DrainCaptureFn func(tc *v1alpha1.TidbCluster, ordinal int32) (tableCount int, retry bool, err error)
 	ResignOwnerFn  func(tc *v1alpha1.TidbCluster, ordinal int32) (ok bool, err error)
 	IsHealthyFn    func(tc *v1alpha1.TidbCluster, ordinal int32) (ok bool, err error)
+
+	GetChangefeedFn    func(tc *v1alpha1.TidbCluster, id string) (*ChangefeedInfo, error)
+	CreateChangefeedFn func(tc *v1alpha1.TidbCluster, cfg *ChangefeedConfig) (*ChangefeedInfo, error)
+	RemoveChangefeedFn func(tc *v1alpha1.TidbCluster, id string) error
 }
 
 // NewFakeTiCDCControl returns a FakeTiCDCControl instance
@@ -381,3 +510,24 @@ func (c *FakeTiCDCControl) IsHealthy(tc *v1alpha1.TidbCluster, ordinal int32) (b
 	}
 	return c.IsHealthyFn(tc, ordinal)
 }
+
+func (c *FakeTiCDCControl) GetChangefeed(tc *v1alpha1.TidbCluster, id string) (*ChangefeedInfo, error) {
+	if c.GetChangefeedFn == nil {
+		return nil, fmt.Errorf("undefined GetChangefeed")
+	}
+	return c.GetChangefeedFn(tc, id)
+}
+
+func (c *FakeTiCDCControl) CreateChangefeed(tc *v1alpha1.TidbCluster, cfg *ChangefeedConfig) (*ChangefeedInfo, error) {
+	if c.CreateChangefeedFn == nil {
+		return nil, fmt.Errorf("undefined CreateChangefeed")
+	}
+	return c.CreateChangefeedFn(tc, cfg)
+}
+
+func (c *FakeTiCDCControl) RemoveChangefeed(tc *v1alpha1.TidbCluster, id string) error {
+	if c.RemoveChangefeedFn == nil {
+		return fmt.Errorf("undefined RemoveChangefeed")
+	}
+	return c.RemoveChangefeedFn(tc, id)
+}
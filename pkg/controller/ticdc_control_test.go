@@ -594,3 +594,64 @@ func TestTiCDCControllerIsHealthy(t *testing.T) {
 		svr.Close()
 	}
 }
+
+func TestTiCDCControllerChangefeed(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cdc := defaultTiCDCControl{}
+	tc := getTidbCluster()
+
+	changefeeds := map[string]*ChangefeedInfo{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/changefeeds", func(w http.ResponseWriter, req *http.Request) {
+		g.Expect(req.Method).To(Equal("POST"))
+		cfg := &ChangefeedConfig{}
+		g.Expect(json.NewDecoder(req.Body).Decode(cfg)).To(Succeed())
+		g.Expect(cfg.ReplicaConfig).To(HaveKeyWithValue("force_replicate", true))
+		changefeeds[cfg.ID] = &ChangefeedInfo{ID: cfg.ID, State: "normal", StartTS: 100, CheckpointTS: 100}
+		payload, err := json.Marshal(changefeeds[cfg.ID])
+		g.Expect(err).Should(BeNil())
+		fmt.Fprint(w, string(payload))
+	})
+	mux.HandleFunc("/api/v2/changefeeds/", func(w http.ResponseWriter, req *http.Request) {
+		id := req.URL.Path[len("/api/v2/changefeeds/"):]
+		cf, ok := changefeeds[id]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error_msg":"changefeed not exists","error_code":"CDC:ErrChangeFeedNotExists"}`)
+			return
+		}
+		if req.Method == "DELETE" {
+			delete(changefeeds, id)
+			return
+		}
+		payload, err := json.Marshal(cf)
+		g.Expect(err).Should(BeNil())
+		fmt.Fprint(w, string(payload))
+	})
+	svr := httptest.NewServer(mux)
+	defer svr.Close()
+	cdc.testURL = svr.URL
+
+	cf, err := cdc.GetChangefeed(tc, "binlog")
+	g.Expect(err).Should(BeNil())
+	g.Expect(cf).Should(BeNil())
+
+	cf, err = cdc.CreateChangefeed(tc, &ChangefeedConfig{
+		ID:            "binlog",
+		SinkURI:       "mysql://root@downstream:3306/",
+		ReplicaConfig: map[string]interface{}{"force_replicate": true},
+	})
+	g.Expect(err).Should(BeNil())
+	g.Expect(cf.StartTS).Should(Equal(uint64(100)))
+
+	cf, err = cdc.GetChangefeed(tc, "binlog")
+	g.Expect(err).Should(BeNil())
+	g.Expect(cf.ID).Should(Equal("binlog"))
+	g.Expect(cf.CheckpointTS).Should(Equal(uint64(100)))
+
+	g.Expect(cdc.RemoveChangefeed(tc, "binlog")).Should(Succeed())
+	g.Expect(changefeeds).Should(BeEmpty())
+	// removing a changefeed that does not exist is a no-op
+	g.Expect(cdc.RemoveChangefeed(tc, "binlog")).Should(Succeed())
+}
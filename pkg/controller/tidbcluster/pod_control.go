@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -198,8 +199,14 @@ func (c *PodController) sync(key string) (result reconcile.Result, err error) {
 	if tcName == "" {
 		return reconcile.Result{}, nil
 	}
+	component := pod.Labels[label.ComponentLabelKey]
+	// the pods of a component group are labeled with the instance `<cluster>-<group>`
+	group := pod.Labels[label.ComponentGroupLabelKey]
+	if group != "" {
+		tcName = strings.TrimSuffix(tcName, "-"+group)
+	}
 
-	tc, err := c.deps.TiDBClusterLister.TidbClusters(ns).Get(tcName)
+	tc, err := c.getTidbCluster(ns, tcName, v1alpha1.MemberType(component), group)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			klog.V(4).Infof("TidbCluster %q is not found, skip sync the Pod %s", ns+"/"+tcName, name)
@@ -207,7 +214,10 @@ func (c *PodController) sync(key string) (result reconcile.Result, err error) {
 		}
 		return reconcile.Result{}, perrors.Annotatef(err, "failed to get TidbCluster %q", ns+"/"+tcName)
 	}
-	tc = tc.DeepCopy()
+	if tc == nil {
+		klog.V(4).Infof("Group %s of TidbCluster %q is not found, skip sync the Pod %s", group, ns+"/"+tcName, name)
+		return reconcile.Result{}, nil
+	}
 
 	startTime := time.Now()
 	defer func() {
@@ -226,7 +236,6 @@ func (c *PodController) sync(key string) (result reconcile.Result, err error) {
 		klog.V(4).Infof("Finished syncing TidbCluster pod %q (%v)", key, duration)
 	}()
 
-	component := pod.Labels[label.ComponentLabelKey]
 	ctx := context.Background()
 	switch component {
 	case label.PDLabelVal:
@@ -242,6 +251,52 @@ func (c *PodController) sync(key string) (result reconcile.Result, err error) {
 	}
 }
 
+// getTidbCluster returns a copy of the TidbCluster, or the TidbCluster of the group of the component if group is not empty.
+// Nil is returned if the group is not found in the TidbCluster.
+func (c *PodController) getTidbCluster(ns, name string, memberType v1alpha1.MemberType, group string) (*v1alpha1.TidbCluster, error) {
+	tc, err := c.deps.TiDBClusterLister.TidbClusters(ns).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if group == "" {
+		return tc.DeepCopy(), nil
+	}
+	if gtc, err := member.NewComponentGroupCluster(tc, memberType, group); err == nil {
+		return gtc, nil
+	}
+	return nil, nil
+}
+
+// updateTidbCluster updates tc, the TidbCluster of a component group is not a real one, so the status of the group
+// is updated into the TidbCluster which the group belongs to.
+func (c *PodController) updateTidbCluster(ctx context.Context, tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType) (*v1alpha1.TidbCluster, error) {
+	if !tc.IsComponentGroup() {
+		return c.deps.Clientset.PingcapV1alpha1().TidbClusters(tc.Namespace).Update(ctx, tc, metav1.UpdateOptions{})
+	}
+	name, group := componentGroupOwner(tc)
+	owner, err := c.deps.TiDBClusterLister.TidbClusters(tc.Namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	owner = owner.DeepCopy()
+	member.SetComponentGroupStatus(owner, tc, memberType, group)
+	owner, err = c.deps.Clientset.PingcapV1alpha1().TidbClusters(tc.Namespace).Update(ctx, owner, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return member.NewComponentGroupCluster(owner, memberType, group)
+}
+
+// componentGroupOwner returns the name of the TidbCluster which tc belongs to and the name of the group,
+// the group is empty if tc is not a component group.
+func componentGroupOwner(tc *v1alpha1.TidbCluster) (string, string) {
+	ref := metav1.GetControllerOf(tc)
+	if !tc.IsComponentGroup() || ref == nil {
+		return tc.Name, ""
+	}
+	return ref.Name, tc.Labels[label.ComponentGroupLabelKey]
+}
+
 func (c *PodController) getPDClient(tc *v1alpha1.TidbCluster) pdapi.PDClient {
 	if c.testPDClient != nil {
 		return c.testPDClient
@@ -421,7 +476,7 @@ func (c *PodController) syncTiKVPodForEviction(ctx context.Context, pod *corev1.
 			tc.Status.TiKV.EvictLeader[pod.Name] = evictStatus
 			var err error
 			key := fmt.Sprintf("%s/%s", tc.Namespace, tc.Name)
-			tc, err = c.deps.Clientset.PingcapV1alpha1().TidbClusters(tc.Namespace).Update(ctx, tc, metav1.UpdateOptions{})
+			tc, err = c.updateTidbCluster(ctx, tc, v1alpha1.TiKVMemberType)
 			if err != nil {
 				return reconcile.Result{}, perrors.Annotatef(err, "failed to update tc %q status", key)
 			}
@@ -497,14 +552,14 @@ func (c *PodController) syncTiKVPodForEviction(ctx context.Context, pod *corev1.
 
 			err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 				delete(tc.Status.TiKV.EvictLeader, pod.Name)
-				_, updateErr := c.deps.Clientset.PingcapV1alpha1().TidbClusters(tc.Namespace).Update(ctx, tc, metav1.UpdateOptions{})
+				_, updateErr := c.updateTidbCluster(ctx, tc, v1alpha1.TiKVMemberType)
 				if updateErr == nil {
 					return nil
 				}
 
-				if updated, err := c.deps.TiDBClusterLister.TidbClusters(tc.Namespace).Get(tc.Name); err == nil {
-					// // make a copy so we don't mutate the shared cache
-					tc = updated.DeepCopy()
+				name, group := componentGroupOwner(tc)
+				if updated, err := c.getTidbCluster(tc.Namespace, name, v1alpha1.TiKVMemberType, group); err == nil && updated != nil {
+					tc = updated
 				} else {
 					utilruntime.HandleError(fmt.Errorf("error getting updated tc %s/%s from lister: %v", tc.Namespace, tc.Name, err))
 				}
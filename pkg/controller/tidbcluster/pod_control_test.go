@@ -862,6 +862,43 @@ func TestNeedEvictLeader(t *testing.T) {
 
 }
 
+func TestComponentGroupPodCluster(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	c := NewPodController(deps)
+	tc := newTidbCluster()
+	tc.Spec.TiKV.Groups = []v1alpha1.TiKVGroupSpec{{ComponentGroupSpec: v1alpha1.ComponentGroupSpec{Name: "hot", Replicas: 1}}}
+	ctx := context.Background()
+	tc, err := deps.Clientset.PingcapV1alpha1().TidbClusters(tc.Namespace).Create(ctx, tc, metav1.CreateOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer().Add(tc)).To(Succeed())
+
+	gtc, err := c.getTidbCluster(tc.Namespace, tc.Name, v1alpha1.TiKVMemberType, "hot")
+	g.Expect(err).To(Succeed())
+	g.Expect(gtc.Name).To(Equal(tc.Name + "-hot"))
+	name, group := componentGroupOwner(gtc)
+	g.Expect(name).To(Equal(tc.Name))
+	g.Expect(group).To(Equal("hot"))
+
+	// the group is removed
+	removed, err := c.getTidbCluster(tc.Namespace, tc.Name, v1alpha1.TiKVMemberType, "cold")
+	g.Expect(err).To(Succeed())
+	g.Expect(removed).To(BeNil())
+
+	// the status of the group is updated into the TidbCluster which the group belongs to
+	gtc.Status.TiKV.EvictLeader = map[string]*v1alpha1.EvictLeaderStatus{"test-pd-hot-tikv-0": {Value: v1alpha1.EvictLeaderValueDeletePod}}
+	gtc, err = c.updateTidbCluster(ctx, gtc, v1alpha1.TiKVMemberType)
+	g.Expect(err).To(Succeed())
+	g.Expect(gtc.Status.TiKV.EvictLeader).To(HaveKey("test-pd-hot-tikv-0"))
+	updated, err := deps.Clientset.PingcapV1alpha1().TidbClusters(tc.Namespace).Get(ctx, tc.Name, metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+	g.Expect(updated.Status.TiKV.EvictLeader).To(BeEmpty())
+	g.Expect(updated.Status.TiKVGroups["hot"].EvictLeader).To(HaveKey("test-pd-hot-tikv-0"))
+	_, err = deps.Clientset.PingcapV1alpha1().TidbClusters(tc.Namespace).Get(ctx, gtc.Name, metav1.GetOptions{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+}
+
 func newTiKVPod(tc *v1alpha1.TidbCluster) *corev1.Pod {
 	return &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
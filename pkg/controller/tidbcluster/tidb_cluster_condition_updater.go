@@ -44,6 +44,21 @@ func allStatefulSetsAreUpToDate(tc *v1alpha1.TidbCluster) bool {
 		}
 		return status.CurrentRevision == status.UpdateRevision
 	}
+	for _, status := range tc.Status.TiKVGroups {
+		if !isUpToDate(status.StatefulSet, false) {
+			return false
+		}
+	}
+	for _, status := range tc.Status.TiDBGroups {
+		if !isUpToDate(status.StatefulSet, false) {
+			return false
+		}
+	}
+	for _, status := range tc.Status.TiFlashGroups {
+		if !isUpToDate(status.StatefulSet, false) {
+			return false
+		}
+	}
 	return (isUpToDate(tc.Status.PD.StatefulSet, false)) &&
 		(isUpToDate(tc.Status.TiKV.StatefulSet, false)) &&
 		(isUpToDate(tc.Status.TiDB.StatefulSet, false)) &&
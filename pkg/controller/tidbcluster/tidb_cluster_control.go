@@ -58,6 +58,7 @@ func NewDefaultTidbClusterControl(
 	tiflashMemberManager manager.Manager,
 	ticdcMemberManager manager.Manager,
 	discoveryManager member.TidbDiscoveryManager,
+	componentGroupManager member.ComponentGroupManager,
 	tidbClusterStatusManager manager.Manager,
 	conditionUpdater TidbClusterConditionUpdater,
 	recorder record.EventRecorder) ControlInterface {
@@ -78,6 +79,7 @@ func NewDefaultTidbClusterControl(
 		tiflashMemberManager:     tiflashMemberManager,
 		ticdcMemberManager:       ticdcMemberManager,
 		discoveryManager:         discoveryManager,
+		componentGroupManager:    componentGroupManager,
 		tidbClusterStatusManager: tidbClusterStatusManager,
 		conditionUpdater:         conditionUpdater,
 		recorder:                 recorder,
@@ -101,6 +103,7 @@ type defaultTidbClusterControl struct {
 	tiflashMemberManager     manager.Manager
 	ticdcMemberManager       manager.Manager
 	discoveryManager         member.TidbDiscoveryManager
+	componentGroupManager    member.ComponentGroupManager
 	tidbClusterStatusManager manager.Manager
 	conditionUpdater         TidbClusterConditionUpdater
 	recorder                 record.EventRecorder
@@ -142,6 +145,11 @@ func (c *defaultTidbClusterControl) validate(tc *v1alpha1.TidbCluster) bool {
 		c.recorder.Event(tc, v1.EventTypeWarning, "FailedValidation", aggregatedErr.Error())
 		return false
 	}
+	if err := c.componentGroupManager.CheckConflicts(tc); err != nil {
+		klog.Errorf("tidb cluster %s/%s is not valid and must be fixed first, error: %v", tc.GetNamespace(), tc.GetName(), err)
+		c.recorder.Event(tc, v1.EventTypeWarning, "FailedValidation", err.Error())
+		return false
+	}
 	return true
 }
 
@@ -240,6 +248,10 @@ func (c *defaultTidbClusterControl) updateTidbCluster(tc *v1alpha1.TidbCluster)
 		metrics.ClusterUpdateErrors.WithLabelValues(ns, tcName, "tiflash").Inc()
 		return err
 	}
+	if err := c.syncComponentGroups(tc, v1alpha1.TiFlashMemberType, c.tiflashMemberManager); err != nil {
+		metrics.ClusterUpdateErrors.WithLabelValues(ns, tcName, "tiflash_groups").Inc()
+		return err
+	}
 
 	// works that should be done to make the tikv cluster current state match the desired state:
 	//   - waiting for the pd cluster available(pd cluster is in quorum)
@@ -254,6 +266,10 @@ func (c *defaultTidbClusterControl) updateTidbCluster(tc *v1alpha1.TidbCluster)
 		metrics.ClusterUpdateErrors.WithLabelValues(ns, tcName, "tikv").Inc()
 		return err
 	}
+	if err := c.syncComponentGroups(tc, v1alpha1.TiKVMemberType, c.tikvMemberManager); err != nil {
+		metrics.ClusterUpdateErrors.WithLabelValues(ns, tcName, "tikv_groups").Inc()
+		return err
+	}
 
 	// syncing the pump cluster
 	if err := c.pumpMemberManager.Sync(tc); err != nil {
@@ -273,6 +289,10 @@ func (c *defaultTidbClusterControl) updateTidbCluster(tc *v1alpha1.TidbCluster)
 		metrics.ClusterUpdateErrors.WithLabelValues(ns, tcName, "tidb").Inc()
 		return err
 	}
+	if err := c.syncComponentGroups(tc, v1alpha1.TiDBMemberType, c.tidbMemberManager); err != nil {
+		metrics.ClusterUpdateErrors.WithLabelValues(ns, tcName, "tidb_groups").Inc()
+		return err
+	}
 
 	// works that should be done to make the ticdc cluster current state match the desired state:
 	//   - waiting for the pd cluster available(pd cluster is in quorum)
@@ -328,6 +348,48 @@ func (c *defaultTidbClusterControl) updateTidbCluster(tc *v1alpha1.TidbCluster)
 	return err
 }
 
+// syncComponentGroups syncs the named groups of the component one by one. Each group is synced by the
+// member manager of the component as a separate cluster which joins tc, and its status is recorded
+// back into the groups status of tc.
+func (c *defaultTidbClusterControl) syncComponentGroups(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, m manager.Manager) error {
+	member.CleanComponentGroupStatus(tc)
+	if err := c.componentGroupManager.CleanRemovedGroups(tc, memberType); err != nil {
+		return err
+	}
+	for _, group := range member.ComponentGroupNames(tc, memberType) {
+		gtc, err := member.NewComponentGroupCluster(tc, memberType, group)
+		if err != nil {
+			return err
+		}
+		err = c.syncComponentGroup(gtc, m)
+		member.SetComponentGroupStatus(tc, gtc, memberType, group)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *defaultTidbClusterControl) syncComponentGroup(gtc *v1alpha1.TidbCluster, m manager.Manager) error {
+	if err := c.reclaimPolicyManager.Sync(gtc); err != nil {
+		return err
+	}
+	if _, err := c.orphanPodsCleaner.Clean(gtc); err != nil {
+		return err
+	}
+	if err := m.Sync(gtc); err != nil {
+		return err
+	}
+	if err := c.metaManager.Sync(gtc); err != nil {
+		return err
+	}
+	if _, err := c.pvcCleaner.Clean(gtc); err != nil {
+		return err
+	}
+	// the storage of the group may differ from the default group
+	return c.pvcModifier.Sync(gtc)
+}
+
 func (c *defaultTidbClusterControl) recordMetrics(tc *v1alpha1.TidbCluster) {
 	ns := tc.GetNamespace()
 	tcName := tc.GetName()
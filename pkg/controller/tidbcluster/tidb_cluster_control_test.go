@@ -343,6 +343,7 @@ func newFakeTidbClusterControl() (
 		tiflashMemberManager,
 		ticdcMemberManager,
 		discoveryManager,
+		mm.NewFakeComponentGroupManager(),
 		statusManager,
 		&tidbClusterConditionUpdater{},
 		recorder,
@@ -0,0 +1,140 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbuser
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager/member"
+)
+
+// ControlInterface reconciles TidbUser
+type ControlInterface interface {
+	// ReconcileTidbUser implements the reconcile logic of TidbUser
+	ReconcileTidbUser(tu *v1alpha1.TidbUser) error
+}
+
+// NewDefaultTidbUserControl returns a new instance of the default TidbUser ControlInterface
+func NewDefaultTidbUserControl(deps *controller.Dependencies, manager member.UserManager) ControlInterface {
+	return &defaultTidbUserControl{deps: deps, userManager: manager}
+}
+
+type defaultTidbUserControl struct {
+	deps        *controller.Dependencies
+	userManager member.UserManager
+}
+
+func (c *defaultTidbUserControl) ReconcileTidbUser(tu *v1alpha1.TidbUser) error {
+	if tu.DeletionTimestamp != nil {
+		return c.revokeAndRemoveProtectionFinalizerIfNeed(tu)
+	}
+
+	if !controllerutil.ContainsFinalizer(tu, label.TiDBUserProtectionFinalizer) {
+		controllerutil.AddFinalizer(tu, label.TiDBUserProtectionFinalizer)
+		updated, err := c.deps.Clientset.PingcapV1alpha1().TidbUsers(tu.Namespace).Update(context.TODO(), tu, metav1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+		tu = updated
+	}
+
+	oldStatus := tu.Status.DeepCopy()
+	syncErr := c.userManager.Sync(tu)
+	if !apiequality.Semantic.DeepEqual(&tu.Status, oldStatus) {
+		if err := c.updateStatus(tu); err != nil {
+			return err
+		}
+	}
+	return syncErr
+}
+
+// revokeAndRemoveProtectionFinalizerIfNeed drops the user from TiDB before the TidbUser is deleted.
+func (c *defaultTidbUserControl) revokeAndRemoveProtectionFinalizerIfNeed(tu *v1alpha1.TidbUser) error {
+	if !controllerutil.ContainsFinalizer(tu, label.TiDBUserProtectionFinalizer) {
+		return nil
+	}
+	if err := c.userManager.Revoke(tu); err != nil {
+		return err
+	}
+	controllerutil.RemoveFinalizer(tu, label.TiDBUserProtectionFinalizer)
+	_, err := c.deps.Clientset.PingcapV1alpha1().TidbUsers(tu.Namespace).Update(context.TODO(), tu, metav1.UpdateOptions{})
+	return err
+}
+
+func (c *defaultTidbUserControl) updateStatus(tu *v1alpha1.TidbUser) error {
+	var (
+		ns     = tu.GetNamespace()
+		name   = tu.GetName()
+		status = tu.Status.DeepCopy()
+	)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, updateErr := c.deps.Clientset.PingcapV1alpha1().TidbUsers(ns).UpdateStatus(context.TODO(), tu, metav1.UpdateOptions{})
+		if updateErr == nil {
+			klog.Infof("TidbUser: [%s/%s], update status successfully", ns, name)
+			return nil
+		}
+
+		klog.V(4).Infof("TidbUser: [%s/%s], update status failed, error: %v", ns, name, updateErr)
+
+		if updated, err := c.deps.TiDBUserLister.TidbUsers(ns).Get(name); err == nil {
+			// make a copy so we don't mutate the shared cache
+			tu = updated.DeepCopy()
+			tu.Status = *status
+		} else {
+			utilruntime.HandleError(fmt.Errorf("error getting updated TidbUser %s/%s from lister: %v", ns, name, err))
+		}
+
+		return updateErr
+	})
+	if err != nil {
+		klog.Errorf("TidbUser: [%s/%s], failed to updateStatus, error: %v", ns, name, err)
+	}
+	return err
+}
+
+var _ ControlInterface = &defaultTidbUserControl{}
+
+// FakeTidbUserControl is a fake TidbUser ControlInterface
+type FakeTidbUserControl struct {
+	err error
+}
+
+// NewFakeTidbUserControl returns a FakeTidbUserControl
+func NewFakeTidbUserControl() *FakeTidbUserControl {
+	return &FakeTidbUserControl{}
+}
+
+// SetReconcileTidbUserError sets error for TidbUserControl
+func (c *FakeTidbUserControl) SetReconcileTidbUserError(err error) {
+	c.err = err
+}
+
+// ReconcileTidbUser fake ReconcileTidbUser
+func (c *FakeTidbUserControl) ReconcileTidbUser(_ *v1alpha1.TidbUser) error {
+	return c.err
+}
+
+var _ ControlInterface = &FakeTidbUserControl{}
@@ -0,0 +1,92 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbuser
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+)
+
+type fakeUserManager struct {
+	syncErr   error
+	revokeErr error
+	revoked   int
+}
+
+func (m *fakeUserManager) Sync(tu *v1alpha1.TidbUser) error {
+	if m.syncErr != nil {
+		tu.Status.Phase = v1alpha1.TidbUserFailed
+		tu.Status.Message = m.syncErr.Error()
+		return m.syncErr
+	}
+	tu.Status.Phase = v1alpha1.TidbUserSynced
+	return nil
+}
+
+func (m *fakeUserManager) Revoke(_ *v1alpha1.TidbUser) error {
+	m.revoked++
+	return m.revokeErr
+}
+
+func TestReconcileTidbUser(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	manager := &fakeUserManager{}
+	control := NewDefaultTidbUserControl(deps, manager)
+	client := deps.Clientset.PingcapV1alpha1().TidbUsers(corev1.NamespaceDefault)
+
+	tu := &v1alpha1.TidbUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: corev1.NamespaceDefault},
+		Spec:       v1alpha1.TidbUserSpec{Cluster: v1alpha1.TidbClusterRef{Name: "test"}},
+	}
+	_, err := client.Create(context.TODO(), tu, metav1.CreateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// the finalizer is added and the status is updated
+	g.Expect(control.ReconcileTidbUser(tu.DeepCopy())).To(Succeed())
+	tu, err = client.Get(context.TODO(), tu.Name, metav1.GetOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tu.Finalizers).To(ConsistOf(label.TiDBUserProtectionFinalizer))
+	g.Expect(tu.Status.Phase).To(Equal(v1alpha1.TidbUserSynced))
+
+	// the failure is recorded in the status
+	manager.syncErr = fmt.Errorf("access denied")
+	g.Expect(control.ReconcileTidbUser(tu.DeepCopy())).To(HaveOccurred())
+	tu, err = client.Get(context.TODO(), tu.Name, metav1.GetOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tu.Status.Phase).To(Equal(v1alpha1.TidbUserFailed))
+	g.Expect(tu.Status.Message).To(Equal("access denied"))
+
+	// the finalizer is kept until the user is dropped
+	now := metav1.Now()
+	tu.DeletionTimestamp = &now
+	manager.revokeErr = fmt.Errorf("tidb is unavailable")
+	g.Expect(control.ReconcileTidbUser(tu.DeepCopy())).To(HaveOccurred())
+	manager.revokeErr = nil
+	g.Expect(control.ReconcileTidbUser(tu.DeepCopy())).To(Succeed())
+	g.Expect(manager.revoked).To(Equal(2))
+	tu, err = client.Get(context.TODO(), tu.Name, metav1.GetOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tu.Finalizers).To(BeEmpty())
+}
@@ -34,6 +34,8 @@ import (
 // TiDBDiscovery helps new PD and dm-master member to discover all other members in cluster bootstrap phase.
 type TiDBDiscovery interface {
 	Discover(string) (string, error)
+	// DiscoverRegistration is the same as Discover but takes the structured registration of the member
+	DiscoverRegistration(*Registration) (string, error)
 	DiscoverDM(string) (string, error)
 	VerifyPDEndpoint(string) (string, error)
 }
@@ -45,11 +47,22 @@ type tidbDiscovery struct {
 	dmClusters    map[string]*clusterInfo
 	pdControl     pdapi.PDControlInterface
 	masterControl dmapi.MasterControlInterface
+	resolver      AddressResolver
+	// store persists the bootstrap state of PD, the state is only kept in memory if it is nil
+	store stateStore
 }
 
 type clusterInfo struct {
 	resourceVersion string
 	peers           map[string]struct{}
+
+	// the following fields are only used by PD
+	initializer  string
+	bootstrapped bool
+	joined       map[string]struct{}
+	// stateVersion is the version of the persisted state which the info is loaded from or saved to
+	stateVersion string
+	persisted    bool
 }
 
 type pdEndpointURL struct {
@@ -61,10 +74,17 @@ type pdEndpointURL struct {
 
 // NewTiDBDiscovery returns a TiDBDiscovery
 func NewTiDBDiscovery(pdControl pdapi.PDControlInterface, masterControl dmapi.MasterControlInterface, cli versioned.Interface, kubeCli kubernetes.Interface) TiDBDiscovery {
+	return NewTiDBDiscoveryWithResolver(pdControl, masterControl, cli, kubeCli, FQDNResolver{})
+}
+
+// NewTiDBDiscoveryWithResolver returns a TiDBDiscovery which resolves the advertised peer URLs of PD by the resolver
+func NewTiDBDiscoveryWithResolver(pdControl pdapi.PDControlInterface, masterControl dmapi.MasterControlInterface, cli versioned.Interface, kubeCli kubernetes.Interface, resolver AddressResolver) TiDBDiscovery {
 	return &tidbDiscovery{
 		cli:           cli,
 		pdControl:     pdControl,
 		masterControl: masterControl,
+		resolver:      resolver,
+		store:         &configMapStateStore{kubeCli: kubeCli},
 		clusters:      map[string]*clusterInfo{},
 		dmClusters:    map[string]*clusterInfo{},
 	}
@@ -78,15 +98,32 @@ func (d *tidbDiscovery) Discover(advertisePeerUrl string) (string, error) {
 		return "", fmt.Errorf("advertisePeerUrl is empty")
 	}
 	klog.Infof("advertisePeerUrl is: %s", advertisePeerUrl)
-	strArr := strings.Split(advertisePeerUrl, ":")
-	hostArr := strings.Split(strArr[0], ".")
+	reg, err := d.resolver.Resolve(advertisePeerUrl)
+	if err != nil {
+		return "", err
+	}
+	return d.discover(reg)
+}
+
+func (d *tidbDiscovery) DiscoverRegistration(reg *Registration) (string, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
 
-	if len(hostArr) < 4 || hostArr[3] != "svc" {
-		return "", fmt.Errorf("advertisePeerUrl format is wrong: %s", advertisePeerUrl)
+	if reg == nil {
+		return "", fmt.Errorf("registration is empty")
 	}
+	klog.Infof("registration is: %+v", *reg)
+	return d.discover(reg)
+}
 
-	podName, peerServiceName, ns := hostArr[0], hostArr[1], hostArr[2]
-	tcName := strings.TrimSuffix(peerServiceName, "-pd-peer")
+// discover returns the arguments for the member to bootstrap or join the PD cluster, d.lock must be held.
+// The decisions are persisted before they are returned, so the same pod gets the same answer after
+// the discovery restarts or another replica takes over, and the PD cluster is never initialized twice.
+func (d *tidbDiscovery) discover(reg *Registration) (string, error) {
+	if err := reg.Validate(); err != nil {
+		return "", err
+	}
+	podName, ns, tcName := reg.PodName, reg.Namespace, reg.ClusterName
 	podNamespace := os.Getenv("MY_POD_NAMESPACE")
 
 	if ns != podNamespace {
@@ -96,34 +133,162 @@ func (d *tidbDiscovery) Discover(advertisePeerUrl string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	keyName := fmt.Sprintf("%s/%s", ns, tcName)
-
-	currentCluster := d.clusters[keyName]
-	if currentCluster == nil || currentCluster.resourceVersion != tc.ResourceVersion {
-		d.clusters[keyName] = &clusterInfo{
-			resourceVersion: tc.ResourceVersion,
-			peers:           map[string]struct{}{},
-		}
+	currentCluster, err := d.getClusterInfo(tc)
+	if err != nil {
+		return "", err
 	}
-	currentCluster = d.clusters[keyName]
 	currentCluster.peers[podName] = struct{}{}
 
+	// the initializer asks again if it restarted before PD served, give it the same answer until
+	// the PD cluster is up
+	retryInitialize := currentCluster.initializer == podName && !currentCluster.bootstrapped
+
 	// Should take failover replicas into consideration
-	if len(currentCluster.peers) == int(tc.PDStsDesiredReplicas()) && tc.Spec.Cluster == nil {
-		delete(currentCluster.peers, podName)
+	if (len(currentCluster.peers) == int(tc.PDStsDesiredReplicas()) && currentCluster.initializer == "" && !currentCluster.bootstrapped || retryInitialize) &&
+		tc.Spec.Cluster == nil {
 		pdAddresses := tc.Spec.PDAddresses
 		// Join an existing PD cluster if tc.Spec.PDAddresses is set
 		if len(pdAddresses) != 0 {
+			delete(currentCluster.peers, podName)
+			currentCluster.joined[podName] = struct{}{}
+			currentCluster.bootstrapped = true
+			if err := d.saveClusterInfo(tc, currentCluster); err != nil {
+				return "", err
+			}
 			return fmt.Sprintf("--join=%s", strings.Join(pdAddresses, ",")), nil
 		}
-		// Initialize the PD cluster with the FQDN format service record if deploy across k8s or tc.Spec.ClusterDomain is set
-		if tc.AcrossK8s() || tc.Spec.ClusterDomain != "" {
-			return fmt.Sprintf("--initial-cluster=%s=%s://%s", strArr[0], tc.Scheme(), advertisePeerUrl), nil
+		if !retryInitialize || !d.pdServes(tc) {
+			delete(currentCluster.peers, podName)
+			currentCluster.initializer = podName
+			if err := d.saveClusterInfo(tc, currentCluster); err != nil {
+				return "", err
+			}
+			// Initialize the PD cluster with the FQDN format service record if deploy across k8s or tc.Spec.ClusterDomain is set,
+			// otherwise in the normal format service record.
+			memberName := reg.MemberName
+			if memberName == "" {
+				memberName = podName
+				if tc.AcrossK8s() || tc.Spec.ClusterDomain != "" {
+					memberName = reg.hosts()[0]
+				}
+			}
+			initialCluster := make([]string, 0, len(reg.Addresses))
+			for _, addr := range reg.Addresses {
+				initialCluster = append(initialCluster, fmt.Sprintf("%s=%s://%s", memberName, tc.Scheme(), addr))
+			}
+			return fmt.Sprintf("--initial-cluster=%s", strings.Join(initialCluster, ",")), nil
+		}
+	}
+	// record the peer, so a restarted discovery keeps counting from it
+	if err := d.saveClusterInfo(tc, currentCluster); err != nil {
+		return "", err
+	}
+
+	membersInfo, err := d.getMembers(tc)
+	if err != nil {
+		return "", err
+	}
+
+	selfNames := map[string]struct{}{podName: {}}
+	if reg.MemberName != "" {
+		selfNames[reg.MemberName] = struct{}{}
+	}
+	for _, host := range reg.hosts() {
+		selfNames[host] = struct{}{}
+	}
+	membersArr := make([]string, 0)
+	for _, member := range membersInfo.Members {
+		// Corresponds to https://github.com/tikv/pd/blob/43baea981b406df26cd49e8b99cc42354f0a6696/server/join/join.go#L88.
+		// When multi-cluster enabled, the PD member name is not pod name(cluster1-pd-0) but the FQDN (cluster1-pd-0.cluster1-pd-peer.pingcap.svc.cluster.local).
+		// For example,
+		// advertisePeerURL without cluster domain: host = cluster1-pd-0.cluster1-pd-peer.pingcap.svc, member.Name = cluster1-pd-0, podName = cluster1-pd-0
+		// advertisePeerURL with cluster domain: host = cluster1-pd-0.cluster1-pd-peer.pingcap.svc.cluster.local, member.Name = cluster1-pd-0.cluster1-pd-peer.pingcap.svc.cluster.local, podName = cluster1-pd-0
+		// So we skip the members named by the pod name, the member name or the hosts of the registration.
+		//
+		// In some failure situations, for example, delete the pd's data directory, pd will try to restart
+		// and get join info from discovery service. But pd embed etcd may still have the registered member info,
+		// which will return the argument to join pd itself, which is not suggested in pd.
+		if _, ok := selfNames[member.Name]; ok {
+			continue
+		}
+		// a member advertising multiple addresses, e.g. both IPv4 and IPv6, can be joined by any of them
+		for _, peerURL := range member.PeerUrls {
+			memberURL := strings.ReplaceAll(peerURL, fmt.Sprintf(":%d", v1alpha1.DefaultPDPeerPort), fmt.Sprintf(":%d", v1alpha1.DefaultPDClientPort))
+			membersArr = append(membersArr, memberURL)
 		}
-		// Initialize the PD cluster in the normal format service record.
-		return fmt.Sprintf("--initial-cluster=%s=%s://%s", podName, tc.Scheme(), advertisePeerUrl), nil
 	}
+	delete(currentCluster.peers, podName)
+	currentCluster.joined[podName] = struct{}{}
+	currentCluster.bootstrapped = true
+	if err := d.saveClusterInfo(tc, currentCluster); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("--join=%s", strings.Join(membersArr, ",")), nil
+}
+
+// getClusterInfo returns the bootstrap info of the cluster, the persisted state takes precedence over
+// the one in memory if it is changed by another discovery. A cluster without persisted state is
+// recovered from the status of the TidbCluster.
+func (d *tidbDiscovery) getClusterInfo(tc *v1alpha1.TidbCluster) (*clusterInfo, error) {
+	keyName := fmt.Sprintf("%s/%s", tc.GetNamespace(), tc.GetName())
+	currentCluster := d.clusters[keyName]
+
+	if d.store != nil {
+		state, version, err := d.store.load(tc)
+		if err != nil {
+			return nil, err
+		}
+		if state != nil && (currentCluster == nil || !currentCluster.persisted || currentCluster.stateVersion != version) {
+			currentCluster = newClusterInfoFromState(state, version)
+		}
+		if state == nil && currentCluster != nil {
+			currentCluster.persisted = false
+		}
+	}
+
+	if currentCluster == nil {
+		currentCluster = &clusterInfo{
+			resourceVersion: tc.ResourceVersion,
+			peers:           map[string]struct{}{},
+			// the PD cluster exists if there are members in the status
+			bootstrapped: len(tc.Status.PD.Members) > 0,
+		}
+	}
+	// the peers are counted again if the TidbCluster is changed, but the decisions are kept
+	if currentCluster.resourceVersion != tc.ResourceVersion {
+		currentCluster.resourceVersion = tc.ResourceVersion
+		currentCluster.peers = map[string]struct{}{}
+	}
+	if currentCluster.joined == nil {
+		currentCluster.joined = map[string]struct{}{}
+	}
+	d.clusters[keyName] = currentCluster
+	return currentCluster, nil
+}
 
+func (d *tidbDiscovery) saveClusterInfo(tc *v1alpha1.TidbCluster, info *clusterInfo) error {
+	if d.store == nil {
+		return nil
+	}
+	version, err := d.store.save(tc, info.toState(), info.stateVersion, !info.persisted)
+	if err != nil {
+		// drop the info in memory, it is loaded again in the next request
+		delete(d.clusters, fmt.Sprintf("%s/%s", tc.GetNamespace(), tc.GetName()))
+		return fmt.Errorf("failed to save the bootstrap state of tidbcluster %s/%s: %v", tc.GetNamespace(), tc.GetName(), err)
+	}
+	info.stateVersion = version
+	info.persisted = true
+	return nil
+}
+
+// pdServes returns whether the members of the PD cluster can be got
+func (d *tidbDiscovery) pdServes(tc *v1alpha1.TidbCluster) bool {
+	_, err := d.getMembers(tc)
+	return err == nil
+}
+
+func (d *tidbDiscovery) getMembers(tc *v1alpha1.TidbCluster) (*pdapi.MembersInfo, error) {
+	ns := tc.GetNamespace()
 	var pdClients []pdapi.PDClient
 
 	if tc.Spec.PD != nil {
@@ -151,6 +316,7 @@ func (d *tidbDiscovery) Discover(advertisePeerUrl string) (string, error) {
 	}
 
 	var membersInfo *pdapi.MembersInfo
+	var err error
 	for _, client := range pdClients {
 		membersInfo, err = client.GetMembers()
 		if err == nil {
@@ -158,29 +324,12 @@ func (d *tidbDiscovery) Discover(advertisePeerUrl string) (string, error) {
 		}
 	}
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-
-	membersArr := make([]string, 0)
-	for _, member := range membersInfo.Members {
-		// Corresponds to https://github.com/tikv/pd/blob/43baea981b406df26cd49e8b99cc42354f0a6696/server/join/join.go#L88.
-		// When multi-cluster enabled, the PD member name is not pod name(cluster1-pd-0) but the FQDN (cluster1-pd-0.cluster1-pd-peer.pingcap.svc.cluster.local).
-		// For example,
-		// advertisePeerURL without cluster domain: strArr = ["cluster1-pd-0.cluster1-pd-peer.pingcap.svc","2380"], member.Name = cluster1-pd-0, podName = cluster1-pd-0
-		// advertisePeerURL with cluster domain: strArr = ["cluster1-pd-0.cluster1-pd-peer.pingcap.svc.cluster.local","2380"], member.Name = cluster1-pd-0.cluster1-pd-peer.pingcap.svc.cluster.local, podName = cluster1-pd-0
-		// So we use podName when advertisePeerURL without cluster domain and use strArr[0] when advertisePeerURL with cluster domain
-		//
-		// In some failure situations, for example, delete the pd's data directory, pd will try to restart
-		// and get join info from discovery service. But pd embed etcd may still have the registered member info,
-		// which will return the argument to join pd itself, which is not suggested in pd.
-		if member.Name == podName || member.Name == strArr[0] {
-			continue
-		}
-		memberURL := strings.ReplaceAll(member.PeerUrls[0], fmt.Sprintf(":%d", v1alpha1.DefaultPDPeerPort), fmt.Sprintf(":%d", v1alpha1.DefaultPDClientPort))
-		membersArr = append(membersArr, memberURL)
+	if membersInfo == nil {
+		return nil, fmt.Errorf("no pd client for tidbcluster %s/%s", ns, tc.GetName())
 	}
-	delete(currentCluster.peers, podName)
-	return fmt.Sprintf("--join=%s", strings.Join(membersArr, ",")), nil
+	return membersInfo, nil
 }
 
 func (d *tidbDiscovery) DiscoverDM(advertisePeerUrl string) (string, error) {
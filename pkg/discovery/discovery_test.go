@@ -22,11 +22,13 @@ import (
 
 	. "github.com/onsi/gomega"
 	"github.com/pingcap/kvproto/pkg/pdpb"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned/fake"
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/dmapi"
 	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubeinformers "k8s.io/client-go/informers"
@@ -535,6 +537,270 @@ func TestDiscoveryDiscovery(t *testing.T) {
 	}
 }
 
+func TestDiscoveryDiscoverRegistration(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type testcase struct {
+		name         string
+		reg          *Registration
+		clusters     map[string]*clusterInfo
+		getMembersFn func() (*pdapi.MembersInfo, error)
+		expectFn     func(*GomegaWithT, string, error)
+	}
+	testFn := func(test testcase, t *testing.T) {
+		cli := fake.NewSimpleClientset()
+		kubeCli := kubefake.NewSimpleClientset()
+		informer := kubeinformers.NewSharedInformerFactory(kubeCli, 0)
+		fakePDControl := pdapi.NewFakePDControl(informer.Core().V1().Secrets().Lister())
+		fakeMasterControl := dmapi.NewFakeMasterControl(informer.Core().V1().Secrets().Lister())
+		pdClient := pdapi.NewFakePDClient()
+		tc := newTC()
+		cli.PingcapV1alpha1().TidbClusters(tc.Namespace).Create(context.TODO(), tc, metav1.CreateOptions{})
+		fakePDControl.SetPDClient(pdapi.Namespace(tc.GetNamespace()), tc.GetName(), pdClient)
+		pdClient.AddReaction(pdapi.GetMembersActionType, func(action *pdapi.Action) (interface{}, error) {
+			return test.getMembersFn()
+		})
+
+		td := NewTiDBDiscovery(fakePDControl, fakeMasterControl, cli, kubeCli)
+		td.(*tidbDiscovery).clusters = test.clusters
+
+		os.Setenv("MY_POD_NAMESPACE", "default")
+		re, err := td.DiscoverRegistration(test.reg)
+		test.expectFn(g, re, err)
+	}
+	tests := []testcase{
+		{
+			name:     "registration without addresses",
+			reg:      &Registration{PodName: "demo-pd-0", Namespace: "default", ClusterName: "demo"},
+			clusters: map[string]*clusterInfo{},
+			expectFn: func(g *GomegaWithT, s string, err error) {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring("addresses is empty"))
+			},
+		},
+		{
+			name: "IPv6 address without port",
+			reg: &Registration{
+				PodName: "demo-pd-0", Namespace: "default", ClusterName: "demo",
+				Addresses: []string{"fd00::1"},
+			},
+			clusters: map[string]*clusterInfo{},
+			expectFn: func(g *GomegaWithT, s string, err error) {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring("address \"fd00::1\" is invalid"))
+			},
+		},
+		{
+			name: "initialize the cluster with multiple addresses",
+			reg: &Registration{
+				PodName: "demo-pd-0", Namespace: "default", ClusterName: "demo",
+				Addresses: []string{"10.0.0.1:2380", "[fd00::1]:2380"},
+			},
+			clusters: map[string]*clusterInfo{
+				"default/demo": {
+					resourceVersion: "1",
+					peers: map[string]struct{}{
+						"demo-pd-1": {},
+						"demo-pd-2": {},
+					},
+				},
+			},
+			expectFn: func(g *GomegaWithT, s string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(s).To(Equal("--initial-cluster=demo-pd-0=http://10.0.0.1:2380,demo-pd-0=http://[fd00::1]:2380"))
+			},
+		},
+		{
+			name: "join the cluster by the addresses of other members",
+			reg: &Registration{
+				PodName: "demo-pd-1", Namespace: "default", ClusterName: "demo", MemberName: "pd-1",
+				Addresses: []string{"[fd00::2]:2380"},
+			},
+			clusters: map[string]*clusterInfo{},
+			getMembersFn: func() (*pdapi.MembersInfo, error) {
+				return &pdapi.MembersInfo{
+					Members: []*pdpb.Member{
+						{
+							Name:     "demo-pd-0",
+							PeerUrls: []string{"http://10.0.0.1:2380", "http://[fd00::1]:2380"},
+						},
+						{
+							Name:     "pd-1",
+							PeerUrls: []string{"http://[fd00::2]:2380"},
+						},
+					},
+				}, nil
+			},
+			expectFn: func(g *GomegaWithT, s string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(s).To(Equal("--join=http://10.0.0.1:2379,http://[fd00::1]:2379"))
+			},
+		},
+	}
+
+	for i := range tests {
+		t.Log(tests[i].name)
+		testFn(tests[i], t)
+	}
+}
+
+func TestDiscoveryRecoverState(t *testing.T) {
+	g := NewGomegaWithT(t)
+	os.Setenv("MY_POD_NAMESPACE", "default")
+
+	cli := fake.NewSimpleClientset()
+	kubeCli := kubefake.NewSimpleClientset()
+	informer := kubeinformers.NewSharedInformerFactory(kubeCli, 0)
+	fakePDControl := pdapi.NewFakePDControl(informer.Core().V1().Secrets().Lister())
+	fakeMasterControl := dmapi.NewFakeMasterControl(informer.Core().V1().Secrets().Lister())
+	pdClient := pdapi.NewFakePDClient()
+	tc := newTC()
+	cli.PingcapV1alpha1().TidbClusters(tc.Namespace).Create(context.TODO(), tc, metav1.CreateOptions{})
+	fakePDControl.SetPDClient(pdapi.Namespace(tc.GetNamespace()), tc.GetName(), pdClient)
+	var members []*pdpb.Member
+	pdClient.AddReaction(pdapi.GetMembersActionType, func(action *pdapi.Action) (interface{}, error) {
+		if len(members) == 0 {
+			return nil, fmt.Errorf("pd is not serving")
+		}
+		return &pdapi.MembersInfo{Members: members}, nil
+	})
+	// a new discovery is created for each request, as if it restarted or another replica took over
+	discover := func(url string) (string, error) {
+		return NewTiDBDiscovery(fakePDControl, fakeMasterControl, cli, kubeCli).Discover(url)
+	}
+
+	_, err := discover("demo-pd-0.demo-pd-peer.default.svc:2380")
+	g.Expect(err).To(HaveOccurred())
+	_, err = discover("demo-pd-1.demo-pd-peer.default.svc:2380")
+	g.Expect(err).To(HaveOccurred())
+	// the peers are counted across the restarts
+	s, err := discover("demo-pd-2.demo-pd-peer.default.svc:2380")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(s).To(Equal("--initial-cluster=demo-pd-2=http://demo-pd-2.demo-pd-peer.default.svc:2380"))
+
+	cm, err := kubeCli.CoreV1().ConfigMaps("default").Get(context.TODO(), "demo-discovery-state", metav1.GetOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cm.OwnerReferences).To(HaveLen(1))
+	g.Expect(cm.Data[stateKey]).To(ContainSubstring(`"initializer":"demo-pd-2"`))
+
+	// the initializer gets the same answer before PD serves
+	s, err = discover("demo-pd-2.demo-pd-peer.default.svc:2380")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(s).To(Equal("--initial-cluster=demo-pd-2=http://demo-pd-2.demo-pd-peer.default.svc:2380"))
+
+	// the cluster is never initialized twice, even if the peers are counted again
+	tc.ResourceVersion = "2"
+	cli.PingcapV1alpha1().TidbClusters(tc.Namespace).Update(context.TODO(), tc, metav1.UpdateOptions{})
+	for _, url := range []string{"demo-pd-0.demo-pd-peer.default.svc:2380", "demo-pd-1.demo-pd-peer.default.svc:2380"} {
+		_, err = discover(url)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("pd is not serving"))
+	}
+
+	members = []*pdpb.Member{{Name: "demo-pd-2", PeerUrls: []string{"http://demo-pd-2.demo-pd-peer.default.svc:2380"}}}
+	s, err = discover("demo-pd-0.demo-pd-peer.default.svc:2380")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(s).To(Equal("--join=http://demo-pd-2.demo-pd-peer.default.svc:2379"))
+	// the initializer joins once PD serves
+	s, err = discover("demo-pd-2.demo-pd-peer.default.svc:2380")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(s).To(Equal("--join="))
+}
+
+func TestDiscoveryRecoverFromStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+	os.Setenv("MY_POD_NAMESPACE", "default")
+
+	cli := fake.NewSimpleClientset()
+	kubeCli := kubefake.NewSimpleClientset()
+	informer := kubeinformers.NewSharedInformerFactory(kubeCli, 0)
+	fakePDControl := pdapi.NewFakePDControl(informer.Core().V1().Secrets().Lister())
+	fakeMasterControl := dmapi.NewFakeMasterControl(informer.Core().V1().Secrets().Lister())
+	pdClient := pdapi.NewFakePDClient()
+	tc := newTC()
+	tc.Spec.PD.Replicas = 1
+	tc.Status.PD.Members = map[string]v1alpha1.PDMember{"demo-pd-0": {Name: "demo-pd-0"}}
+	cli.PingcapV1alpha1().TidbClusters(tc.Namespace).Create(context.TODO(), tc, metav1.CreateOptions{})
+	fakePDControl.SetPDClient(pdapi.Namespace(tc.GetNamespace()), tc.GetName(), pdClient)
+	pdClient.AddReaction(pdapi.GetMembersActionType, func(action *pdapi.Action) (interface{}, error) {
+		return nil, fmt.Errorf("pd is not serving")
+	})
+
+	// the PD cluster in the status exists, the only peer must not initialize another one
+	td := NewTiDBDiscovery(fakePDControl, fakeMasterControl, cli, kubeCli)
+	_, err := td.Discover("demo-pd-0.demo-pd-peer.default.svc:2380")
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("pd is not serving"))
+}
+
+func TestFQDNResolver(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	reg, err := FQDNResolver{}.Resolve("demo-pd-0.demo-pd-peer.default.svc.cluster.local:2380")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(reg).To(Equal(&Registration{
+		PodName:     "demo-pd-0",
+		Namespace:   "default",
+		ClusterName: "demo",
+		Addresses:   []string{"demo-pd-0.demo-pd-peer.default.svc.cluster.local:2380"},
+	}))
+	g.Expect(reg.hosts()).To(Equal([]string{"demo-pd-0.demo-pd-peer.default.svc.cluster.local"}))
+
+	_, err = FQDNResolver{}.Resolve("[fd00::1]:2380")
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("advertisePeerUrl format is wrong"))
+}
+
+func TestPodIPResolver(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	newPod := func(name, component, ip string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    label.New().Instance("demo").Component(component).Labels(),
+			},
+			Status: corev1.PodStatus{
+				PodIP:  ip,
+				PodIPs: []corev1.PodIP{{IP: ip}, {IP: "fd00::" + strings.TrimPrefix(ip, "10.0.0.")}},
+			},
+		}
+	}
+	kubeCli := kubefake.NewSimpleClientset(
+		// a TiKV pod in the host network shares the IP with the PD pod
+		newPod("demo-tikv-0", label.TiKVLabelVal, "10.0.0.1"),
+		newPod("demo-pd-0", label.PDLabelVal, "10.0.0.1"),
+		newPod("demo-pd-1", label.PDLabelVal, "10.0.0.2"),
+	)
+	resolver, err := NewAddressResolver(v1alpha1.DiscoveryAddressResolverPodIP, kubeCli, "default")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	reg, err := resolver.Resolve("10.0.0.1:2380")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(reg).To(Equal(&Registration{
+		PodName:     "demo-pd-0",
+		Namespace:   "default",
+		ClusterName: "demo",
+		Addresses:   []string{"10.0.0.1:2380"},
+	}))
+
+	reg, err = resolver.Resolve("[fd00::2]:2380")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(reg.PodName).To(Equal("demo-pd-1"))
+
+	_, err = resolver.Resolve("10.0.0.3:2380")
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("no PD pod has the IP"))
+
+	_, err = resolver.Resolve("demo-pd-0.demo-pd-peer.default.svc:2380")
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("is not an IP address"))
+
+	_, err = NewAddressResolver("dns", kubeCli, "default")
+	g.Expect(err).To(HaveOccurred())
+}
+
 func TestDiscoveryDMDiscovery(t *testing.T) {
 	g := NewGomegaWithT(t)
 
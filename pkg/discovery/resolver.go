@@ -0,0 +1,179 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Registration is the structured registration of a PD member in the bootstrap phase, it does not
+// depend on the DNS records of the peer service, so it can be used by the members which run in
+// the host network or advertise IP addresses.
+type Registration struct {
+	// PodName is the name of the pod of the member
+	PodName string `json:"podName"`
+	// Namespace is the namespace of the pod, it must be the namespace of the discovery service
+	Namespace string `json:"namespace"`
+	// ClusterName is the name of the TidbCluster which the member belongs to
+	ClusterName string `json:"clusterName"`
+	// MemberName is the name of the PD member, it defaults to the pod name, or the host of the first
+	// address if the cluster is deployed across Kubernetes clusters or the cluster domain is set
+	// +optional
+	MemberName string `json:"memberName,omitempty"`
+	// Addresses are the advertised peer addresses of the member in the form of host:port, IPv6
+	// hosts must be enclosed in brackets. All of them are used as the peer URLs of the member.
+	Addresses []string `json:"addresses"`
+}
+
+// Validate checks the required fields and the format of the addresses
+func (r *Registration) Validate() error {
+	if r.PodName == "" {
+		return fmt.Errorf("podName is empty")
+	}
+	if r.Namespace == "" {
+		return fmt.Errorf("namespace is empty")
+	}
+	if r.ClusterName == "" {
+		return fmt.Errorf("clusterName is empty")
+	}
+	if len(r.Addresses) == 0 {
+		return fmt.Errorf("addresses is empty")
+	}
+	for _, addr := range r.Addresses {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return fmt.Errorf("address %q is invalid: %v", addr, err)
+		}
+	}
+	return nil
+}
+
+// hosts returns the hosts of the addresses
+func (r *Registration) hosts() []string {
+	hosts := make([]string, 0, len(r.Addresses))
+	for _, addr := range r.Addresses {
+		hosts = append(hosts, addressHost(addr))
+	}
+	return hosts
+}
+
+// addressHost returns the host of an address, the address itself is returned if it has no port
+func addressHost(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// AddressResolver resolves the advertised peer URL of a PD member to its registration
+type AddressResolver interface {
+	Resolve(advertisePeerURL string) (*Registration, error)
+}
+
+// NewAddressResolver returns the resolver by its name, the PD pods are looked up in the namespace
+// if the pod IPs are resolved
+func NewAddressResolver(name v1alpha1.DiscoveryAddressResolver, kubeCli kubernetes.Interface, namespace string) (AddressResolver, error) {
+	switch name {
+	case "", v1alpha1.DiscoveryAddressResolverFQDN:
+		return FQDNResolver{}, nil
+	case v1alpha1.DiscoveryAddressResolverPodIP:
+		return &PodIPResolver{kubeCli: kubeCli, namespace: namespace}, nil
+	default:
+		return nil, fmt.Errorf("unknown address resolver %q", name)
+	}
+}
+
+// FQDNResolver resolves the advertised peer URL in the form of <pod>.<tc>-pd-peer.<ns>.svc[.<domain>]:<port>,
+// which is the default format of the PD start script
+type FQDNResolver struct{}
+
+var _ AddressResolver = FQDNResolver{}
+
+func (FQDNResolver) Resolve(advertisePeerURL string) (*Registration, error) {
+	hostArr := strings.Split(addressHost(advertisePeerURL), ".")
+	if len(hostArr) < 4 || hostArr[3] != "svc" {
+		return nil, fmt.Errorf("advertisePeerUrl format is wrong: %s", advertisePeerURL)
+	}
+
+	podName, peerServiceName, ns := hostArr[0], hostArr[1], hostArr[2]
+	return &Registration{
+		PodName:     podName,
+		Namespace:   ns,
+		ClusterName: strings.TrimSuffix(peerServiceName, "-pd-peer"),
+		Addresses:   []string{advertisePeerURL},
+	}, nil
+}
+
+// PodIPResolver resolves the advertised peer URL in the form of <ip>:<port> by the PD pod which has
+// the IP, it is used if PD advertises the pod IPs, e.g. in the host network
+type PodIPResolver struct {
+	kubeCli   kubernetes.Interface
+	namespace string
+}
+
+var _ AddressResolver = &PodIPResolver{}
+
+func (r *PodIPResolver) Resolve(advertisePeerURL string) (*Registration, error) {
+	host := addressHost(advertisePeerURL)
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil, fmt.Errorf("advertisePeerUrl is not an IP address: %s", advertisePeerURL)
+	}
+
+	pods, err := r.kubeCli.CoreV1().Pods(r.namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: label.New().PD().String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PD pods in namespace %s: %v", r.namespace, err)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !podHasIP(pod, ip) {
+			continue
+		}
+		tcName := pod.Labels[label.InstanceLabelKey]
+		if tcName == "" {
+			return nil, fmt.Errorf("pod %s/%s has no label %s", pod.Namespace, pod.Name, label.InstanceLabelKey)
+		}
+		return &Registration{
+			PodName:     pod.Name,
+			Namespace:   pod.Namespace,
+			ClusterName: tcName,
+			Addresses:   []string{advertisePeerURL},
+		}, nil
+	}
+	return nil, fmt.Errorf("no PD pod has the IP of advertisePeerUrl: %s", advertisePeerURL)
+}
+
+// podHasIP returns whether any of the IPs of the pod equals to ip
+func podHasIP(pod *corev1.Pod, ip net.IP) bool {
+	podIPs := []string{pod.Status.PodIP}
+	for _, podIP := range pod.Status.PodIPs {
+		podIPs = append(podIPs, podIP.IP)
+	}
+	for _, podIP := range podIPs {
+		if parsed := net.ParseIP(podIP); parsed != nil && parsed.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
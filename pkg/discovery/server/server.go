@@ -33,26 +33,65 @@ import (
 type server struct {
 	discovery discovery.TiDBDiscovery
 	container *restful.Container
+	// isLeader returns whether the server is the leader of the discovery replicas, only the
+	// leader answers the bootstrap requests
+	isLeader func() bool
+	// resolver resolves the advertised peer URLs of PD
+	resolver discovery.AddressResolver
+}
+
+// Option configures the server
+type Option func(*server)
+
+// WithLeaderCheck makes the server answer the bootstrap requests only if isLeader returns true,
+// the others get 503 and retry, which is expected to be served by the leader
+func WithLeaderCheck(isLeader func() bool) Option {
+	return func(s *server) {
+		s.isLeader = isLeader
+	}
+}
+
+// WithAddressResolver makes the server resolve the advertised peer URLs of PD by the resolver,
+// FQDNResolver is used if it is not set
+func WithAddressResolver(resolver discovery.AddressResolver) Option {
+	return func(s *server) {
+		s.resolver = resolver
+	}
 }
 
 // NewServer creates a new server.
-func NewServer(pdControl pdapi.PDControlInterface, masterControl dmapi.MasterControlInterface, cli versioned.Interface, kubeCli kubernetes.Interface) Server {
+func NewServer(pdControl pdapi.PDControlInterface, masterControl dmapi.MasterControlInterface, cli versioned.Interface, kubeCli kubernetes.Interface, opts ...Option) Server {
 	s := &server{
-		discovery: discovery.NewTiDBDiscovery(pdControl, masterControl, cli, kubeCli),
 		container: restful.NewContainer(),
+		resolver:  discovery.FQDNResolver{},
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	s.discovery = discovery.NewTiDBDiscoveryWithResolver(pdControl, masterControl, cli, kubeCli, s.resolver)
 	s.registerHandlers()
 	return s
 }
 
 func (s *server) registerHandlers() {
 	ws := new(restful.WebService)
-	ws.Route(ws.GET("/new/{advertise-peer-url}").To(s.newHandler))
-	ws.Route(ws.GET("/new/{advertise-peer-url}/{register-type}").To(s.newHandler))
+	ws.Route(ws.GET("/new/{advertise-peer-url}").Filter(s.leaderFilter).To(s.newHandler))
+	ws.Route(ws.GET("/new/{advertise-peer-url}/{register-type}").Filter(s.leaderFilter).To(s.newHandler))
 	ws.Route(ws.GET("/verify/{pd-url}").To(s.newVerifyHandler))
+	ws.Route(ws.POST("/register").Consumes(restful.MIME_JSON).Filter(s.leaderFilter).To(s.registerHandler))
 	s.container.Add(ws)
 }
 
+func (s *server) leaderFilter(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
+	if s.isLeader != nil && !s.isLeader() {
+		if werr := resp.WriteErrorString(http.StatusServiceUnavailable, "not the leader of discovery"); werr != nil {
+			klog.Errorf("failed to writeError: %v", werr)
+		}
+		return
+	}
+	chain.ProcessFilter(req, resp)
+}
+
 func (s *server) ListenAndServe(addr string) {
 	klog.Fatal(http.ListenAndServe(addr, s.container.ServeMux))
 }
@@ -102,6 +141,33 @@ func (s *server) newHandler(req *restful.Request, resp *restful.Response) {
 
 }
 
+// registerHandler handles the structured registration of PD members, which does not depend on
+// the DNS records of the peer service
+func (s *server) registerHandler(req *restful.Request, resp *restful.Response) {
+	reg := &discovery.Registration{}
+	if err := req.ReadEntity(reg); err != nil {
+		klog.Errorf("failed to read registration: %v", err)
+		if werr := resp.WriteError(http.StatusBadRequest, err); werr != nil {
+			klog.Errorf("failed to writeError: %v", werr)
+		}
+		return
+	}
+
+	result, err := s.discovery.DiscoverRegistration(reg)
+	if err != nil {
+		klog.Errorf("failed to discover: %+v, %v", *reg, err)
+		if werr := resp.WriteError(http.StatusInternalServerError, err); werr != nil {
+			klog.Errorf("failed to writeError: %v", werr)
+		}
+		return
+	}
+
+	klog.Infof("generated args for %s/%s: %s", reg.Namespace, reg.PodName, result)
+	if _, err := io.WriteString(resp, result); err != nil {
+		klog.Errorf("failed to writeString: %s, %v", result, err)
+	}
+}
+
 func (s *server) newVerifyHandler(req *restful.Request, resp *restful.Response) {
 	encodedPDPeerURL := req.PathParameter("pd-url")
 	data, err := base64.StdEncoding.DecodeString(encodedPDPeerURL)
@@ -265,3 +265,74 @@ func TestVerifyServer(t *testing.T) {
 		t.Errorf("verify pdEndpoint failed: %v", err)
 	}
 }
+
+func TestRegisterServer(t *testing.T) {
+	os.Setenv("MY_POD_NAMESPACE", "default")
+	cli := fake.NewSimpleClientset()
+	kubeCli := kubefake.NewSimpleClientset()
+	informer := informers.NewSharedInformerFactory(kubeCli, 0)
+	fakePDControl := pdapi.NewFakePDControl(informer.Core().V1().Secrets().Lister())
+	fakeMasterControl := dmapi.NewFakeMasterControl(informer.Core().V1().Secrets().Lister())
+	pdClient := pdapi.NewFakePDClient()
+	pdClient.AddReaction(pdapi.GetMembersActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.MembersInfo{
+			Members: []*pdpb.Member{
+				{Name: "foo-pd-0", PeerUrls: []string{"http://[fd00::1]:2380"}},
+			},
+		}, nil
+	})
+	cli.PingcapV1alpha1().TidbClusters(tc.Namespace).Create(context.TODO(), tc, metav1.CreateOptions{})
+	fakePDControl.SetPDClient(pdapi.Namespace(tc.Namespace), tc.Name, pdClient)
+	s := NewServer(fakePDControl, fakeMasterControl, cli, kubeCli)
+	httpServer := httptest.NewServer(s.(*server).container.ServeMux)
+	defer httpServer.Close()
+
+	register := func(body string) (int, string) {
+		resp, err := http.Post(httpServer.URL+"/register", "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("failed to register: %v", err)
+		}
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("failed to read response: %v", err)
+		}
+		return resp.StatusCode, string(data)
+	}
+
+	if code, _ := register("{"); code != http.StatusBadRequest {
+		t.Errorf("invalid body expects status %d, got %d", http.StatusBadRequest, code)
+	}
+	if code, _ := register(`{"podName":"foo-pd-1","namespace":"other","clusterName":"foo","addresses":["[fd00::2]:2380"]}`); code != http.StatusInternalServerError {
+		t.Errorf("wrong namespace expects status %d, got %d", http.StatusInternalServerError, code)
+	}
+	code, result := register(`{"podName":"foo-pd-1","namespace":"default","clusterName":"foo","addresses":["[fd00::2]:2380"]}`)
+	if code != http.StatusOK {
+		t.Errorf("registration expects status %d, got %d: %s", http.StatusOK, code, result)
+	}
+	if result != "--join=http://[fd00::1]:2379" {
+		t.Errorf("unexpected result: %s", result)
+	}
+}
+
+func TestServerNotLeader(t *testing.T) {
+	os.Setenv("MY_POD_NAMESPACE", "default")
+	cli := fake.NewSimpleClientset()
+	kubeCli := kubefake.NewSimpleClientset()
+	informer := informers.NewSharedInformerFactory(kubeCli, 0)
+	fakePDControl := pdapi.NewFakePDControl(informer.Core().V1().Secrets().Lister())
+	fakeMasterControl := dmapi.NewFakeMasterControl(informer.Core().V1().Secrets().Lister())
+	s := NewServer(fakePDControl, fakeMasterControl, cli, kubeCli, WithLeaderCheck(func() bool { return false }))
+	httpServer := httptest.NewServer(s.(*server).container.ServeMux)
+	defer httpServer.Close()
+
+	svc := "foo-pd-0.foo-pd-peer.default.svc:2380"
+	resp, err := http.Get(httpServer.URL + fmt.Sprintf("/new/%s", base64.StdEncoding.EncodeToString([]byte(svc))))
+	if err != nil {
+		t.Fatalf("failed to discover: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expects status %d, got %d", http.StatusServiceUnavailable, resp.StatusCode)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const stateKey = "state.json"
+
+// bootstrapState is the persisted bootstrap decisions of a PD cluster, so a restarted or newly
+// elected discovery answers the same as before
+type bootstrapState struct {
+	// ResourceVersion is the resource version of the TidbCluster when the peers are counted
+	ResourceVersion string `json:"resourceVersion"`
+	// Peers are the pods waiting for the bootstrap of the PD cluster
+	Peers []string `json:"peers,omitempty"`
+	// Initializer is the pod which is told to initialize the PD cluster
+	Initializer string `json:"initializer,omitempty"`
+	// Bootstrapped is true if the PD cluster is known to be initialized
+	Bootstrapped bool `json:"bootstrapped,omitempty"`
+	// Joined are the pods which are told to join the PD cluster
+	Joined []string `json:"joined,omitempty"`
+}
+
+func (c *clusterInfo) toState() *bootstrapState {
+	return &bootstrapState{
+		ResourceVersion: c.resourceVersion,
+		Peers:           sortedKeys(c.peers),
+		Initializer:     c.initializer,
+		Bootstrapped:    c.bootstrapped,
+		Joined:          sortedKeys(c.joined),
+	}
+}
+
+func newClusterInfoFromState(state *bootstrapState, version string) *clusterInfo {
+	c := &clusterInfo{
+		resourceVersion: state.ResourceVersion,
+		peers:           map[string]struct{}{},
+		initializer:     state.Initializer,
+		bootstrapped:    state.Bootstrapped,
+		joined:          map[string]struct{}{},
+		stateVersion:    version,
+		persisted:       true,
+	}
+	for _, peer := range state.Peers {
+		c.peers[peer] = struct{}{}
+	}
+	for _, pod := range state.Joined {
+		c.joined[pod] = struct{}{}
+	}
+	return c
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// stateStore persists the bootstrap state of the PD clusters
+type stateStore interface {
+	// load returns the state and its version, the state is nil if it is not persisted yet
+	load(tc *v1alpha1.TidbCluster) (*bootstrapState, string, error)
+	// save persists the state if it is not changed since the version, and returns the new version.
+	// The state is created if it is not persisted yet.
+	save(tc *v1alpha1.TidbCluster, state *bootstrapState, version string, create bool) (string, error)
+}
+
+// configMapStateStore keeps the state in the ConfigMap <cluster>-discovery-state owned by the TidbCluster
+type configMapStateStore struct {
+	kubeCli kubernetes.Interface
+}
+
+func stateConfigMapName(tcName string) string {
+	return fmt.Sprintf("%s-state", controller.DiscoveryMemberName(tcName))
+}
+
+func (s *configMapStateStore) load(tc *v1alpha1.TidbCluster) (*bootstrapState, string, error) {
+	cm, err := s.kubeCli.CoreV1().ConfigMaps(tc.GetNamespace()).Get(context.TODO(), stateConfigMapName(tc.GetName()), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, "", nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	state := &bootstrapState{}
+	if err := json.Unmarshal([]byte(cm.Data[stateKey]), state); err != nil {
+		return nil, "", fmt.Errorf("failed to parse the bootstrap state in configmap %s/%s: %v", cm.GetNamespace(), cm.GetName(), err)
+	}
+	return state, cm.GetResourceVersion(), nil
+}
+
+func (s *configMapStateStore) save(tc *v1alpha1.TidbCluster, state *bootstrapState, version string, create bool) (string, error) {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return "", err
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            stateConfigMapName(tc.GetName()),
+			Namespace:       tc.GetNamespace(),
+			ResourceVersion: version,
+			OwnerReferences: []metav1.OwnerReference{controller.GetOwnerRef(tc)},
+		},
+		Data: map[string]string{stateKey: string(data)},
+	}
+	if create {
+		cm, err = s.kubeCli.CoreV1().ConfigMaps(tc.GetNamespace()).Create(context.TODO(), cm, metav1.CreateOptions{})
+	} else {
+		// the update fails with a conflict if another discovery changed the state, then the caller retries
+		cm, err = s.kubeCli.CoreV1().ConfigMaps(tc.GetNamespace()).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return "", err
+	}
+	return cm.GetResourceVersion(), nil
+}
@@ -0,0 +1,496 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/binlog"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+)
+
+const (
+	drainerNodeStateOnline = "online"
+	changefeedStateNormal  = "normal"
+	// changefeedStateWarning means the changefeed meets a retryable error and is retrying
+	changefeedStateWarning = "warning"
+	tidbBinlogEnabledEnv   = "BINLOG_ENABLED"
+)
+
+// BinlogMigrationManager implements the steps of BinlogMigration.
+type BinlogMigrationManager interface {
+	// Sync runs the steps of the migration in order and records the progress in the status, it returns
+	// a RequeueError while a step is waiting. The steps that are done are rolled back if the migration is aborted.
+	Sync(*v1alpha1.BinlogMigration) error
+}
+
+// drainerCommitTSClient gets the checkpoint of the drainers registered in PD.
+type drainerCommitTSClient interface {
+	DrainerCommitTS(ctx context.Context) ([]*binlog.NodeCommitTS, error)
+	Close() error
+}
+
+type binlogMigrationManager struct {
+	deps *controller.Dependencies
+	// only use for test
+	binlogClient drainerCommitTSClient
+}
+
+// NewBinlogMigrationManager returns a BinlogMigrationManager.
+func NewBinlogMigrationManager(deps *controller.Dependencies) BinlogMigrationManager {
+	return &binlogMigrationManager{deps: deps}
+}
+
+func (m *binlogMigrationManager) Sync(bm *v1alpha1.BinlogMigration) error {
+	if bm.Status.Phase == v1alpha1.BinlogMigrationComplete || bm.Status.Phase == v1alpha1.BinlogMigrationAborted {
+		return nil
+	}
+
+	tc, err := m.getCluster(bm)
+	if err != nil {
+		return err
+	}
+
+	if len(bm.Status.Steps) == 0 {
+		for _, ty := range v1alpha1.BinlogMigrationSteps {
+			bm.Status.Steps = append(bm.Status.Steps, v1alpha1.BinlogMigrationStep{
+				Type:  ty,
+				State: v1alpha1.BinlogMigrationStepPending,
+			})
+		}
+		bm.Status.Phase = v1alpha1.BinlogMigrationRunning
+	}
+
+	if bm.Spec.Abort {
+		if binlogMigrationAbortable(bm) {
+			return m.rollback(bm, tc)
+		}
+		msg := "the binlog of tidb has been disabled, the migration can not be aborted"
+		klog.Warningf("BinlogMigration %s/%s: %s", bm.Namespace, bm.Name, msg)
+		m.deps.Recorder.Event(bm, corev1.EventTypeWarning, "AbortIgnored", msg)
+	}
+
+	for i := range bm.Status.Steps {
+		step := &bm.Status.Steps[i]
+		if step.State == v1alpha1.BinlogMigrationStepComplete {
+			continue
+		}
+		if step.State == v1alpha1.BinlogMigrationStepPending {
+			now := metav1.Now()
+			step.State = v1alpha1.BinlogMigrationStepRunning
+			step.StartTime = &now
+			klog.Infof("BinlogMigration %s/%s: start step %s", bm.Namespace, bm.Name, step.Type)
+		}
+		bm.Status.CurrentStep = step.Type
+
+		done, err := m.runStep(bm, tc, step)
+		if err != nil {
+			step.Message = err.Error()
+			return err
+		}
+		if !done {
+			return controller.RequeueErrorf("BinlogMigration %s/%s: step %s is in progress: %s", bm.Namespace, bm.Name, step.Type, step.Message)
+		}
+		now := metav1.Now()
+		step.State = v1alpha1.BinlogMigrationStepComplete
+		step.Message = ""
+		step.CompletionTime = &now
+		klog.Infof("BinlogMigration %s/%s: step %s is complete", bm.Namespace, bm.Name, step.Type)
+	}
+
+	bm.Status.CurrentStep = ""
+	bm.Status.Phase = v1alpha1.BinlogMigrationComplete
+	return nil
+}
+
+// binlogMigrationAbortable returns whether the migration can be aborted, the binlog of TiDB
+// is incomplete once it starts to be disabled, so the Pumps can not take over the replication again.
+func binlogMigrationAbortable(bm *v1alpha1.BinlogMigration) bool {
+	for _, step := range bm.Status.Steps {
+		if step.Type == v1alpha1.BinlogMigrationDisableBinlog {
+			return step.State == v1alpha1.BinlogMigrationStepPending
+		}
+	}
+	return true
+}
+
+// runStep runs the step, it returns false with the step message set if the step is waiting for something.
+func (m *binlogMigrationManager) runStep(bm *v1alpha1.BinlogMigration, tc *v1alpha1.TidbCluster, step *v1alpha1.BinlogMigrationStep) (bool, error) {
+	switch step.Type {
+	case v1alpha1.BinlogMigrationCreateChangefeed:
+		return m.createChangefeed(bm, tc)
+	case v1alpha1.BinlogMigrationWaitCatchUp:
+		return m.waitCatchUp(bm, tc, step)
+	case v1alpha1.BinlogMigrationSwitchConsumers:
+		return m.switchConsumers(bm, step)
+	case v1alpha1.BinlogMigrationDisableBinlog:
+		return m.disableBinlog(tc, step)
+	case v1alpha1.BinlogMigrationOfflinePump:
+		return m.offlinePump(tc, step)
+	default:
+		return false, fmt.Errorf("unknown step %s", step.Type)
+	}
+}
+
+// rollback rolls back the steps that are started in the reverse order.
+func (m *binlogMigrationManager) rollback(bm *v1alpha1.BinlogMigration, tc *v1alpha1.TidbCluster) error {
+	for i := len(bm.Status.Steps) - 1; i >= 0; i-- {
+		step := &bm.Status.Steps[i]
+		if step.State != v1alpha1.BinlogMigrationStepRunning && step.State != v1alpha1.BinlogMigrationStepComplete {
+			continue
+		}
+		bm.Status.CurrentStep = step.Type
+
+		var err error
+		switch step.Type {
+		case v1alpha1.BinlogMigrationCreateChangefeed:
+			if tc.Spec.TiCDC != nil {
+				err = m.deps.CDCControl.RemoveChangefeed(tc, bm.Spec.Changefeed.ID)
+			}
+		case v1alpha1.BinlogMigrationSwitchConsumers:
+			if bm.Spec.ConsumerService != nil && bm.Status.ConsumerServiceExternalName != "" {
+				err = m.setConsumerServiceExternalName(bm, bm.Status.ConsumerServiceExternalName)
+			}
+		}
+		if err != nil {
+			step.Message = err.Error()
+			return err
+		}
+
+		now := metav1.Now()
+		step.State = v1alpha1.BinlogMigrationStepRolledBack
+		step.Message = ""
+		step.CompletionTime = &now
+		klog.Infof("BinlogMigration %s/%s: step %s is rolled back", bm.Namespace, bm.Name, step.Type)
+	}
+
+	bm.Status.CurrentStep = ""
+	bm.Status.Phase = v1alpha1.BinlogMigrationAborted
+	return nil
+}
+
+// createChangefeed creates the changefeed, TiCDC starts it from the current TSO.
+func (m *binlogMigrationManager) createChangefeed(bm *v1alpha1.BinlogMigration, tc *v1alpha1.TidbCluster) (bool, error) {
+	if tc.Spec.TiCDC == nil {
+		return false, fmt.Errorf("tidbcluster %s/%s has no ticdc", tc.Namespace, tc.Name)
+	}
+
+	id := bm.Spec.Changefeed.ID
+	cf, err := m.deps.CDCControl.GetChangefeed(tc, id)
+	if err != nil {
+		return false, err
+	}
+	if cf == nil {
+		sinkURI, err := m.getSinkURI(bm)
+		if err != nil {
+			return false, err
+		}
+		cfg := &controller.ChangefeedConfig{ID: id, SinkURI: sinkURI}
+		if bm.Spec.Changefeed.Config != nil {
+			cfg.ReplicaConfig = bm.Spec.Changefeed.Config.Inner()
+		}
+		if cf, err = m.deps.CDCControl.CreateChangefeed(tc, cfg); err != nil {
+			return false, err
+		}
+		klog.Infof("BinlogMigration %s/%s: created changefeed %s from ts %d", bm.Namespace, bm.Name, id, cf.StartTS)
+	}
+
+	bm.Status.ChangefeedStartTS = strconv.FormatUint(cf.StartTS, 10)
+	return true, nil
+}
+
+// waitCatchUp waits for the drainers to replicate all the binlog before the start ts of the changefeed, and
+// then for the changefeed to catch up with the checkpoint of the drainers at that time, after which the
+// downstream of the changefeed has all the changes that are replicated by the drainers.
+func (m *binlogMigrationManager) waitCatchUp(bm *v1alpha1.BinlogMigration, tc *v1alpha1.TidbCluster, step *v1alpha1.BinlogMigrationStep) (bool, error) {
+	id := bm.Spec.Changefeed.ID
+	cf, err := m.deps.CDCControl.GetChangefeed(tc, id)
+	if err != nil {
+		return false, err
+	}
+	if cf == nil {
+		return false, fmt.Errorf("changefeed %s is not found", id)
+	}
+	if cf.State != changefeedStateNormal && cf.State != changefeedStateWarning {
+		if cf.Error != nil {
+			return false, fmt.Errorf("changefeed %s is %s: %s", id, cf.State, cf.Error.Message)
+		}
+		return false, fmt.Errorf("changefeed %s is %s", id, cf.State)
+	}
+	bm.Status.ChangefeedCheckpointTS = strconv.FormatUint(cf.CheckpointTS, 10)
+
+	// the checkpoint of the drainers is fixed as the target once it passes the start ts of the
+	// changefeed, otherwise the changefeed would chase a moving target
+	target, _ := strconv.ParseUint(bm.Status.DrainerCheckpointTS, 10, 64)
+	if target < cf.StartTS {
+		checkpoint, found, err := m.getDrainerCheckpoint(bm, tc)
+		if err != nil {
+			return false, err
+		}
+		if !found {
+			// a drainer restarting or paused temporarily would be missed if it's not confirmed by the user
+			if bm.Spec.NoDrainer {
+				klog.Infof("BinlogMigration %s/%s: no drainer replicates the binlog, skip waiting for the changefeed", bm.Namespace, bm.Name)
+				return true, nil
+			}
+			step.Message = "waiting for an online drainer to get its checkpoint, set spec.noDrainer if no drainer replicates the binlog"
+			return false, nil
+		}
+		bm.Status.DrainerCheckpointTS = strconv.FormatUint(checkpoint, 10)
+		if checkpoint < cf.StartTS {
+			step.Message = fmt.Sprintf("waiting for the drainers to replicate to the start ts %d of the changefeed, drainer checkpoint: %d", cf.StartTS, checkpoint)
+			return false, nil
+		}
+		target = checkpoint
+	}
+
+	if cf.CheckpointTS < target {
+		step.Message = fmt.Sprintf("waiting for the changefeed to catch up with the drainers, changefeed checkpoint: %d, drainer checkpoint: %d", cf.CheckpointTS, target)
+		return false, nil
+	}
+	return true, nil
+}
+
+// getDrainerCheckpoint returns the min checkpoint of the online drainers of the migration. The paused drainers
+// are ignored since their checkpoints never move forward.
+func (m *binlogMigrationManager) getDrainerCheckpoint(bm *v1alpha1.BinlogMigration, tc *v1alpha1.TidbCluster) (uint64, bool, error) {
+	var dr *v1alpha1.Drainer
+	if bm.Spec.Drainer != "" {
+		var err error
+		dr, err = m.deps.DrainerLister.Drainers(bm.Namespace).Get(bm.Spec.Drainer)
+		if err != nil {
+			return 0, false, fmt.Errorf("get Drainer %s/%s failed: %v", bm.Namespace, bm.Spec.Drainer, err)
+		}
+	}
+
+	client, err := m.buildBinlogClient(tc)
+	if err != nil {
+		return 0, false, err
+	}
+	defer client.Close()
+
+	nodes, err := client.DrainerCommitTS(context.TODO())
+	if err != nil {
+		return 0, false, err
+	}
+	var (
+		checkpoint uint64
+		found      bool
+	)
+	for _, node := range nodes {
+		if node.State != drainerNodeStateOnline || (dr != nil && !isDrainerAddr(dr, node.Host)) {
+			continue
+		}
+		if ts := uint64(node.MaxCommitTS); !found || ts < checkpoint {
+			checkpoint = ts
+		}
+		found = true
+	}
+	return checkpoint, found, nil
+}
+
+// switchConsumers switches the consumer Service to the downstream of the changefeed. The original external
+// name is recorded in the status before the Service is updated, so that it can be restored if aborted.
+func (m *binlogMigrationManager) switchConsumers(bm *v1alpha1.BinlogMigration, step *v1alpha1.BinlogMigrationStep) (bool, error) {
+	spec := bm.Spec.ConsumerService
+	if spec == nil {
+		return true, nil
+	}
+
+	svc, err := m.getConsumerService(bm)
+	if err != nil {
+		return false, err
+	}
+	if svc.Spec.ExternalName == spec.ExternalName {
+		return true, nil
+	}
+	if bm.Status.ConsumerServiceExternalName == "" {
+		bm.Status.ConsumerServiceExternalName = svc.Spec.ExternalName
+		step.Message = fmt.Sprintf("recorded the external name %s of service %s", svc.Spec.ExternalName, svc.Name)
+		return false, nil
+	}
+
+	if err := m.setConsumerServiceExternalName(bm, spec.ExternalName); err != nil {
+		return false, err
+	}
+	klog.Infof("BinlogMigration %s/%s: switched service %s from %s to %s", bm.Namespace, bm.Name, svc.Name, svc.Spec.ExternalName, spec.ExternalName)
+	return true, nil
+}
+
+func (m *binlogMigrationManager) getConsumerService(bm *v1alpha1.BinlogMigration) (*corev1.Service, error) {
+	name := bm.Spec.ConsumerService.Name
+	svc, err := m.deps.ServiceLister.Services(bm.Namespace).Get(name)
+	if err != nil {
+		return nil, fmt.Errorf("get consumer service %s/%s failed: %v", bm.Namespace, name, err)
+	}
+	if svc.Spec.Type != corev1.ServiceTypeExternalName {
+		return nil, fmt.Errorf("consumer service %s/%s is of type %s, not %s", bm.Namespace, name, svc.Spec.Type, corev1.ServiceTypeExternalName)
+	}
+	return svc, nil
+}
+
+func (m *binlogMigrationManager) setConsumerServiceExternalName(bm *v1alpha1.BinlogMigration, externalName string) error {
+	svc, err := m.getConsumerService(bm)
+	if err != nil {
+		return err
+	}
+	if svc.Spec.ExternalName == externalName {
+		return nil
+	}
+	svc = svc.DeepCopy()
+	svc.Spec.ExternalName = externalName
+	_, err = m.deps.KubeClientset.CoreV1().Services(svc.Namespace).Update(context.TODO(), svc, metav1.UpdateOptions{})
+	return err
+}
+
+// disableBinlog disables the binlog of TiDB and waits for TiDB to be rolling restarted.
+func (m *binlogMigrationManager) disableBinlog(tc *v1alpha1.TidbCluster, step *v1alpha1.BinlogMigrationStep) (bool, error) {
+	if tc.Spec.TiDB == nil {
+		return true, nil
+	}
+	if tc.IsTiDBBinlogEnabled() {
+		newTC := tc.DeepCopy()
+		newTC.Spec.TiDB.BinlogEnabled = pointer.BoolPtr(false)
+		if _, err := m.deps.TiDBClusterControl.Update(newTC); err != nil {
+			return false, fmt.Errorf("disable binlog of tidbcluster %s/%s failed: %v", tc.Namespace, tc.Name, err)
+		}
+		klog.Infof("BinlogMigration: disabled binlog of tidbcluster %s/%s", tc.Namespace, tc.Name)
+		step.Message = "disabling the binlog of tidb"
+		return false, nil
+	}
+
+	set, err := m.deps.StatefulSetLister.StatefulSets(tc.Namespace).Get(controller.TiDBMemberName(tc.Name))
+	if err != nil {
+		return false, fmt.Errorf("get tidb statefulset of tidbcluster %s/%s failed: %v", tc.Namespace, tc.Name, err)
+	}
+	if tidbBinlogEnabledInTemplate(set.Spec.Template.Spec.Containers) {
+		step.Message = "waiting for the tidb statefulset to be updated"
+		return false, nil
+	}
+	if set.Status.ObservedGeneration < set.Generation || mngerutils.StatefulSetIsUpgrading(set) || tc.Status.TiDB.Phase != v1alpha1.NormalPhase {
+		step.Message = "waiting for tidb to be rolling restarted"
+		return false, nil
+	}
+	return true, nil
+}
+
+func tidbBinlogEnabledInTemplate(containers []corev1.Container) bool {
+	for _, c := range containers {
+		if c.Name != v1alpha1.TiDBMemberType.String() {
+			continue
+		}
+		for _, env := range c.Env {
+			if env.Name == tidbBinlogEnabledEnv {
+				return env.Value == "true"
+			}
+		}
+	}
+	return false
+}
+
+// offlinePump scales in the Pumps to 0, the pump scaler makes each pump offline through the binlog
+// client before its pod is deleted.
+func (m *binlogMigrationManager) offlinePump(tc *v1alpha1.TidbCluster, step *v1alpha1.BinlogMigrationStep) (bool, error) {
+	if tc.Spec.Pump == nil {
+		return true, nil
+	}
+	if tc.Spec.Pump.Replicas != 0 {
+		newTC := tc.DeepCopy()
+		newTC.Spec.Pump.Replicas = 0
+		if _, err := m.deps.TiDBClusterControl.Update(newTC); err != nil {
+			return false, fmt.Errorf("scale in pumps of tidbcluster %s/%s failed: %v", tc.Namespace, tc.Name, err)
+		}
+		klog.Infof("BinlogMigration: scaling in pumps of tidbcluster %s/%s", tc.Namespace, tc.Name)
+		step.Message = "scaling in the pumps"
+		return false, nil
+	}
+
+	set, err := m.deps.StatefulSetLister.StatefulSets(tc.Namespace).Get(controller.PumpMemberName(tc.Name))
+	if errors.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("get pump statefulset of tidbcluster %s/%s failed: %v", tc.Namespace, tc.Name, err)
+	}
+	if set.Status.Replicas > 0 {
+		step.Message = fmt.Sprintf("waiting for %d pumps to be offline", set.Status.Replicas)
+		return false, nil
+	}
+	return true, nil
+}
+
+func (m *binlogMigrationManager) getCluster(bm *v1alpha1.BinlogMigration) (*v1alpha1.TidbCluster, error) {
+	ns := bm.Spec.Cluster.Namespace
+	if ns == "" {
+		ns = bm.Namespace
+	}
+	return m.deps.TiDBClusterLister.TidbClusters(ns).Get(bm.Spec.Cluster.Name)
+}
+
+func (m *binlogMigrationManager) getSinkURI(bm *v1alpha1.BinlogMigration) (string, error) {
+	ref := bm.Spec.Changefeed.SinkURISecret
+	if ref == nil {
+		return bm.Spec.Changefeed.SinkURI, nil
+	}
+	secret, err := m.deps.SecretLister.Secrets(bm.Namespace).Get(ref.Name)
+	if err != nil {
+		return "", fmt.Errorf("get sink uri secret %s/%s failed: %v", bm.Namespace, ref.Name, err)
+	}
+	data, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("key %s is not found in sink uri secret %s/%s", ref.Key, bm.Namespace, ref.Name)
+	}
+	return string(data), nil
+}
+
+func (m *binlogMigrationManager) buildBinlogClient(tc *v1alpha1.TidbCluster) (drainerCommitTSClient, error) {
+	if m.binlogClient != nil {
+		return m.binlogClient, nil
+	}
+	return buildBinlogClient(tc, m.deps.PDControl)
+}
+
+var _ BinlogMigrationManager = &binlogMigrationManager{}
+
+// FakeBinlogMigrationManager is a fake BinlogMigrationManager
+type FakeBinlogMigrationManager struct {
+	err error
+}
+
+// NewFakeBinlogMigrationManager returns a FakeBinlogMigrationManager
+func NewFakeBinlogMigrationManager() *FakeBinlogMigrationManager {
+	return &FakeBinlogMigrationManager{}
+}
+
+// SetSyncError sets the error returned by Sync
+func (m *FakeBinlogMigrationManager) SetSyncError(err error) {
+	m.err = err
+}
+
+// Sync fake Sync
+func (m *FakeBinlogMigrationManager) Sync(_ *v1alpha1.BinlogMigration) error {
+	return m.err
+}
+
+var _ BinlogMigrationManager = &FakeBinlogMigrationManager{}
@@ -0,0 +1,345 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/util/config"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/util"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ComponentGroupNames returns the names of the groups of the component in tc
+func ComponentGroupNames(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType) []string {
+	var names []string
+	switch memberType {
+	case v1alpha1.TiKVMemberType:
+		if tc.Spec.TiKV != nil {
+			for i := range tc.Spec.TiKV.Groups {
+				names = append(names, tc.Spec.TiKV.Groups[i].Name)
+			}
+		}
+	case v1alpha1.TiDBMemberType:
+		if tc.Spec.TiDB != nil {
+			for i := range tc.Spec.TiDB.Groups {
+				names = append(names, tc.Spec.TiDB.Groups[i].Name)
+			}
+		}
+	case v1alpha1.TiFlashMemberType:
+		if tc.Spec.TiFlash != nil {
+			for i := range tc.Spec.TiFlash.Groups {
+				names = append(names, tc.Spec.TiFlash.Groups[i].Name)
+			}
+		}
+	}
+	return names
+}
+
+// NewComponentGroupCluster returns a TidbCluster which only contains the given group of the component.
+// The returned TidbCluster joins tc in the same way as a heterogeneous cluster, so that the group can be
+// synced by the member manager of the component as it is. The resources of the group are named with
+// `<cluster>-<group>` and owned by tc.
+func NewComponentGroupCluster(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, group string) (*v1alpha1.TidbCluster, error) {
+	gtc := &v1alpha1.TidbCluster{
+		TypeMeta: tc.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%s-%s", tc.Name, group),
+			Namespace:       tc.Namespace,
+			UID:             tc.UID,
+			Labels:          util.CopyStringMap(tc.Labels),
+			Annotations:     util.CopyStringMap(tc.Annotations),
+			OwnerReferences: []metav1.OwnerReference{controller.GetOwnerRef(tc)},
+		},
+	}
+	if gtc.Labels == nil {
+		gtc.Labels = map[string]string{}
+	}
+	gtc.Labels[label.InstanceLabelKey] = gtc.Name
+	gtc.Labels[label.ComponentGroupLabelKey] = group
+	// delete slots are only supported by the default group
+	for _, key := range []string{label.AnnTiKVDeleteSlots, label.AnnTiDBDeleteSlots, label.AnnTiFlashDeleteSlots} {
+		delete(gtc.Annotations, key)
+	}
+	// the ConfigMap names stored before deleting the StatefulSets of the other components and groups must not be
+	// used by the group, the one of the group is stored in tc with the key of the group
+	for key := range gtc.Annotations {
+		if strings.HasPrefix(key, label.AnnoPrefixConfigMapNameBeforeDelete) {
+			delete(gtc.Annotations, key)
+		}
+	}
+	if name := tc.Annotations[label.AnnoKeyOfConfigMapNameForNewGroupSTS(string(memberType), group)]; name != "" {
+		gtc.Annotations[label.AnnoKeyOfConfigMapNameForNewSTS(string(memberType))] = name
+	}
+
+	spec := tc.Spec.DeepCopy()
+	spec.PD = nil
+	spec.PDMS = nil
+	spec.TiKV = nil
+	spec.TiDB = nil
+	spec.TiFlash = nil
+	spec.TiProxy = nil
+	spec.TiCDC = nil
+	spec.Pump = nil
+	if tc.Heterogeneous() && tc.WithoutLocalPD() {
+		spec.Cluster = tc.Spec.Cluster.DeepCopy()
+	} else {
+		spec.Cluster = &v1alpha1.TidbClusterRef{
+			Name:          tc.Name,
+			Namespace:     tc.Namespace,
+			ClusterDomain: tc.Spec.ClusterDomain,
+		}
+	}
+
+	status := tc.Status.DeepCopy()
+	status.TiKVGroups = nil
+	status.TiDBGroups = nil
+	status.TiFlashGroups = nil
+
+	var err error
+	switch memberType {
+	case v1alpha1.TiKVMemberType:
+		spec.TiKV, err = newTiKVGroupSpec(tc.Spec.TiKV, group)
+		status.TiKV = tc.Status.TiKVGroups[group]
+	case v1alpha1.TiDBMemberType:
+		spec.TiDB, err = newTiDBGroupSpec(tc.Spec.TiDB, group)
+		status.TiDB = tc.Status.TiDBGroups[group]
+	case v1alpha1.TiFlashMemberType:
+		spec.TiFlash, err = newTiFlashGroupSpec(tc.Spec.TiFlash, group)
+		status.TiFlash = tc.Status.TiFlashGroups[group]
+	default:
+		err = fmt.Errorf("component %s does not support groups", memberType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	gtc.Spec = *spec
+	gtc.Status = *status
+	return gtc, nil
+}
+
+// SetComponentGroupStatus records the status of the component group represented by gtc into tc
+func SetComponentGroupStatus(tc, gtc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, group string) {
+	switch memberType {
+	case v1alpha1.TiKVMemberType:
+		if tc.Status.TiKVGroups == nil {
+			tc.Status.TiKVGroups = map[string]v1alpha1.TiKVStatus{}
+		}
+		tc.Status.TiKVGroups[group] = gtc.Status.TiKV
+	case v1alpha1.TiDBMemberType:
+		if tc.Status.TiDBGroups == nil {
+			tc.Status.TiDBGroups = map[string]v1alpha1.TiDBStatus{}
+		}
+		tc.Status.TiDBGroups[group] = gtc.Status.TiDB
+	case v1alpha1.TiFlashMemberType:
+		if tc.Status.TiFlashGroups == nil {
+			tc.Status.TiFlashGroups = map[string]v1alpha1.TiFlashStatus{}
+		}
+		tc.Status.TiFlashGroups[group] = gtc.Status.TiFlash
+	}
+}
+
+// CleanComponentGroupStatus removes the status of the groups which have been removed from the spec
+func CleanComponentGroupStatus(tc *v1alpha1.TidbCluster) {
+	cleanGroupStatus(tc.Status.TiKVGroups, ComponentGroupNames(tc, v1alpha1.TiKVMemberType))
+	cleanGroupStatus(tc.Status.TiDBGroups, ComponentGroupNames(tc, v1alpha1.TiDBMemberType))
+	cleanGroupStatus(tc.Status.TiFlashGroups, ComponentGroupNames(tc, v1alpha1.TiFlashMemberType))
+}
+
+func cleanGroupStatus[T any](status map[string]T, names []string) {
+	for name := range status {
+		found := false
+		for _, n := range names {
+			if n == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			delete(status, name)
+		}
+	}
+}
+
+func newTiKVGroupSpec(base *v1alpha1.TiKVSpec, name string) (*v1alpha1.TiKVSpec, error) {
+	var group *v1alpha1.TiKVGroupSpec
+	for i := range base.Groups {
+		if base.Groups[i].Name == name {
+			group = base.Groups[i].DeepCopy()
+		}
+	}
+	if group == nil {
+		return nil, fmt.Errorf("tikv group %s not found", name)
+	}
+
+	spec := base.DeepCopy()
+	spec.Groups = nil
+	overrideComponentGroupSpec(&spec.ComponentSpec, &spec.ResourceRequirements, &spec.Replicas, &group.ComponentGroupSpec)
+	if group.StorageClassName != nil {
+		spec.StorageClassName = group.StorageClassName
+	}
+	if len(group.StorageVolumes) > 0 {
+		spec.StorageVolumes = group.StorageVolumes
+	}
+
+	if spec.Config == nil {
+		spec.Config = v1alpha1.NewTiKVConfig()
+	}
+	if group.Config != nil {
+		if err := spec.Config.Merge(group.Config.GenericConfig); err != nil {
+			return nil, fmt.Errorf("merge config of tikv group %s failed: %v", name, err)
+		}
+	}
+	setConfigLabels(spec.Config.GenericConfig, "server.labels", group.ServerLabels)
+	return spec, nil
+}
+
+func newTiDBGroupSpec(base *v1alpha1.TiDBSpec, name string) (*v1alpha1.TiDBSpec, error) {
+	var group *v1alpha1.TiDBGroupSpec
+	for i := range base.Groups {
+		if base.Groups[i].Name == name {
+			group = base.Groups[i].DeepCopy()
+		}
+	}
+	if group == nil {
+		return nil, fmt.Errorf("tidb group %s not found", name)
+	}
+
+	spec := base.DeepCopy()
+	spec.Groups = nil
+	// the cluster is bootstrapped and initialized by the default group
+	spec.Initializer = nil
+	spec.BootstrapSQLConfigMapName = nil
+	overrideComponentGroupSpec(&spec.ComponentSpec, &spec.ResourceRequirements, &spec.Replicas, &group.ComponentGroupSpec)
+	if group.StorageClassName != nil {
+		spec.StorageClassName = group.StorageClassName
+	}
+	if len(group.StorageVolumes) > 0 {
+		spec.StorageVolumes = group.StorageVolumes
+	}
+	if len(group.ServerLabels) > 0 {
+		spec.ServerLabels = util.CombineStringMap(spec.ServerLabels, group.ServerLabels)
+	}
+
+	if group.Config != nil {
+		if spec.Config == nil {
+			spec.Config = v1alpha1.NewTiDBConfig()
+		}
+		if err := spec.Config.Merge(group.Config.GenericConfig); err != nil {
+			return nil, fmt.Errorf("merge config of tidb group %s failed: %v", name, err)
+		}
+	}
+	return spec, nil
+}
+
+func newTiFlashGroupSpec(base *v1alpha1.TiFlashSpec, name string) (*v1alpha1.TiFlashSpec, error) {
+	var group *v1alpha1.TiFlashGroupSpec
+	for i := range base.Groups {
+		if base.Groups[i].Name == name {
+			group = base.Groups[i].DeepCopy()
+		}
+	}
+	if group == nil {
+		return nil, fmt.Errorf("tiflash group %s not found", name)
+	}
+
+	spec := base.DeepCopy()
+	spec.Groups = nil
+	overrideComponentGroupSpec(&spec.ComponentSpec, &spec.ResourceRequirements, &spec.Replicas, &group.ComponentGroupSpec)
+	if len(group.StorageClaims) > 0 {
+		spec.StorageClaims = group.StorageClaims
+	}
+
+	if spec.Config == nil {
+		spec.Config = v1alpha1.NewTiFlashConfig()
+	}
+	if spec.Config.Common == nil {
+		spec.Config.Common = v1alpha1.NewTiFlashCommonConfig()
+	}
+	if spec.Config.Proxy == nil {
+		spec.Config.Proxy = v1alpha1.NewTiFlashProxyConfig()
+	}
+	if group.Config != nil {
+		if group.Config.Common != nil {
+			if err := spec.Config.Common.Merge(group.Config.Common.GenericConfig); err != nil {
+				return nil, fmt.Errorf("merge config of tiflash group %s failed: %v", name, err)
+			}
+		}
+		if group.Config.Proxy != nil {
+			if err := spec.Config.Proxy.Merge(group.Config.Proxy.GenericConfig); err != nil {
+				return nil, fmt.Errorf("merge proxy config of tiflash group %s failed: %v", name, err)
+			}
+		}
+	}
+	// the store labels of TiFlash are reported by the proxy
+	setConfigLabels(spec.Config.Proxy.GenericConfig, "server.labels", group.ServerLabels)
+	return spec, nil
+}
+
+// overrideComponentGroupSpec overrides the settings of a component with the non-empty ones of the group
+func overrideComponentGroupSpec(spec *v1alpha1.ComponentSpec, resources *corev1.ResourceRequirements, replicas *int32, group *v1alpha1.ComponentGroupSpec) {
+	dst := reflect.ValueOf(spec).Elem()
+	src := reflect.ValueOf(&group.ComponentSpec).Elem()
+	for i := 0; i < src.NumField(); i++ {
+		if f := src.Field(i); !f.IsZero() {
+			dst.Field(i).Set(f)
+		}
+	}
+	spec.Labels = util.CombineStringMap(spec.Labels, map[string]string{label.ComponentGroupLabelKey: group.Name})
+
+	// resources are overridden one by one to keep the storage request if it is not specified by the group
+	resources.Requests = mergeResourceList(resources.Requests, group.Requests)
+	resources.Limits = mergeResourceList(resources.Limits, group.Limits)
+	*replicas = group.Replicas
+}
+
+func mergeResourceList(base, override corev1.ResourceList) corev1.ResourceList {
+	if len(override) == 0 {
+		return base
+	}
+	r := corev1.ResourceList{}
+	for k, v := range base {
+		r[k] = v
+	}
+	for k, v := range override {
+		r[k] = v
+	}
+	return r
+}
+
+// setConfigLabels merges labels into the table of key in the config
+func setConfigLabels(c *config.GenericConfig, key string, labels map[string]string) {
+	if len(labels) == 0 {
+		return
+	}
+	ls := map[string]interface{}{}
+	if v := c.Get(key); v != nil {
+		if m, ok := v.Interface().(map[string]interface{}); ok {
+			for k, v := range m {
+				ls[k] = v
+			}
+		}
+	}
+	for k, v := range labels {
+		ls[k] = v
+	}
+	c.Set(key, ls)
+}
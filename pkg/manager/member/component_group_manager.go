@@ -0,0 +1,172 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+)
+
+var componentGroupMemberTypes = []v1alpha1.MemberType{
+	v1alpha1.TiKVMemberType,
+	v1alpha1.TiDBMemberType,
+	v1alpha1.TiFlashMemberType,
+}
+
+// ComponentGroupManager checks and cleans the resources of the named component groups of a TidbCluster
+type ComponentGroupManager interface {
+	// CheckConflicts returns an error if the resources of the groups, which are named with `<cluster>-<group>`,
+	// conflict with the ones of another TidbCluster in the same namespace.
+	CheckConflicts(tc *v1alpha1.TidbCluster) error
+	// CleanRemovedGroups deletes the StatefulSets, Services and ConfigMaps of the groups of the component
+	// which have been removed from the spec.
+	CleanRemovedGroups(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType) error
+}
+
+type componentGroupManager struct {
+	deps *controller.Dependencies
+}
+
+// NewComponentGroupManager returns a ComponentGroupManager
+func NewComponentGroupManager(deps *controller.Dependencies) ComponentGroupManager {
+	return &componentGroupManager{deps: deps}
+}
+
+func (m *componentGroupManager) CheckConflicts(tc *v1alpha1.TidbCluster) error {
+	tcs, err := m.deps.TiDBClusterLister.TidbClusters(tc.Namespace).List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("list tidbclusters in namespace %s failed: %v", tc.Namespace, err)
+	}
+	names := map[string]struct{}{}
+	for _, other := range tcs {
+		if other.UID == tc.UID {
+			continue
+		}
+		names[other.Name] = struct{}{}
+		for _, memberType := range componentGroupMemberTypes {
+			for _, group := range ComponentGroupNames(other, memberType) {
+				if fmt.Sprintf("%s-%s", other.Name, group) == tc.Name {
+					return fmt.Errorf("the name of the cluster conflicts with %s group %s of tidbcluster %s/%s", memberType, group, other.Namespace, other.Name)
+				}
+			}
+		}
+	}
+	for _, memberType := range componentGroupMemberTypes {
+		for _, group := range ComponentGroupNames(tc, memberType) {
+			if _, ok := names[fmt.Sprintf("%s-%s", tc.Name, group)]; ok {
+				return fmt.Errorf("%s group %s conflicts with tidbcluster %s/%s-%s", memberType, group, tc.Namespace, tc.Name, group)
+			}
+		}
+	}
+	return nil
+}
+
+func (m *componentGroupManager) CleanRemovedGroups(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType) error {
+	ns := tc.Namespace
+	instances := map[string]struct{}{}
+	for _, group := range ComponentGroupNames(tc, memberType) {
+		instances[fmt.Sprintf("%s-%s", tc.Name, group)] = struct{}{}
+	}
+	// removed returns whether the object is a resource of a group of tc which has been removed
+	removed := func(obj metav1.Object) bool {
+		ls := obj.GetLabels()
+		if ls[label.ComponentLabelKey] != memberType.String() || !metav1.IsControlledBy(obj, tc) {
+			return false
+		}
+		instance := ls[label.InstanceLabelKey]
+		if instance == tc.GetInstanceName() {
+			return false
+		}
+		_, ok := instances[instance]
+		return !ok
+	}
+	selector, err := label.New().Selector()
+	if err != nil {
+		return err
+	}
+
+	sets, err := m.deps.StatefulSetLister.StatefulSets(ns).List(selector)
+	if err != nil {
+		return fmt.Errorf("list statefulsets of tidbcluster %s/%s failed: %v", ns, tc.Name, err)
+	}
+	for _, set := range sets {
+		if !removed(set) {
+			continue
+		}
+		// it is guaranteed by the validation, check it again in case the validation webhook is not enabled
+		if set.Status.Replicas > 0 {
+			return controller.RequeueErrorf("tidbcluster: [%s/%s]'s removed group statefulset %s still has %d replicas", ns, tc.Name, set.Name, set.Status.Replicas)
+		}
+		if err := m.deps.StatefulSetControl.DeleteStatefulSet(tc, set, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		klog.Infof("tidbcluster: [%s/%s] deleted the statefulset %s of the removed group", ns, tc.Name, set.Name)
+	}
+
+	svcs, err := m.deps.ServiceLister.Services(ns).List(selector)
+	if err != nil {
+		return fmt.Errorf("list services of tidbcluster %s/%s failed: %v", ns, tc.Name, err)
+	}
+	for _, svc := range svcs {
+		if !removed(svc) {
+			continue
+		}
+		if err := m.deps.ServiceControl.DeleteService(tc, svc); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		klog.Infof("tidbcluster: [%s/%s] deleted the service %s of the removed group", ns, tc.Name, svc.Name)
+	}
+
+	cms, err := m.deps.ConfigMapLister.ConfigMaps(ns).List(selector)
+	if err != nil {
+		return fmt.Errorf("list configmaps of tidbcluster %s/%s failed: %v", ns, tc.Name, err)
+	}
+	for _, cm := range cms {
+		if !removed(cm) {
+			continue
+		}
+		if err := m.deps.ConfigMapControl.DeleteConfigMap(tc, cm); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		klog.Infof("tidbcluster: [%s/%s] deleted the configmap %s of the removed group", ns, tc.Name, cm.Name)
+	}
+	return nil
+}
+
+type FakeComponentGroupManager struct {
+	err error
+}
+
+func NewFakeComponentGroupManager() *FakeComponentGroupManager {
+	return &FakeComponentGroupManager{}
+}
+
+func (m *FakeComponentGroupManager) SetCheckConflictsError(err error) {
+	m.err = err
+}
+
+func (m *FakeComponentGroupManager) CheckConflicts(_ *v1alpha1.TidbCluster) error {
+	return m.err
+}
+
+func (m *FakeComponentGroupManager) CleanRemovedGroups(_ *v1alpha1.TidbCluster, _ v1alpha1.MemberType) error {
+	return nil
+}
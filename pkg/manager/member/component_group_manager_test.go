@@ -0,0 +1,133 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestComponentGroupManagerCheckConflicts(t *testing.T) {
+	g := NewGomegaWithT(t)
+	deps := controller.NewFakeDependencies()
+	m := NewComponentGroupManager(deps)
+	indexer := deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer()
+
+	tc := newTidbClusterWithGroups()
+	g.Expect(indexer.Add(tc)).To(Succeed())
+	g.Expect(m.CheckConflicts(tc)).To(Succeed())
+
+	// a cluster named with <cluster>-<group>
+	other := newTidbCluster()
+	other.Name = tc.Name + "-hot"
+	other.UID = types.UID("other")
+	g.Expect(indexer.Add(other)).To(Succeed())
+	g.Expect(m.CheckConflicts(tc)).To(MatchError(ContainSubstring("tikv group hot conflicts with tidbcluster")))
+	g.Expect(m.CheckConflicts(other)).To(MatchError(ContainSubstring("conflicts with tikv group hot")))
+
+	other.Name = tc.Name + "-cold"
+	g.Expect(indexer.Update(other)).To(Succeed())
+	g.Expect(m.CheckConflicts(tc)).To(Succeed())
+	g.Expect(m.CheckConflicts(other)).To(Succeed())
+}
+
+func TestComponentGroupManagerCleanRemovedGroups(t *testing.T) {
+	g := NewGomegaWithT(t)
+	deps := controller.NewSimpleClientDependencies()
+	deps.ServiceControl = controller.NewRealServiceControl(deps.KubeClientset, deps.ServiceLister, deps.Recorder)
+	m := NewComponentGroupManager(deps)
+
+	tc := newTidbClusterWithGroups()
+	ownerRef := controller.GetOwnerRef(tc)
+	meta := func(name, instance string, memberType v1alpha1.MemberType, ref *metav1.OwnerReference) metav1.ObjectMeta {
+		om := metav1.ObjectMeta{
+			Name:      name,
+			Namespace: tc.Namespace,
+			Labels:    label.New().Instance(instance).Component(memberType.String()).Labels(),
+		}
+		if ref != nil {
+			om.OwnerReferences = []metav1.OwnerReference{*ref}
+		}
+		return om
+	}
+	ctx := context.Background()
+	kubeCli := deps.KubeClientset
+	addSet := func(set *apps.StatefulSet) {
+		_, err := kubeCli.AppsV1().StatefulSets(set.Namespace).Create(ctx, set, metav1.CreateOptions{})
+		g.Expect(err).To(Succeed())
+		g.Expect(deps.KubeInformerFactory.Apps().V1().StatefulSets().Informer().GetIndexer().Add(set)).To(Succeed())
+	}
+	addSvc := func(svc *corev1.Service) {
+		_, err := kubeCli.CoreV1().Services(svc.Namespace).Create(ctx, svc, metav1.CreateOptions{})
+		g.Expect(err).To(Succeed())
+		g.Expect(deps.KubeInformerFactory.Core().V1().Services().Informer().GetIndexer().Add(svc)).To(Succeed())
+	}
+	addCm := func(cm *corev1.ConfigMap) {
+		_, err := kubeCli.CoreV1().ConfigMaps(cm.Namespace).Create(ctx, cm, metav1.CreateOptions{})
+		g.Expect(err).To(Succeed())
+		g.Expect(deps.LabelFilterKubeInformerFactory.Core().V1().ConfigMaps().Informer().GetIndexer().Add(cm)).To(Succeed())
+	}
+
+	// the default group, the kept group "hot" and the removed group "cold"
+	for _, instance := range []string{tc.Name, tc.Name + "-hot", tc.Name + "-cold"} {
+		addSet(&apps.StatefulSet{ObjectMeta: meta(instance+"-tikv", instance, v1alpha1.TiKVMemberType, &ownerRef)})
+		addSvc(&corev1.Service{ObjectMeta: meta(instance+"-tikv-peer", instance, v1alpha1.TiKVMemberType, &ownerRef)})
+		addCm(&corev1.ConfigMap{ObjectMeta: meta(instance+"-tikv", instance, v1alpha1.TiKVMemberType, &ownerRef)})
+	}
+	// the resources of the removed group of TiDB are not touched when cleaning TiKV
+	addSet(&apps.StatefulSet{ObjectMeta: meta(tc.Name+"-oltp-tidb", tc.Name+"-oltp", v1alpha1.TiDBMemberType, &ownerRef)})
+	// the resources of another cluster are not touched
+	addSet(&apps.StatefulSet{ObjectMeta: meta("other-tikv", "other", v1alpha1.TiKVMemberType, nil)})
+
+	g.Expect(m.CleanRemovedGroups(tc, v1alpha1.TiKVMemberType)).To(Succeed())
+
+	for _, instance := range []string{tc.Name, tc.Name + "-hot"} {
+		_, err := kubeCli.AppsV1().StatefulSets(tc.Namespace).Get(ctx, instance+"-tikv", metav1.GetOptions{})
+		g.Expect(err).To(Succeed())
+		_, err = kubeCli.CoreV1().Services(tc.Namespace).Get(ctx, instance+"-tikv-peer", metav1.GetOptions{})
+		g.Expect(err).To(Succeed())
+		_, err = kubeCli.CoreV1().ConfigMaps(tc.Namespace).Get(ctx, instance+"-tikv", metav1.GetOptions{})
+		g.Expect(err).To(Succeed())
+	}
+	_, err := kubeCli.AppsV1().StatefulSets(tc.Namespace).Get(ctx, tc.Name+"-cold-tikv", metav1.GetOptions{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+	_, err = kubeCli.CoreV1().Services(tc.Namespace).Get(ctx, tc.Name+"-cold-tikv-peer", metav1.GetOptions{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+	_, err = kubeCli.CoreV1().ConfigMaps(tc.Namespace).Get(ctx, tc.Name+"-cold-tikv", metav1.GetOptions{})
+	g.Expect(errors.IsNotFound(err)).To(BeTrue())
+	_, err = kubeCli.AppsV1().StatefulSets(tc.Namespace).Get(ctx, tc.Name+"-oltp-tidb", metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+	_, err = kubeCli.AppsV1().StatefulSets(tc.Namespace).Get(ctx, "other-tikv", metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+
+	// the statefulset of a removed group which still has replicas is not deleted
+	set := &apps.StatefulSet{
+		ObjectMeta: meta(tc.Name+"-warm-tikv", tc.Name+"-warm", v1alpha1.TiKVMemberType, &ownerRef),
+		Status:     apps.StatefulSetStatus{Replicas: 1},
+	}
+	addSet(set)
+	g.Expect(m.CleanRemovedGroups(tc, v1alpha1.TiKVMemberType)).To(MatchError(ContainSubstring("still has 1 replicas")))
+	_, err = kubeCli.AppsV1().StatefulSets(tc.Namespace).Get(ctx, set.Name, metav1.GetOptions{})
+	g.Expect(err).To(Succeed())
+}
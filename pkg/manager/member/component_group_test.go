@@ -0,0 +1,215 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+)
+
+func newTidbClusterWithGroups() *v1alpha1.TidbCluster {
+	tc := newTidbCluster()
+	tc.Labels = label.New().Instance(tc.Name)
+	tc.Spec.TiKV.Replicas = 3
+	tc.Spec.TiKV.StorageClassName = pointer.StringPtr("ebs")
+	tc.Spec.TiKV.NodeSelector = map[string]string{"pool": "default"}
+	tc.Spec.TiKV.ResourceRequirements = corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:     resource.MustParse("4"),
+			corev1.ResourceStorage: resource.MustParse("100Gi"),
+		},
+	}
+	tc.Spec.TiKV.Config = v1alpha1.NewTiKVConfig()
+	tc.Spec.TiKV.Config.Set("log.level", "info")
+	tc.Spec.TiKV.Config.Set("server.labels", map[string]interface{}{"zone": "z1"})
+
+	hotConfig := v1alpha1.NewTiKVConfig()
+	hotConfig.Set("storage.block-cache.capacity", "16GB")
+	tc.Spec.TiKV.Groups = []v1alpha1.TiKVGroupSpec{
+		{
+			ComponentGroupSpec: v1alpha1.ComponentGroupSpec{
+				Name: "hot",
+				ComponentSpec: v1alpha1.ComponentSpec{
+					NodeSelector: map[string]string{"pool": "nvme"},
+				},
+				ResourceRequirements: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("16"),
+					},
+				},
+				Replicas:     2,
+				ServerLabels: map[string]string{"disk": "nvme"},
+			},
+			StorageClassName: pointer.StringPtr("local-nvme"),
+			Config:           hotConfig,
+		},
+	}
+
+	tc.Spec.TiDB.Initializer = &v1alpha1.TiDBInitializer{CreatePassword: true}
+	tc.Spec.TiDB.ServerLabels = map[string]string{"zone": "z1"}
+	tc.Spec.TiDB.Groups = []v1alpha1.TiDBGroupSpec{
+		{
+			ComponentGroupSpec: v1alpha1.ComponentGroupSpec{
+				Name:         "olap",
+				Replicas:     1,
+				ServerLabels: map[string]string{"workload": "olap"},
+			},
+		},
+	}
+
+	tc.Spec.TiFlash.Groups = []v1alpha1.TiFlashGroupSpec{
+		{
+			ComponentGroupSpec: v1alpha1.ComponentGroupSpec{
+				Name:         "ap",
+				Replicas:     1,
+				ServerLabels: map[string]string{"workload": "ap"},
+			},
+		},
+	}
+	return tc
+}
+
+func TestComponentGroupNames(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterWithGroups()
+	g.Expect(ComponentGroupNames(tc, v1alpha1.TiKVMemberType)).To(Equal([]string{"hot"}))
+	g.Expect(ComponentGroupNames(tc, v1alpha1.TiDBMemberType)).To(Equal([]string{"olap"}))
+	g.Expect(ComponentGroupNames(tc, v1alpha1.TiFlashMemberType)).To(Equal([]string{"ap"}))
+	g.Expect(ComponentGroupNames(tc, v1alpha1.PDMemberType)).To(BeEmpty())
+}
+
+func TestNewTiKVComponentGroupCluster(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterWithGroups()
+	tc.Annotations = map[string]string{
+		label.AnnTiKVDeleteSlots:                                   "[1]",
+		label.AnnoKeyOfConfigMapNameForNewSTS("tikv"):              "test-pd-tikv-1",
+		label.AnnoKeyOfConfigMapNameForNewSTS("tidb"):              "test-pd-tidb-1",
+		label.AnnoKeyOfConfigMapNameForNewGroupSTS("tikv", "hot"):  "test-pd-hot-tikv-1",
+		label.AnnoKeyOfConfigMapNameForNewGroupSTS("tikv", "cold"): "test-pd-cold-tikv-1",
+	}
+	tc.Status.TiKVGroups = map[string]v1alpha1.TiKVStatus{"hot": {Phase: v1alpha1.UpgradePhase}}
+	gtc, err := NewComponentGroupCluster(tc, v1alpha1.TiKVMemberType, "hot")
+	g.Expect(err).To(Succeed())
+	// only the ConfigMap name stored for the group is kept
+	g.Expect(gtc.Annotations).To(Equal(map[string]string{
+		label.AnnoKeyOfConfigMapNameForNewSTS("tikv"): "test-pd-hot-tikv-1",
+	}))
+
+	g.Expect(gtc.Name).To(Equal("test-pd-hot"))
+	g.Expect(gtc.Namespace).To(Equal(tc.Namespace))
+	g.Expect(gtc.IsComponentGroup()).To(BeTrue())
+	g.Expect(gtc.Labels[label.InstanceLabelKey]).To(Equal("test-pd-hot"))
+	g.Expect(gtc.OwnerReferences).To(HaveLen(1))
+	g.Expect(gtc.OwnerReferences[0].Name).To(Equal(tc.Name))
+	g.Expect(gtc.Heterogeneous()).To(BeTrue())
+	g.Expect(gtc.WithoutLocalPD()).To(BeTrue())
+	g.Expect(gtc.Spec.Cluster.Name).To(Equal(tc.Name))
+	g.Expect(gtc.Spec.TiDB).To(BeNil())
+	g.Expect(gtc.Spec.TiFlash).To(BeNil())
+	g.Expect(gtc.Status.TiKV.Phase).To(Equal(v1alpha1.UpgradePhase))
+	g.Expect(gtc.Status.TiKVGroups).To(BeNil())
+
+	spec := gtc.Spec.TiKV
+	g.Expect(spec.Groups).To(BeNil())
+	g.Expect(spec.Replicas).To(Equal(int32(2)))
+	g.Expect(spec.NodeSelector).To(Equal(map[string]string{"pool": "nvme"}))
+	g.Expect(spec.Labels[label.ComponentGroupLabelKey]).To(Equal("hot"))
+	g.Expect(*spec.StorageClassName).To(Equal("local-nvme"))
+	g.Expect(spec.Requests[corev1.ResourceCPU]).To(Equal(resource.MustParse("16")))
+	g.Expect(spec.Requests[corev1.ResourceStorage]).To(Equal(resource.MustParse("100Gi")))
+	g.Expect(spec.Config.Get("log.level").MustString()).To(Equal("info"))
+	g.Expect(spec.Config.Get("storage.block-cache.capacity").MustString()).To(Equal("16GB"))
+	g.Expect(spec.Config.Get("server.labels").Interface()).To(Equal(map[string]interface{}{"zone": "z1", "disk": "nvme"}))
+
+	// the spec of the default group should not be changed
+	g.Expect(tc.Spec.TiKV.Replicas).To(Equal(int32(3)))
+	g.Expect(tc.Spec.TiKV.Config.Get("server.labels").Interface()).To(Equal(map[string]interface{}{"zone": "z1"}))
+
+	// the resources of the group are named after the group
+	cm, err := getTikVConfigMap(gtc)
+	g.Expect(err).To(Succeed())
+	sts, err := getNewTiKVSetForTidbCluster(gtc, cm)
+	g.Expect(err).To(Succeed())
+	g.Expect(sts.Name).To(Equal("test-pd-hot-tikv"))
+	g.Expect(sts.Labels[label.InstanceLabelKey]).To(Equal("test-pd-hot"))
+	g.Expect(sts.Spec.Template.Labels[label.ComponentGroupLabelKey]).To(Equal("hot"))
+	g.Expect(sts.OwnerReferences[0].Name).To(Equal(tc.Name))
+
+	_, err = NewComponentGroupCluster(tc, v1alpha1.TiKVMemberType, "cold")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestNewTiDBComponentGroupCluster(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterWithGroups()
+	gtc, err := NewComponentGroupCluster(tc, v1alpha1.TiDBMemberType, "olap")
+	g.Expect(err).To(Succeed())
+	g.Expect(gtc.Spec.TiKV).To(BeNil())
+	g.Expect(gtc.Spec.TiDB.Replicas).To(Equal(int32(1)))
+	g.Expect(gtc.Spec.TiDB.Initializer).To(BeNil())
+	g.Expect(gtc.Spec.TiDB.ServerLabels).To(Equal(map[string]string{"zone": "z1", "workload": "olap"}))
+	g.Expect(tc.Spec.TiDB.Initializer).NotTo(BeNil())
+}
+
+func TestNewTiFlashComponentGroupCluster(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterWithGroups()
+	gtc, err := NewComponentGroupCluster(tc, v1alpha1.TiFlashMemberType, "ap")
+	g.Expect(err).To(Succeed())
+	g.Expect(gtc.Spec.TiFlash.Replicas).To(Equal(int32(1)))
+	g.Expect(gtc.Spec.TiFlash.Config.Proxy.Get("server.labels").Interface()).To(Equal(map[string]interface{}{"workload": "ap"}))
+	g.Expect(tc.Spec.TiFlash.Config).To(BeNil())
+}
+
+func TestNewComponentGroupClusterOfHeterogeneousCluster(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterWithGroups()
+	tc.Spec.PD = nil
+	tc.Spec.Cluster = &v1alpha1.TidbClusterRef{Name: "main", Namespace: "main-ns"}
+	gtc, err := NewComponentGroupCluster(tc, v1alpha1.TiKVMemberType, "hot")
+	g.Expect(err).To(Succeed())
+	// the group joins the cluster that tc joins
+	g.Expect(gtc.Spec.Cluster.Name).To(Equal("main"))
+	g.Expect(gtc.Spec.Cluster.Namespace).To(Equal("main-ns"))
+}
+
+func TestComponentGroupStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterWithGroups()
+	gtc, err := NewComponentGroupCluster(tc, v1alpha1.TiKVMemberType, "hot")
+	g.Expect(err).To(Succeed())
+	gtc.Status.TiKV.Phase = v1alpha1.ScalePhase
+	SetComponentGroupStatus(tc, gtc, v1alpha1.TiKVMemberType, "hot")
+	g.Expect(tc.Status.TiKVGroups["hot"].Phase).To(Equal(v1alpha1.ScalePhase))
+	// the status of the default group should not be changed
+	g.Expect(tc.Status.TiKV.Phase).To(BeEmpty())
+
+	tc.Status.TiKVGroups["cold"] = v1alpha1.TiKVStatus{}
+	CleanComponentGroupStatus(tc)
+	g.Expect(tc.Status.TiKVGroups).To(HaveKey("hot"))
+	g.Expect(tc.Status.TiKVGroups).NotTo(HaveKey("cold"))
+}
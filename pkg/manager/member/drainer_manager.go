@@ -0,0 +1,580 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"text/template"
+
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/util/config"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+	"github.com/pingcap/tidb-operator/pkg/util"
+)
+
+const (
+	drainerPort                   int32 = 8249
+	drainerConfigKey                    = "config-file"
+	drainerDataPath                     = "/data"
+	drainerConfigPath                   = "/etc/drainer"
+	drainerCertVolumeMount              = "drainer-tls"
+	drainerCertPath                     = "/var/lib/drainer-tls"
+	drainerSinkCertVolumeMount          = "drainer-syncer-tls"
+	drainerSinkCertPath                 = "/var/lib/drainer-syncer-tls"
+	drainerSinkPasswordEnv              = "MYSQL_PWD"
+	drainerNodeStateOffline             = "offline"
+	drainerNodeStatePaused              = "paused"
+	defaultDrainerInitialCommitTS       = -1
+)
+
+// DrainerManager implements the logic for syncing Drainer.
+type DrainerManager interface {
+	// Sync deploys the drainer and records the nodes of it registered in PD in the status.
+	Sync(*v1alpha1.Drainer) error
+	// Offline makes the drainer offline, it returns a RequeueError until the drainer is offline.
+	Offline(*v1alpha1.Drainer) error
+}
+
+type drainerManager struct {
+	deps *controller.Dependencies
+	// only use for test
+	binlogClient binlogClient
+}
+
+// NewDrainerManager returns a DrainerManager which deploys the drainer as a StatefulSet.
+func NewDrainerManager(deps *controller.Dependencies) DrainerManager {
+	return &drainerManager{deps: deps}
+}
+
+func (m *drainerManager) Sync(dr *v1alpha1.Drainer) error {
+	tc, err := m.getCluster(dr)
+	if err != nil {
+		return err
+	}
+	if tc.Spec.Pump == nil {
+		return fmt.Errorf("tidbcluster %s/%s of Drainer %s/%s has no pump", tc.Namespace, tc.Name, dr.Namespace, dr.Name)
+	}
+
+	if _, err := m.deps.TypedControl.CreateOrUpdateService(dr, getNewDrainerHeadlessService(dr)); err != nil {
+		return err
+	}
+
+	stsName := controller.DrainerMemberName(dr.Name)
+	oldSetTemp, err := m.deps.StatefulSetLister.StatefulSets(dr.Namespace).Get(stsName)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to get sts %s for Drainer %s/%s, error: %s", stsName, dr.Namespace, dr.Name, err)
+	}
+	notFound := errors.IsNotFound(err)
+	oldSet := oldSetTemp.DeepCopy()
+
+	if err := m.syncStatus(dr, tc, oldSet); err != nil {
+		// the last members are kept, the spec is still applied if PD is unavailable
+		klog.Warningf("failed to sync Drainer: [%s/%s]'s status, error: %v", dr.Namespace, dr.Name, err)
+	}
+
+	cm, err := getNewDrainerConfigMap(dr, tc)
+	if err != nil {
+		return err
+	}
+	if _, err := m.deps.TypedControl.CreateOrUpdateConfigMap(dr, cm); err != nil {
+		return err
+	}
+
+	newSet, err := getNewDrainerStatefulSet(dr, tc, cm)
+	if err != nil {
+		return err
+	}
+	if notFound {
+		if err := mngerutils.SetStatefulSetLastAppliedConfigAnnotation(newSet); err != nil {
+			return err
+		}
+		return m.deps.StatefulSetControl.CreateStatefulSet(dr, newSet)
+	}
+	return mngerutils.UpdateStatefulSet(m.deps.StatefulSetControl, dr, newSet, oldSet)
+}
+
+func (m *drainerManager) syncStatus(dr *v1alpha1.Drainer, tc *v1alpha1.TidbCluster, set *apps.StatefulSet) error {
+	if set == nil {
+		// skip if not created yet
+		return nil
+	}
+
+	dr.Status.StatefulSet = &set.Status
+	if mngerutils.StatefulSetIsUpgrading(set) {
+		dr.Status.Phase = v1alpha1.UpgradePhase
+	} else {
+		dr.Status.Phase = v1alpha1.NormalPhase
+	}
+
+	client, err := m.buildBinlogClient(tc)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	nodes, err := client.DrainerNodeStatus(context.TODO())
+	if err != nil {
+		return err
+	}
+	dr.Status.Members = drainerNodes(dr, nodes)
+	return nil
+}
+
+func (m *drainerManager) Offline(dr *v1alpha1.Drainer) error {
+	tc, err := m.getCluster(dr)
+	if errors.IsNotFound(err) {
+		klog.Infof("Drainer %s/%s: tidbcluster is deleted, skip offlining the drainer", dr.Namespace, dr.Name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	client, err := m.buildBinlogClient(tc)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	nodes, err := client.DrainerNodeStatus(context.TODO())
+	if err != nil {
+		return err
+	}
+	var waiting []string
+	for _, node := range drainerNodes(dr, nodes) {
+		switch node.State {
+		case drainerNodeStateOffline:
+			continue
+		case drainerNodeStatePaused:
+			// the drainer process is not running, there is nothing to close
+			if err := client.UpdateDrainerState(context.TODO(), node.Host, drainerNodeStateOffline); err != nil {
+				return fmt.Errorf("mark paused drainer %s of Drainer %s/%s offline failed: %v", node.Host, dr.Namespace, dr.Name, err)
+			}
+			klog.Infof("Drainer %s/%s: marked paused drainer %s offline", dr.Namespace, dr.Name, node.Host)
+		default:
+			if err := client.OfflineDrainer(context.TODO(), node.Host); err != nil {
+				return fmt.Errorf("offline drainer %s of Drainer %s/%s failed: %v", node.Host, dr.Namespace, dr.Name, err)
+			}
+			klog.Infof("Drainer %s/%s: offlining drainer %s in state %s", dr.Namespace, dr.Name, node.Host, node.State)
+			waiting = append(waiting, node.Host)
+		}
+	}
+	if len(waiting) > 0 {
+		return controller.RequeueErrorf("Drainer %s/%s: waiting for drainers %v to be offline", dr.Namespace, dr.Name, waiting)
+	}
+	return nil
+}
+
+func (m *drainerManager) getCluster(dr *v1alpha1.Drainer) (*v1alpha1.TidbCluster, error) {
+	ns := dr.Spec.Cluster.Namespace
+	if ns == "" {
+		ns = dr.Namespace
+	}
+	return m.deps.TiDBClusterLister.TidbClusters(ns).Get(dr.Spec.Cluster.Name)
+}
+
+func (m *drainerManager) buildBinlogClient(tc *v1alpha1.TidbCluster) (binlogClient, error) {
+	if m.binlogClient != nil {
+		return m.binlogClient, nil
+	}
+	return buildBinlogClient(tc, m.deps.PDControl)
+}
+
+// drainerNodes returns the nodes registered by the pods of the Drainer.
+func drainerNodes(dr *v1alpha1.Drainer, nodes []*v1alpha1.PumpNodeStatus) []*v1alpha1.PumpNodeStatus {
+	var ret []*v1alpha1.PumpNodeStatus
+	for _, node := range nodes {
+		if isDrainerAddr(dr, node.Host) {
+			ret = append(ret, node)
+		}
+	}
+	return ret
+}
+
+// isDrainerAddr returns whether the address is registered by a pod of the Drainer. The pods advertise
+// `<pod>.<headless service>.<namespace>:<port>`, which is qualified with the namespace so that the drainer
+// is accessible from the operator, the address of any ordinal is matched.
+func isDrainerAddr(dr *v1alpha1.Drainer, addr string) bool {
+	name := controller.DrainerMemberName(dr.Name)
+	host := strings.TrimSuffix(addr, fmt.Sprintf(".%s.%s:%d", name, dr.Namespace, drainerPort))
+	if host == addr || !strings.HasPrefix(host, name+"-") {
+		return false
+	}
+	_, err := strconv.ParseUint(strings.TrimPrefix(host, name+"-"), 10, 32)
+	return err == nil
+}
+
+func getDrainerMeta(dr *v1alpha1.Drainer) (metav1.ObjectMeta, label.Label) {
+	drainerLabel := label.NewDrainer().Instance(dr.Name).Drainer()
+	objMeta := metav1.ObjectMeta{
+		Name:            controller.DrainerMemberName(dr.Name),
+		Namespace:       dr.Namespace,
+		Labels:          drainerLabel,
+		OwnerReferences: []metav1.OwnerReference{controller.GetDrainerOwnerRef(dr)},
+	}
+	return objMeta, drainerLabel
+}
+
+func getNewDrainerHeadlessService(dr *v1alpha1.Drainer) *corev1.Service {
+	objMeta, drainerLabel := getDrainerMeta(dr)
+	return &corev1.Service{
+		ObjectMeta: objMeta,
+		Spec: corev1.ServiceSpec{
+			ClusterIP: "None",
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "drainer",
+					Port:       drainerPort,
+					TargetPort: intstr.FromInt(int(drainerPort)),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+			Selector:                 drainerLabel,
+			PublishNotReadyAddresses: true,
+		},
+	}
+}
+
+// getNewDrainerConfigMap returns the configMap of the drainer, the name of it is suffixed with the digest
+// of the config so that the drainer is restarted when the config changes.
+func getNewDrainerConfigMap(dr *v1alpha1.Drainer, tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
+	cfg := config.New(map[string]interface{}{})
+	if dr.Spec.Config != nil {
+		cfg = dr.Spec.Config.DeepCopy()
+	}
+
+	sink := dr.Spec.Sink
+	cfg.Set("syncer.db-type", string(sink.Type))
+	switch sink.Type {
+	case v1alpha1.DrainerSinkTypeMySQL, v1alpha1.DrainerSinkTypeTiDB:
+		cfg.Set("syncer.to.host", sink.MySQL.Host)
+		cfg.Set("syncer.to.port", int64(sink.MySQL.Port))
+		cfg.Set("syncer.to.user", sink.MySQL.User)
+		if sink.MySQL.TLSClientSecretName != nil {
+			cfg.Set("syncer.to.security.ssl-ca", path.Join(drainerSinkCertPath, corev1.ServiceAccountRootCAKey))
+			cfg.Set("syncer.to.security.ssl-cert", path.Join(drainerSinkCertPath, corev1.TLSCertKey))
+			cfg.Set("syncer.to.security.ssl-key", path.Join(drainerSinkCertPath, corev1.TLSPrivateKeyKey))
+		}
+	case v1alpha1.DrainerSinkTypeFile:
+		cfg.Set("syncer.to.dir", sink.File.Dir)
+	}
+
+	if tc.IsTLSClusterEnabled() {
+		cfg.Set("security.ssl-ca", path.Join(drainerCertPath, corev1.ServiceAccountRootCAKey))
+		cfg.Set("security.ssl-cert", path.Join(drainerCertPath, corev1.TLSCertKey))
+		cfg.Set("security.ssl-key", path.Join(drainerCertPath, corev1.TLSPrivateKeyKey))
+	}
+
+	confText, err := cfg.MarshalTOML()
+	if err != nil {
+		return nil, err
+	}
+
+	objMeta, _ := getDrainerMeta(dr)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: objMeta,
+		Data: map[string]string{
+			drainerConfigKey: string(confText),
+		},
+	}
+	if err := mngerutils.AddConfigMapDigestSuffix(cm); err != nil {
+		return nil, err
+	}
+	return cm, nil
+}
+
+func getNewDrainerStatefulSet(dr *v1alpha1.Drainer, tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.StatefulSet, error) {
+	spec := dr.BaseDrainerSpec()
+	objMeta, stsLabels := getDrainerMeta(dr)
+	memberName := v1alpha1.DrainerMemberType.String()
+
+	storageRequest, err := controller.ParseStorageRequest(dr.Spec.Requests)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse storage request for Drainer %s/%s, error: %v", dr.Namespace, dr.Name, err)
+	}
+	startScript, err := renderDrainerStartScript(dr, tc)
+	if err != nil {
+		return nil, fmt.Errorf("render start-script for Drainer %s/%s failed: %v", dr.Namespace, dr.Name, err)
+	}
+
+	dataVolumeName := string(v1alpha1.GetStorageVolumeName("", v1alpha1.DrainerMemberType))
+	volumeMounts := []corev1.VolumeMount{
+		{Name: dataVolumeName, MountPath: drainerDataPath},
+		{Name: "config", MountPath: drainerConfigPath},
+	}
+	volumes := []corev1.Volume{
+		{
+			Name: "config",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: cm.Name},
+					Items:                []corev1.KeyToPath{{Key: drainerConfigKey, Path: "drainer.toml"}},
+				},
+			},
+		},
+	}
+	if tc.IsTLSClusterEnabled() {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name: drainerCertVolumeMount, ReadOnly: true, MountPath: drainerCertPath,
+		})
+		volumes = append(volumes, corev1.Volume{
+			Name: drainerCertVolumeMount, VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: util.ClusterTLSSecretName(tc.Name, label.DrainerLabelVal),
+				},
+			},
+		})
+	}
+
+	var envs []corev1.EnvVar
+	if sink := dr.Spec.Sink.MySQL; sink != nil {
+		if sink.TLSClientSecretName != nil {
+			volumeMounts = append(volumeMounts, corev1.VolumeMount{
+				Name: drainerSinkCertVolumeMount, ReadOnly: true, MountPath: drainerSinkCertPath,
+			})
+			volumes = append(volumes, corev1.Volume{
+				Name: drainerSinkCertVolumeMount, VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: *sink.TLSClientSecretName,
+					},
+				},
+			})
+		}
+		if sink.PasswordSecret != nil {
+			// the drainer reads the password of the downstream from MYSQL_PWD if it is not set in the config
+			envs = append(envs, corev1.EnvVar{
+				Name:      drainerSinkPasswordEnv,
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: sink.PasswordSecret},
+			})
+		}
+	}
+
+	containers := []corev1.Container{
+		{
+			Name:            memberName,
+			Image:           drainerImage(dr, tc),
+			ImagePullPolicy: spec.ImagePullPolicy(),
+			Command:         []string{"/bin/sh", "-c", startScript},
+			Ports: []corev1.ContainerPort{{
+				Name:          memberName,
+				ContainerPort: drainerPort,
+			}},
+			Resources:       controller.ContainerResource(dr.Spec.ResourceRequirements),
+			SecurityContext: spec.SecurityContext(),
+			Env:             util.AppendEnv(envs, spec.Env()),
+			EnvFrom:         spec.EnvFrom(),
+			VolumeMounts:    append(volumeMounts, spec.AdditionalVolumeMounts()...),
+			ReadinessProbe: &corev1.Probe{
+				ProbeHandler: corev1.ProbeHandler{
+					TCPSocket: &corev1.TCPSocketAction{
+						Port: intstr.FromInt(int(drainerPort)),
+					},
+				},
+			},
+		},
+	}
+
+	podSpec := spec.BuildPodSpec()
+	podSpec.Containers, err = MergePatchContainers(containers, spec.AdditionalContainers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge containers spec for Drainer %s/%s, error: %v", dr.Namespace, dr.Name, err)
+	}
+	podSpec.Volumes = append(volumes, spec.AdditionalVolumes()...)
+	podSpec.InitContainers = spec.InitContainers()
+	podSpec.DNSPolicy = spec.DnsPolicy()
+	podSpec.SecurityContext = spec.PodSecurityContext()
+
+	return &apps.StatefulSet{
+		ObjectMeta: objMeta,
+		Spec: apps.StatefulSetSpec{
+			Selector:    stsLabels.LabelSelector(),
+			ServiceName: controller.DrainerMemberName(dr.Name),
+			// a drainer replicates all the binlog to its downstream, so there is only one replica
+			Replicas: pointer.Int32Ptr(1),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: util.CombineStringMap(spec.Annotations(), controller.AnnProm(drainerPort, "/metrics")),
+					Labels:      util.CombineStringMap(stsLabels.Labels(), spec.Labels()),
+				},
+				Spec: podSpec,
+			},
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: dataVolumeName},
+					Spec: corev1.PersistentVolumeClaimSpec{
+						AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+						StorageClassName: dr.Spec.StorageClassName,
+						Resources:        storageRequest,
+					},
+				},
+			},
+			PodManagementPolicy: spec.PodManagementPolicy(),
+			UpdateStrategy: apps.StatefulSetUpdateStrategy{
+				Type: spec.StatefulSetUpdateStrategy(),
+			},
+		},
+	}, nil
+}
+
+// drainerImage returns the image of the drainer, the version of the TidbCluster is used if the version is not set.
+func drainerImage(dr *v1alpha1.Drainer, tc *v1alpha1.TidbCluster) string {
+	if dr.Spec.BaseImage == "" {
+		return dr.Spec.Image
+	}
+	version := tc.Spec.Version
+	if dr.Spec.Version != nil {
+		version = *dr.Spec.Version
+	}
+	if version == "" {
+		return dr.Spec.BaseImage
+	}
+	return fmt.Sprintf("%s:%s", dr.Spec.BaseImage, version)
+}
+
+// drainerStartScriptModel is the model of the start script of the drainer.
+type drainerStartScriptModel struct {
+	Domain          string
+	LogLevel        string
+	PDAddr          string
+	AdvertiseAddr   string
+	DisableDetect   bool
+	InitialCommitTS int64
+}
+
+// drainerStartScriptTpl is the same as the start script of the tidb-drainer chart, except that the advertise
+// address is qualified with the namespace.
+var drainerStartScriptTpl = template.Must(template.New("drainer-start-script").Parse(`set -euo pipefail
+
+domain={{ .Domain }}
+
+elapseTime=0
+period=1
+threshold=30
+while true; do
+    sleep ${period}
+    elapseTime=$(( elapseTime+period ))
+
+    if [[ ${elapseTime} -ge ${threshold} ]]
+    then
+        echo "waiting for drainer domain ready timeout" >&2
+        exit 1
+    fi
+
+    if nslookup ${domain} 2>/dev/null
+    then
+        echo "nslookup domain ${domain} success"
+        break
+    else
+        echo "nslookup domain ${domain} failed" >&2
+    fi
+done
+
+/drainer \
+-L={{ .LogLevel }} \
+-pd-urls={{ .PDAddr }} \
+-addr=0.0.0.0:8249 \
+-advertise-addr={{ .AdvertiseAddr }} \
+-config=/etc/drainer/drainer.toml \
+-disable-detect={{ .DisableDetect }} \
+-initial-commit-ts={{ .InitialCommitTS }} \
+-data-dir=/data \
+-log-file=""
+
+if [ $? == 0 ]; then
+    echo $(date -u +"[%Y/%m/%d %H:%M:%S.%3N %:z]") "drainer offline, please delete my pod"
+    tail -f /dev/null
+fi
+`))
+
+func renderDrainerStartScript(dr *v1alpha1.Drainer, tc *v1alpha1.TidbCluster) (string, error) {
+	scheme := "http"
+	if tc.IsTLSClusterEnabled() {
+		scheme = "https"
+	}
+	pdName, pdNamespace := tc.Name, tc.Namespace
+	if tc.Heterogeneous() && tc.WithoutLocalPD() {
+		// use pd of reference cluster
+		pdName = tc.Spec.Cluster.Name
+		if tc.Spec.Cluster.Namespace != "" {
+			pdNamespace = tc.Spec.Cluster.Namespace
+		}
+	}
+	initialCommitTS := int64(defaultDrainerInitialCommitTS)
+	if dr.Spec.InitialCommitTS != nil {
+		initialCommitTS = *dr.Spec.InitialCommitTS
+	}
+
+	name := controller.DrainerMemberName(dr.Name)
+	model := &drainerStartScriptModel{
+		Domain:          fmt.Sprintf("${HOSTNAME}.%s.%s", name, dr.Namespace),
+		LogLevel:        dr.Spec.LogLevel,
+		PDAddr:          fmt.Sprintf("%s://%s.%s:%d", scheme, controller.PDMemberName(pdName), pdNamespace, v1alpha1.DefaultPDClientPort),
+		AdvertiseAddr:   fmt.Sprintf("${HOSTNAME}.%s.%s:%d", name, dr.Namespace, drainerPort),
+		DisableDetect:   dr.Spec.DisableDetect,
+		InitialCommitTS: initialCommitTS,
+	}
+	buff := new(bytes.Buffer)
+	if err := drainerStartScriptTpl.Execute(buff, model); err != nil {
+		return "", err
+	}
+	return buff.String(), nil
+}
+
+var _ DrainerManager = &drainerManager{}
+
+// FakeDrainerManager is a fake DrainerManager
+type FakeDrainerManager struct {
+	syncErr    error
+	offlineErr error
+}
+
+// NewFakeDrainerManager returns a FakeDrainerManager
+func NewFakeDrainerManager() *FakeDrainerManager {
+	return &FakeDrainerManager{}
+}
+
+// SetSyncError sets the error returned by Sync
+func (m *FakeDrainerManager) SetSyncError(err error) {
+	m.syncErr = err
+}
+
+// SetOfflineError sets the error returned by Offline
+func (m *FakeDrainerManager) SetOfflineError(err error) {
+	m.offlineErr = err
+}
+
+func (m *FakeDrainerManager) Sync(_ *v1alpha1.Drainer) error {
+	return m.syncErr
+}
+
+func (m *FakeDrainerManager) Offline(_ *v1alpha1.Drainer) error {
+	return m.offlineErr
+}
+
+var _ DrainerManager = &FakeDrainerManager{}
@@ -0,0 +1,261 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1/defaulting"
+	"github.com/pingcap/tidb-operator/pkg/apis/util/config"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+)
+
+func newDrainer() *v1alpha1.Drainer {
+	dr := &v1alpha1.Drainer{
+		ObjectMeta: metav1.ObjectMeta{Name: "to-mysql", Namespace: "binlog"},
+		Spec: v1alpha1.DrainerSpec{
+			Cluster: v1alpha1.TidbClusterRef{Name: "test", Namespace: corev1.NamespaceDefault},
+			ResourceRequirements: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			},
+			Sink: v1alpha1.DrainerSinkSpec{
+				Type: v1alpha1.DrainerSinkTypeMySQL,
+				MySQL: &v1alpha1.DrainerMySQLSink{
+					Host: "mysql.db",
+					PasswordSecret: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "mysql"},
+						Key:                  "password",
+					},
+					TLSClientSecretName: pointer.String("mysql-client"),
+				},
+			},
+			Config: config.New(map[string]interface{}{
+				"worker-count": 16,
+				"syncer": map[string]interface{}{
+					"txn-batch": 20,
+					"db-type":   "kafka",
+				},
+			}),
+		},
+	}
+	defaulting.SetDrainerDefault(dr)
+	return dr
+}
+
+func newTidbClusterForDrainer() *v1alpha1.TidbCluster {
+	tc := newTidbClusterForPump()
+	tc.Spec.Version = "v7.5.0"
+	return tc
+}
+
+func TestGetNewDrainerConfigMap(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dr := newDrainer()
+	tc := newTidbClusterForDrainer()
+	tc.Spec.TLSCluster = &v1alpha1.TLSCluster{Enabled: true}
+	cm, err := getNewDrainerConfigMap(dr, tc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cm.Name).To(HavePrefix("to-mysql-drainer-"))
+	g.Expect(cm.Namespace).To(Equal("binlog"))
+	g.Expect(cm.OwnerReferences[0].Kind).To(Equal(v1alpha1.DrainerKind))
+
+	var cfg map[string]interface{}
+	_, err = toml.Decode(cm.Data[drainerConfigKey], &cfg)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cfg["worker-count"]).To(BeEquivalentTo(16))
+	g.Expect(cfg["security"]).To(Equal(map[string]interface{}{
+		"ssl-ca":   "/var/lib/drainer-tls/ca.crt",
+		"ssl-cert": "/var/lib/drainer-tls/tls.crt",
+		"ssl-key":  "/var/lib/drainer-tls/tls.key",
+	}))
+	syncer := cfg["syncer"].(map[string]interface{})
+	g.Expect(syncer["txn-batch"]).To(BeEquivalentTo(20))
+	// the sink in the spec overrides the config
+	g.Expect(syncer["db-type"]).To(Equal("mysql"))
+	to := syncer["to"].(map[string]interface{})
+	g.Expect(to["host"]).To(Equal("mysql.db"))
+	g.Expect(to["port"]).To(BeEquivalentTo(3306))
+	g.Expect(to["user"]).To(Equal("root"))
+	g.Expect(to).NotTo(HaveKey("password"))
+	g.Expect(to["security"]).To(HaveKeyWithValue("ssl-ca", "/var/lib/drainer-syncer-tls/ca.crt"))
+
+	// the configMap is renamed when the config changes
+	dr.Spec.Sink = v1alpha1.DrainerSinkSpec{Type: v1alpha1.DrainerSinkTypeFile}
+	defaulting.SetDrainerDefault(dr)
+	fileCm, err := getNewDrainerConfigMap(dr, newTidbClusterForDrainer())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(fileCm.Name).NotTo(Equal(cm.Name))
+	cfg = nil
+	_, err = toml.Decode(fileCm.Data[drainerConfigKey], &cfg)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cfg).NotTo(HaveKey("security"))
+	g.Expect(cfg["syncer"]).To(HaveKeyWithValue("db-type", "file"))
+	g.Expect(cfg["syncer"]).To(HaveKeyWithValue("to", map[string]interface{}{"dir": "/data/pb"}))
+}
+
+func TestGetNewDrainerStatefulSet(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dr := newDrainer()
+	tc := newTidbClusterForDrainer()
+	cm, err := getNewDrainerConfigMap(dr, tc)
+	g.Expect(err).NotTo(HaveOccurred())
+	sts, err := getNewDrainerStatefulSet(dr, tc, cm)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(sts.Name).To(Equal("to-mysql-drainer"))
+	g.Expect(*sts.Spec.Replicas).To(Equal(int32(1)))
+	g.Expect(sts.Spec.ServiceName).To(Equal("to-mysql-drainer"))
+	g.Expect(sts.Spec.Selector.MatchLabels).To(HaveKeyWithValue("app.kubernetes.io/component", "drainer"))
+	g.Expect(sts.Spec.Selector.MatchLabels).To(HaveKeyWithValue("app.kubernetes.io/name", "tidb-drainer"))
+	g.Expect(sts.Spec.VolumeClaimTemplates[0].Name).To(Equal("data"))
+	g.Expect(sts.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests.Storage().String()).To(Equal("10Gi"))
+
+	podSpec := sts.Spec.Template.Spec
+	g.Expect(podSpec.Containers).To(HaveLen(1))
+	c := podSpec.Containers[0]
+	g.Expect(c.Image).To(Equal("pingcap/tidb-binlog:v7.5.0"))
+	g.Expect(c.Env).To(ContainElement(corev1.EnvVar{
+		Name:      "MYSQL_PWD",
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: dr.Spec.Sink.MySQL.PasswordSecret},
+	}))
+	g.Expect(c.VolumeMounts).To(ContainElement(HaveField("MountPath", drainerSinkCertPath)))
+	g.Expect(c.VolumeMounts).NotTo(ContainElement(HaveField("MountPath", drainerCertPath)))
+	g.Expect(podSpec.Volumes).To(ContainElement(HaveField("ConfigMap.Name", cm.Name)))
+
+	script := c.Command[2]
+	g.Expect(script).To(ContainSubstring("-pd-urls=http://test-pd.default:2379"))
+	g.Expect(script).To(ContainSubstring("-advertise-addr=${HOSTNAME}.to-mysql-drainer.binlog:8249"))
+	g.Expect(script).To(ContainSubstring("-initial-commit-ts=-1"))
+	g.Expect(script).To(ContainSubstring("-disable-detect=false"))
+	g.Expect(script).To(ContainSubstring("-L=info"))
+
+	dr.Spec.Version = pointer.String("v7.1.0")
+	dr.Spec.InitialCommitTS = pointer.Int64(445566)
+	tc.Spec.TLSCluster = &v1alpha1.TLSCluster{Enabled: true}
+	sts, err = getNewDrainerStatefulSet(dr, tc, cm)
+	g.Expect(err).NotTo(HaveOccurred())
+	c = sts.Spec.Template.Spec.Containers[0]
+	g.Expect(c.Image).To(Equal("pingcap/tidb-binlog:v7.1.0"))
+	g.Expect(c.Command[2]).To(ContainSubstring("-pd-urls=https://test-pd.default:2379"))
+	g.Expect(c.Command[2]).To(ContainSubstring("-initial-commit-ts=445566"))
+	g.Expect(sts.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Secret.SecretName", "test-drainer-cluster-secret")))
+}
+
+func TestDrainerManagerSync(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	binlogCli := &fakeBinlogClient{drainers: []*v1alpha1.PumpNodeStatus{
+		{NodeID: "to-mysql-drainer-0:8249", Host: "to-mysql-drainer-0.to-mysql-drainer.binlog:8249", State: "online"},
+		{NodeID: "helm-drainer-0:8249", Host: "test-helm-drainer-0.test-helm-drainer:8249", State: "online"},
+	}}
+	m := &drainerManager{deps: deps, binlogClient: binlogCli}
+	dr := newDrainer()
+
+	// the tidbcluster must exist and have pumps
+	g.Expect(m.Sync(dr)).To(HaveOccurred())
+	tc := newTidbClusterForDrainer()
+	tc.Spec.Pump = nil
+	tcIndexer := deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer()
+	g.Expect(tcIndexer.Add(tc)).To(Succeed())
+	g.Expect(m.Sync(dr)).To(MatchError(ContainSubstring("has no pump")))
+	tc = newTidbClusterForDrainer()
+	g.Expect(tcIndexer.Update(tc)).To(Succeed())
+
+	g.Expect(m.Sync(dr)).To(Succeed())
+	svc := &corev1.Service{}
+	exist, err := deps.TypedControl.Exist(client.ObjectKey{Namespace: "binlog", Name: "to-mysql-drainer"}, svc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(exist).To(BeTrue())
+	g.Expect(svc.Spec.ClusterIP).To(Equal(corev1.ClusterIPNone))
+	sts, err := deps.StatefulSetLister.StatefulSets("binlog").Get("to-mysql-drainer")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// only the drainers of the Drainer are in the status
+	g.Expect(m.Sync(dr)).To(Succeed())
+	g.Expect(dr.Status.StatefulSet).To(Equal(&sts.Status))
+	g.Expect(dr.Status.Members).To(Equal(binlogCli.drainers[:1]))
+
+	// the spec is still applied if the drainer status is unavailable
+	binlogCli.drainerErr = fmt.Errorf("drainer status unavailable")
+	dr.Spec.BaseImage = "pingcap/tidb-binlog-new"
+	g.Expect(m.Sync(dr)).To(Succeed())
+	g.Expect(dr.Status.Members).To(Equal(binlogCli.drainers[:1]))
+	sts, err = deps.StatefulSetLister.StatefulSets("binlog").Get("to-mysql-drainer")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(sts.Spec.Template.Spec.Containers[0].Image).To(Equal("pingcap/tidb-binlog-new:v7.5.0"))
+}
+
+func TestIsDrainerAddr(t *testing.T) {
+	g := NewGomegaWithT(t)
+	dr := newDrainer()
+
+	tests := []struct {
+		addr     string
+		expected bool
+	}{
+		{addr: "to-mysql-drainer-0.to-mysql-drainer.binlog:8249", expected: true},
+		{addr: "to-mysql-drainer-1.to-mysql-drainer.binlog:8249", expected: true},
+		{addr: "to-mysql-drainer-0.to-mysql-drainer.other:8249"},
+		{addr: "to-mysql-drainer-0.to-mysql-drainer.binlog:8250"},
+		{addr: "to-mysql-drainer-x.to-mysql-drainer.binlog:8249"},
+		{addr: "other-to-mysql-drainer-0.to-mysql-drainer.binlog:8249"},
+		{addr: "test-helm-drainer-0.test-helm-drainer:8249"},
+	}
+	for _, tt := range tests {
+		g.Expect(isDrainerAddr(dr, tt.addr)).To(Equal(tt.expected), tt.addr)
+	}
+}
+
+func TestDrainerManagerOffline(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	binlogCli := &fakeBinlogClient{}
+	m := &drainerManager{deps: deps, binlogClient: binlogCli}
+	dr := newDrainer()
+
+	// nothing to offline if the tidbcluster is deleted
+	g.Expect(m.Offline(dr)).To(Succeed())
+
+	tc := newTidbClusterForDrainer()
+	g.Expect(deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer().Add(tc)).To(Succeed())
+	// or if the drainer is never registered
+	g.Expect(m.Offline(dr)).To(Succeed())
+
+	binlogCli.drainers = []*v1alpha1.PumpNodeStatus{
+		{NodeID: "to-mysql-drainer-0:8249", Host: "to-mysql-drainer-0.to-mysql-drainer.binlog:8249", State: "online"},
+	}
+	err := m.Offline(dr)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(binlogCli.drainers[0].State).To(Equal("offline"))
+	g.Expect(m.Offline(dr)).To(Succeed())
+
+	// a paused drainer is marked offline directly
+	binlogCli.drainers[0].State = "paused"
+	g.Expect(m.Offline(dr)).To(Succeed())
+	g.Expect(binlogCli.drainers[0].State).To(Equal("offline"))
+}
@@ -34,6 +34,12 @@ type Failover interface {
 	RemoveUndesiredFailures(*v1alpha1.TidbCluster)
 }
 
+// PDMSFailover implements the logic for PD microservices' failover and recovery.
+type PDMSFailover interface {
+	Failover(*v1alpha1.TidbCluster, *v1alpha1.PDMSSpec) error
+	Recover(*v1alpha1.TidbCluster, *v1alpha1.PDMSSpec)
+}
+
 // DMFailover implements the logic for dm-master/dm-worker's failover and recovery.
 type DMFailover interface {
 	Failover(*v1alpha1.DMCluster) error
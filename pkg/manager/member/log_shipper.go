@@ -0,0 +1,687 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/util/config"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager/member/constants"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	logShipperContainerName = "log-shipper"
+	logShipperConfigVolume  = "log-shipper-config"
+	logShipperDataVolume    = "log-shipper-data"
+	logShipperConfigDir     = "/etc/log-shipper"
+	logShipperDataDir       = "/var/lib/log-shipper"
+
+	// the keys of the log shipper config in the ConfigMap of the component
+	logShipperConfigKey  = "log-shipper-config"
+	logShipperParsersKey = "log-shipper-parsers"
+	logShipperScriptKey  = "log-shipper-script"
+
+	defaultVectorImage    = "timberio/vector:0.39.0-debian"
+	defaultFluentBitImage = "fluent/fluent-bit:3.0.7"
+)
+
+// logFormat is the format of a log file, it decides how the lines are parsed
+type logFormat string
+
+const (
+	// logFormatUnified is the unified log format of TiDB components,
+	// e.g. `[2024/01/01 00:00:00.000 +00:00] [INFO] [server.go:1] ["message"] [key=value]`
+	logFormatUnified logFormat = "unified"
+	// logFormatSlowLog is the slow query log of TiDB, a record is multiple lines beginning with `# Time: `
+	logFormatSlowLog logFormat = "slowlog"
+	// logFormatRaw is not parsed, the line is kept as the message
+	logFormatRaw logFormat = "raw"
+)
+
+// logShipperSource is a log file of the component to be shipped
+type logShipperSource struct {
+	// name is the `log_type` field of the records
+	name   string
+	path   string
+	format logFormat
+}
+
+// getLogShipperSources returns the log files of the component to be shipped, they are decided by the spec of
+// the cluster only, so that the config in the ConfigMap and the volumes of the StatefulSet are consistent.
+func getLogShipperSources(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType) ([]logShipperSource, error) {
+	var sources []logShipperSource
+	switch memberType {
+	case v1alpha1.PDMemberType:
+		sources = appendConfiguredLogFile(sources, pdConfig(tc.Spec.PD.Config), "log.file.filename")
+	case v1alpha1.TiDBMemberType:
+		if tc.Spec.TiDB.ShouldSeparateSlowLog() {
+			file := defaultSlowLogFile
+			if name := tc.Spec.TiDB.SlowLogVolumeName; name != "" {
+				mountPath, ok := logVolumeMountPath(tc.Spec.TiDB.StorageVolumes, tc.Spec.TiDB.AdditionalVolumeMounts, name)
+				if !ok {
+					return nil, fmt.Errorf("failed to get slowLogVolume %s for cluster %s/%s", name, tc.Namespace, tc.Name)
+				}
+				file = path.Join(mountPath, name)
+			}
+			sources = append(sources, logShipperSource{name: "slowlog", path: file, format: logFormatSlowLog})
+		}
+		sources = appendConfiguredLogFile(sources, tidbConfig(tc.Spec.TiDB.Config), "log.file.filename")
+	case v1alpha1.TiKVMemberType:
+		format := logFormatUnified
+		rocksDBLogFile, raftLogFile := "rocksdb.info", "raftdb.info"
+		if TiKVLessThanV50(tc.TiKVImage()) {
+			format = logFormatRaw
+			rocksDBLogFile, raftLogFile = "db/LOG", "raft/LOG"
+		}
+		if tc.Spec.TiKV.ShouldSeparateRocksDBLog() {
+			file, err := tikvLogFilePath(tc, tc.Spec.TiKV.RocksDBLogVolumeName, rocksDBLogFile)
+			if err != nil {
+				return nil, err
+			}
+			sources = append(sources, logShipperSource{name: "rocksdblog", path: file, format: format})
+		}
+		if tc.Spec.TiKV.ShouldSeparateRaftLog() {
+			file, err := tikvLogFilePath(tc, tc.Spec.TiKV.RaftLogVolumeName, raftLogFile)
+			if err != nil {
+				return nil, err
+			}
+			sources = append(sources, logShipperSource{name: "raftlog", path: file, format: format})
+		}
+		sources = appendConfiguredLogFile(sources, tikvConfig(tc.Spec.TiKV.Config), "log.file.filename", "log-file")
+	case v1alpha1.TiFlashMemberType:
+		cfg := tc.Spec.TiFlash.Config.DeepCopy()
+		if cfg == nil {
+			cfg = v1alpha1.NewTiFlashConfig()
+		}
+		setTiFlashLogConfigDefault(cfg)
+		for _, log := range []struct {
+			name, key string
+			format    logFormat
+		}{
+			{"serverlog", "logger.log", logFormatUnified},
+			{"errorlog", "logger.errorlog", logFormatUnified},
+			{"clusterlog", "flash.flash_cluster.log", logFormatRaw},
+		} {
+			file, err := cfg.Common.Get(log.key).AsString()
+			if err != nil {
+				return nil, err
+			}
+			sources = append(sources, logShipperSource{name: log.name, path: file, format: log.format})
+		}
+	case v1alpha1.TiCDCMemberType:
+		if file := tc.TiCDCLogFile(); file != "" {
+			sources = append(sources, logShipperSource{name: "log", path: file, format: logFormatUnified})
+		}
+	}
+	return sources, nil
+}
+
+// appendConfiguredLogFile appends the log file of the component if it is configured by one of the keys
+func appendConfiguredLogFile(sources []logShipperSource, cfg *config.GenericConfig, keys ...string) []logShipperSource {
+	for _, key := range keys {
+		if v := cfg.Get(key); v != nil {
+			if file, err := v.AsString(); err == nil && file != "" {
+				return append(sources, logShipperSource{name: "log", path: file, format: logFormatUnified})
+			}
+		}
+	}
+	return sources
+}
+
+func pdConfig(c *v1alpha1.PDConfigWraper) *config.GenericConfig {
+	if c == nil {
+		return nil
+	}
+	return c.GenericConfig
+}
+
+func tidbConfig(c *v1alpha1.TiDBConfigWraper) *config.GenericConfig {
+	if c == nil {
+		return nil
+	}
+	return c.GenericConfig
+}
+
+func tikvConfig(c *v1alpha1.TiKVConfigWraper) *config.GenericConfig {
+	if c == nil {
+		return nil
+	}
+	return c.GenericConfig
+}
+
+func tikvLogFilePath(tc *v1alpha1.TidbCluster, volumeName, logFile string) (string, error) {
+	if volumeName == "" {
+		return path.Join(constants.TiKVDataVolumeMountPath, logFile), nil
+	}
+	mountPath, ok := logVolumeMountPath(tc.Spec.TiKV.StorageVolumes, tc.Spec.TiKV.AdditionalVolumeMounts, volumeName)
+	if !ok {
+		return "", fmt.Errorf("failed to get log volume %s for cluster %s/%s", volumeName, tc.Namespace, tc.Name)
+	}
+	return path.Join(mountPath, logFile), nil
+}
+
+// logVolumeMountPath returns the mount path of the volume in storageVolumes or additionalVolumeMounts
+func logVolumeMountPath(storageVolumes []v1alpha1.StorageVolume, additionalVolumeMounts []corev1.VolumeMount, name string) (string, bool) {
+	for _, sv := range storageVolumes {
+		if sv.Name == name && sv.MountPath != "" {
+			return sv.MountPath, true
+		}
+	}
+	for _, vm := range additionalVolumeMounts {
+		if vm.Name == name {
+			return vm.MountPath, true
+		}
+	}
+	return "", false
+}
+
+// addLogShipperConfig adds the config of the log shipper into the data of the ConfigMap of the component
+func addLogShipperConfig(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, data map[string]string) error {
+	spec := tc.ComponentSpec(memberType).LogShipper()
+	if spec == nil {
+		return nil
+	}
+	sources, err := getLogShipperSources(tc, memberType)
+	if err != nil {
+		return err
+	}
+	if len(sources) == 0 {
+		return nil
+	}
+
+	switch logShipperType(spec) {
+	case v1alpha1.LogShipperTypeFluentBit:
+		data[logShipperConfigKey] = renderFluentBitConfig(tc, memberType, spec, sources)
+		data[logShipperParsersKey] = fluentBitParsers
+		data[logShipperScriptKey] = fluentBitScript
+	default:
+		cfg, err := renderVectorConfig(tc, memberType, spec, sources)
+		if err != nil {
+			return err
+		}
+		data[logShipperConfigKey] = cfg
+	}
+	return nil
+}
+
+// buildLogShipperContainer returns the log shipper container and its volumes, the container mounts the volumes
+// of the log files from the component container read-only. It returns nil if the logs are not shipped.
+func buildLogShipperContainer(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, cm *corev1.ConfigMap,
+	volMounts []corev1.VolumeMount) (*corev1.Container, []corev1.Volume, error) {
+	spec := tc.ComponentSpec(memberType).LogShipper()
+	if spec == nil || cm == nil {
+		return nil, nil, nil
+	}
+	sources, err := getLogShipperSources(tc, memberType)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(sources) == 0 {
+		return nil, nil, nil
+	}
+
+	mounts := []corev1.VolumeMount{
+		{Name: logShipperConfigVolume, ReadOnly: true, MountPath: logShipperConfigDir},
+		{Name: logShipperDataVolume, MountPath: logShipperDataDir},
+	}
+	mounted := map[string]bool{}
+	for _, source := range sources {
+		vm, ok := volumeMountOfPath(volMounts, source.path)
+		if !ok {
+			return nil, nil, fmt.Errorf("log file %s of %s is not on any volume of cluster %s/%s", source.path, memberType, tc.Namespace, tc.Name)
+		}
+		if mounted[vm.Name] {
+			continue
+		}
+		mounted[vm.Name] = true
+		vm.ReadOnly = true
+		mounts = append(mounts, vm)
+	}
+
+	env := []corev1.EnvVar{
+		{
+			Name: "POD_NAME",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "metadata.name",
+				},
+			},
+		},
+	}
+	for _, sink := range spec.Sinks {
+		if sink.HTTP != nil && sink.HTTP.AuthorizationSecret != nil {
+			env = append(env, corev1.EnvVar{
+				Name:      logShipperAuthorizationEnv(sink.Name),
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: sink.HTTP.AuthorizationSecret},
+			})
+		}
+	}
+
+	image, args := spec.Image, []string{"--config", path.Join(logShipperConfigDir, "vector.yaml")}
+	items := []corev1.KeyToPath{{Key: logShipperConfigKey, Path: "vector.yaml"}}
+	if logShipperType(spec) == v1alpha1.LogShipperTypeFluentBit {
+		args = []string{"-c", path.Join(logShipperConfigDir, "fluent-bit.conf")}
+		items = []corev1.KeyToPath{
+			{Key: logShipperConfigKey, Path: "fluent-bit.conf"},
+			{Key: logShipperParsersKey, Path: "parsers.conf"},
+			{Key: logShipperScriptKey, Path: "tidb.lua"},
+		}
+		if image == "" {
+			image = defaultFluentBitImage
+		}
+	} else if image == "" {
+		image = defaultVectorImage
+	}
+	pullPolicy := tc.Spec.ImagePullPolicy
+	if spec.ImagePullPolicy != nil {
+		pullPolicy = *spec.ImagePullPolicy
+	}
+
+	c := &corev1.Container{
+		Name:            logShipperContainerName,
+		Image:           image,
+		ImagePullPolicy: pullPolicy,
+		Args:            args,
+		Env:             env,
+		Resources:       controller.ContainerResource(spec.ResourceRequirements),
+		SecurityContext: spec.SecurityContext,
+		VolumeMounts:    mounts,
+	}
+	if spec.UseSidecar {
+		c.RestartPolicy = ptr.To(corev1.ContainerRestartPolicyAlways)
+	}
+	vols := []corev1.Volume{
+		{
+			Name: logShipperConfigVolume,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: cm.Name},
+					Items:                items,
+				},
+			},
+		},
+		{
+			Name: logShipperDataVolume,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+	}
+	return c, vols, nil
+}
+
+// appendLogShipperContainer appends the log shipper container to the containers, or to the init containers
+// if it uses the native sidecar feature.
+func appendLogShipperContainer(c *corev1.Container, containers, initContainers []corev1.Container) ([]corev1.Container, []corev1.Container) {
+	if c == nil {
+		return containers, initContainers
+	}
+	if c.RestartPolicy != nil {
+		return containers, append(initContainers, *c)
+	}
+	return append(containers, *c), initContainers
+}
+
+// volumeMountOfPath returns the volume mount with the longest mount path containing the file
+func volumeMountOfPath(volMounts []corev1.VolumeMount, file string) (corev1.VolumeMount, bool) {
+	var found corev1.VolumeMount
+	ok := false
+	for _, vm := range volMounts {
+		dir := strings.TrimSuffix(vm.MountPath, "/") + "/"
+		if strings.HasPrefix(file, dir) && (!ok || len(vm.MountPath) > len(found.MountPath)) {
+			found, ok = vm, true
+		}
+	}
+	return found, ok
+}
+
+func logShipperType(spec *v1alpha1.LogShipperSpec) v1alpha1.LogShipperType {
+	if spec.Type == "" {
+		return v1alpha1.LogShipperTypeVector
+	}
+	return spec.Type
+}
+
+func logShipperAuthorizationEnv(sink string) string {
+	return fmt.Sprintf("LOG_SHIPPER_%s_AUTHORIZATION", strings.ToUpper(strings.ReplaceAll(sink, "-", "_")))
+}
+
+// logShipperLabels returns the fields added to each record except `pod`, which is read from the env
+func logShipperLabels(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, spec *v1alpha1.LogShipperSpec) map[string]string {
+	labels := map[string]string{}
+	for k, v := range spec.Labels {
+		labels[k] = v
+	}
+	labels["namespace"] = tc.Namespace
+	labels["cluster"] = tc.Name
+	labels["component"] = memberType.String()
+	return labels
+}
+
+// vrlUnified parses the unified log format into `time`, `level`, `source`, `message` and `fields`
+const vrlUnified = `parsed, err = parse_regex(.message, r'^\[(?P<time>[^\]]+)\] \[(?P<level>[^\]]+)\] \[(?P<source>[^\]]*)\] \[(?P<message>(?:[^\]"]|"(?:[^"\\]|\\.)*")*)\](?P<fields>.*)$$')
+if err == null {
+  .time = parsed.time
+  .level = parsed.level
+  .source = parsed.source
+  .message = parsed.message
+  fields = {}
+  pairs = parse_regex_all!(parsed.fields, r' \[(?P<key>[^=\]]+)=(?P<value>(?:[^\]"]|"(?:[^"\\]|\\.)*")*)\]')
+  for_each(pairs) -> |_index, pair| {
+    fields = set!(fields, [pair.key], pair.value)
+  }
+  .fields = fields
+}
+`
+
+// vrlSlowLog parses the `# Key: value` lines of a slow log record into `slow_log` and the rest into `query`
+const vrlSlowLog = `lines = split(string!(.message), "\n")
+fields = {}
+query = []
+for_each(lines) -> |_index, line| {
+  if starts_with(line, "# ") {
+    pairs = parse_regex_all!(line, r'(?P<key>[A-Za-z_@]+): (?P<value>\S+)')
+    for_each(pairs) -> |_i, pair| {
+      fields = set!(fields, [pair.key], pair.value)
+    }
+  } else if line != "" {
+    query = push(query, line)
+  }
+}
+.slow_log = fields
+.query = join!(query, "\n")
+.time = fields.Time
+del(.message)
+`
+
+// renderVectorConfig renders the config of Vector, each log file is a source followed by a remap transform
+// which parses the records and adds the labels.
+func renderVectorConfig(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, spec *v1alpha1.LogShipperSpec,
+	sources []logShipperSource) (string, error) {
+	labels := logShipperLabels(tc, memberType, spec)
+	var addLabels strings.Builder
+	for _, k := range sortedStringKeys(labels) {
+		fmt.Fprintf(&addLabels, ".%q = %q\n", k, strings.ReplaceAll(labels[k], "$", "$$"))
+	}
+	addLabels.WriteString(".pod = get_env_var(\"POD_NAME\") ?? \"\"\n")
+
+	vectorSources := map[string]interface{}{}
+	transforms := map[string]interface{}{}
+	var inputs []string
+	for _, source := range sources {
+		file := map[string]interface{}{
+			"type":      "file",
+			"include":   []string{source.path},
+			"read_from": "beginning",
+		}
+		remap := fmt.Sprintf(".log_type = %q\n", source.name)
+		switch source.format {
+		case logFormatUnified:
+			remap += vrlUnified
+		case logFormatSlowLog:
+			file["multiline"] = map[string]interface{}{
+				"start_pattern":     "^# Time: ",
+				"condition_pattern": "^# Time: ",
+				"mode":              "halt_before",
+				"timeout_ms":        1000,
+			}
+			remap += vrlSlowLog
+		}
+		vectorSources[source.name] = file
+		transforms[source.name+"_parsed"] = map[string]interface{}{
+			"type":   "remap",
+			"inputs": []string{source.name},
+			"source": remap + addLabels.String(),
+		}
+		inputs = append(inputs, source.name+"_parsed")
+	}
+
+	sinks := map[string]interface{}{}
+	for _, sink := range spec.Sinks {
+		switch {
+		case sink.File != nil:
+			sinks[sink.Name] = map[string]interface{}{
+				"type":     "file",
+				"inputs":   inputs,
+				"path":     sink.File.Path,
+				"encoding": map[string]interface{}{"codec": "json"},
+			}
+		case sink.HTTP != nil:
+			headers := map[string]string{}
+			for k, v := range sink.HTTP.Headers {
+				headers[k] = v
+			}
+			if sink.HTTP.AuthorizationSecret != nil {
+				headers["Authorization"] = fmt.Sprintf("${%s}", logShipperAuthorizationEnv(sink.Name))
+			}
+			s := map[string]interface{}{
+				"type":     "http",
+				"inputs":   inputs,
+				"uri":      sink.HTTP.URL,
+				"method":   "post",
+				"encoding": map[string]interface{}{"codec": "json"},
+				"framing":  map[string]interface{}{"method": "newline_delimited"},
+			}
+			if len(headers) > 0 {
+				s["request"] = map[string]interface{}{"headers": headers}
+			}
+			if sink.HTTP.InsecureSkipVerify {
+				s["tls"] = map[string]interface{}{"verify_certificate": false}
+			}
+			sinks[sink.Name] = s
+		}
+	}
+
+	out, err := yaml.Marshal(map[string]interface{}{
+		"data_dir":   logShipperDataDir,
+		"sources":    vectorSources,
+		"transforms": transforms,
+		"sinks":      sinks,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// fluentBitParsers defines the multiline parser of the TiDB slow log
+const fluentBitParsers = `[MULTILINE_PARSER]
+    name          tidb_slowlog
+    type          regex
+    flush_timeout 1000
+    rule          "start_state"  "/^# Time: /"       "cont"
+    rule          "cont"         "/^(?!# Time: )/"   "cont"
+`
+
+// fluentBitScript parses the records as vrlUnified and vrlSlowLog do
+const fluentBitScript = `function parse_unified(tag, timestamp, record)
+  local line = record["log"]
+  if line == nil then
+    return 0, timestamp, record
+  end
+  local time, level, source, message, rest = string.match(line, "^%[(.-)%] %[(.-)%] %[(.-)%] %[(.-)%](.*)$")
+  if time == nil then
+    record["message"] = line
+    record["log"] = nil
+    return 2, timestamp, record
+  end
+  local fields = {}
+  for key, value in string.gmatch(rest, " %[([^=%]]+)=(.-)%]") do
+    fields[key] = value
+  end
+  record["time"] = time
+  record["level"] = level
+  record["source"] = source
+  record["message"] = message
+  record["fields"] = fields
+  record["log"] = nil
+  return 2, timestamp, record
+end
+
+function parse_slowlog(tag, timestamp, record)
+  local text = record["log"]
+  if text == nil then
+    return 0, timestamp, record
+  end
+  local fields = {}
+  local query = {}
+  for line in string.gmatch(text .. "\n", "(.-)\n") do
+    if string.sub(line, 1, 2) == "# " then
+      for key, value in string.gmatch(line, "([%w_@]+): (%S+)") do
+        fields[key] = value
+      end
+    elseif line ~= "" then
+      table.insert(query, line)
+    end
+  end
+  record["slow_log"] = fields
+  record["query"] = table.concat(query, "\n")
+  record["time"] = fields["Time"]
+  record["log"] = nil
+  return 2, timestamp, record
+end
+
+function parse_raw(tag, timestamp, record)
+  record["message"] = record["log"]
+  record["log"] = nil
+  return 2, timestamp, record
+end
+`
+
+// renderFluentBitConfig renders the config of Fluent Bit, each log file is a tail input tagged with its name,
+// the records are parsed by the lua filters and the labels are added by the modify filter.
+func renderFluentBitConfig(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, spec *v1alpha1.LogShipperSpec,
+	sources []logShipperSource) string {
+	var b strings.Builder
+	section := func(name string, kvs ...string) {
+		fmt.Fprintf(&b, "[%s]\n", name)
+		for i := 0; i+1 < len(kvs); i += 2 {
+			fmt.Fprintf(&b, "    %-16s %s\n", kvs[i], kvs[i+1])
+		}
+		b.WriteString("\n")
+	}
+
+	section("SERVICE",
+		"Flush", "1",
+		"Parsers_File", path.Join(logShipperConfigDir, "parsers.conf"),
+		"storage.path", logShipperDataDir,
+	)
+	for _, source := range sources {
+		kvs := []string{
+			"Name", "tail",
+			"Tag", source.name,
+			"Path", source.path,
+			"DB", path.Join(logShipperDataDir, source.name+".db"),
+			"Read_from_Head", "true",
+		}
+		if source.format == logFormatSlowLog {
+			kvs = append(kvs, "multiline.parser", "tidb_slowlog")
+		}
+		section("INPUT", kvs...)
+
+		call := "parse_raw"
+		switch source.format {
+		case logFormatUnified:
+			call = "parse_unified"
+		case logFormatSlowLog:
+			call = "parse_slowlog"
+		}
+		section("FILTER",
+			"Name", "lua",
+			"Match", source.name,
+			"script", path.Join(logShipperConfigDir, "tidb.lua"),
+			"call", call,
+		)
+		section("FILTER",
+			"Name", "modify",
+			"Match", source.name,
+			"Add", "log_type "+source.name,
+		)
+	}
+
+	labels := logShipperLabels(tc, memberType, spec)
+	kvs := []string{"Name", "modify", "Match", "*"}
+	for _, k := range sortedStringKeys(labels) {
+		kvs = append(kvs, "Add", k+" "+labels[k])
+	}
+	kvs = append(kvs, "Add", "pod ${POD_NAME}")
+	section("FILTER", kvs...)
+
+	for _, sink := range spec.Sinks {
+		switch {
+		case sink.File != nil:
+			section("OUTPUT",
+				"Name", "file",
+				"Alias", sink.Name,
+				"Match", "*",
+				"Path", path.Dir(sink.File.Path),
+				"File", path.Base(sink.File.Path),
+				"Format", "plain",
+			)
+		case sink.HTTP != nil:
+			// the URL is validated by the webhook
+			u, _ := url.Parse(sink.HTTP.URL)
+			port := u.Port()
+			if port == "" {
+				port = "80"
+				if u.Scheme == "https" {
+					port = "443"
+				}
+			}
+			uri := u.RequestURI()
+			kvs := []string{
+				"Name", "http",
+				"Alias", sink.Name,
+				"Match", "*",
+				"Host", u.Hostname(),
+				"Port", port,
+				"URI", uri,
+				"Format", "json_lines",
+			}
+			if u.Scheme == "https" {
+				verify := "On"
+				if sink.HTTP.InsecureSkipVerify {
+					verify = "Off"
+				}
+				kvs = append(kvs, "tls", "On", "tls.verify", verify)
+			}
+			for _, k := range sortedStringKeys(sink.HTTP.Headers) {
+				kvs = append(kvs, "Header", k+" "+sink.HTTP.Headers[k])
+			}
+			if sink.HTTP.AuthorizationSecret != nil {
+				kvs = append(kvs, "Header", fmt.Sprintf("Authorization ${%s}", logShipperAuthorizationEnv(sink.Name)))
+			}
+			section("OUTPUT", kvs...)
+		}
+	}
+	return b.String()
+}
+
+func sortedStringKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,206 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func newLogShipperSpec() *v1alpha1.LogShipperSpec {
+	return &v1alpha1.LogShipperSpec{
+		Labels: map[string]string{"env": "test"},
+		Sinks: []v1alpha1.LogSinkSpec{
+			{Name: "local", File: &v1alpha1.FileLogSink{Path: "/var/log/shipped/tidb.json"}},
+			{Name: "remote", HTTP: &v1alpha1.HTTPLogSink{
+				URL:                 "https://logs.example.com/ingest?source=tidb",
+				Headers:             map[string]string{"X-Tenant": "t1"},
+				AuthorizationSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "logs"}, Key: "token"},
+			}},
+		},
+	}
+}
+
+func TestGetLogShipperSources(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForTiDB()
+	sources, err := getLogShipperSources(tc, v1alpha1.TiDBMemberType)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(sources).To(Equal([]logShipperSource{{name: "slowlog", path: defaultSlowLogFile, format: logFormatSlowLog}}))
+
+	tc.Spec.TiDB.SlowLogVolumeName = "log"
+	tc.Spec.TiDB.StorageVolumes = []v1alpha1.StorageVolume{{Name: "log", StorageSize: "1Gi", MountPath: "/var/log/tidb-log"}}
+	tc.Spec.TiDB.Config = v1alpha1.NewTiDBConfig()
+	tc.Spec.TiDB.Config.Set("log.file.filename", "/var/log/tidb-log/tidb.log")
+	sources, err = getLogShipperSources(tc, v1alpha1.TiDBMemberType)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(sources).To(Equal([]logShipperSource{
+		{name: "slowlog", path: "/var/log/tidb-log/log", format: logFormatSlowLog},
+		{name: "log", path: "/var/log/tidb-log/tidb.log", format: logFormatUnified},
+	}))
+
+	tc.Spec.TiDB.StorageVolumes = nil
+	_, err = getLogShipperSources(tc, v1alpha1.TiDBMemberType)
+	g.Expect(err).To(HaveOccurred())
+
+	tc = newTidbClusterForTiKV()
+	tc.Spec.TiKV.SeparateRocksDBLog = pointerBool(true)
+	sources, err = getLogShipperSources(tc, v1alpha1.TiKVMemberType)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(sources).To(ConsistOf(logShipperSource{name: "rocksdblog", path: "/var/lib/tikv/rocksdb.info", format: logFormatUnified}))
+
+	tc.Spec.TiFlash = &v1alpha1.TiFlashSpec{}
+	sources, err = getLogShipperSources(tc, v1alpha1.TiFlashMemberType)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(sources).To(HaveLen(3))
+	g.Expect(sources[0].path).To(Equal(defaultServerLog))
+
+	// PD logs to stdout by default
+	sources, err = getLogShipperSources(tc, v1alpha1.PDMemberType)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(sources).To(BeEmpty())
+}
+
+func TestRenderVectorConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tc := newTidbClusterForTiDB()
+	tc.Spec.LogShipper = newLogShipperSpec()
+
+	data := map[string]string{}
+	g.Expect(addLogShipperConfig(tc, v1alpha1.TiDBMemberType, data)).To(Succeed())
+	g.Expect(data).To(HaveKey(logShipperConfigKey))
+	g.Expect(data).NotTo(HaveKey(logShipperParsersKey))
+
+	var cfg struct {
+		DataDir    string                            `json:"data_dir"`
+		Sources    map[string]map[string]interface{} `json:"sources"`
+		Transforms map[string]map[string]interface{} `json:"transforms"`
+		Sinks      map[string]map[string]interface{} `json:"sinks"`
+	}
+	g.Expect(yaml.Unmarshal([]byte(data[logShipperConfigKey]), &cfg)).To(Succeed())
+	g.Expect(cfg.DataDir).To(Equal(logShipperDataDir))
+	g.Expect(cfg.Sources["slowlog"]["include"]).To(Equal([]interface{}{defaultSlowLogFile}))
+	g.Expect(cfg.Sources["slowlog"]).To(HaveKey("multiline"))
+	remap := cfg.Transforms["slowlog_parsed"]["source"].(string)
+	g.Expect(remap).To(ContainSubstring(`."cluster" = "test"`))
+	g.Expect(remap).To(ContainSubstring(`."component" = "tidb"`))
+	g.Expect(remap).To(ContainSubstring(`."env" = "test"`))
+	g.Expect(remap).To(ContainSubstring(`.log_type = "slowlog"`))
+	g.Expect(cfg.Sinks["local"]["path"]).To(Equal("/var/log/shipped/tidb.json"))
+	g.Expect(cfg.Sinks["remote"]["uri"]).To(Equal("https://logs.example.com/ingest?source=tidb"))
+	g.Expect(cfg.Sinks["remote"]["inputs"]).To(Equal([]interface{}{"slowlog_parsed"}))
+	g.Expect(cfg.Sinks["remote"]["request"]).To(Equal(map[string]interface{}{
+		"headers": map[string]interface{}{
+			"Authorization": "${LOG_SHIPPER_REMOTE_AUTHORIZATION}",
+			"X-Tenant":      "t1",
+		},
+	}))
+
+	// no log file is shipped
+	tc.Spec.TiDB.SeparateSlowLog = pointerBool(false)
+	data = map[string]string{}
+	g.Expect(addLogShipperConfig(tc, v1alpha1.TiDBMemberType, data)).To(Succeed())
+	g.Expect(data).To(BeEmpty())
+}
+
+func TestRenderFluentBitConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tc := newTidbClusterForTiDB()
+	tc.Spec.LogShipper = newLogShipperSpec()
+	tc.Spec.LogShipper.Type = v1alpha1.LogShipperTypeFluentBit
+
+	data := map[string]string{}
+	g.Expect(addLogShipperConfig(tc, v1alpha1.TiDBMemberType, data)).To(Succeed())
+	g.Expect(data).To(HaveKey(logShipperParsersKey))
+	g.Expect(data).To(HaveKey(logShipperScriptKey))
+	cfg := data[logShipperConfigKey]
+	g.Expect(cfg).To(ContainSubstring("multiline.parser tidb_slowlog"))
+	g.Expect(cfg).To(ContainSubstring("call             parse_slowlog"))
+	g.Expect(cfg).To(ContainSubstring("Add              pod ${POD_NAME}"))
+	g.Expect(cfg).To(ContainSubstring("Host             logs.example.com"))
+	g.Expect(cfg).To(ContainSubstring("Port             443"))
+	g.Expect(cfg).To(ContainSubstring("URI              /ingest?source=tidb"))
+	g.Expect(cfg).To(ContainSubstring("tls.verify       On"))
+	g.Expect(cfg).To(ContainSubstring("Header           Authorization ${LOG_SHIPPER_REMOTE_AUTHORIZATION}"))
+	g.Expect(cfg).To(ContainSubstring("File             tidb.json"))
+}
+
+func TestBuildLogShipperContainer(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tc := newTidbClusterForTiDB()
+	tc.Spec.LogShipper = newLogShipperSpec()
+	cm := &corev1.ConfigMap{}
+	cm.Name = "test-tidb"
+	volMounts := []corev1.VolumeMount{
+		{Name: "config", MountPath: "/etc/tidb"},
+		{Name: defaultSlowLogVolume, MountPath: defaultSlowLogDir},
+	}
+
+	c, vols, err := buildLogShipperContainer(tc, v1alpha1.TiDBMemberType, nil, volMounts)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(c).To(BeNil())
+
+	c, vols, err = buildLogShipperContainer(tc, v1alpha1.TiDBMemberType, cm, volMounts)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(c.Image).To(Equal(defaultVectorImage))
+	g.Expect(c.RestartPolicy).To(BeNil())
+	g.Expect(c.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: defaultSlowLogVolume, MountPath: defaultSlowLogDir, ReadOnly: true}))
+	g.Expect(c.VolumeMounts).NotTo(ContainElement(HaveField("Name", "config")))
+	g.Expect(c.Env).To(ContainElement(HaveField("Name", "LOG_SHIPPER_REMOTE_AUTHORIZATION")))
+	g.Expect(vols).To(HaveLen(2))
+	g.Expect(vols[0].ConfigMap.Name).To(Equal("test-tidb"))
+
+	// the component-level spec overrides the cluster-level one
+	tc.Spec.TiDB.LogShipper = newLogShipperSpec()
+	tc.Spec.TiDB.LogShipper.UseSidecar = true
+	tc.Spec.TiDB.LogShipper.Image = "vector:custom"
+	c, _, err = buildLogShipperContainer(tc, v1alpha1.TiDBMemberType, cm, volMounts)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(c.Image).To(Equal("vector:custom"))
+	containers, initContainers := appendLogShipperContainer(c, nil, nil)
+	g.Expect(containers).To(BeEmpty())
+	g.Expect(initContainers).To(HaveLen(1))
+
+	// the log file must be on a volume of the component
+	_, _, err = buildLogShipperContainer(tc, v1alpha1.TiDBMemberType, cm, volMounts[:1])
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestGetNewTiDBSetWithLogShipper(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tc := newTidbClusterForTiDB()
+	tc.Spec.TiDB.Config = v1alpha1.NewTiDBConfig()
+	tc.Spec.LogShipper = newLogShipperSpec()
+	cm, err := getTiDBConfigMap(tc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cm.Data).To(HaveKey(logShipperConfigKey))
+
+	sts, err := getNewTiDBSetForTidbCluster(tc, cm)
+	g.Expect(err).NotTo(HaveOccurred())
+	var names []string
+	for _, c := range sts.Spec.Template.Spec.Containers {
+		names = append(names, c.Name)
+	}
+	g.Expect(names).To(ContainElement(logShipperContainerName))
+	g.Expect(sts.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Name", logShipperConfigVolume)))
+}
+
+func pointerBool(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,194 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	errorutils "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+)
+
+const pdLeaderTransferredReason = "PDLeaderTransferred"
+
+// pdLeaderPlacement contains the leader priorities and zones of the PD members in a cluster, keyed by the member name
+type pdLeaderPlacement struct {
+	priorities map[string]int
+	zones      map[string]string
+}
+
+// getPDLeaderPlacement calculates the leader priorities of the PD members from the leader preference.
+// The zone of a member is read from the labels of the node that its pod runs on, and is left empty if
+// the node can not be accessed.
+func getPDLeaderPlacement(deps *controller.Dependencies, tc *v1alpha1.TidbCluster) (*pdLeaderPlacement, error) {
+	ns := tc.GetNamespace()
+	placement := &pdLeaderPlacement{
+		priorities: map[string]int{},
+		zones:      map[string]string{},
+	}
+	for name := range tc.Status.PD.Members {
+		podName := strings.Split(name, ".")[0]
+		ordinal, err := util.GetOrdinalFromPodName(podName)
+		if err != nil {
+			klog.Warningf("unexpected pd member name %q of tidbcluster %s/%s: %v", name, ns, tc.GetName(), err)
+			continue
+		}
+
+		zone := ""
+		if deps.NodeLister != nil {
+			pod, err := deps.PodLister.Pods(ns).Get(podName)
+			if err != nil && !errors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to get pod %s/%s, error: %v", ns, podName, err)
+			}
+			if pod != nil && pod.Spec.NodeName != "" {
+				node, err := deps.NodeLister.Get(pod.Spec.NodeName)
+				if err != nil && !errors.IsNotFound(err) {
+					return nil, fmt.Errorf("failed to get node %s of pod %s/%s, error: %v", pod.Spec.NodeName, ns, podName, err)
+				}
+				if node != nil {
+					zone = getZoneFromNodeLabels(node.Labels)
+				}
+			}
+		}
+		placement.zones[name] = zone
+
+		priority := 0
+		for _, pref := range tc.Spec.PD.LeaderPreference {
+			if pref.Priority <= priority {
+				continue
+			}
+			if (pref.Zone != "" && pref.Zone == zone) || containsOrdinal(pref.Ordinals, ordinal) {
+				priority = pref.Priority
+			}
+		}
+		placement.priorities[name] = priority
+	}
+	return placement, nil
+}
+
+// preferredMember returns the healthy member with the highest priority in candidates, the first one
+// is returned if multiple members have the same priority.
+func (p *pdLeaderPlacement) preferredMember(tc *v1alpha1.TidbCluster, candidates []string) string {
+	target := ""
+	for _, name := range candidates {
+		if !tc.Status.PD.Members[name].Health {
+			continue
+		}
+		if target == "" || p.priorities[name] > p.priorities[target] {
+			target = name
+		}
+	}
+	return target
+}
+
+// syncLeaderPreference exposes the zone of the PD leader and enforces the leader preference of PD:
+//   - the leader priorities of the members are set according to the preference
+//   - the leader is transferred to the healthy member with the highest priority if the current one is less preferred
+//
+// It is skipped while PD is scaling or upgrading, and re-evaluated once PD is back to normal.
+func (m *pdMemberManager) syncLeaderPreference(tc *v1alpha1.TidbCluster) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	if len(tc.Spec.PD.LeaderPreference) == 0 && len(tc.Status.PD.LeaderPriorities) == 0 && m.deps.NodeLister == nil {
+		return nil
+	}
+
+	placement, err := getPDLeaderPlacement(m.deps, tc)
+	if err != nil {
+		return err
+	}
+	tc.Status.PD.LeaderZone = placement.zones[tc.Status.PD.Leader.Name]
+
+	if len(tc.Spec.PD.LeaderPreference) == 0 && len(tc.Status.PD.LeaderPriorities) == 0 {
+		return nil
+	}
+	if !tc.PDIsAvailable() || tc.Status.PD.Phase != v1alpha1.NormalPhase {
+		klog.V(4).Infof("pd of tidbcluster %s/%s is not available or not in normal phase, skip syncing leader preference", ns, tcName)
+		return nil
+	}
+
+	pdClient := controller.GetPDClient(m.deps.PDControl, tc)
+	membersInfo, err := pdClient.GetMembers()
+	if err != nil {
+		return fmt.Errorf("failed to get pd members of tidbcluster %s/%s: %v", ns, tcName, err)
+	}
+	var errs []error
+	applied := map[string]int{}
+	for _, member := range membersInfo.Members {
+		name := member.GetName()
+		priority, ok := placement.priorities[name]
+		if !ok {
+			// the member is not in this cluster
+			continue
+		}
+		if int(member.GetLeaderPriority()) != priority {
+			if err := pdClient.SetMemberLeaderPriority(name, priority); err != nil {
+				errs = append(errs, fmt.Errorf("failed to set leader priority of pd member %s of tidbcluster %s/%s: %v", name, ns, tcName, err))
+				continue
+			}
+			klog.Infof("set leader priority of pd member %s of tidbcluster %s/%s to %d", name, ns, tcName, priority)
+		}
+		if priority > 0 {
+			applied[name] = priority
+		}
+	}
+	tc.Status.PD.LeaderPriorities = applied
+	if len(errs) > 0 || len(tc.Spec.PD.LeaderPreference) == 0 {
+		return errorutils.NewAggregate(errs)
+	}
+
+	leaderName := tc.Status.PD.Leader.Name
+	names := make([]string, 0, len(tc.Status.PD.Members))
+	for name := range tc.Status.PD.Members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	target := placement.preferredMember(tc, names)
+	if target == "" || target == leaderName || placement.priorities[target] <= placement.priorities[leaderName] {
+		return nil
+	}
+	if err := pdClient.TransferPDLeader(target); err != nil {
+		return fmt.Errorf("failed to transfer pd leader of tidbcluster %s/%s to %s: %v", ns, tcName, target, err)
+	}
+	klog.Infof("transfer pd leader of tidbcluster %s/%s from %s to the preferred member %s", ns, tcName, leaderName, target)
+	m.deps.Recorder.Eventf(tc, corev1.EventTypeNormal, pdLeaderTransferredReason,
+		"pd leader is transferred from %s to the preferred member %s", leaderName, target)
+	return nil
+}
+
+// getZoneFromNodeLabels returns the zone of a node
+func getZoneFromNodeLabels(labels map[string]string) string {
+	for _, key := range topologyZoneLabels {
+		if zone := labels[key]; zone != "" {
+			return zone
+		}
+	}
+	return ""
+}
+
+func containsOrdinal(ordinals []int32, ordinal int32) bool {
+	for _, o := range ordinals {
+		if o == ordinal {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,182 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/kvproto/pkg/pdpb"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func newTidbClusterForPDLeaderPreference() *v1alpha1.TidbCluster {
+	tc := newTidbClusterForPD()
+	tc.Status.PD.Phase = v1alpha1.NormalPhase
+	tc.Status.PD.Members = map[string]v1alpha1.PDMember{
+		"test-pd-0": {Name: "test-pd-0", Health: true},
+		"test-pd-1": {Name: "test-pd-1", Health: true},
+		"test-pd-2": {Name: "test-pd-2", Health: true},
+	}
+	tc.Status.PD.Leader = tc.Status.PD.Members["test-pd-0"]
+	tc.Status.PD.StatefulSet = &apps.StatefulSetStatus{ReadyReplicas: 3}
+	tc.Spec.PD.LeaderPreference = []v1alpha1.PDLeaderPreference{
+		{Zone: "z2", Priority: 10},
+		{Ordinals: []int32{2}, Priority: 5},
+	}
+	return tc
+}
+
+// addPDPodsInZones adds the pd pods which run on the nodes in z1, z2 and z2
+func addPDPodsInZones(deps *controller.Dependencies, podIndexer cache.Indexer) {
+	nodeIndexer := deps.KubeInformerFactory.Core().V1().Nodes().Informer().GetIndexer()
+	for i, zone := range []string{"z1", "z2", "z2"} {
+		nodeName := fmt.Sprintf("node-%d", i)
+		nodeIndexer.Add(&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   nodeName,
+				Labels: map[string]string{"topology.kubernetes.io/zone": zone},
+			},
+		})
+		podIndexer.Add(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("test-pd-%d", i),
+				Namespace: corev1.NamespaceDefault,
+			},
+			Spec: corev1.PodSpec{NodeName: nodeName},
+		})
+	}
+}
+
+func TestGetPDLeaderPlacement(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForPDLeaderPreference()
+	pmm, podIndexer, _ := newFakePDMemberManager()
+	addPDPodsInZones(pmm.deps, podIndexer)
+
+	placement, err := getPDLeaderPlacement(pmm.deps, tc)
+	g.Expect(err).To(Succeed())
+	g.Expect(placement.zones).To(Equal(map[string]string{"test-pd-0": "z1", "test-pd-1": "z2", "test-pd-2": "z2"}))
+	// the highest priority is used if a member matches multiple preferences
+	g.Expect(placement.priorities).To(Equal(map[string]int{"test-pd-0": 0, "test-pd-1": 10, "test-pd-2": 10}))
+
+	g.Expect(placement.preferredMember(tc, []string{"test-pd-0", "test-pd-2", "test-pd-1"})).To(Equal("test-pd-2"))
+	member := tc.Status.PD.Members["test-pd-2"]
+	member.Health = false
+	tc.Status.PD.Members["test-pd-2"] = member
+	g.Expect(placement.preferredMember(tc, []string{"test-pd-0", "test-pd-2", "test-pd-1"})).To(Equal("test-pd-1"))
+}
+
+func TestPDMemberManagerSyncLeaderPreference(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type testcase struct {
+		name       string
+		update     func(tc *v1alpha1.TidbCluster)
+		priorities map[string]int32
+		expectFn   func(tc *v1alpha1.TidbCluster, set map[string]int, transferred []string)
+	}
+
+	testFn := func(test testcase) {
+		t.Log(test.name)
+		tc := newTidbClusterForPDLeaderPreference()
+		if test.update != nil {
+			test.update(tc)
+		}
+
+		pmm, podIndexer, _ := newFakePDMemberManager()
+		addPDPodsInZones(pmm.deps, podIndexer)
+		pdClient := controller.NewFakePDClient(pmm.deps.PDControl.(*pdapi.FakePDControl), tc)
+		pdClient.AddReaction(pdapi.GetMembersActionType, func(action *pdapi.Action) (interface{}, error) {
+			membersInfo := &pdapi.MembersInfo{}
+			for _, name := range []string{"test-pd-0", "test-pd-1", "test-pd-2", "peer-pd-0"} {
+				membersInfo.Members = append(membersInfo.Members, &pdpb.Member{Name: name, LeaderPriority: test.priorities[name]})
+			}
+			return membersInfo, nil
+		})
+		set := map[string]int{}
+		pdClient.AddReaction(pdapi.SetMemberLeaderPriorityActionType, func(action *pdapi.Action) (interface{}, error) {
+			set[action.Name] = action.Priority
+			return nil, nil
+		})
+		var transferred []string
+		pdClient.AddReaction(pdapi.TransferPDLeaderActionType, func(action *pdapi.Action) (interface{}, error) {
+			transferred = append(transferred, action.Name)
+			return nil, nil
+		})
+
+		g.Expect(pmm.syncLeaderPreference(tc)).To(Succeed())
+		test.expectFn(tc, set, transferred)
+	}
+
+	tests := []testcase{
+		{
+			name: "set priorities and transfer leader",
+			expectFn: func(tc *v1alpha1.TidbCluster, set map[string]int, transferred []string) {
+				g.Expect(set).To(Equal(map[string]int{"test-pd-1": 10, "test-pd-2": 10}))
+				g.Expect(transferred).To(Equal([]string{"test-pd-1"}))
+				g.Expect(tc.Status.PD.LeaderZone).To(Equal("z1"))
+				g.Expect(tc.Status.PD.LeaderPriorities).To(Equal(map[string]int{"test-pd-1": 10, "test-pd-2": 10}))
+			},
+		},
+		{
+			name: "leader is preferred",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.PD.Leader = tc.Status.PD.Members["test-pd-2"]
+			},
+			priorities: map[string]int32{"test-pd-1": 10, "test-pd-2": 10},
+			expectFn: func(tc *v1alpha1.TidbCluster, set map[string]int, transferred []string) {
+				g.Expect(set).To(BeEmpty())
+				g.Expect(transferred).To(BeEmpty())
+				g.Expect(tc.Status.PD.LeaderZone).To(Equal("z2"))
+			},
+		},
+		{
+			name: "pd is upgrading",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.PD.Phase = v1alpha1.UpgradePhase
+			},
+			expectFn: func(tc *v1alpha1.TidbCluster, set map[string]int, transferred []string) {
+				g.Expect(set).To(BeEmpty())
+				g.Expect(transferred).To(BeEmpty())
+				g.Expect(tc.Status.PD.LeaderZone).To(Equal("z1"))
+			},
+		},
+		{
+			name: "preference is removed",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.PD.LeaderPreference = nil
+				tc.Status.PD.LeaderPriorities = map[string]int{"test-pd-1": 10, "test-pd-2": 10}
+			},
+			priorities: map[string]int32{"test-pd-1": 10, "test-pd-2": 10, "peer-pd-0": 3},
+			expectFn: func(tc *v1alpha1.TidbCluster, set map[string]int, transferred []string) {
+				// the priorities of the members in other clusters are not changed
+				g.Expect(set).To(Equal(map[string]int{"test-pd-1": 0, "test-pd-2": 0}))
+				g.Expect(transferred).To(BeEmpty())
+				g.Expect(tc.Status.PD.LeaderPriorities).To(BeEmpty())
+			},
+		},
+	}
+	for _, test := range tests {
+		testFn(test)
+	}
+}
@@ -108,7 +108,18 @@ func (m *pdMemberManager) Sync(tc *v1alpha1.TidbCluster) error {
 	}
 
 	// Sync PD StatefulSet
-	return m.syncPDStatefulSetForTidbCluster(tc)
+	if err := m.syncPDStatefulSetForTidbCluster(tc); err != nil {
+		return err
+	}
+
+	if tc.Spec.Paused {
+		return nil
+	}
+	// Sync the placement rules and the leader preference after PD is synced
+	if err := m.syncPlacementRules(tc); err != nil {
+		return err
+	}
+	return m.syncLeaderPreference(tc)
 }
 
 func (m *pdMemberManager) syncPDServiceForTidbCluster(tc *v1alpha1.TidbCluster) error {
@@ -818,8 +829,15 @@ func getNewPDSetForTidbCluster(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (
 	}
 	pdContainer.Env = util.AppendEnv(env, basePDSpec.Env())
 	pdContainer.EnvFrom = basePDSpec.EnvFrom()
+	containers := []corev1.Container{pdContainer}
+	logShipper, logShipperVols, err := buildLogShipperContainer(tc, v1alpha1.PDMemberType, cm, pdContainer.VolumeMounts)
+	if err != nil {
+		return nil, err
+	}
+	containers, initContainers = appendLogShipperContainer(logShipper, containers, initContainers)
+	vols = append(vols, logShipperVols...)
 	podSpec.Volumes = append(vols, basePDSpec.AdditionalVolumes()...)
-	podSpec.Containers, err = MergePatchContainers([]corev1.Container{pdContainer}, basePDSpec.AdditionalContainers())
+	podSpec.Containers, err = MergePatchContainers(containers, basePDSpec.AdditionalContainers())
 	if err != nil {
 		return nil, fmt.Errorf("failed to merge containers spec for PD of [%s/%s], error: %v", tc.Namespace, tc.Name, err)
 	}
@@ -929,6 +947,9 @@ func getPDConfigMap(tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
 			"startup-script": startScript,
 		},
 	}
+	if err := addLogShipperConfig(tc, v1alpha1.PDMemberType, cm.Data); err != nil {
+		return nil, fmt.Errorf("render log shipper config for tc %s/%s failed: %v", tc.Namespace, tc.Name, err)
+	}
 	return cm, nil
 }
 
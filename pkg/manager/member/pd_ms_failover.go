@@ -0,0 +1,345 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/util"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+// pdMSFailover has the Failover logic for PD microservice members.
+// Different from PD, a failure member is replaced in place: its pod (and optionally its PVCs) is
+// deleted and recreated by the statefulset, no extra replicas are added.
+type pdMSFailover struct {
+	deps *controller.Dependencies
+}
+
+// NewPDMSFailover returns a PD microservice Failover
+func NewPDMSFailover(deps *controller.Dependencies) PDMSFailover {
+	return &pdMSFailover{
+		deps: deps,
+	}
+}
+
+func (f *pdMSFailover) failureRecovery(curService string) *commonStatefulFailureRecovery {
+	return &commonStatefulFailureRecovery{
+		deps:                f.deps,
+		failureObjectAccess: &pdMSFailureMemberAccess{service: curService},
+	}
+}
+
+// Failover is used to replace the broken members of a PD microservice.
+// If tso-0 stays unhealthy longer than the failover period, pdMSFailover will do failover in 2 rounds:
+// 1. mark tso-0 as a failure member with non-deleted state (MemberDeleted=false)
+// 2. transfer the primary to a healthy member if tso-0 is the primary, then delete the pod of tso-0
+// (and its PVCs if FailoverDeletePVC is true) and mark it deleted (MemberDeleted=true),
+// the statefulset controller will recreate the pod
+//
+// If the count of the failure members with the deleted state (MemberDeleted=true) is equal or greater than MaxFailoverCount, we will skip failover.
+func (f *pdMSFailover) Failover(tc *v1alpha1.TidbCluster, spec *v1alpha1.PDMSSpec) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	curService := spec.Name
+
+	status := tc.Status.PDMS[curService]
+	if status == nil || !status.Synced {
+		return fmt.Errorf("TidbCluster: %s/%s .Status.PDMS[%s].Synced = false, can't failover", ns, tcName, curService)
+	}
+	if status.FailureMembers == nil {
+		status.FailureMembers = map[string]v1alpha1.PDMSFailureMember{}
+	}
+
+	deletedFailureReplicas := pdMSDeletedFailureReplicas(status)
+	if deletedFailureReplicas >= *spec.MaxFailoverCount {
+		klog.Errorf("PDMS %s failover replicas (%d) reaches the limit (%d), skip failover", curService, deletedFailureReplicas, *spec.MaxFailoverCount)
+		return nil
+	}
+
+	notDeletedFailureReplicas := len(status.FailureMembers) - int(deletedFailureReplicas)
+
+	// we can only failover one at a time
+	if notDeletedFailureReplicas == 0 {
+		return f.tryToMarkAPeerAsFailure(tc, spec)
+	}
+
+	failureRecovery := f.failureRecovery(curService)
+	if err := failureRecovery.RestartPodOnHostDown(tc); err != nil {
+		if controller.IsIgnoreError(err) {
+			return nil
+		}
+		return err
+	}
+
+	return f.tryToDeleteAFailureMember(tc, spec, failureRecovery)
+}
+
+func (f *pdMSFailover) Recover(tc *v1alpha1.TidbCluster, spec *v1alpha1.PDMSSpec) {
+	if status := tc.Status.PDMS[spec.Name]; status != nil {
+		status.FailureMembers = nil
+	}
+	klog.Infof("pdms failover: clearing %s failoverMembers, %s/%s", spec.Name, tc.GetNamespace(), tc.GetName())
+}
+
+func (f *pdMSFailover) tryToMarkAPeerAsFailure(tc *v1alpha1.TidbCluster, spec *v1alpha1.PDMSSpec) error {
+	ns := tc.GetNamespace()
+	curService := spec.Name
+	status := tc.Status.PDMS[curService]
+
+	for _, podName := range sortedPDMSMemberNames(status) {
+		member := status.MemberHealth[podName]
+		if member.LastTransitionTime.IsZero() {
+			continue
+		}
+		failoverDeadline := member.LastTransitionTime.Add(f.deps.CLIConfig.PDMSFailoverPeriod)
+		_, exist := status.FailureMembers[podName]
+
+		if member.Health || time.Now().Before(failoverDeadline) || exist {
+			continue
+		}
+
+		pvcUIDSet := make(map[types.UID]v1alpha1.EmptyStruct)
+		if spec.FailoverDeletePVC {
+			pvcs, err := f.failureRecovery(curService).getPodPvcs(tc, podName)
+			if err != nil {
+				return fmt.Errorf("tryToMarkAPeerAsFailure: failed to get pvcs for pod %s/%s, error: %s", ns, podName, err)
+			}
+			for _, pvc := range pvcs {
+				pvcUIDSet[pvc.UID] = v1alpha1.EmptyStruct{}
+			}
+		}
+
+		f.deps.Recorder.Eventf(tc, corev1.EventTypeWarning, "PDMSMemberUnhealthy", "%s/%s of %s is unhealthy", ns, podName, curService)
+
+		// mark a member failed and return an error to skip reconciliation
+		// note that status of tidb cluster will be updated always
+		status.FailureMembers[podName] = v1alpha1.PDMSFailureMember{
+			PodName:       podName,
+			PVCUIDSet:     pvcUIDSet,
+			MemberDeleted: false,
+			CreatedAt:     metav1.Now(),
+		}
+		return controller.RequeueErrorf("marking Pod: %s/%s pdms %s member as failure", ns, podName, curService)
+	}
+
+	return nil
+}
+
+// tryToDeleteAFailureMember tries to transfer the primary away from a failure member and delete its Pod & PVCs.
+// On success, new Pod & PVCs will be created by the statefulset.
+func (f *pdMSFailover) tryToDeleteAFailureMember(tc *v1alpha1.TidbCluster, spec *v1alpha1.PDMSSpec, failureRecovery *commonStatefulFailureRecovery) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	curService := spec.Name
+	status := tc.Status.PDMS[curService]
+
+	failurePodName := ""
+	for podName, failureMember := range status.FailureMembers {
+		if failureMember.MemberDeleted {
+			continue
+		}
+		// If the failure member becomes healthy again (for ex. after pod restart), then do not delete it
+		if status.MemberHealth[podName].Health {
+			klog.Infof("PDMS %s FailureMember %s of tc %s/%s is healthy again", curService, podName, ns, tcName)
+			continue
+		}
+		failurePodName = podName
+		break
+	}
+	if failurePodName == "" {
+		klog.Infof("No PDMS %s FailureMembers to delete for tc %s/%s", curService, ns, tcName)
+		return nil
+	}
+
+	if !failureRecovery.canDoCleanUpNow(tc, failurePodName) {
+		return nil
+	}
+
+	if err := f.transferPrimary(tc, curService, failurePodName); err != nil {
+		return err
+	}
+
+	if err := failureRecovery.deletePodAndPvcs(tc, failurePodName); err != nil {
+		return err
+	}
+	klog.Infof("pdms failover[tryToDeleteAFailureMember]: delete failure member %s/%s of %s successfully", ns, failurePodName, curService)
+	f.deps.Recorder.Eventf(tc, corev1.EventTypeWarning, "PDMSMemberDeleted", "failure member %s/%s of %s deleted", ns, failurePodName, curService)
+
+	failureMember := status.FailureMembers[failurePodName]
+	failureMember.MemberDeleted = true
+	status.FailureMembers[failurePodName] = failureMember
+	return nil
+}
+
+// transferPrimary transfers the primary of the service to a healthy member if the failure member is the primary
+func (f *pdMSFailover) transferPrimary(tc *v1alpha1.TidbCluster, curService, failurePodName string) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	status := tc.Status.PDMS[curService]
+	if !pdMSAddrMatchesPod(status.Primary, failurePodName) {
+		return nil
+	}
+	// Only support after `8.3.0` to keep compatibility.
+	if check, err := pdMSSupportMicroservicesWithName.Check(tc.PDMSVersion(curService)); !check || err != nil {
+		klog.Warningf("TidbCluster: [%s/%s]' pdms failover: skip to transfer %s primary, because the version does not support it", ns, tcName, curService)
+		return nil
+	}
+
+	targetName := ""
+	for _, podName := range sortedPDMSMemberNames(status) {
+		if _, failure := status.FailureMembers[podName]; failure || !status.MemberHealth[podName].Health {
+			continue
+		}
+		ordinal, err := util.GetOrdinalFromPodName(podName)
+		if err != nil {
+			return err
+		}
+		targetName = PDMSName(tcName, ordinal, ns, tc.Spec.ClusterDomain, tc.Spec.AcrossK8s, curService)
+		break
+	}
+	if targetName == "" {
+		klog.Warningf("TidbCluster: [%s/%s]' pdms failover: skip to transfer %s primary, because can not find a healthy member", ns, tcName, curService)
+		return nil
+	}
+
+	if err := controller.GetPDMSClient(f.deps.PDControl, tc, curService).TransferPrimary(targetName); err != nil {
+		klog.Errorf("TidbCluster: [%s/%s]' pdms failover: failed to transfer %s primary to: %s, %v", ns, tcName, curService, targetName, err)
+		return err
+	}
+	klog.Infof("TidbCluster: [%s/%s]' pdms failover: transfer %s primary to: %s successfully", ns, tcName, curService, targetName)
+	return nil
+}
+
+// pdMSAllMembersHealthy returns whether all the desired members of the service are healthy
+func pdMSAllMembersHealthy(tc *v1alpha1.TidbCluster, curService string) bool {
+	status := tc.Status.PDMS[curService]
+	if status == nil || int32(len(status.MemberHealth)) != tc.PDMSStsDesiredReplicas(curService) {
+		return false
+	}
+	for _, member := range status.MemberHealth {
+		if !member.Health {
+			return false
+		}
+	}
+	return true
+}
+
+func pdMSDeletedFailureReplicas(status *v1alpha1.PDMSStatus) int32 {
+	var deleted int32
+	for _, failureMember := range status.FailureMembers {
+		if failureMember.MemberDeleted {
+			deleted++
+		}
+	}
+	return deleted
+}
+
+func sortedPDMSMemberNames(status *v1alpha1.PDMSStatus) []string {
+	names := make([]string, 0, len(status.MemberHealth))
+	for name := range status.MemberHealth {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// pdMSFailureMemberAccess implements the FailureObjectAccess interface for PD microservice member
+type pdMSFailureMemberAccess struct {
+	service string
+}
+
+var _ FailureObjectAccess = (*pdMSFailureMemberAccess)(nil)
+
+func (fma *pdMSFailureMemberAccess) GetMemberType() v1alpha1.MemberType {
+	return v1alpha1.PDMSMemberType(fma.service)
+}
+
+// GetFailureObjects returns the set of failure pod names
+func (fma *pdMSFailureMemberAccess) GetFailureObjects(tc *v1alpha1.TidbCluster) map[string]v1alpha1.EmptyStruct {
+	failureMembers := make(map[string]v1alpha1.EmptyStruct)
+	for podName := range tc.Status.PDMS[fma.service].FailureMembers {
+		failureMembers[podName] = v1alpha1.EmptyStruct{}
+	}
+	return failureMembers
+}
+
+// IsFailing returns if the particular member is in unhealthy state
+func (fma *pdMSFailureMemberAccess) IsFailing(tc *v1alpha1.TidbCluster, podName string) bool {
+	return !tc.Status.PDMS[fma.service].MemberHealth[podName].Health
+}
+
+// GetPodName returns the pod name of the given failure member
+func (fma *pdMSFailureMemberAccess) GetPodName(_ *v1alpha1.TidbCluster, podName string) string {
+	return podName
+}
+
+// IsHostDownForFailedPod checks if HostDown is set for any failure member
+func (fma *pdMSFailureMemberAccess) IsHostDownForFailedPod(tc *v1alpha1.TidbCluster) bool {
+	for _, failureMember := range tc.Status.PDMS[fma.service].FailureMembers {
+		if failureMember.HostDown {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHostDown returns true if HostDown is set for the given failure member
+func (fma *pdMSFailureMemberAccess) IsHostDown(tc *v1alpha1.TidbCluster, podName string) bool {
+	return tc.Status.PDMS[fma.service].FailureMembers[podName].HostDown
+}
+
+// SetHostDown sets the HostDown property in the given failure member
+func (fma *pdMSFailureMemberAccess) SetHostDown(tc *v1alpha1.TidbCluster, podName string, hostDown bool) {
+	failureMember := tc.Status.PDMS[fma.service].FailureMembers[podName]
+	failureMember.HostDown = hostDown
+	tc.Status.PDMS[fma.service].FailureMembers[podName] = failureMember
+}
+
+// GetCreatedAt returns the CreatedAt timestamp of the given failure member
+func (fma *pdMSFailureMemberAccess) GetCreatedAt(tc *v1alpha1.TidbCluster, podName string) metav1.Time {
+	return tc.Status.PDMS[fma.service].FailureMembers[podName].CreatedAt
+}
+
+// GetLastTransitionTime returns the LastTransitionTime timestamp of the given failure member
+func (fma *pdMSFailureMemberAccess) GetLastTransitionTime(tc *v1alpha1.TidbCluster, podName string) metav1.Time {
+	return tc.Status.PDMS[fma.service].MemberHealth[podName].LastTransitionTime
+}
+
+// GetPVCUIDSet returns the PVC UID set of the given failure member
+func (fma *pdMSFailureMemberAccess) GetPVCUIDSet(tc *v1alpha1.TidbCluster, podName string) map[types.UID]v1alpha1.EmptyStruct {
+	return tc.Status.PDMS[fma.service].FailureMembers[podName].PVCUIDSet
+}
+
+type fakePDMSFailover struct{}
+
+// NewFakePDMSFailover returns a fake PDMSFailover
+func NewFakePDMSFailover() PDMSFailover {
+	return &fakePDMSFailover{}
+}
+
+func (f *fakePDMSFailover) Failover(_ *v1alpha1.TidbCluster, _ *v1alpha1.PDMSSpec) error {
+	return nil
+}
+
+func (f *fakePDMSFailover) Recover(_ *v1alpha1.TidbCluster, _ *v1alpha1.PDMSSpec) {
+}
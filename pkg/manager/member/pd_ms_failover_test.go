@@ -0,0 +1,279 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+)
+
+func newTidbClusterForPDMSFailover() *v1alpha1.TidbCluster {
+	tc := newTidbClusterForPDMS()
+	tc.Spec.PDMS[0].Image = "pingcap/pd:v8.3.0"
+	tc.Spec.PDMS[0].MaxFailoverCount = pointer.Int32Ptr(3)
+	tc.Status.PDMS = map[string]*v1alpha1.PDMSStatus{
+		tsoService: {
+			Name:   tsoService,
+			Synced: true,
+			MemberHealth: map[string]v1alpha1.PDMSMemberHealth{
+				"test-tso-0": {Name: "test-tso-0", Health: true, LastTransitionTime: metav1.Now()},
+				"test-tso-1": {Name: "test-tso-1", Health: false, LastTransitionTime: metav1.Time{Time: time.Now().Add(-10 * time.Minute)}},
+				"test-tso-2": {Name: "test-tso-2", Health: true, LastTransitionTime: metav1.Now()},
+			},
+		},
+	}
+	return tc
+}
+
+func TestPDMSFailoverFailover(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type testcase struct {
+		name     string
+		update   func(*v1alpha1.TidbCluster)
+		errFn    func(error)
+		expectFn func(tc *v1alpha1.TidbCluster, transferred []string, podDeleted, pvcDeleted bool)
+	}
+
+	testFn := func(test testcase) {
+		t.Log(test.name)
+		tc := newTidbClusterForPDMSFailover()
+		if test.update != nil {
+			test.update(tc)
+		}
+
+		fakeDeps, pvcIndexer, podIndexer, _ := newFakeDependenciesForFailover(false)
+		fakeDeps.CLIConfig.PDMSFailoverPeriod = 5 * time.Minute
+		failover := NewPDMSFailover(fakeDeps)
+
+		podName := "test-tso-1"
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              podName,
+				Namespace:         tc.Namespace,
+				Labels:            label.New().Instance(tc.Name).PDMS(tsoService).Labels(),
+				CreationTimestamp: metav1.Time{Time: time.Now().Add(-time.Hour)},
+			},
+		}
+		podIndexer.Add(pod)
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tso-" + podName,
+				Namespace: tc.Namespace,
+				UID:       types.UID("pvc-tso-1"),
+				Labels:    label.New().Instance(tc.Name).PDMS(tsoService).Labels(),
+			},
+		}
+		pvc.Labels[label.AnnPodNameKey] = podName
+		pvcIndexer.Add(pvc)
+
+		pdmsClient := pdapi.NewFakePDMSClient("")
+		pdmsClient.AddReaction(pdapi.GetHealthActionType, func(action *pdapi.Action) (interface{}, error) {
+			return nil, nil
+		})
+		var transferred []string
+		pdmsClient.AddReaction(pdapi.PDMSTransferPrimaryActionType, func(action *pdapi.Action) (interface{}, error) {
+			transferred = append(transferred, action.Name)
+			return nil, nil
+		})
+		fakeDeps.PDControl.(*pdapi.FakePDControl).SetPDMSClient(pdapi.Namespace(tc.Namespace), tc.Name, tsoService, pdmsClient)
+
+		err := failover.Failover(tc, tc.Spec.PDMS[0])
+		if test.errFn != nil {
+			test.errFn(err)
+		} else {
+			g.Expect(err).To(Succeed())
+		}
+
+		_, podErr := fakeDeps.PodLister.Pods(tc.Namespace).Get(podName)
+		_, pvcErr := fakeDeps.PVCLister.PersistentVolumeClaims(tc.Namespace).Get(pvc.Name)
+		test.expectFn(tc, transferred, podErr != nil, pvcErr != nil)
+	}
+
+	tests := []testcase{
+		{
+			name: "member is unhealthy within the failover period",
+			update: func(tc *v1alpha1.TidbCluster) {
+				member := tc.Status.PDMS[tsoService].MemberHealth["test-tso-1"]
+				member.LastTransitionTime = metav1.Now()
+				tc.Status.PDMS[tsoService].MemberHealth["test-tso-1"] = member
+			},
+			expectFn: func(tc *v1alpha1.TidbCluster, transferred []string, podDeleted, pvcDeleted bool) {
+				g.Expect(tc.Status.PDMS[tsoService].FailureMembers).To(BeEmpty())
+				g.Expect(podDeleted).To(BeFalse())
+			},
+		},
+		{
+			name: "mark the member as failure",
+			errFn: func(err error) {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring("marking Pod"))
+			},
+			expectFn: func(tc *v1alpha1.TidbCluster, transferred []string, podDeleted, pvcDeleted bool) {
+				failureMembers := tc.Status.PDMS[tsoService].FailureMembers
+				g.Expect(failureMembers).To(HaveLen(1))
+				g.Expect(failureMembers["test-tso-1"].MemberDeleted).To(BeFalse())
+				g.Expect(failureMembers["test-tso-1"].PVCUIDSet).To(BeEmpty())
+				g.Expect(podDeleted).To(BeFalse())
+			},
+		},
+		{
+			name: "mark the member as failure with pvcs",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.PDMS[0].FailoverDeletePVC = true
+			},
+			errFn: func(err error) {
+				g.Expect(err).To(HaveOccurred())
+			},
+			expectFn: func(tc *v1alpha1.TidbCluster, transferred []string, podDeleted, pvcDeleted bool) {
+				failureMembers := tc.Status.PDMS[tsoService].FailureMembers
+				g.Expect(failureMembers["test-tso-1"].PVCUIDSet).To(HaveKey(types.UID("pvc-tso-1")))
+			},
+		},
+		{
+			name: "replace the failure member which is the primary",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.PDMS[tsoService].Primary = "http://test-tso-1.test-tso-peer.default.svc:2379"
+				tc.Status.PDMS[tsoService].FailureMembers = map[string]v1alpha1.PDMSFailureMember{
+					"test-tso-1": {PodName: "test-tso-1", CreatedAt: metav1.Now()},
+				}
+			},
+			expectFn: func(tc *v1alpha1.TidbCluster, transferred []string, podDeleted, pvcDeleted bool) {
+				g.Expect(transferred).To(Equal([]string{"test-tso-0"}))
+				g.Expect(tc.Status.PDMS[tsoService].FailureMembers["test-tso-1"].MemberDeleted).To(BeTrue())
+				g.Expect(podDeleted).To(BeTrue())
+				g.Expect(pvcDeleted).To(BeFalse())
+			},
+		},
+		{
+			name: "replace the failure member with pvcs",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.PDMS[tsoService].Primary = "http://test-tso-10.test-tso-peer.default.svc:2379"
+				tc.Status.PDMS[tsoService].FailureMembers = map[string]v1alpha1.PDMSFailureMember{
+					"test-tso-1": {
+						PodName:   "test-tso-1",
+						PVCUIDSet: map[types.UID]v1alpha1.EmptyStruct{"pvc-tso-1": {}},
+						CreatedAt: metav1.Now(),
+					},
+				}
+			},
+			expectFn: func(tc *v1alpha1.TidbCluster, transferred []string, podDeleted, pvcDeleted bool) {
+				g.Expect(transferred).To(BeEmpty())
+				g.Expect(tc.Status.PDMS[tsoService].FailureMembers["test-tso-1"].MemberDeleted).To(BeTrue())
+				g.Expect(podDeleted).To(BeTrue())
+				g.Expect(pvcDeleted).To(BeTrue())
+			},
+		},
+		{
+			name: "failure member is healthy again",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.PDMS[tsoService].MemberHealth["test-tso-1"] = v1alpha1.PDMSMemberHealth{Name: "test-tso-1", Health: true}
+				tc.Status.PDMS[tsoService].FailureMembers = map[string]v1alpha1.PDMSFailureMember{
+					"test-tso-1": {PodName: "test-tso-1", CreatedAt: metav1.Now()},
+				}
+			},
+			expectFn: func(tc *v1alpha1.TidbCluster, transferred []string, podDeleted, pvcDeleted bool) {
+				g.Expect(tc.Status.PDMS[tsoService].FailureMembers["test-tso-1"].MemberDeleted).To(BeFalse())
+				g.Expect(podDeleted).To(BeFalse())
+			},
+		},
+		{
+			name: "failover count reaches the limit",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.PDMS[0].MaxFailoverCount = pointer.Int32Ptr(1)
+				tc.Status.PDMS[tsoService].FailureMembers = map[string]v1alpha1.PDMSFailureMember{
+					"test-tso-2": {PodName: "test-tso-2", MemberDeleted: true, CreatedAt: metav1.Now()},
+				}
+			},
+			expectFn: func(tc *v1alpha1.TidbCluster, transferred []string, podDeleted, pvcDeleted bool) {
+				g.Expect(tc.Status.PDMS[tsoService].FailureMembers).To(HaveLen(1))
+				g.Expect(podDeleted).To(BeFalse())
+			},
+		},
+		{
+			name: "status is not synced",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.PDMS[tsoService].Synced = false
+			},
+			errFn: func(err error) {
+				g.Expect(err).To(HaveOccurred())
+			},
+			expectFn: func(tc *v1alpha1.TidbCluster, transferred []string, podDeleted, pvcDeleted bool) {
+				g.Expect(tc.Status.PDMS[tsoService].FailureMembers).To(BeEmpty())
+			},
+		},
+	}
+	for _, test := range tests {
+		testFn(test)
+	}
+}
+
+func TestPDMSFailoverRecover(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForPDMSFailover()
+	g.Expect(pdMSAllMembersHealthy(tc, tsoService)).To(BeFalse())
+
+	tc.Status.PDMS[tsoService].MemberHealth["test-tso-1"] = v1alpha1.PDMSMemberHealth{Name: "test-tso-1", Health: true}
+	tc.Status.PDMS[tsoService].FailureMembers = map[string]v1alpha1.PDMSFailureMember{
+		"test-tso-1": {PodName: "test-tso-1", MemberDeleted: true},
+	}
+	g.Expect(pdMSAllMembersHealthy(tc, tsoService)).To(BeTrue())
+
+	NewPDMSFailover(nil).Recover(tc, tc.Spec.PDMS[0])
+	g.Expect(tc.Status.PDMS[tsoService].FailureMembers).To(BeNil())
+}
+
+func TestPDMSMemberManagerSyncMemberHealth(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForPDMSFailover()
+	pmm, podIndexer, _ := newFakePDMSMemberManager()
+	sts := &apps.StatefulSet{Spec: apps.StatefulSetSpec{Replicas: pointer.Int32Ptr(3)}}
+	for _, podName := range []string{"test-tso-0", "test-tso-1"} {
+		podIndexer.Add(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: tc.Namespace},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		})
+	}
+	status := tc.Status.PDMS[tsoService]
+	status.Members = []string{
+		"http://test-tso-0.test-tso-peer.default.svc:2379",
+		"http://test-tso-1.test-tso-peer.default.svc:2379",
+	}
+	lastTransitionTime := status.MemberHealth["test-tso-0"].LastTransitionTime
+
+	g.Expect(pmm.syncMemberHealth(tc, sts, tsoService)).To(Succeed())
+	g.Expect(status.MemberHealth).To(HaveLen(3))
+	// the health is not changed
+	g.Expect(status.MemberHealth["test-tso-0"].Health).To(BeTrue())
+	g.Expect(status.MemberHealth["test-tso-0"].LastTransitionTime).To(Equal(lastTransitionTime))
+	// the health is changed
+	g.Expect(status.MemberHealth["test-tso-1"].Health).To(BeTrue())
+	g.Expect(status.MemberHealth["test-tso-1"].LastTransitionTime.After(time.Now().Add(-time.Minute))).To(BeTrue())
+	// the pod does not exist
+	g.Expect(status.MemberHealth["test-tso-2"].Health).To(BeFalse())
+}
@@ -18,6 +18,7 @@ import (
 	"path"
 	"strings"
 
+	"github.com/pingcap/advanced-statefulset/client/apis/apps/v1/helper"
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
@@ -27,6 +28,7 @@ import (
 	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
 	"github.com/pingcap/tidb-operator/pkg/manager/volumes"
 	"github.com/pingcap/tidb-operator/pkg/metrics"
+	"github.com/pingcap/tidb-operator/pkg/third_party/k8s"
 	"github.com/pingcap/tidb-operator/pkg/util"
 	apps "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -41,16 +43,18 @@ type pdMSMemberManager struct {
 	deps              *controller.Dependencies
 	scaler            Scaler
 	upgrader          Upgrader
+	failover          PDMSFailover
 	suspender         suspender.Suspender
 	podVolumeModifier volumes.PodVolumeModifier
 }
 
 // NewPDMSMemberManager returns a *pdMSMemberManager
-func NewPDMSMemberManager(dependencies *controller.Dependencies, pdMSScaler Scaler, pdMSUpgrader Upgrader, spder suspender.Suspender, pvm volumes.PodVolumeModifier) manager.Manager {
+func NewPDMSMemberManager(dependencies *controller.Dependencies, pdMSScaler Scaler, pdMSUpgrader Upgrader, pdMSFailover PDMSFailover, spder suspender.Suspender, pvm volumes.PodVolumeModifier) manager.Manager {
 	return &pdMSMemberManager{
 		deps:              dependencies,
 		scaler:            pdMSScaler,
 		upgrader:          pdMSUpgrader,
+		failover:          pdMSFailover,
 		suspender:         spder,
 		podVolumeModifier: pvm,
 	}
@@ -319,7 +323,15 @@ func (m *pdMSMemberManager) syncPDMSStatefulSet(tc *v1alpha1.TidbCluster, curSpe
 		return err
 	}
 
-	//TODO: Add FailOver logic
+	if m.deps.CLIConfig.AutoFailover && curSpec.MaxFailoverCount != nil && *curSpec.MaxFailoverCount > 0 {
+		if pdMSAllMembersHealthy(tc, curService) {
+			if len(tc.Status.PDMS[curService].FailureMembers) > 0 {
+				m.failover.Recover(tc, curSpec)
+			}
+		} else if err := m.failover.Failover(tc, curSpec); err != nil {
+			return err
+		}
+	}
 
 	if !templateEqual(newPDMSSet, oldPDMSSet) || tc.Status.PDMS[curService].Phase == v1alpha1.UpgradePhase {
 		if err := m.upgrader.Upgrade(tc, oldPDMSSet, newPDMSSet); err != nil {
@@ -360,6 +372,10 @@ func (m *pdMSMemberManager) syncStatus(tc *v1alpha1.TidbCluster, sts *apps.State
 		return err
 	}
 	tc.Status.PDMS[curService].Members = members
+	tc.Status.PDMS[curService].Primary = m.getPDMSPrimary(tc, curService)
+	if err := m.syncMemberHealth(tc, sts, curService); err != nil {
+		return err
+	}
 	tc.Status.PDMS[curService].Synced = true
 
 	// sync volumes
@@ -371,6 +387,68 @@ func (m *pdMSMemberManager) syncStatus(tc *v1alpha1.TidbCluster, sts *apps.State
 	return nil
 }
 
+// getPDMSPrimary returns the primary of the service, an empty string is returned if the primary is unknown
+func (m *pdMSMemberManager) getPDMSPrimary(tc *v1alpha1.TidbCluster, curService string) string {
+	if check, err := pdMSSupportMicroservicesWithName.Check(tc.PDMSVersion(curService)); !check || err != nil {
+		return ""
+	}
+	primary, err := controller.GetPDClient(m.deps.PDControl, tc).GetMSPrimary(curService)
+	if err != nil {
+		klog.V(4).Infof("failed to get primary of pdms %s for cluster %s/%s, error: %v", curService, tc.GetNamespace(), tc.GetName(), err)
+		return ""
+	}
+	return primary
+}
+
+// syncMemberHealth records the health of the pods of the service, a pod is healthy if it is ready and registered
+// in the service. The last transition time of the health is used to determine when to failover.
+func (m *pdMSMemberManager) syncMemberHealth(tc *v1alpha1.TidbCluster, sts *apps.StatefulSet, curService string) error {
+	ns := tc.GetNamespace()
+	status := tc.Status.PDMS[curService]
+	memberHealth := map[string]v1alpha1.PDMSMemberHealth{}
+	for ordinal := range helper.GetPodOrdinals(*sts.Spec.Replicas, sts) {
+		podName := PDMSPodName(tc.GetName(), ordinal, curService)
+		pod, err := m.deps.PodLister.Pods(ns).Get(podName)
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("syncMemberHealth: failed to get pod %s/%s, error: %s", ns, podName, err)
+		}
+		health := pod != nil && k8s.IsPodReady(pod) && pdMSMemberRegistered(status.Members, podName)
+		member := v1alpha1.PDMSMemberHealth{
+			Name:               podName,
+			Health:             health,
+			LastTransitionTime: metav1.Now(),
+		}
+		if old, exist := status.MemberHealth[podName]; exist && old.Health == health {
+			member.LastTransitionTime = old.LastTransitionTime
+		}
+		memberHealth[podName] = member
+	}
+	status.MemberHealth = memberHealth
+	return nil
+}
+
+func pdMSMemberRegistered(members []string, podName string) bool {
+	for _, member := range members {
+		if pdMSAddrMatchesPod(member, podName) {
+			return true
+		}
+	}
+	return false
+}
+
+// pdMSAddrMatchesPod returns whether the address of a PD microservice member, e.g.
+// http://basic-tso-0.basic-tso-peer.default.svc:2379, belongs to the given pod
+func pdMSAddrMatchesPod(addr, podName string) bool {
+	if i := strings.Index(addr, "://"); i >= 0 {
+		addr = addr[i+len("://"):]
+	}
+	if !strings.HasPrefix(addr, podName) {
+		return false
+	}
+	rest := addr[len(podName):]
+	return rest == "" || rest[0] == '.' || rest[0] == ':'
+}
+
 // syncPDMSConfigMap syncs the configmap of PDMS
 func (m *pdMSMemberManager) syncPDMSConfigMap(tc *v1alpha1.TidbCluster, set *apps.StatefulSet, curSpec *v1alpha1.PDMSSpec) (*corev1.ConfigMap, error) {
 	newCm, err := m.getPDMSConfigMap(tc, curSpec)
@@ -499,6 +499,7 @@ func newFakePDMSMemberManager() (*pdMSMemberManager, cache.Indexer, cache.Indexe
 		deps:              fakeDeps,
 		scaler:            NewFakePDMSScaler(),
 		upgrader:          NewFakePDMSUpgrader(),
+		failover:          NewFakePDMSFailover(),
 		suspender:         suspender.NewFakeSuspender(),
 		podVolumeModifier: &volumes.FakePodVolumeModifier{},
 	}
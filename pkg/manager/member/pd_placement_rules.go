@@ -0,0 +1,255 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	errorutils "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+const (
+	placementRuleInvalidReason = "PlacementRuleInvalid"
+	placementRuleDriftedReason = "PlacementRuleDrifted"
+)
+
+// syncPlacementRules reconciles the placement rule bundles in the spec of PD:
+//   - the bundles are validated against the labels of the stores in PD, an invalid bundle is not applied
+//   - the bundles which are different from the ones in PD are applied, and the changes made outside
+//     the operator are reported as drift
+//   - the bundles which are managed by the operator but removed from the spec are deleted from PD
+func (m *pdMemberManager) syncPlacementRules(tc *v1alpha1.TidbCluster) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	if len(tc.Spec.PD.PlacementRules) == 0 && len(tc.Status.PD.PlacementRules) == 0 {
+		return nil
+	}
+	if !tc.PDIsAvailable() {
+		klog.V(4).Infof("pd of tidbcluster %s/%s is not available, skip syncing placement rules", ns, tcName)
+		return nil
+	}
+
+	pdClient := controller.GetPDClient(m.deps.PDControl, tc)
+	storesInfo, err := pdClient.GetStores()
+	if err != nil {
+		if pdapi.IsTiKVNotBootstrappedError(err) {
+			klog.V(4).Infof("tikv of tidbcluster %s/%s is not bootstrapped, skip syncing placement rules", ns, tcName)
+			return nil
+		}
+		return fmt.Errorf("failed to get stores of tidbcluster %s/%s: %v", ns, tcName, err)
+	}
+	storeLabels := map[string]sets.String{}
+	for _, store := range storesInfo.Stores {
+		if store.Store == nil {
+			continue
+		}
+		for _, l := range store.Store.Labels {
+			if _, ok := storeLabels[l.Key]; !ok {
+				storeLabels[l.Key] = sets.NewString()
+			}
+			storeLabels[l.Key].Insert(l.Value)
+		}
+	}
+
+	if tc.Status.PD.PlacementRules == nil {
+		tc.Status.PD.PlacementRules = map[string]v1alpha1.PlacementRuleBundleStatus{}
+	}
+	var errs []error
+	desired := sets.NewString()
+	for i := range tc.Spec.PD.PlacementRules {
+		spec := &tc.Spec.PD.PlacementRules[i]
+		desired.Insert(spec.GroupID)
+		status := tc.Status.PD.PlacementRules[spec.GroupID]
+		if err := m.syncPlacementRuleBundle(tc, pdClient, spec, storeLabels, &status); err != nil {
+			errs = append(errs, err)
+		}
+		tc.Status.PD.PlacementRules[spec.GroupID] = status
+	}
+
+	for groupID := range tc.Status.PD.PlacementRules {
+		if desired.Has(groupID) {
+			continue
+		}
+		if err := pdClient.DeletePlacementRuleBundle(groupID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete placement rule bundle %s of tidbcluster %s/%s: %v", groupID, ns, tcName, err))
+			continue
+		}
+		klog.Infof("placement rule bundle %s of tidbcluster %s/%s is deleted", groupID, ns, tcName)
+		delete(tc.Status.PD.PlacementRules, groupID)
+	}
+	return errorutils.NewAggregate(errs)
+}
+
+func (m *pdMemberManager) syncPlacementRuleBundle(tc *v1alpha1.TidbCluster, pdClient pdapi.PDClient,
+	spec *v1alpha1.PlacementRuleBundle, storeLabels map[string]sets.String, status *v1alpha1.PlacementRuleBundleStatus) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+
+	bundle, err := newPlacementRuleBundle(spec, tc.Spec.PD.PlacementPolicies)
+	if err == nil {
+		err = checkPlacementRuleLabels(bundle, storeLabels)
+	}
+	if err != nil {
+		if status.Message != err.Error() {
+			m.deps.Recorder.Event(tc, corev1.EventTypeWarning, placementRuleInvalidReason,
+				fmt.Sprintf("placement rule bundle %s is not applied: %v", spec.GroupID, err))
+		}
+		status.Message = err.Error()
+		return nil
+	}
+
+	current, err := pdClient.GetPlacementRuleBundle(bundle.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get placement rule bundle %s of tidbcluster %s/%s: %v", bundle.ID, ns, tcName, err)
+	}
+	version, err := placementRuleBundleVersion(bundle)
+	if err != nil {
+		return err
+	}
+	currentVersion := ""
+	if current != nil {
+		if currentVersion, err = placementRuleBundleVersion(current); err != nil {
+			return err
+		}
+	}
+	if currentVersion == version {
+		status.Version = version
+		status.Message = ""
+		return nil
+	}
+
+	now := metav1.Now()
+	if status.Version != "" && currentVersion != status.Version {
+		klog.Infof("placement rule bundle %s of tidbcluster %s/%s is changed outside, version: %s -> %s", bundle.ID, ns, tcName, status.Version, currentVersion)
+		m.deps.Recorder.Event(tc, corev1.EventTypeWarning, placementRuleDriftedReason,
+			fmt.Sprintf("placement rule bundle %s is changed outside the operator and will be overwritten", bundle.ID))
+		status.LastDriftTime = &now
+	}
+
+	if err := pdClient.SetPlacementRuleBundle(bundle); err != nil {
+		status.Message = err.Error()
+		return fmt.Errorf("failed to set placement rule bundle %s of tidbcluster %s/%s: %v", bundle.ID, ns, tcName, err)
+	}
+	klog.Infof("placement rule bundle %s of tidbcluster %s/%s is applied, version: %s", bundle.ID, ns, tcName, version)
+	status.Version = version
+	status.LastAppliedTime = &now
+	status.Message = ""
+	return nil
+}
+
+// newPlacementRuleBundle converts the bundle in the spec to the one of PD
+func newPlacementRuleBundle(spec *v1alpha1.PlacementRuleBundle, policies []v1alpha1.PlacementPolicy) (*pdapi.GroupBundle, error) {
+	rules := spec.Rules
+	if spec.Policy != "" {
+		found := false
+		for i := range policies {
+			if policies[i].Name == spec.Policy {
+				rules = policies[i].Rules
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("placement policy %s is not found", spec.Policy)
+		}
+	}
+
+	bundle := &pdapi.GroupBundle{
+		ID:       spec.GroupID,
+		Index:    spec.Index,
+		Override: spec.Override,
+	}
+	for _, r := range rules {
+		rule := &pdapi.PlacementRule{
+			GroupID:        spec.GroupID,
+			ID:             r.ID,
+			Index:          r.Index,
+			Override:       r.Override,
+			StartKeyHex:    r.StartKey,
+			EndKeyHex:      r.EndKey,
+			Role:           string(r.Role),
+			Count:          r.Count,
+			LocationLabels: r.LocationLabels,
+			IsolationLevel: r.IsolationLevel,
+		}
+		for _, c := range r.LabelConstraints {
+			rule.LabelConstraints = append(rule.LabelConstraints, pdapi.LabelConstraint{
+				Key:    c.Key,
+				Op:     string(c.Op),
+				Values: c.Values,
+			})
+		}
+		bundle.Rules = append(bundle.Rules, rule)
+	}
+	return bundle, nil
+}
+
+// checkPlacementRuleLabels checks whether the labels used by the rules are present on the stores,
+// so that the rules which can never be satisfied are not applied.
+func checkPlacementRuleLabels(bundle *pdapi.GroupBundle, storeLabels map[string]sets.String) error {
+	for _, rule := range bundle.Rules {
+		for _, c := range rule.LabelConstraints {
+			if c.Op != string(v1alpha1.PlacementLabelConstraintOpIn) {
+				continue
+			}
+			values, ok := storeLabels[c.Key]
+			if !ok {
+				return fmt.Errorf("rule %s: label %s is not found on any store", rule.ID, c.Key)
+			}
+			if !values.HasAny(c.Values...) {
+				return fmt.Errorf("rule %s: none of the values [%s] of label %s is found on the stores", rule.ID, strings.Join(c.Values, ","), c.Key)
+			}
+		}
+		for _, l := range rule.LocationLabels {
+			if _, ok := storeLabels[l]; !ok {
+				return fmt.Errorf("rule %s: location label %s is not found on any store", rule.ID, l)
+			}
+		}
+	}
+	return nil
+}
+
+// placementRuleBundleVersion returns the hash of the bundle which is independent of the order of the rules
+func placementRuleBundleVersion(bundle *pdapi.GroupBundle) (string, error) {
+	b := *bundle
+	b.Rules = make([]*pdapi.PlacementRule, 0, len(bundle.Rules))
+	for _, r := range bundle.Rules {
+		rule := *r
+		if len(rule.LabelConstraints) == 0 {
+			rule.LabelConstraints = nil
+		}
+		if len(rule.LocationLabels) == 0 {
+			rule.LocationLabels = nil
+		}
+		b.Rules = append(b.Rules, &rule)
+	}
+	sort.Slice(b.Rules, func(i, j int) bool {
+		return b.Rules[i].ID < b.Rules[j].ID
+	})
+	data, err := json.Marshal(b)
+	if err != nil {
+		return "", err
+	}
+	return v1alpha1.HashContents(data), nil
+}
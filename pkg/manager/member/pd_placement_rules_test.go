@@ -0,0 +1,211 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	apps "k8s.io/api/apps/v1"
+)
+
+func newTidbClusterForPlacementRules() *v1alpha1.TidbCluster {
+	tc := newTidbClusterForPD()
+	tc.Status.PD.Members = map[string]v1alpha1.PDMember{
+		"pd-0": {Name: "pd-0", Health: true},
+		"pd-1": {Name: "pd-1", Health: true},
+		"pd-2": {Name: "pd-2", Health: true},
+	}
+	tc.Status.PD.StatefulSet = &apps.StatefulSetStatus{ReadyReplicas: 3}
+	tc.Spec.PD.PlacementPolicies = []v1alpha1.PlacementPolicy{
+		{
+			Name: "nvme",
+			Rules: []v1alpha1.PlacementRule{
+				{
+					ID:    "nvme",
+					Role:  v1alpha1.PlacementRuleRoleVoter,
+					Count: 3,
+					LabelConstraints: []v1alpha1.PlacementLabelConstraint{
+						{Key: "disk", Op: v1alpha1.PlacementLabelConstraintOpIn, Values: []string{"nvme"}},
+					},
+					LocationLabels: []string{"zone"},
+				},
+			},
+		},
+	}
+	tc.Spec.PD.PlacementRules = []v1alpha1.PlacementRuleBundle{
+		{GroupID: "hot", Index: 10, Policy: "nvme"},
+	}
+	return tc
+}
+
+func TestPDMemberManagerSyncPlacementRules(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type testcase struct {
+		name        string
+		update      func(tc *v1alpha1.TidbCluster)
+		current     func(desired *pdapi.GroupBundle) *pdapi.GroupBundle
+		storeLabels map[string]string
+		expectFn    func(tc *v1alpha1.TidbCluster, set, deleted []string)
+	}
+
+	testFn := func(test testcase) {
+		t.Log(test.name)
+		tc := newTidbClusterForPlacementRules()
+		desired, err := newPlacementRuleBundle(&tc.Spec.PD.PlacementRules[0], tc.Spec.PD.PlacementPolicies)
+		g.Expect(err).To(Succeed())
+		if test.update != nil {
+			test.update(tc)
+		}
+
+		pmm, _, _ := newFakePDMemberManager()
+		pdClient := controller.NewFakePDClient(pmm.deps.PDControl.(*pdapi.FakePDControl), tc)
+		pdClient.AddReaction(pdapi.GetStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+			store := &pdapi.StoreInfo{Store: &pdapi.MetaStore{Store: &metapb.Store{Id: 1}}}
+			for k, v := range test.storeLabels {
+				store.Store.Labels = append(store.Store.Labels, &metapb.StoreLabel{Key: k, Value: v})
+			}
+			return &pdapi.StoresInfo{Stores: []*pdapi.StoreInfo{store}}, nil
+		})
+		pdClient.AddReaction(pdapi.GetPlacementRuleBundleActionType, func(action *pdapi.Action) (interface{}, error) {
+			if test.current == nil {
+				return nil, nil
+			}
+			return test.current(desired), nil
+		})
+		var set, deleted []string
+		pdClient.AddReaction(pdapi.SetPlacementRuleBundleActionType, func(action *pdapi.Action) (interface{}, error) {
+			g.Expect(action.Bundle.Rules).To(Equal(desired.Rules))
+			set = append(set, action.Name)
+			return nil, nil
+		})
+		pdClient.AddReaction(pdapi.DeletePlacementRuleBundleActionType, func(action *pdapi.Action) (interface{}, error) {
+			deleted = append(deleted, action.Name)
+			return nil, nil
+		})
+
+		g.Expect(pmm.syncPlacementRules(tc)).To(Succeed())
+		test.expectFn(tc, set, deleted)
+	}
+
+	version := func() string {
+		tc := newTidbClusterForPlacementRules()
+		bundle, _ := newPlacementRuleBundle(&tc.Spec.PD.PlacementRules[0], tc.Spec.PD.PlacementPolicies)
+		v, _ := placementRuleBundleVersion(bundle)
+		return v
+	}()
+
+	tests := []testcase{
+		{
+			name:        "create bundle",
+			storeLabels: map[string]string{"disk": "nvme", "zone": "z1"},
+			expectFn: func(tc *v1alpha1.TidbCluster, set, deleted []string) {
+				g.Expect(set).To(Equal([]string{"hot"}))
+				status := tc.Status.PD.PlacementRules["hot"]
+				g.Expect(status.Version).To(Equal(version))
+				g.Expect(status.LastAppliedTime).NotTo(BeNil())
+				g.Expect(status.LastDriftTime).To(BeNil())
+				g.Expect(status.Message).To(BeEmpty())
+			},
+		},
+		{
+			name:        "bundle is up to date",
+			storeLabels: map[string]string{"disk": "nvme", "zone": "z1"},
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.PD.PlacementRules = map[string]v1alpha1.PlacementRuleBundleStatus{"hot": {Version: version}}
+			},
+			current: func(desired *pdapi.GroupBundle) *pdapi.GroupBundle {
+				return desired
+			},
+			expectFn: func(tc *v1alpha1.TidbCluster, set, deleted []string) {
+				g.Expect(set).To(BeEmpty())
+				g.Expect(tc.Status.PD.PlacementRules["hot"].LastDriftTime).To(BeNil())
+			},
+		},
+		{
+			name:        "bundle is changed outside",
+			storeLabels: map[string]string{"disk": "nvme", "zone": "z1"},
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.PD.PlacementRules = map[string]v1alpha1.PlacementRuleBundleStatus{"hot": {Version: version}}
+			},
+			current: func(desired *pdapi.GroupBundle) *pdapi.GroupBundle {
+				b := *desired
+				b.Rules = []*pdapi.PlacementRule{{GroupID: "hot", ID: "nvme", Role: "voter", Count: 5}}
+				return &b
+			},
+			expectFn: func(tc *v1alpha1.TidbCluster, set, deleted []string) {
+				g.Expect(set).To(Equal([]string{"hot"}))
+				status := tc.Status.PD.PlacementRules["hot"]
+				g.Expect(status.LastDriftTime).NotTo(BeNil())
+				g.Expect(status.Version).To(Equal(version))
+			},
+		},
+		{
+			name:        "label is not found on stores",
+			storeLabels: map[string]string{"disk": "hdd", "zone": "z1"},
+			expectFn: func(tc *v1alpha1.TidbCluster, set, deleted []string) {
+				g.Expect(set).To(BeEmpty())
+				status := tc.Status.PD.PlacementRules["hot"]
+				g.Expect(status.Version).To(BeEmpty())
+				g.Expect(status.Message).To(ContainSubstring("disk"))
+			},
+		},
+		{
+			name:        "bundle is removed from spec",
+			storeLabels: map[string]string{"disk": "nvme", "zone": "z1"},
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.PD.PlacementRules = nil
+				tc.Status.PD.PlacementRules = map[string]v1alpha1.PlacementRuleBundleStatus{"hot": {Version: version}}
+			},
+			expectFn: func(tc *v1alpha1.TidbCluster, set, deleted []string) {
+				g.Expect(set).To(BeEmpty())
+				g.Expect(deleted).To(Equal([]string{"hot"}))
+				g.Expect(tc.Status.PD.PlacementRules).To(BeEmpty())
+			},
+		},
+	}
+	for _, test := range tests {
+		testFn(test)
+	}
+}
+
+func TestPlacementRuleBundleVersion(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	a := &pdapi.GroupBundle{ID: "g", Rules: []*pdapi.PlacementRule{
+		{GroupID: "g", ID: "r1", Role: "voter", Count: 1},
+		{GroupID: "g", ID: "r2", Role: "learner", Count: 1, LocationLabels: []string{}},
+	}}
+	b := &pdapi.GroupBundle{ID: "g", Rules: []*pdapi.PlacementRule{
+		{GroupID: "g", ID: "r2", Role: "learner", Count: 1},
+		{GroupID: "g", ID: "r1", Role: "voter", Count: 1},
+	}}
+	va, err := placementRuleBundleVersion(a)
+	g.Expect(err).To(Succeed())
+	vb, err := placementRuleBundleVersion(b)
+	g.Expect(err).To(Succeed())
+	// the order of the rules and empty slices make no difference
+	g.Expect(va).To(Equal(vb))
+	g.Expect(a.Rules[0].ID).To(Equal("r1"))
+
+	b.Rules[0].Count = 2
+	vb, err = placementRuleBundleVersion(b)
+	g.Expect(err).To(Succeed())
+	g.Expect(va).NotTo(Equal(vb))
+}
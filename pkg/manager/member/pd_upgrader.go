@@ -160,7 +160,11 @@ func (u *pdUpgrader) upgradePDPod(tc *v1alpha1.TidbCluster, ordinal int32, newSe
 		targetName := ""
 
 		if tc.PDStsActualReplicas() > 1 {
-			targetName = choosePDToTransferFromMembers(tc, newSet, ordinal)
+			placement, err := getPDLeaderPlacement(u.deps, tc)
+			if err != nil {
+				return err
+			}
+			targetName = choosePDToTransferFromMembers(tc, newSet, ordinal, placement)
 		}
 
 		if targetName == "" {
@@ -210,7 +214,10 @@ func (u *pdUpgrader) transferPDLeaderTo(tc *v1alpha1.TidbCluster, targetName str
 // Assume that current leader ordinal is x, and range is [0, n]
 //  1. Find the max suitable ordinal in (x, n], because they have been upgraded
 //  2. If no suitable ordinal, find the min suitable ordinal in [0, x) to reduce the count of transfer
-func choosePDToTransferFromMembers(tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet, ordinal int32) string {
+//
+// If the leader preference is specified, the suitable member with the highest priority is chosen,
+// and the order above is used to break ties.
+func choosePDToTransferFromMembers(tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet, ordinal int32, placement *pdLeaderPlacement) string {
 	tcName := tc.GetName()
 	ordinals := helper.GetPodOrdinals(*newSet.Spec.Replicas, newSet)
 
@@ -221,39 +228,24 @@ func choosePDToTransferFromMembers(tc *v1alpha1.TidbCluster, newSet *apps.Statef
 		}
 		return pdName
 	}
-	pred := func(pdName string) bool {
-		return tc.Status.PD.Members[pdName].Health
-	}
 
 	// set ordinal to max ordinal if ordinal isn't exist
 	if !ordinals.Has(ordinal) {
 		ordinal = helper.GetMaxPodOrdinal(*newSet.Spec.Replicas, newSet)
 	}
 
-	targetName := ""
 	list := ordinals.List()
-
-	// find the max ordinal which is larger than ordinal
+	candidates := make([]string, 0, len(list))
+	// the max ordinals which are larger than ordinal
 	for i := len(list) - 1; i >= 0 && list[i] > ordinal; i-- {
-		curName := genPDName(list[i])
-		if pred(curName) {
-			targetName = curName
-			break
-		}
+		candidates = append(candidates, genPDName(list[i]))
 	}
-
-	if targetName == "" {
-		// find the min ordinal which is less than ordinal
-		for i := 0; i < len(list) && list[i] < ordinal; i++ {
-			curName := genPDName(list[i])
-			if pred(curName) {
-				targetName = curName
-				break
-			}
-		}
+	// the min ordinals which are less than ordinal
+	for i := 0; i < len(list) && list[i] < ordinal; i++ {
+		candidates = append(candidates, genPDName(list[i]))
 	}
 
-	return targetName
+	return placement.preferredMember(tc, candidates)
 }
 
 // choosePDToTransferFromPeerMembers choose a pd to transfer leader from peer members
@@ -366,6 +366,7 @@ func TestChoosePDToTransferFromMembers(t *testing.T) {
 		name             string
 		changeFn         func(*v1alpha1.TidbCluster, *apps.StatefulSet)
 		ordinal          int32
+		priorities       map[string]int
 		expectTargetName string
 	}
 
@@ -460,6 +461,28 @@ func TestChoosePDToTransferFromMembers(t *testing.T) {
 			ordinal:          0,
 			expectTargetName: "",
 		},
+		{
+			name: "ordinal is min and a lower ordinal pod is preferred",
+			changeFn: func(tc *v1alpha1.TidbCluster, ss *apps.StatefulSet) {
+				tc.Status.PD.Members[PdName(tc.Name, 0, tc.Namespace, tc.Spec.ClusterDomain, tc.Spec.AcrossK8s)] = v1alpha1.PDMember{Health: true}
+				tc.Status.PD.Members[PdName(tc.Name, 1, tc.Namespace, tc.Spec.ClusterDomain, tc.Spec.AcrossK8s)] = v1alpha1.PDMember{Health: true}
+				tc.Status.PD.Members[PdName(tc.Name, 2, tc.Namespace, tc.Spec.ClusterDomain, tc.Spec.AcrossK8s)] = v1alpha1.PDMember{Health: true}
+			},
+			ordinal:          0,
+			priorities:       map[string]int{"upgrader-pd-1": 10},
+			expectTargetName: "upgrader-pd-1",
+		},
+		{
+			name: "ordinal is max but the preferred pod is unhealthy",
+			changeFn: func(tc *v1alpha1.TidbCluster, ss *apps.StatefulSet) {
+				tc.Status.PD.Members[PdName(tc.Name, 0, tc.Namespace, tc.Spec.ClusterDomain, tc.Spec.AcrossK8s)] = v1alpha1.PDMember{Health: false}
+				tc.Status.PD.Members[PdName(tc.Name, 1, tc.Namespace, tc.Spec.ClusterDomain, tc.Spec.AcrossK8s)] = v1alpha1.PDMember{Health: true}
+				tc.Status.PD.Members[PdName(tc.Name, 2, tc.Namespace, tc.Spec.ClusterDomain, tc.Spec.AcrossK8s)] = v1alpha1.PDMember{Health: true}
+			},
+			ordinal:          2,
+			priorities:       map[string]int{"upgrader-pd-0": 10},
+			expectTargetName: "upgrader-pd-1",
+		},
 	}
 
 	for _, testcase := range cases {
@@ -475,7 +498,7 @@ func TestChoosePDToTransferFromMembers(t *testing.T) {
 		newSet := oriNewSet.DeepCopy()
 		ordinal := testcase.ordinal
 
-		targetName := choosePDToTransferFromMembers(tc, newSet, ordinal)
+		targetName := choosePDToTransferFromMembers(tc, newSet, ordinal, &pdLeaderPlacement{priorities: testcase.priorities})
 		g.Expect(targetName).Should(Equal(testcase.expectTargetName))
 		g.Expect(tc).Should(Equal(oriTC))
 		g.Expect(newSet).Should(Equal(oriNewSet))
@@ -50,6 +50,9 @@ const (
 
 type binlogClient interface {
 	PumpNodeStatus(ctx context.Context) (status []*v1alpha1.PumpNodeStatus, err error)
+	DrainerNodeStatus(ctx context.Context) (status []*v1alpha1.PumpNodeStatus, err error)
+	OfflineDrainer(ctx context.Context, addr string) error
+	UpdateDrainerState(ctx context.Context, addr string, state string) error
 	Close() error
 }
 
@@ -219,6 +222,14 @@ func (m *pumpMemberManager) syncTiDBClusterStatus(tc *v1alpha1.TidbCluster, set
 
 	tc.Status.Pump.Members = status
 
+	// the status of the drainers is only informational, failing to get it should not block syncing the pumps
+	drainers, err := client.DrainerNodeStatus(context.TODO())
+	if err != nil {
+		klog.Warningf("failed to get the drainer status of tc %s/%s, keep the last one, err: %v", tc.Namespace, tc.Name, err)
+	} else {
+		tc.Status.Pump.Drainers = drainers
+	}
+
 	err = volumes.SyncVolumeStatus(m.podVolumeModifier, m.deps.PodLister, tc, v1alpha1.PumpMemberType)
 	if err != nil {
 		return fmt.Errorf("failed to sync volume status for pump: %v", err)
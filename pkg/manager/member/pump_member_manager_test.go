@@ -740,6 +740,7 @@ func TestSyncTiDBClusterStatus(t *testing.T) {
 		// `upgradingFn` is unused
 		// nolint: structcheck
 		upgradingFn func(corelisters.PodLister, *appsv1.StatefulSet, *v1alpha1.TidbCluster) (bool, error)
+		drainerErr  error
 		errExpectFn func(*GomegaWithT, error)
 		tcExpectFn  func(*GomegaWithT, *v1alpha1.TidbCluster)
 	}
@@ -756,6 +757,10 @@ func TestSyncTiDBClusterStatus(t *testing.T) {
 			test.updateTC(set)
 		}
 		pmm, _, _ := newFakePumpMemberManager()
+		pmm.binlogClient = &fakeBinlogClient{
+			drainers:   []*v1alpha1.PumpNodeStatus{{NodeID: "drainer-0", Host: "drainer-0:8249", State: "online"}},
+			drainerErr: test.drainerErr,
+		}
 
 		err := pmm.syncTiDBClusterStatus(tc, set)
 
@@ -782,6 +787,17 @@ func TestSyncTiDBClusterStatus(t *testing.T) {
 				g.Expect(tc.Status.Pump.Phase).To(Equal(v1alpha1.UpgradePhase))
 			},
 		},
+		{
+			name:       "failed to get the drainer status",
+			drainerErr: fmt.Errorf("drainer status unavailable"),
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+			},
+			tcExpectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster) {
+				g.Expect(tc.Status.Pump.Phase).To(Equal(v1alpha1.NormalPhase))
+				g.Expect(tc.Status.Pump.Drainers).To(BeEmpty())
+			},
+		},
 	}
 
 	for i := range tests {
@@ -791,12 +807,38 @@ func TestSyncTiDBClusterStatus(t *testing.T) {
 }
 
 type fakeBinlogClient struct {
+	drainers   []*v1alpha1.PumpNodeStatus
+	drainerErr error
 }
 
 func (c *fakeBinlogClient) PumpNodeStatus(ctx context.Context) (status []*v1alpha1.PumpNodeStatus, err error) {
 	return nil, nil
 }
 
+func (c *fakeBinlogClient) DrainerNodeStatus(ctx context.Context) (status []*v1alpha1.PumpNodeStatus, err error) {
+	return c.drainers, c.drainerErr
+}
+
+func (c *fakeBinlogClient) OfflineDrainer(ctx context.Context, addr string) error {
+	for _, node := range c.drainers {
+		if node.Host == addr {
+			node.State = "offline"
+			return nil
+		}
+	}
+	return fmt.Errorf("drainer %s not found", addr)
+}
+
+func (c *fakeBinlogClient) UpdateDrainerState(ctx context.Context, addr string, state string) error {
+	for _, node := range c.drainers {
+		if node.Host == addr {
+			node.State = state
+			return nil
+		}
+	}
+	return fmt.Errorf("drainer %s not found", addr)
+}
+
 func (c *fakeBinlogClient) Close() error {
 	return nil
 }
@@ -104,6 +104,12 @@ func RenderTiFlashStartScript(tc *v1alpha1.TidbCluster) (string, error) {
 	return tiflash[tc.StartScriptVersion()](tc)
 }
 
+// RenderTiFlashComputeStartScript renders the start script of TiFlash compute nodes,
+// which always read config from the ConfigMap mounted in the container.
+func RenderTiFlashComputeStartScript(tc *v1alpha1.TidbCluster) (string, error) {
+	return v2.RenderTiFlashStartScriptWithStartArgs(tc)
+}
+
 func RenderTiFlashInitScript(tc *v1alpha1.TidbCluster) (string, error) {
 	return tiflashInit[tc.StartScriptVersion()](tc)
 }
@@ -880,7 +880,10 @@ join=${join%,}
 ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/member/wal ]]
 then
-until result=$(wget -qO- -T 3 http://${discovery_url}/new/${encoded_domain_url} 2>/dev/null); do
+registration="{\"podName\":\"${POD_NAME}\",\"namespace\":\"${NAMESPACE}\",\"clusterName\":\"${cluster_name}\",\"memberName\":\"${POD_NAME}\",\"addresses\":[\"${domain}:2380\"]}"
+# fall back to the advertised peer URL if the discovery does not support the registration
+until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://${discovery_url}/register 2>/dev/null \
+|| wget -qO- -T 3 http://${discovery_url}/new/${encoded_domain_url} 2>/dev/null); do
 echo "waiting for discovery service to return start args ..."
 sleep $((RANDOM % 5))
 done
@@ -975,7 +978,10 @@ join=${join%,}
 ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/data/member/wal ]]
 then
-until result=$(wget -qO- -T 3 http://${discovery_url}/new/${encoded_domain_url} 2>/dev/null); do
+registration="{\"podName\":\"${POD_NAME}\",\"namespace\":\"${NAMESPACE}\",\"clusterName\":\"${cluster_name}\",\"memberName\":\"${POD_NAME}\",\"addresses\":[\"${domain}:2380\"]}"
+# fall back to the advertised peer URL if the discovery does not support the registration
+until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://${discovery_url}/register 2>/dev/null \
+|| wget -qO- -T 3 http://${discovery_url}/new/${encoded_domain_url} 2>/dev/null); do
 echo "waiting for discovery service to return start args ..."
 sleep $((RANDOM % 5))
 done
@@ -1070,7 +1076,10 @@ join=${join%,}
 ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/member/wal ]]
 then
-until result=$(wget -qO- -T 3 http://${discovery_url}/new/${encoded_domain_url} 2>/dev/null); do
+registration="{\"podName\":\"${POD_NAME}\",\"namespace\":\"${NAMESPACE}\",\"clusterName\":\"${cluster_name}\",\"memberName\":\"${domain}\",\"addresses\":[\"${domain}:2380\"]}"
+# fall back to the advertised peer URL if the discovery does not support the registration
+until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://${discovery_url}/register 2>/dev/null \
+|| wget -qO- -T 3 http://${discovery_url}/new/${encoded_domain_url} 2>/dev/null); do
 echo "waiting for discovery service to return start args ..."
 sleep $((RANDOM % 5))
 done
@@ -1166,7 +1175,10 @@ join=${join%,}
 ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/member/wal ]]
 then
-until result=$(wget -qO- -T 3 http://${discovery_url}/new/${encoded_domain_url} 2>/dev/null); do
+registration="{\"podName\":\"${POD_NAME}\",\"namespace\":\"${NAMESPACE}\",\"clusterName\":\"${cluster_name}\",\"memberName\":\"${domain}\",\"addresses\":[\"${domain}:2380\"]}"
+# fall back to the advertised peer URL if the discovery does not support the registration
+until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://${discovery_url}/register 2>/dev/null \
+|| wget -qO- -T 3 http://${discovery_url}/new/${encoded_domain_url} 2>/dev/null); do
 echo "waiting for discovery service to return start args ..."
 sleep $((RANDOM % 5))
 done
@@ -1262,7 +1274,10 @@ join=${join%,}
 ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/member/wal ]]
 then
-until result=$(wget -qO- -T 3 http://${discovery_url}/new/${encoded_domain_url} 2>/dev/null); do
+registration="{\"podName\":\"${POD_NAME}\",\"namespace\":\"${NAMESPACE}\",\"clusterName\":\"${cluster_name}\",\"memberName\":\"${domain}\",\"addresses\":[\"${domain}:2380\"]}"
+# fall back to the advertised peer URL if the discovery does not support the registration
+until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://${discovery_url}/register 2>/dev/null \
+|| wget -qO- -T 3 http://${discovery_url}/new/${encoded_domain_url} 2>/dev/null); do
 echo "waiting for discovery service to return start args ..."
 sleep $((RANDOM % 5))
 done
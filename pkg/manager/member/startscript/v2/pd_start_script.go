@@ -36,6 +36,8 @@ type PDStartScriptModel struct {
 	ClientURL          string
 	AdvertiseClientURL string
 	DiscoveryAddr      string
+	ClusterName        string
+	Namespace          string
 	ExtraArgs          string
 	PDAddresses        string
 	PDStartTimeout     int
@@ -92,6 +94,10 @@ func RenderPDStartScript(tc *v1alpha1.TidbCluster) (string, error) {
 
 	m.DiscoveryAddr = fmt.Sprintf("%s-discovery.%s:10261", tcName, tcNS)
 
+	m.ClusterName = tcName
+
+	m.Namespace = tcNS
+
 	m.PDStartTimeout = tc.PDStartTimeout()
 
 	m.PDInitWaitTime = tc.PDInitWaitTime()
@@ -259,9 +265,12 @@ if [[ -f {{ .DataDir }}/join ]]; then
     join=${join%,}
     ARGS="${ARGS} --join=${join}"
 elif [[ ! -d {{ .DataDir }}/member/wal ]]; then
+    registration="{\"podName\":\"${PD_POD_NAME}\",\"namespace\":\"{{ .Namespace }}\",\"clusterName\":\"{{ .ClusterName }}\",\"memberName\":\"{{ .PDName }}\",\"addresses\":[\"${PD_DOMAIN}:2380\"]}"
     encoded_domain_url=$(echo ${PD_DOMAIN}:2380 | base64 | tr "\n" " " | sed "s/ //g")
 
-    until result=$(wget -qO- -T 3 http://{{ .DiscoveryAddr }}/new/${encoded_domain_url} 2>/dev/null); do
+    # fall back to the advertised peer URL if the discovery does not support the registration
+    until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://{{ .DiscoveryAddr }}/register 2>/dev/null \
+        || wget -qO- -T 3 http://{{ .DiscoveryAddr }}/new/${encoded_domain_url} 2>/dev/null); do
         echo "waiting for discovery service to return start args ..."
         sleep $((RANDOM % 5))
     done
@@ -101,9 +101,12 @@ if [[ -f /var/lib/pd/join ]]; then
     join=${join%,}
     ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/member/wal ]]; then
+    registration="{\"podName\":\"${PD_POD_NAME}\",\"namespace\":\"start-script-test-ns\",\"clusterName\":\"start-script-test\",\"memberName\":\"${PD_POD_NAME}\",\"addresses\":[\"${PD_DOMAIN}:2380\"]}"
     encoded_domain_url=$(echo ${PD_DOMAIN}:2380 | base64 | tr "\n" " " | sed "s/ //g")
 
-    until result=$(wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
+    # fall back to the advertised peer URL if the discovery does not support the registration
+    until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://start-script-test-discovery.start-script-test-ns:10261/register 2>/dev/null \
+        || wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
         echo "waiting for discovery service to return start args ..."
         sleep $((RANDOM % 5))
     done
@@ -185,9 +188,12 @@ if [[ -f /var/lib/pd/join ]]; then
     join=${join%,}
     ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/member/wal ]]; then
+    registration="{\"podName\":\"${PD_POD_NAME}\",\"namespace\":\"start-script-test-ns\",\"clusterName\":\"start-script-test\",\"memberName\":\"${PD_POD_NAME}\",\"addresses\":[\"${PD_DOMAIN}:2380\"]}"
     encoded_domain_url=$(echo ${PD_DOMAIN}:2380 | base64 | tr "\n" " " | sed "s/ //g")
 
-    until result=$(wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
+    # fall back to the advertised peer URL if the discovery does not support the registration
+    until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://start-script-test-discovery.start-script-test-ns:10261/register 2>/dev/null \
+        || wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
         echo "waiting for discovery service to return start args ..."
         sleep $((RANDOM % 5))
     done
@@ -344,9 +350,12 @@ if [[ -f /var/lib/pd/join ]]; then
     join=${join%,}
     ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/member/wal ]]; then
+    registration="{\"podName\":\"${PD_POD_NAME}\",\"namespace\":\"start-script-test-ns\",\"clusterName\":\"start-script-test\",\"memberName\":\"${PD_POD_NAME}\",\"addresses\":[\"${PD_DOMAIN}:2380\"]}"
     encoded_domain_url=$(echo ${PD_DOMAIN}:2380 | base64 | tr "\n" " " | sed "s/ //g")
 
-    until result=$(wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
+    # fall back to the advertised peer URL if the discovery does not support the registration
+    until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://start-script-test-discovery.start-script-test-ns:10261/register 2>/dev/null \
+        || wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
         echo "waiting for discovery service to return start args ..."
         sleep $((RANDOM % 5))
     done
@@ -428,9 +437,12 @@ if [[ -f /var/lib/pd/pd-data/join ]]; then
     join=${join%,}
     ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/pd-data/member/wal ]]; then
+    registration="{\"podName\":\"${PD_POD_NAME}\",\"namespace\":\"start-script-test-ns\",\"clusterName\":\"start-script-test\",\"memberName\":\"${PD_POD_NAME}\",\"addresses\":[\"${PD_DOMAIN}:2380\"]}"
     encoded_domain_url=$(echo ${PD_DOMAIN}:2380 | base64 | tr "\n" " " | sed "s/ //g")
 
-    until result=$(wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
+    # fall back to the advertised peer URL if the discovery does not support the registration
+    until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://start-script-test-discovery.start-script-test-ns:10261/register 2>/dev/null \
+        || wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
         echo "waiting for discovery service to return start args ..."
         sleep $((RANDOM % 5))
     done
@@ -512,9 +524,12 @@ if [[ -f /var/lib/pd/join ]]; then
     join=${join%,}
     ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/member/wal ]]; then
+    registration="{\"podName\":\"${PD_POD_NAME}\",\"namespace\":\"start-script-test-ns\",\"clusterName\":\"start-script-test\",\"memberName\":\"${PD_DOMAIN}\",\"addresses\":[\"${PD_DOMAIN}:2380\"]}"
     encoded_domain_url=$(echo ${PD_DOMAIN}:2380 | base64 | tr "\n" " " | sed "s/ //g")
 
-    until result=$(wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
+    # fall back to the advertised peer URL if the discovery does not support the registration
+    until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://start-script-test-discovery.start-script-test-ns:10261/register 2>/dev/null \
+        || wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
         echo "waiting for discovery service to return start args ..."
         sleep $((RANDOM % 5))
     done
@@ -597,9 +612,12 @@ if [[ -f /var/lib/pd/join ]]; then
     join=${join%,}
     ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/member/wal ]]; then
+    registration="{\"podName\":\"${PD_POD_NAME}\",\"namespace\":\"start-script-test-ns\",\"clusterName\":\"start-script-test\",\"memberName\":\"${PD_DOMAIN}\",\"addresses\":[\"${PD_DOMAIN}:2380\"]}"
     encoded_domain_url=$(echo ${PD_DOMAIN}:2380 | base64 | tr "\n" " " | sed "s/ //g")
 
-    until result=$(wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
+    # fall back to the advertised peer URL if the discovery does not support the registration
+    until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://start-script-test-discovery.start-script-test-ns:10261/register 2>/dev/null \
+        || wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
         echo "waiting for discovery service to return start args ..."
         sleep $((RANDOM % 5))
     done
@@ -682,9 +700,12 @@ if [[ -f /var/lib/pd/join ]]; then
     join=${join%,}
     ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/member/wal ]]; then
+    registration="{\"podName\":\"${PD_POD_NAME}\",\"namespace\":\"start-script-test-ns\",\"clusterName\":\"start-script-test\",\"memberName\":\"${PD_DOMAIN}\",\"addresses\":[\"${PD_DOMAIN}:2380\"]}"
     encoded_domain_url=$(echo ${PD_DOMAIN}:2380 | base64 | tr "\n" " " | sed "s/ //g")
 
-    until result=$(wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
+    # fall back to the advertised peer URL if the discovery does not support the registration
+    until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://start-script-test-discovery.start-script-test-ns:10261/register 2>/dev/null \
+        || wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
         echo "waiting for discovery service to return start args ..."
         sleep $((RANDOM % 5))
     done
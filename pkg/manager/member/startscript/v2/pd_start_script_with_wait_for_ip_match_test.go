@@ -131,9 +131,12 @@ if [[ -f /var/lib/pd/join ]]; then
     join=${join%,}
     ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/member/wal ]]; then
+    registration="{\"podName\":\"${PD_POD_NAME}\",\"namespace\":\"start-script-test-ns\",\"clusterName\":\"start-script-test\",\"memberName\":\"${PD_POD_NAME}\",\"addresses\":[\"${PD_DOMAIN}:2380\"]}"
     encoded_domain_url=$(echo ${PD_DOMAIN}:2380 | base64 | tr "\n" " " | sed "s/ //g")
 
-    until result=$(wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
+    # fall back to the advertised peer URL if the discovery does not support the registration
+    until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://start-script-test-discovery.start-script-test-ns:10261/register 2>/dev/null \
+        || wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
         echo "waiting for discovery service to return start args ..."
         sleep $((RANDOM % 5))
     done
@@ -247,9 +250,12 @@ if [[ -f /var/lib/pd/join ]]; then
     join=${join%,}
     ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/member/wal ]]; then
+    registration="{\"podName\":\"${PD_POD_NAME}\",\"namespace\":\"start-script-test-ns\",\"clusterName\":\"start-script-test\",\"memberName\":\"${PD_POD_NAME}\",\"addresses\":[\"${PD_DOMAIN}:2380\"]}"
     encoded_domain_url=$(echo ${PD_DOMAIN}:2380 | base64 | tr "\n" " " | sed "s/ //g")
 
-    until result=$(wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
+    # fall back to the advertised peer URL if the discovery does not support the registration
+    until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://start-script-test-discovery.start-script-test-ns:10261/register 2>/dev/null \
+        || wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
         echo "waiting for discovery service to return start args ..."
         sleep $((RANDOM % 5))
     done
@@ -363,9 +369,12 @@ if [[ -f /var/lib/pd/pd-data/join ]]; then
     join=${join%,}
     ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/pd-data/member/wal ]]; then
+    registration="{\"podName\":\"${PD_POD_NAME}\",\"namespace\":\"start-script-test-ns\",\"clusterName\":\"start-script-test\",\"memberName\":\"${PD_POD_NAME}\",\"addresses\":[\"${PD_DOMAIN}:2380\"]}"
     encoded_domain_url=$(echo ${PD_DOMAIN}:2380 | base64 | tr "\n" " " | sed "s/ //g")
 
-    until result=$(wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
+    # fall back to the advertised peer URL if the discovery does not support the registration
+    until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://start-script-test-discovery.start-script-test-ns:10261/register 2>/dev/null \
+        || wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
         echo "waiting for discovery service to return start args ..."
         sleep $((RANDOM % 5))
     done
@@ -479,9 +488,12 @@ if [[ -f /var/lib/pd/join ]]; then
     join=${join%,}
     ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/member/wal ]]; then
+    registration="{\"podName\":\"${PD_POD_NAME}\",\"namespace\":\"start-script-test-ns\",\"clusterName\":\"start-script-test\",\"memberName\":\"${PD_DOMAIN}\",\"addresses\":[\"${PD_DOMAIN}:2380\"]}"
     encoded_domain_url=$(echo ${PD_DOMAIN}:2380 | base64 | tr "\n" " " | sed "s/ //g")
 
-    until result=$(wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
+    # fall back to the advertised peer URL if the discovery does not support the registration
+    until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://start-script-test-discovery.start-script-test-ns:10261/register 2>/dev/null \
+        || wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
         echo "waiting for discovery service to return start args ..."
         sleep $((RANDOM % 5))
     done
@@ -596,9 +608,12 @@ if [[ -f /var/lib/pd/join ]]; then
     join=${join%,}
     ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/member/wal ]]; then
+    registration="{\"podName\":\"${PD_POD_NAME}\",\"namespace\":\"start-script-test-ns\",\"clusterName\":\"start-script-test\",\"memberName\":\"${PD_DOMAIN}\",\"addresses\":[\"${PD_DOMAIN}:2380\"]}"
     encoded_domain_url=$(echo ${PD_DOMAIN}:2380 | base64 | tr "\n" " " | sed "s/ //g")
 
-    until result=$(wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
+    # fall back to the advertised peer URL if the discovery does not support the registration
+    until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://start-script-test-discovery.start-script-test-ns:10261/register 2>/dev/null \
+        || wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
         echo "waiting for discovery service to return start args ..."
         sleep $((RANDOM % 5))
     done
@@ -713,9 +728,12 @@ if [[ -f /var/lib/pd/join ]]; then
     join=${join%,}
     ARGS="${ARGS} --join=${join}"
 elif [[ ! -d /var/lib/pd/member/wal ]]; then
+    registration="{\"podName\":\"${PD_POD_NAME}\",\"namespace\":\"start-script-test-ns\",\"clusterName\":\"start-script-test\",\"memberName\":\"${PD_DOMAIN}\",\"addresses\":[\"${PD_DOMAIN}:2380\"]}"
     encoded_domain_url=$(echo ${PD_DOMAIN}:2380 | base64 | tr "\n" " " | sed "s/ //g")
 
-    until result=$(wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
+    # fall back to the advertised peer URL if the discovery does not support the registration
+    until result=$(wget -qO- -T 3 --header "Content-Type: application/json" --post-data "${registration}" http://start-script-test-discovery.start-script-test-ns:10261/register 2>/dev/null \
+        || wget -qO- -T 3 http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url} 2>/dev/null); do
         echo "waiting for discovery service to return start args ..."
         sleep $((RANDOM % 5))
     done
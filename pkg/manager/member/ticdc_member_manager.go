@@ -69,6 +69,9 @@ func getTiCDCConfigMap(tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
 	data := map[string]string{
 		"config-file": string(confText),
 	}
+	if err := addLogShipperConfig(tc, v1alpha1.TiCDCMemberType, data); err != nil {
+		return nil, fmt.Errorf("render log shipper config for tc %s/%s failed: %v", tc.Namespace, tc.Name, err)
+	}
 
 	name := controller.TiCDCMemberName(tc.Name)
 	instanceName := tc.GetInstanceName()
@@ -484,16 +487,25 @@ func getNewTiCDCStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*ap
 		})
 	}
 
+	containers := []corev1.Container{ticdcContainer}
+	var initContainers []corev1.Container
+	logShipper, logShipperVols, err := buildLogShipperContainer(tc, v1alpha1.TiCDCMemberType, cm, ticdcContainer.VolumeMounts)
+	if err != nil {
+		return nil, err
+	}
+	containers, initContainers = appendLogShipperContainer(logShipper, containers, initContainers)
+	vols = append(vols, logShipperVols...)
+
 	podSpec := baseTiCDCSpec.BuildPodSpec()
 
-	podSpec.Containers, err = MergePatchContainers([]corev1.Container{ticdcContainer}, baseTiCDCSpec.AdditionalContainers())
+	podSpec.Containers, err = MergePatchContainers(containers, baseTiCDCSpec.AdditionalContainers())
 	if err != nil {
 		return nil, fmt.Errorf("failed to merge containers spec for TiCDC of [%s/%s], error: %v", ns, tcName, err)
 	}
 
 	podSpec.Volumes = append(vols, baseTiCDCSpec.AdditionalVolumes()...)
 	podSpec.ServiceAccountName = tc.Spec.TiCDC.ServiceAccount
-	podSpec.InitContainers = append(podSpec.InitContainers, baseTiCDCSpec.InitContainers()...)
+	podSpec.InitContainers = append(initContainers, baseTiCDCSpec.InitContainers()...)
 	if podSpec.ServiceAccountName == "" {
 		podSpec.ServiceAccountName = tc.Spec.ServiceAccount
 	}
@@ -19,6 +19,7 @@ import (
 	"strconv"
 
 	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -57,6 +58,7 @@ func (m *realTidbDiscoveryManager) Reconcile(obj client.Object) error {
 
 	var (
 		clusterPolicyRule rbacv1.PolicyRule
+		extraPolicyRules  []rbacv1.PolicyRule
 		preferIPv6        bool
 	)
 	switch cluster := obj.(type) {
@@ -71,6 +73,28 @@ func (m *realTidbDiscoveryManager) Reconcile(obj client.Object) error {
 			ResourceNames: []string{metaObj.GetName()},
 			Verbs:         []string{"get"},
 		}
+		// the bootstrap state of PD is kept in a ConfigMap, and the replicas of discovery elect
+		// the leader by a Lease
+		extraPolicyRules = []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{corev1.GroupName},
+				Resources: []string{"configmaps"},
+				Verbs:     []string{"get", "create", "update"},
+			},
+			{
+				APIGroups: []string{coordinationv1.GroupName},
+				Resources: []string{"leases"},
+				Verbs:     []string{"get", "create", "update"},
+			},
+		}
+		// the PD pods are looked up by their IPs if the discovery resolves the pod IPs
+		if cluster.DiscoveryAddressResolver() == v1alpha1.DiscoveryAddressResolverPodIP {
+			extraPolicyRules = append(extraPolicyRules, rbacv1.PolicyRule{
+				APIGroups: []string{corev1.GroupName},
+				Resources: []string{"pods"},
+				Verbs:     []string{"list"},
+			})
+		}
 		preferIPv6 = cluster.Spec.PreferIPv6
 	case *v1alpha1.DMCluster:
 		clusterPolicyRule = rbacv1.PolicyRule{
@@ -89,14 +113,14 @@ func (m *realTidbDiscoveryManager) Reconcile(obj client.Object) error {
 	// Ensure RBAC
 	_, err := m.deps.TypedControl.CreateOrUpdateRole(obj, &rbacv1.Role{
 		ObjectMeta: meta,
-		Rules: []rbacv1.PolicyRule{
+		Rules: append([]rbacv1.PolicyRule{
 			clusterPolicyRule,
 			{
 				APIGroups: []string{corev1.GroupName},
 				Resources: []string{"secrets"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
-		},
+		}, extraPolicyRules...),
 	})
 	if err != nil {
 		return controller.RequeueErrorf("error creating or updating discovery role: %v", err)
@@ -170,6 +194,8 @@ func getTidbDiscoveryService(obj metav1.Object, deploy *appsv1.Deployment, prefe
 func (m *realTidbDiscoveryManager) getTidbDiscoveryDeployment(obj metav1.Object) (*appsv1.Deployment, error) {
 	var (
 		resources     corev1.ResourceRequirements
+		replicas      int32 = 1
+		resolver            = v1alpha1.DiscoveryAddressResolverFQDN
 		timezone      string
 		baseSpec      v1alpha1.ComponentAccessor
 		podSpec       corev1.PodSpec
@@ -180,6 +206,8 @@ func (m *realTidbDiscoveryManager) getTidbDiscoveryDeployment(obj metav1.Object)
 	switch cluster := obj.(type) {
 	case *v1alpha1.TidbCluster:
 		resources = cluster.Spec.Discovery.ResourceRequirements
+		replicas = cluster.DiscoveryReplicas()
+		resolver = cluster.DiscoveryAddressResolver()
 		timezone = cluster.Timezone()
 		baseSpec = cluster.BaseDiscoverySpec()
 		podSpec = baseSpec.BuildPodSpec()
@@ -252,6 +280,12 @@ func (m *realTidbDiscoveryManager) getTidbDiscoveryDeployment(obj metav1.Object)
 			},
 		},
 	}
+	if replicas > 1 {
+		discoveryContainer.Args = append(discoveryContainer.Args, "--leader-elect=true")
+	}
+	if resolver != v1alpha1.DiscoveryAddressResolverFQDN {
+		discoveryContainer.Args = append(discoveryContainer.Args, fmt.Sprintf("--address-resolver=%s", resolver))
+	}
 	if readinessProb != nil {
 		discoveryContainer.ReadinessProbe = readinessProb
 	}
@@ -292,13 +326,19 @@ func (m *realTidbDiscoveryManager) getTidbDiscoveryDeployment(obj metav1.Object)
 		})
 	}
 
+	// a single discovery is recreated to avoid two of them answering at the same time, the replicas
+	// under leader election are rolled one by one to keep a leader available
+	strategy := appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType}
+	if replicas > 1 {
+		strategy = appsv1.DeploymentStrategy{Type: appsv1.RollingUpdateDeploymentStrategyType}
+	}
 	podLabels := util.CombineStringMap(l.Labels(), baseSpec.Labels())
 	podAnnotations := baseSpec.Annotations()
 	d := &appsv1.Deployment{
 		ObjectMeta: meta,
 		Spec: appsv1.DeploymentSpec{
-			Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
-			Replicas: pointer.Int32Ptr(1),
+			Strategy: strategy,
+			Replicas: pointer.Int32Ptr(replicas),
 			Selector: l.LabelSelector(),
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
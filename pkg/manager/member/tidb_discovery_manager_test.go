@@ -24,6 +24,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
 )
 
 func TestTidbDiscoveryManager_Reconcile(t *testing.T) {
@@ -96,6 +97,32 @@ func TestTidbDiscoveryManager_Reconcile(t *testing.T) {
 			},
 			errOnCreateOrUpdate: false,
 		},
+		{
+			name: "Multiple replicas with leader election",
+			prepare: func(tc *v1alpha1.TidbCluster, ctrl *controller.FakeGenericControl) {
+				tc.Spec.Discovery.Replicas = pointer.Int32Ptr(3)
+			},
+			expect: func(deploys []appsv1.Deployment, tc *v1alpha1.TidbCluster, err error) {
+				g.Expect(err).To(Succeed())
+				g.Expect(deploys).To(HaveLen(1))
+				g.Expect(*deploys[0].Spec.Replicas).To(Equal(int32(3)))
+				g.Expect(deploys[0].Spec.Strategy.Type).To(Equal(appsv1.RollingUpdateDeploymentStrategyType))
+				g.Expect(deploys[0].Spec.Template.Spec.Containers[0].Args).To(Equal([]string{"--leader-elect=true"}))
+			},
+			errOnCreateOrUpdate: false,
+		},
+		{
+			name: "Resolve the pod IPs",
+			prepare: func(tc *v1alpha1.TidbCluster, ctrl *controller.FakeGenericControl) {
+				tc.Spec.Discovery.AddressResolver = v1alpha1.DiscoveryAddressResolverPodIP
+			},
+			expect: func(deploys []appsv1.Deployment, tc *v1alpha1.TidbCluster, err error) {
+				g.Expect(err).To(Succeed())
+				g.Expect(deploys).To(HaveLen(1))
+				g.Expect(deploys[0].Spec.Template.Spec.Containers[0].Args).To(Equal([]string{"--address-resolver=pod-ip"}))
+			},
+			errOnCreateOrUpdate: false,
+		},
 		{
 			name: "Create or update resource error",
 			expect: func(deploys []appsv1.Deployment, tc *v1alpha1.TidbCluster, err error) {
@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"sync"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
@@ -58,12 +59,19 @@ type InitManager interface {
 }
 
 type tidbInitManager struct {
-	deps *controller.Dependencies
+	deps                  *controller.Dependencies
+	migrationStoreFactory migrationStoreFactory
+
+	// migrationRuns are the runs of the migrations in the background, keyed by the TidbInitializers
+	migrationRunsLock sync.Mutex
+	migrationRuns     map[string]*migrationRun
 }
 
 // NewTiDBInitManager return tidbInitManager
 func NewTiDBInitManager(deps *controller.Dependencies) InitManager {
-	return &tidbInitManager{deps: deps}
+	m := &tidbInitManager{deps: deps, migrationRuns: map[string]*migrationRun{}}
+	m.migrationStoreFactory = m.newSQLMigrationStore
+	return m
 }
 
 func (m *tidbInitManager) Sync(ti *v1alpha1.TidbInitializer) error {
@@ -111,7 +119,10 @@ func (m *tidbInitManager) updateStatus(ti *v1alpha1.TidbInitializer) error {
 		}
 	}
 
-	var update bool
+	var (
+		update  bool
+		syncErr error
+	)
 	if !apiequality.Semantic.DeepEqual(ti.Status.JobStatus, job.Status) {
 		job.Status.DeepCopyInto(&ti.Status.JobStatus)
 		update = true
@@ -120,11 +131,24 @@ func (m *tidbInitManager) updateStatus(ti *v1alpha1.TidbInitializer) error {
 		ti.Status.Phase = phase
 		update = true
 	}
+	if ti.Spec.Migrations != nil {
+		tc, err := m.deps.TiDBClusterLister.TidbClusters(ns).Get(ti.Spec.Clusters.Name)
+		if err != nil {
+			return fmt.Errorf("updateStatus: failed to get tidbcluster %s for TidbInitializer %s/%s, error: %s", ti.Spec.Clusters.Name, ns, ti.Name, err)
+		}
+		var migration *v1alpha1.TidbMigrationStatus
+		migration, syncErr = m.syncMigrations(ti, tc, phase)
+		if !apiequality.Semantic.DeepEqual(ti.Status.Migration, migration) {
+			ti.Status.Migration = migration
+			update = true
+		}
+	}
 	if update {
-		_, err = m.updateInitializer(ti)
-		return err
+		if _, err = m.updateInitializer(ti); err != nil {
+			return err
+		}
 	}
-	return nil
+	return syncErr
 }
 
 func (m *tidbInitManager) updateInitializer(ti *v1alpha1.TidbInitializer) (*v1alpha1.TidbInitializer, error) {
@@ -132,7 +132,7 @@ func newFakeTiDBInitManager() (*tidbInitManager, *tidbMemberManager, *fakeIndexe
 	tmm, _, _, indexers := newFakeTiDBMemberManager()
 	indexers.job = tmm.deps.KubeInformerFactory.Batch().V1().Jobs().Informer().GetIndexer()
 	indexers.ti = tmm.deps.InformerFactory.Pingcap().V1alpha1().TidbInitializers().Informer().GetIndexer()
-	return &tidbInitManager{deps: tmm.deps}, tmm, indexers
+	return &tidbInitManager{deps: tmm.deps, migrationRuns: map[string]*migrationRun{}}, tmm, indexers
 }
 
 func newTidbInitializerForTiDB() *v1alpha1.TidbInitializer {
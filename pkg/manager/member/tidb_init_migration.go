@@ -0,0 +1,494 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+)
+
+const (
+	defaultMigrationDatabase = "tidb_operator"
+	migrationTable           = "schema_migrations"
+	rootUser                 = "root"
+)
+
+// migrationFileRegexp matches the migration files like `V1__init.sql` and `V1.2__create_orders.sql`
+var migrationFileRegexp = regexp.MustCompile(`^V(\d+(?:[._]\d+)*)__(.+)\.sql$`)
+
+// migration is a versioned SQL migration in the ConfigMaps
+type migration struct {
+	// Version is the version with the parts separated by `.`
+	Version     string
+	Description string
+	// Script is the `<configmap>/<key>` of the migration
+	Script   string
+	Checksum string
+	SQL      string
+
+	versionParts []uint64
+}
+
+// appliedMigration is a migration recorded in the metadata table
+type appliedMigration struct {
+	Version  string
+	Checksum string
+}
+
+// migrationStore is the TiDB cluster the migrations are applied to
+type migrationStore interface {
+	// EnsureTable creates the metadata table if it does not exist
+	EnsureTable(ctx context.Context) error
+	// ListApplied lists the applied migrations
+	ListApplied(ctx context.Context) ([]appliedMigration, error)
+	// Apply executes the migration and records it in the metadata table
+	Apply(ctx context.Context, m *migration, appliedBy string) error
+	Close() error
+}
+
+// migrationStoreFactory connects to the TiDB cluster of the TidbInitializer
+type migrationStoreFactory func(ctx context.Context, ti *v1alpha1.TidbInitializer, tc *v1alpha1.TidbCluster) (migrationStore, error)
+
+// newSQLMigrationStore connects to the TiDB cluster as root, the password is the `root` key of the passwordSecret
+// which is set by the initialization Job.
+func (m *tidbInitManager) newSQLMigrationStore(ctx context.Context, ti *v1alpha1.TidbInitializer, tc *v1alpha1.TidbCluster) (migrationStore, error) {
+	var password string
+	if ti.Spec.PasswordSecret != nil {
+		secret, err := m.deps.SecretLister.Secrets(ti.Namespace).Get(*ti.Spec.PasswordSecret)
+		if err != nil {
+			return nil, fmt.Errorf("get password secret %s/%s failed: %v", ti.Namespace, *ti.Spec.PasswordSecret, err)
+		}
+		if data, ok := secret.Data[rootUser]; ok {
+			lines := strings.Split(string(data), "\n")
+			password = strings.TrimSuffix(lines[0], "\r")
+		}
+	}
+	db, err := openTiDB(ctx, m.deps.SecretLister, tc, ti.Spec.TLSClientSecretName, rootUser, password, true)
+	if err != nil {
+		return nil, err
+	}
+	database := ti.Spec.Migrations.Database
+	if database == "" {
+		database = defaultMigrationDatabase
+	}
+	return &sqlMigrationStore{db: db, database: database}, nil
+}
+
+// migrationRun is a run of the migrations of a TidbInitializer in the background
+type migrationRun struct {
+	done bool
+	// status is the status of the migrations after the run is done
+	status *v1alpha1.TidbMigrationStatus
+}
+
+// syncMigrations checks the migrations in the ConfigMaps against the metadata table and applies the pending ones
+// in the background, so a long migration does not block the controller. It returns the new status of the
+// migrations and a requeue error while they are running or to be retried, the other errors are reported in the
+// status so that the status of the initialization Job is still updated.
+func (m *tidbInitManager) syncMigrations(ti *v1alpha1.TidbInitializer, tc *v1alpha1.TidbCluster, phase v1alpha1.InitializePhase) (*v1alpha1.TidbMigrationStatus, error) {
+	status := &v1alpha1.TidbMigrationStatus{}
+	if ti.Status.Migration != nil {
+		status = ti.Status.Migration.DeepCopy()
+	}
+	ns, name := ti.Namespace, ti.Name
+	key := fmt.Sprintf("%s/%s", ns, name)
+
+	// the password of root may be changed by the initialization Job, so wait for it
+	if phase != v1alpha1.InitializePhaseCompleted {
+		if status.Phase == "" {
+			status.Phase = v1alpha1.MigrationPhasePending
+		}
+		return status, nil
+	}
+
+	m.migrationRunsLock.Lock()
+	run, ok := m.migrationRuns[key]
+	if ok && run.done {
+		delete(m.migrationRuns, key)
+	}
+	m.migrationRunsLock.Unlock()
+	if ok {
+		if !run.done {
+			status.Phase = v1alpha1.MigrationPhaseRunning
+			return status, controller.RequeueErrorf("TidbInitializer %s/%s: migrations are running", ns, name)
+		}
+		status = run.status
+		// the run failed for a transient error, retry it with the backoff of the requeue
+		if status.Phase == v1alpha1.MigrationPhaseRunning {
+			return status, controller.RequeueErrorf("TidbInitializer %s/%s: retry migrations, error: %s", ns, name, status.Message)
+		}
+	}
+
+	migrations, err := m.loadMigrations(ti)
+	if err != nil {
+		klog.Errorf("TidbInitializer %s/%s: failed to load migrations, error: %v", ns, name, err)
+		status.Phase = v1alpha1.MigrationPhaseFailed
+		status.Message = err.Error()
+		return status, nil
+	}
+	checksum := migrationsChecksum(ti.Spec.Migrations, migrations)
+	// the migrations are checked against TiDB only when the ConfigMaps change. The status is Failed only if
+	// the migrations can't be applied without manual intervention, e.g. a migration fails for a SQL error,
+	// it is not retried until it's fixed since it may be partially applied and fail again.
+	if checksum == status.Checksum &&
+		(status.Phase == v1alpha1.MigrationPhaseCompleted || status.Phase == v1alpha1.MigrationPhaseFailed) {
+		return status, nil
+	}
+
+	m.migrationRunsLock.Lock()
+	run = &migrationRun{}
+	m.migrationRuns[key] = run
+	m.migrationRunsLock.Unlock()
+	go func(ti *v1alpha1.TidbInitializer, tc *v1alpha1.TidbCluster, status *v1alpha1.TidbMigrationStatus) {
+		m.runMigrations(ti, tc, migrations, checksum, status)
+		m.migrationRunsLock.Lock()
+		run.status = status
+		run.done = true
+		m.migrationRunsLock.Unlock()
+	}(ti.DeepCopy(), tc.DeepCopy(), status.DeepCopy())
+
+	status.Phase = v1alpha1.MigrationPhaseRunning
+	status.Message = ""
+	return status, controller.RequeueErrorf("TidbInitializer %s/%s: migrations are running", ns, name)
+}
+
+// runMigrations connects to TiDB and applies the pending migrations, the result is set in status.
+// The phase is kept Running if the migrations should be retried, and the checksum is recorded only
+// if they are not to be retried.
+func (m *tidbInitManager) runMigrations(ti *v1alpha1.TidbInitializer, tc *v1alpha1.TidbCluster, migrations []*migration, checksum string, status *v1alpha1.TidbMigrationStatus) {
+	ns, name := ti.Namespace, ti.Name
+	ctx := context.Background()
+	store, err := m.migrationStoreFactory(ctx, ti, tc)
+	if err != nil {
+		// TiDB may be unavailable temporarily
+		klog.Errorf("TidbInitializer %s/%s: failed to connect to TiDB for migrations, error: %v", ns, name, err)
+		status.Phase = v1alpha1.MigrationPhaseRunning
+		status.Message = err.Error()
+		return
+	}
+	defer store.Close()
+
+	now := metav1.Now()
+	status.LastSyncTime = &now
+	if err = applyMigrations(ctx, store, ti, migrations, status); err != nil {
+		klog.Errorf("TidbInitializer %s/%s: failed to apply migrations, error: %v", ns, name, err)
+		status.Message = err.Error()
+		if status.Phase == v1alpha1.MigrationPhaseRunning {
+			return
+		}
+	}
+	status.Checksum = checksum
+}
+
+// applyMigrations applies the pending migrations in order and updates the status.
+// The phase is set to Failed if the migrations can't be applied without manual intervention,
+// i.e. they are not valid or a migration fails for a SQL error, or kept Running for the other
+// errors such as the connection errors, which are transient.
+func applyMigrations(ctx context.Context, store migrationStore, ti *v1alpha1.TidbInitializer, migrations []*migration, status *v1alpha1.TidbMigrationStatus) error {
+	status.Phase = v1alpha1.MigrationPhaseRunning
+	status.FailedVersion = ""
+	status.Message = ""
+
+	if err := store.EnsureTable(ctx); err != nil {
+		return fmt.Errorf("create migration table failed: %v", err)
+	}
+	applied, err := store.ListApplied(ctx)
+	if err != nil {
+		return fmt.Errorf("list applied migrations failed: %v", err)
+	}
+
+	pending, mismatched, latest, err := planMigrations(ti.Spec.Migrations, migrations, applied)
+	status.AppliedCount = int32(len(applied))
+	status.PendingCount = int32(len(pending))
+	status.AppliedVersion = latest
+	status.MismatchedVersions = mismatched
+	if err != nil {
+		status.Phase = v1alpha1.MigrationPhaseFailed
+		return err
+	}
+	if len(mismatched) > 0 && ti.Spec.Migrations.OnChecksumMismatch != v1alpha1.ChecksumMismatchPolicyIgnore {
+		status.Phase = v1alpha1.MigrationPhaseFailed
+		return fmt.Errorf("checksums of applied migrations %s are changed", strings.Join(mismatched, ", "))
+	}
+
+	for _, mg := range pending {
+		startTime := time.Now()
+		if err = store.Apply(ctx, mg, ti.Name); err != nil {
+			if isSQLError(err) {
+				status.Phase = v1alpha1.MigrationPhaseFailed
+				status.FailedVersion = mg.Version
+			}
+			return fmt.Errorf("apply migration %s (%s) failed: %v", mg.Version, mg.Script, err)
+		}
+		klog.Infof("TidbInitializer %s/%s: migration %s (%s) applied, took %v", ti.Namespace, ti.Name, mg.Version, mg.Script, time.Since(startTime))
+		status.AppliedCount++
+		status.PendingCount--
+		if latest == "" || compareVersionParts(mg.versionParts, parseVersionParts(latest)) > 0 {
+			latest = mg.Version
+			status.AppliedVersion = latest
+		}
+	}
+
+	status.Phase = v1alpha1.MigrationPhaseCompleted
+	return nil
+}
+
+// planMigrations returns the pending migrations, the versions of the applied migrations whose checksums are changed
+// and the latest applied version. The applied migrations not in the ConfigMaps are ignored, so the old migrations
+// can be removed from the ConfigMaps after they are applied.
+func planMigrations(spec *v1alpha1.TidbMigrationSpec, migrations []*migration, applied []appliedMigration) ([]*migration, []string, string, error) {
+	appliedChecksums := make(map[string]string, len(applied))
+	var latest string
+	var latestParts []uint64
+	for _, a := range applied {
+		appliedChecksums[a.Version] = a.Checksum
+		parts := parseVersionParts(a.Version)
+		if latest == "" || compareVersionParts(parts, latestParts) > 0 {
+			latest, latestParts = a.Version, parts
+		}
+	}
+
+	var pending []*migration
+	var mismatched []string
+	for _, mg := range migrations {
+		checksum, ok := appliedChecksums[mg.Version]
+		if !ok {
+			pending = append(pending, mg)
+			continue
+		}
+		if checksum != mg.Checksum {
+			mismatched = append(mismatched, mg.Version)
+		}
+	}
+
+	if !spec.OutOfOrder && latest != "" {
+		for _, mg := range pending {
+			if compareVersionParts(mg.versionParts, latestParts) < 0 {
+				return pending, mismatched, latest, fmt.Errorf("migration %s (%s) is older than the latest applied version %s, set outOfOrder to apply it", mg.Version, mg.Script, latest)
+			}
+		}
+	}
+	return pending, mismatched, latest, nil
+}
+
+// loadMigrations loads the migrations in the ConfigMaps and sorts them by version
+func (m *tidbInitManager) loadMigrations(ti *v1alpha1.TidbInitializer) ([]*migration, error) {
+	var cms []*corev1.ConfigMap
+	for _, name := range ti.Spec.Migrations.ConfigMaps {
+		// the ConfigMaps of the users are not in the informer cache which only watches the ConfigMaps of the operator
+		cm, err := m.deps.KubeClientset.CoreV1().ConfigMaps(ti.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("get migration configmap %s/%s failed: %v", ti.Namespace, name, err)
+		}
+		cms = append(cms, cm)
+	}
+	return parseMigrations(cms)
+}
+
+// parseMigrations parses the migrations in the ConfigMaps, the keys not named as migrations are ignored
+func parseMigrations(cms []*corev1.ConfigMap) ([]*migration, error) {
+	var migrations []*migration
+	scripts := map[string]string{}
+	for _, cm := range cms {
+		for key, data := range cm.Data {
+			matches := migrationFileRegexp.FindStringSubmatch(key)
+			if matches == nil {
+				continue
+			}
+			parts := parseVersionParts(matches[1])
+			if parts == nil {
+				return nil, fmt.Errorf("invalid version of migration %s/%s", cm.Name, key)
+			}
+			mg := &migration{
+				Version:      formatVersionParts(parts),
+				Description:  strings.ReplaceAll(matches[2], "_", " "),
+				Script:       fmt.Sprintf("%s/%s", cm.Name, key),
+				Checksum:     sqlChecksum(data),
+				SQL:          data,
+				versionParts: parts,
+			}
+			if exist, ok := scripts[mg.Version]; ok {
+				return nil, fmt.Errorf("duplicated migration version %s in %s and %s", mg.Version, exist, mg.Script)
+			}
+			scripts[mg.Version] = mg.Script
+			migrations = append(migrations, mg)
+		}
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return compareVersionParts(migrations[i].versionParts, migrations[j].versionParts) < 0
+	})
+	return migrations, nil
+}
+
+// parseVersionParts parses a version like `1.2` or `1_2`, the trailing zero parts are removed
+// so that `1` and `1.0` are the same version. It returns nil if the version is invalid.
+func parseVersionParts(version string) []uint64 {
+	fields := strings.FieldsFunc(version, func(r rune) bool { return r == '.' || r == '_' })
+	if len(fields) == 0 {
+		return nil
+	}
+	parts := make([]uint64, 0, len(fields))
+	for _, f := range fields {
+		n, err := strconv.ParseUint(f, 10, 64)
+		if err != nil {
+			return nil
+		}
+		parts = append(parts, n)
+	}
+	for len(parts) > 1 && parts[len(parts)-1] == 0 {
+		parts = parts[:len(parts)-1]
+	}
+	return parts
+}
+
+func formatVersionParts(parts []uint64) string {
+	strs := make([]string, 0, len(parts))
+	for _, p := range parts {
+		strs = append(strs, strconv.FormatUint(p, 10))
+	}
+	return strings.Join(strs, ".")
+}
+
+func compareVersionParts(a, b []uint64) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y uint64
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// sqlChecksum returns the checksum of a migration, the line endings are normalized
+// so that the checksum does not change if the ConfigMap is edited on another platform
+func sqlChecksum(data string) string {
+	sum := sha256.Sum256([]byte(strings.ReplaceAll(data, "\r\n", "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// migrationsChecksum returns the checksum of all the migrations and the spec affecting how they are applied
+func migrationsChecksum(spec *v1alpha1.TidbMigrationSpec, migrations []*migration) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n%t\n", spec.Database, spec.OnChecksumMismatch, spec.OutOfOrder)
+	for _, mg := range migrations {
+		fmt.Fprintf(h, "%s\n%s\n%s\n", mg.Version, mg.Script, mg.Checksum)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// sqlMigrationStore records the applied migrations in the `schema_migrations` table of the database
+type sqlMigrationStore struct {
+	db       *sql.DB
+	database string
+}
+
+func (s *sqlMigrationStore) table() string {
+	return fmt.Sprintf("%s.%s", quoteIdentifier(s.database), quoteIdentifier(migrationTable))
+}
+
+func (s *sqlMigrationStore) EnsureTable(ctx context.Context) error {
+	if _, err := s.db.ExecContext(ctx, fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", quoteIdentifier(s.database))); err != nil {
+		return err
+	}
+	_, err := s.db.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+  version VARCHAR(64) NOT NULL PRIMARY KEY,
+  description VARCHAR(255) NOT NULL,
+  script VARCHAR(512) NOT NULL,
+  checksum CHAR(64) NOT NULL,
+  applied_by VARCHAR(255) NOT NULL,
+  applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  execution_time_ms BIGINT NOT NULL
+)`, s.table()))
+	return err
+}
+
+func (s *sqlMigrationStore) ListApplied(ctx context.Context) ([]appliedMigration, error) {
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf("SELECT version, checksum FROM %s", s.table()))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var applied []appliedMigration
+	for rows.Next() {
+		a := appliedMigration{}
+		if err = rows.Scan(&a.Version, &a.Checksum); err != nil {
+			return nil, err
+		}
+		applied = append(applied, a)
+	}
+	return applied, rows.Err()
+}
+
+// Apply executes the statements of the migration in a connection, so the session variables set by the
+// migration are kept until it ends. Most DDLs of TiDB are not transactional, so the migrations should
+// be written to be idempotent in case they fail halfway.
+func (s *sqlMigrationStore) Apply(ctx context.Context, mg *migration, appliedBy string) error {
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	startTime := time.Now()
+	if strings.TrimSpace(mg.SQL) != "" {
+		if _, err = conn.ExecContext(ctx, mg.SQL); err != nil {
+			return err
+		}
+	}
+	_, err = conn.ExecContext(ctx,
+		fmt.Sprintf("INSERT INTO %s (version, description, script, checksum, applied_by, execution_time_ms) VALUES (?, ?, ?, ?, ?, ?)", s.table()),
+		mg.Version, mg.Description, mg.Script, mg.Checksum, appliedBy, time.Since(startTime).Milliseconds())
+	return err
+}
+
+func (s *sqlMigrationStore) Close() error {
+	return s.db.Close()
+}
+
+// isSQLError returns whether the error is returned by TiDB for the statements, the other errors such as
+// the connection errors and timeouts may succeed if retried
+func isSQLError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr)
+}
+
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
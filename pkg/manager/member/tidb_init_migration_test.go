@@ -0,0 +1,390 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+)
+
+type fakeMigrationStore struct {
+	applied  []appliedMigration
+	executed []string
+	failOn   string
+	// failErr is the error of the migration failOn, it defaults to a SQL error
+	failErr error
+}
+
+func (s *fakeMigrationStore) EnsureTable(_ context.Context) error {
+	return nil
+}
+
+func (s *fakeMigrationStore) ListApplied(_ context.Context) ([]appliedMigration, error) {
+	return s.applied, nil
+}
+
+func (s *fakeMigrationStore) Apply(_ context.Context, mg *migration, _ string) error {
+	if mg.Version == s.failOn {
+		if s.failErr != nil {
+			return s.failErr
+		}
+		return &mysql.MySQLError{Number: 1064, Message: "syntax error"}
+	}
+	s.executed = append(s.executed, mg.Version)
+	s.applied = append(s.applied, appliedMigration{Version: mg.Version, Checksum: mg.Checksum})
+	return nil
+}
+
+func (s *fakeMigrationStore) Close() error {
+	return nil
+}
+
+func TestParseMigrations(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cms := []*corev1.ConfigMap{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "schema"},
+			Data: map[string]string{
+				"V1__init.sql":              "CREATE DATABASE app;",
+				"V1.10__add_index.sql":      "ALTER TABLE app.t ADD INDEX idx(a);",
+				"V1_2__create_orders.sql":   "CREATE TABLE app.orders (id INT);",
+				"README.md":                 "ignored",
+				"v3__lower_case_prefix.sql": "ignored",
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "users"},
+			Data: map[string]string{
+				"V2__create_user.sql": "CREATE USER app;",
+			},
+		},
+	}
+	migrations, err := parseMigrations(cms)
+	g.Expect(err).NotTo(HaveOccurred())
+	var versions []string
+	for _, mg := range migrations {
+		versions = append(versions, mg.Version)
+	}
+	g.Expect(versions).To(Equal([]string{"1", "1.2", "1.10", "2"}))
+	g.Expect(migrations[1].Description).To(Equal("create orders"))
+	g.Expect(migrations[1].Script).To(Equal("schema/V1_2__create_orders.sql"))
+	g.Expect(migrations[3].Script).To(Equal("users/V2__create_user.sql"))
+
+	// `1` and `1.0` are the same version
+	cms = append(cms, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "dup"},
+		Data:       map[string]string{"V1.0__dup.sql": ""},
+	})
+	_, err = parseMigrations(cms)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("duplicated migration version 1"))
+}
+
+func TestSQLChecksum(t *testing.T) {
+	g := NewGomegaWithT(t)
+	g.Expect(sqlChecksum("SELECT 1;\r\nSELECT 2;")).To(Equal(sqlChecksum("SELECT 1;\nSELECT 2;")))
+	g.Expect(sqlChecksum("SELECT 1;")).NotTo(Equal(sqlChecksum("SELECT 2;")))
+}
+
+func TestApplyMigrations(t *testing.T) {
+	newMigrations := func(versions ...string) []*migration {
+		var ret []*migration
+		for _, v := range versions {
+			parts := parseVersionParts(v)
+			ret = append(ret, &migration{
+				Version:      v,
+				Script:       fmt.Sprintf("cm/V%s__m.sql", v),
+				Checksum:     sqlChecksum(v),
+				versionParts: parts,
+			})
+		}
+		return ret
+	}
+	applied := func(versions ...string) []appliedMigration {
+		var ret []appliedMigration
+		for _, v := range versions {
+			ret = append(ret, appliedMigration{Version: v, Checksum: sqlChecksum(v)})
+		}
+		return ret
+	}
+
+	cases := []struct {
+		name       string
+		spec       v1alpha1.TidbMigrationSpec
+		migrations []*migration
+		applied    []appliedMigration
+		failOn     string
+		failErr    error
+
+		expectErr        bool
+		expectPhase      v1alpha1.MigrationPhase
+		expectExecuted   []string
+		expectApplied    int32
+		expectPending    int32
+		expectVersion    string
+		expectFailed     string
+		expectMismatched []string
+	}{
+		{
+			name:           "apply all",
+			migrations:     newMigrations("1", "1.1", "2"),
+			expectPhase:    v1alpha1.MigrationPhaseCompleted,
+			expectExecuted: []string{"1", "1.1", "2"},
+			expectApplied:  3,
+			expectVersion:  "2",
+		},
+		{
+			name:           "apply new migrations only",
+			migrations:     newMigrations("1", "2", "3"),
+			applied:        applied("1", "2"),
+			expectPhase:    v1alpha1.MigrationPhaseCompleted,
+			expectExecuted: []string{"3"},
+			expectApplied:  3,
+			expectVersion:  "3",
+		},
+		{
+			name:          "removed migrations are ignored",
+			migrations:    newMigrations("3"),
+			applied:       applied("1", "2", "3"),
+			expectPhase:   v1alpha1.MigrationPhaseCompleted,
+			expectApplied: 3,
+			expectVersion: "3",
+		},
+		{
+			name:           "stop at the failed migration",
+			migrations:     newMigrations("1", "2", "3"),
+			failOn:         "2",
+			expectErr:      true,
+			expectPhase:    v1alpha1.MigrationPhaseFailed,
+			expectExecuted: []string{"1"},
+			expectApplied:  1,
+			expectPending:  2,
+			expectVersion:  "1",
+			expectFailed:   "2",
+		},
+		{
+			name:           "keep running on the connection error",
+			migrations:     newMigrations("1", "2", "3"),
+			failOn:         "2",
+			failErr:        driver.ErrBadConn,
+			expectErr:      true,
+			expectPhase:    v1alpha1.MigrationPhaseRunning,
+			expectExecuted: []string{"1"},
+			expectApplied:  1,
+			expectPending:  2,
+			expectVersion:  "1",
+		},
+		{
+			name:          "reject out of order migrations",
+			migrations:    newMigrations("1", "2", "3"),
+			applied:       applied("1", "3"),
+			expectErr:     true,
+			expectPhase:   v1alpha1.MigrationPhaseFailed,
+			expectApplied: 2,
+			expectPending: 1,
+			expectVersion: "3",
+		},
+		{
+			name:           "allow out of order migrations",
+			spec:           v1alpha1.TidbMigrationSpec{OutOfOrder: true},
+			migrations:     newMigrations("1", "2", "3"),
+			applied:        applied("1", "3"),
+			expectPhase:    v1alpha1.MigrationPhaseCompleted,
+			expectExecuted: []string{"2"},
+			expectApplied:  3,
+			expectVersion:  "3",
+		},
+		{
+			name:       "fail on checksum mismatch",
+			migrations: newMigrations("1", "2"),
+			applied: []appliedMigration{
+				{Version: "1", Checksum: "changed"},
+			},
+			expectErr:        true,
+			expectPhase:      v1alpha1.MigrationPhaseFailed,
+			expectApplied:    1,
+			expectPending:    1,
+			expectVersion:    "1",
+			expectMismatched: []string{"1"},
+		},
+		{
+			name:       "ignore checksum mismatch",
+			spec:       v1alpha1.TidbMigrationSpec{OnChecksumMismatch: v1alpha1.ChecksumMismatchPolicyIgnore},
+			migrations: newMigrations("1", "2"),
+			applied: []appliedMigration{
+				{Version: "1", Checksum: "changed"},
+			},
+			expectPhase:      v1alpha1.MigrationPhaseCompleted,
+			expectExecuted:   []string{"2"},
+			expectApplied:    2,
+			expectVersion:    "2",
+			expectMismatched: []string{"1"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			ti := newTidbInitializerForTiDB()
+			spec := c.spec
+			ti.Spec.Migrations = &spec
+			store := &fakeMigrationStore{applied: c.applied, failOn: c.failOn, failErr: c.failErr}
+			status := &v1alpha1.TidbMigrationStatus{}
+
+			err := applyMigrations(context.TODO(), store, ti, c.migrations, status)
+			if c.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(status.Phase).To(Equal(c.expectPhase))
+			g.Expect(store.executed).To(Equal(c.expectExecuted))
+			g.Expect(status.AppliedCount).To(Equal(c.expectApplied))
+			g.Expect(status.PendingCount).To(Equal(c.expectPending))
+			g.Expect(status.AppliedVersion).To(Equal(c.expectVersion))
+			g.Expect(status.FailedVersion).To(Equal(c.expectFailed))
+			g.Expect(status.MismatchedVersions).To(Equal(c.expectMismatched))
+		})
+	}
+}
+
+func TestSyncMigrations(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tim, _, _ := newFakeTiDBInitManager()
+	store := &fakeMigrationStore{}
+	connects := 0
+	var connectErr error
+	tim.migrationStoreFactory = func(_ context.Context, _ *v1alpha1.TidbInitializer, _ *v1alpha1.TidbCluster) (migrationStore, error) {
+		connects++
+		if connectErr != nil {
+			return nil, connectErr
+		}
+		return store, nil
+	}
+
+	ti := newTidbInitializerForTiDB()
+	ti.Spec.Migrations = &v1alpha1.TidbMigrationSpec{ConfigMaps: []string{"schema"}}
+	tc := newTidbClusterForTiDB()
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "schema", Namespace: ti.Namespace},
+		Data:       map[string]string{"V1__init.sql": "CREATE DATABASE app;"},
+	}
+	_, err := tim.deps.KubeClientset.CoreV1().ConfigMaps(ti.Namespace).Create(context.TODO(), cm, metav1.CreateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// syncUntilDone syncs the migrations until they are not running in the background or to be retried
+	syncUntilDone := func() *v1alpha1.TidbMigrationStatus {
+		var status *v1alpha1.TidbMigrationStatus
+		g.Eventually(func() error {
+			var err error
+			status, err = tim.syncMigrations(ti, tc, v1alpha1.InitializePhaseCompleted)
+			if err != nil {
+				g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+				g.Expect(status.Phase).To(Equal(v1alpha1.MigrationPhaseRunning))
+			}
+			return err
+		}, 5*time.Second, 10*time.Millisecond).Should(Succeed())
+		return status
+	}
+
+	// wait for the initialization Job
+	status, err := tim.syncMigrations(ti, tc, v1alpha1.InitializePhaseRunning)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status.Phase).To(Equal(v1alpha1.MigrationPhasePending))
+	g.Expect(connects).To(Equal(0))
+
+	// the migrations run in the background
+	status, err = tim.syncMigrations(ti, tc, v1alpha1.InitializePhaseCompleted)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(status.Phase).To(Equal(v1alpha1.MigrationPhaseRunning))
+	status = syncUntilDone()
+	g.Expect(status.Phase).To(Equal(v1alpha1.MigrationPhaseCompleted))
+	g.Expect(status.AppliedVersion).To(Equal("1"))
+	g.Expect(status.LastSyncTime).NotTo(BeNil())
+	g.Expect(connects).To(Equal(1))
+
+	// TiDB is not checked again if the ConfigMaps are not changed
+	ti.Status.Migration = status
+	status, err = tim.syncMigrations(ti, tc, v1alpha1.InitializePhaseCompleted)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status).To(Equal(ti.Status.Migration))
+	g.Expect(connects).To(Equal(1))
+
+	// the new migration is applied when the ConfigMap changes, and retried if TiDB is unavailable
+	cm.Data["V2__create_table.sql"] = "CREATE TABLE app.t (a INT);"
+	_, err = tim.deps.KubeClientset.CoreV1().ConfigMaps(ti.Namespace).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	connectErr = fmt.Errorf("connection refused")
+	g.Eventually(func() string {
+		status, err = tim.syncMigrations(ti, tc, v1alpha1.InitializePhaseCompleted)
+		g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+		return status.Message
+	}, 5*time.Second, 10*time.Millisecond).Should(Equal("connection refused"))
+	g.Expect(status.Phase).To(Equal(v1alpha1.MigrationPhaseRunning))
+	g.Expect(status.Checksum).To(Equal(ti.Status.Migration.Checksum))
+	ti.Status.Migration = status
+	connectErr = nil
+	status = syncUntilDone()
+	g.Expect(status.Phase).To(Equal(v1alpha1.MigrationPhaseCompleted))
+	g.Expect(status.Message).To(BeEmpty())
+	g.Expect(status.AppliedVersion).To(Equal("2"))
+	g.Expect(status.AppliedCount).To(Equal(int32(2)))
+	g.Expect(store.executed).To(Equal([]string{"1", "2"}))
+	g.Expect(connects).To(Equal(3))
+
+	// the failed migration for the SQL error is not retried until it's fixed
+	ti.Status.Migration = status
+	store.failOn = "3"
+	cm.Data["V3__create_index.sql"] = "CREATE INDEX idx ON app.t (b);"
+	_, err = tim.deps.KubeClientset.CoreV1().ConfigMaps(ti.Namespace).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	status = syncUntilDone()
+	g.Expect(status.Phase).To(Equal(v1alpha1.MigrationPhaseFailed))
+	g.Expect(status.FailedVersion).To(Equal("3"))
+	g.Expect(connects).To(Equal(4))
+	ti.Status.Migration = status
+	status, err = tim.syncMigrations(ti, tc, v1alpha1.InitializePhaseCompleted)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status).To(Equal(ti.Status.Migration))
+	g.Expect(connects).To(Equal(4))
+	store.failOn = ""
+	delete(cm.Data, "V3__create_index.sql")
+	_, err = tim.deps.KubeClientset.CoreV1().ConfigMaps(ti.Namespace).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	status = syncUntilDone()
+	g.Expect(status.Phase).To(Equal(v1alpha1.MigrationPhaseCompleted))
+	g.Expect(status.FailedVersion).To(BeEmpty())
+
+	// the drift of an applied migration is reported
+	ti.Status.Migration = status
+	cm.Data["V1__init.sql"] = "CREATE DATABASE app2;"
+	_, err = tim.deps.KubeClientset.CoreV1().ConfigMaps(ti.Namespace).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	status = syncUntilDone()
+	g.Expect(status.Phase).To(Equal(v1alpha1.MigrationPhaseFailed))
+	g.Expect(status.MismatchedVersions).To(Equal([]string{"1"}))
+	g.Expect(status.Message).To(ContainSubstring("checksums of applied migrations 1 are changed"))
+}
@@ -600,6 +600,10 @@ func getTiDBConfigMap(tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
 	if tc.Spec.TiDB.IsBootstrapSQLEnabled() {
 		config.Set("initialize-sql-file", path.Join(bootstrapSQLFilePath, bootstrapSQLFileName))
 	}
+	// TiDB must know TiFlash works in the disaggregated mode to send MPP tasks to the compute nodes
+	if tc.TiFlashDisaggregated() {
+		config.SetIfNil("disaggregated-tiflash", true)
+	}
 
 	// `DefaultTiDBServerPort`/`DefaultTiDBStatusPort` may be changed when building the binary
 	if v1alpha1.DefaultTiDBServerPort != int32(4000) {
@@ -623,6 +627,9 @@ func getTiDBConfigMap(tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
 		"config-file":    string(confText),
 		"startup-script": startScript,
 	}
+	if err := addLogShipperConfig(tc, v1alpha1.TiDBMemberType, data); err != nil {
+		return nil, fmt.Errorf("render log shipper config for tc %s/%s failed: %v", tc.Namespace, tc.Name, err)
+	}
 	name := controller.TiDBMemberName(tc.Name)
 	instanceName := tc.GetInstanceName()
 	tidbLabels := label.New().Instance(instanceName).TiDB().Labels()
@@ -1092,9 +1099,15 @@ func getNewTiDBSetForTidbCluster(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap)
 
 	containers = append(containers, c)
 
+	logShipper, logShipperVols, err := buildLogShipperContainer(tc, v1alpha1.TiDBMemberType, cm, c.VolumeMounts)
+	if err != nil {
+		return nil, err
+	}
+	containers, initContainers = appendLogShipperContainer(logShipper, containers, initContainers)
+	vols = append(vols, logShipperVols...)
+
 	podSpec := baseTiDBSpec.BuildPodSpec()
 
-	var err error
 	podSpec.Containers, err = MergePatchContainers(containers, baseTiDBSpec.AdditionalContainers())
 	if err != nil {
 		return nil, fmt.Errorf("failed to merge containers spec for TiDB of [%s/%s], error: %v", ns, tcName, err)
@@ -0,0 +1,101 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	corev1 "k8s.io/api/core/v1"
+	corelisterv1 "k8s.io/client-go/listers/core/v1"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/util"
+)
+
+const (
+	tidbSQLConnectTimeout = 5 * time.Second
+	tidbSQLReadTimeout    = 5 * time.Minute
+)
+
+// openTiDB connects to the TiDB service of the cluster as the user. If TLS is enabled for the MySQL clients
+// and not skipped by `tidb.pingcap.com/skip-tls-when-connect-tidb`, the client certificate in the
+// `tlsClientSecretName` Secret, or the `<cluster>-tidb-client-secret` Secret by default, is used.
+// Multiple statements in one query are only allowed if multiStatements is true.
+func openTiDB(ctx context.Context, secretLister corelisterv1.SecretLister, tc *v1alpha1.TidbCluster,
+	tlsClientSecretName *string, user, password string, multiStatements bool) (*sql.DB, error) {
+	cfg := mysql.NewConfig()
+	cfg.User = user
+	cfg.Passwd = password
+	cfg.Net = "tcp"
+	cfg.Addr = fmt.Sprintf("%s.%s.svc:%d", controller.TiDBMemberName(tc.Name), tc.Namespace, tc.Spec.TiDB.GetServicePort())
+	cfg.Timeout = tidbSQLConnectTimeout
+	cfg.ReadTimeout = tidbSQLReadTimeout
+	cfg.MultiStatements = multiStatements
+	cfg.ParseTime = true
+	cfg.Params = map[string]string{"charset": "utf8mb4"}
+
+	if tc.Spec.TiDB.IsTLSClientEnabled() && !tc.SkipTLSWhenConnectTiDB() {
+		secretName := util.TiDBClientTLSSecretName(tc.Name, tlsClientSecretName)
+		secret, err := secretLister.Secrets(tc.Namespace).Get(secretName)
+		if err != nil {
+			return nil, fmt.Errorf("get tidb client tls secret %s/%s failed: %v", tc.Namespace, secretName, err)
+		}
+		tlsCfg, err := loadTiDBClientTLSConfig(secret, tc.Spec.TiDB.TLSClient.SkipInternalClientCA)
+		if err != nil {
+			return nil, err
+		}
+		cfg.TLS = tlsCfg
+	}
+
+	connector, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return nil, err
+	}
+	db := sql.OpenDB(connector)
+	if err = db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("cannot connect to tidb cluster %s/%s, err: %v", tc.Namespace, tc.Name, err)
+	}
+	return db, nil
+}
+
+// loadTiDBClientTLSConfig loads the client certificate in the Secret, the CA is not required if skipCA is true
+// which is the same as the `skipInternalClientCA` of the other clients of TiDB.
+func loadTiDBClientTLSConfig(secret *corev1.Secret, skipCA bool) (*tls.Config, error) {
+	cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, fmt.Errorf("unable to load certificates from secret %s/%s: %v", secret.Namespace, secret.Name, err)
+	}
+	tlsCfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if skipCA {
+		tlsCfg.InsecureSkipVerify = true // nolint: gosec
+		return tlsCfg, nil
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(secret.Data[corev1.ServiceAccountRootCAKey]) {
+		return nil, fmt.Errorf("failed to append ca certs from secret %s/%s", secret.Namespace, secret.Name)
+	}
+	tlsCfg.RootCAs = rootCAs
+	return tlsCfg, nil
+}
@@ -0,0 +1,524 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	v1alpha1validation "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1/validation"
+	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+)
+
+const (
+	defaultTidbUserHost           = "%"
+	defaultResourceGroup          = "default"
+	tidbUserAdminUserKey          = "user"
+	tidbUserSyncTimeout           = time.Minute
+	mysqlErrNonexistingGrant      = 1141
+	mysqlErrNonexistingTableGrant = 1147
+)
+
+var privilegePattern = regexp.MustCompile(`^[A-Z]+( [A-Z]+)*$`)
+
+// UserManager implements the logic for syncing TidbUser.
+type UserManager interface {
+	// Sync creates or alters the user in TiDB to match the spec and records what is applied in the status.
+	Sync(*v1alpha1.TidbUser) error
+	// Revoke drops the user from TiDB.
+	Revoke(*v1alpha1.TidbUser) error
+}
+
+// userExecutor executes the account management statements in TiDB.
+type userExecutor interface {
+	Exec(ctx context.Context, stmt string) error
+	Close() error
+}
+
+// userExecutorFactory connects to the TiDB cluster with the admin account of the TidbUser.
+type userExecutorFactory func(ctx context.Context, tu *v1alpha1.TidbUser, tc *v1alpha1.TidbCluster) (userExecutor, error)
+
+type tidbUserManager struct {
+	deps            *controller.Dependencies
+	executorFactory userExecutorFactory
+}
+
+// NewTiDBUserManager returns a UserManager which manages the users in TiDB with SQL.
+func NewTiDBUserManager(deps *controller.Dependencies) UserManager {
+	m := &tidbUserManager{deps: deps}
+	m.executorFactory = m.newSQLUserExecutor
+	return m
+}
+
+func (m *tidbUserManager) Sync(tu *v1alpha1.TidbUser) error {
+	desired, err := desiredTidbUser(tu)
+	if err != nil {
+		setTidbUserFailed(tu, err)
+		return controller.IgnoreErrorf("TidbUser %s/%s is invalid: %v", tu.Namespace, tu.Name, err)
+	}
+
+	tc, err := m.getCluster(tu)
+	if err != nil {
+		setTidbUserFailed(tu, err)
+		return err
+	}
+
+	password, version, err := m.getPassword(tu)
+	if err != nil {
+		setTidbUserFailed(tu, err)
+		return err
+	}
+
+	status := &tu.Status
+	if status.Phase == v1alpha1.TidbUserSynced && status.ObservedGeneration == tu.Generation &&
+		status.PasswordSecretVersion == version {
+		return nil
+	}
+
+	stmts := planTidbUser(status, desired, tu.Spec.Role, password, version != status.PasswordSecretVersion)
+
+	ctx, cancel := context.WithTimeout(context.Background(), tidbUserSyncTimeout)
+	defer cancel()
+	executor, err := m.executorFactory(ctx, tu, tc)
+	if err != nil {
+		setTidbUserFailed(tu, err)
+		return err
+	}
+	defer executor.Close()
+
+	if err := execUserStatements(ctx, executor, stmts); err != nil {
+		err = fmt.Errorf("sync user %s of TidbUser %s/%s failed: %v", desired.User, tu.Namespace, tu.Name, err)
+		setTidbUserFailed(tu, err)
+		return err
+	}
+	klog.Infof("TidbUser %s/%s: synced user %s to tidbcluster %s/%s, %d statements executed",
+		tu.Namespace, tu.Name, desired.User, tc.Namespace, tc.Name, len(stmts))
+
+	now := metav1.Now()
+	tu.Status = *desired
+	tu.Status.Phase = v1alpha1.TidbUserSynced
+	tu.Status.ObservedGeneration = tu.Generation
+	tu.Status.PasswordSecretVersion = version
+	tu.Status.LastSyncTime = &now
+	return nil
+}
+
+func (m *tidbUserManager) Revoke(tu *v1alpha1.TidbUser) error {
+	accounts := sets.New[string]()
+	for _, host := range tu.Status.Hosts {
+		accounts.Insert(account(tu.Status.User, host))
+	}
+	// the user may be created partially before the status is recorded
+	if desired, err := desiredTidbUser(tu); err == nil && (tu.Status.User == "" || tu.Status.User == desired.User) {
+		for _, host := range desired.Hosts {
+			accounts.Insert(account(desired.User, host))
+		}
+	}
+	if accounts.Len() == 0 {
+		return nil
+	}
+
+	if _, err := clusterNamespace(tu); err != nil {
+		klog.Warningf("TidbUser %s/%s: %v, skip dropping the user", tu.Namespace, tu.Name, err)
+		return nil
+	}
+	tc, err := m.getCluster(tu)
+	if apierrors.IsNotFound(err) {
+		klog.Infof("TidbUser %s/%s: tidbcluster is deleted, skip dropping the user", tu.Namespace, tu.Name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), tidbUserSyncTimeout)
+	defer cancel()
+	executor, err := m.executorFactory(ctx, tu, tc)
+	if err != nil {
+		return err
+	}
+	defer executor.Close()
+
+	drop := "DROP USER IF EXISTS "
+	if tu.Spec.Role {
+		drop = "DROP ROLE IF EXISTS "
+	}
+	if err := executor.Exec(ctx, drop+strings.Join(sets.List(accounts), ", ")); err != nil {
+		return fmt.Errorf("drop user of TidbUser %s/%s failed: %v", tu.Namespace, tu.Name, err)
+	}
+	klog.Infof("TidbUser %s/%s: dropped %s from tidbcluster %s/%s", tu.Namespace, tu.Name,
+		strings.Join(sets.List(accounts), ", "), tc.Namespace, tc.Name)
+	return nil
+}
+
+func (m *tidbUserManager) getCluster(tu *v1alpha1.TidbUser) (*v1alpha1.TidbCluster, error) {
+	ns, err := clusterNamespace(tu)
+	if err != nil {
+		return nil, err
+	}
+	tc, err := m.deps.TiDBClusterLister.TidbClusters(ns).Get(tu.Spec.Cluster.Name)
+	if err != nil {
+		return nil, err
+	}
+	if tc.Spec.TiDB == nil {
+		return nil, fmt.Errorf("tidbcluster %s/%s has no tidb", ns, tc.Name)
+	}
+	return tc, nil
+}
+
+// clusterNamespace returns the namespace of the cluster of the TidbUser, the cluster in another namespace
+// can only be accessed with the admin Secret in the namespace of the TidbUser, the `<cluster>-init` Secret
+// is never used for it.
+func clusterNamespace(tu *v1alpha1.TidbUser) (string, error) {
+	ns := tu.Spec.Cluster.Namespace
+	if ns == "" || ns == tu.Namespace {
+		return tu.Namespace, nil
+	}
+	if tu.Spec.AdminSecretName == nil {
+		return "", fmt.Errorf("adminSecretName must be set to manage the user of tidbcluster %s/%s in another namespace",
+			ns, tu.Spec.Cluster.Name)
+	}
+	return ns, nil
+}
+
+// getPassword returns the password of the user and the resource version of the Secret it comes from.
+func (m *tidbUserManager) getPassword(tu *v1alpha1.TidbUser) (string, string, error) {
+	ref := tu.Spec.PasswordSecret
+	if tu.Spec.Role || ref == nil {
+		return "", "", nil
+	}
+	secret, err := m.deps.SecretLister.Secrets(tu.Namespace).Get(ref.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) && ref.Optional != nil && *ref.Optional {
+			return "", "", nil
+		}
+		return "", "", fmt.Errorf("get password secret %s/%s failed: %v", tu.Namespace, ref.Name, err)
+	}
+	data, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", "", fmt.Errorf("key %s is not found in password secret %s/%s", ref.Key, tu.Namespace, ref.Name)
+	}
+	return string(data), secret.ResourceVersion, nil
+}
+
+// newSQLUserExecutor connects to TiDB with the account in the admin Secret, or as root with the password
+// in the `<cluster>-init` Secret if it is not set.
+func (m *tidbUserManager) newSQLUserExecutor(ctx context.Context, tu *v1alpha1.TidbUser, tc *v1alpha1.TidbCluster) (userExecutor, error) {
+	ns, name, user, passwordKey := tc.Namespace, controller.TiDBInitSecret(tc.Name), rootUser, constants.TidbRootKey
+	if tu.Spec.AdminSecretName != nil {
+		ns, name, passwordKey = tu.Namespace, *tu.Spec.AdminSecretName, constants.TidbPasswordKey
+	}
+	secret, err := m.deps.SecretLister.Secrets(ns).Get(name)
+	if err != nil {
+		return nil, fmt.Errorf("get admin secret %s/%s failed: %v", ns, name, err)
+	}
+	if u, ok := secret.Data[tidbUserAdminUserKey]; ok && tu.Spec.AdminSecretName != nil {
+		user = string(u)
+	}
+	// the account statements are executed one by one, multiple statements are not allowed in the connection
+	db, err := openTiDB(ctx, m.deps.SecretLister, tc, tu.Spec.TLSClientSecretName, user, string(secret.Data[passwordKey]), false)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlUserExecutor{db: db}, nil
+}
+
+type sqlUserExecutor struct {
+	db *sql.DB
+}
+
+func (e *sqlUserExecutor) Exec(ctx context.Context, stmt string) error {
+	_, err := e.db.ExecContext(ctx, stmt)
+	return err
+}
+
+func (e *sqlUserExecutor) Close() error {
+	return e.db.Close()
+}
+
+// userStatement is a statement to execute, REVOKE statements are marked so that
+// revoking a privilege which is already revoked is not an error.
+type userStatement struct {
+	sql    string
+	revoke bool
+}
+
+func execUserStatements(ctx context.Context, executor userExecutor, stmts []userStatement) error {
+	for _, stmt := range stmts {
+		err := executor.Exec(ctx, stmt.sql)
+		var mysqlErr *mysql.MySQLError
+		if stmt.revoke && errors.As(err, &mysqlErr) &&
+			(mysqlErr.Number == mysqlErrNonexistingGrant || mysqlErr.Number == mysqlErrNonexistingTableGrant) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// desiredTidbUser validates the spec and returns it in the normalized form that is recorded in the status.
+func desiredTidbUser(tu *v1alpha1.TidbUser) (*v1alpha1.TidbUserStatus, error) {
+	desired := &v1alpha1.TidbUserStatus{
+		User:          tu.Spec.UserName,
+		ResourceGroup: tu.Spec.ResourceGroup,
+	}
+	if errs := v1alpha1validation.ValidateTidbUser(tu); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+	if desired.User == "" {
+		desired.User = tu.Name
+	}
+	if desired.User == rootUser {
+		return nil, fmt.Errorf("user %s can't be managed by TidbUser", rootUser)
+	}
+	if tu.Spec.Role && tu.Spec.ResourceGroup != "" {
+		return nil, fmt.Errorf("resource group can't be set for a role")
+	}
+
+	hosts := sets.New(tu.Spec.Hosts...)
+	if hosts.Len() == 0 {
+		hosts.Insert(defaultTidbUserHost)
+	}
+	desired.Hosts = sets.List(hosts)
+
+	roles := sets.New[string]()
+	for _, role := range tu.Spec.Roles {
+		name, host := splitRole(role)
+		if name == "" {
+			return nil, fmt.Errorf("invalid role %q", role)
+		}
+		roles.Insert(name + "@" + host)
+	}
+	desired.Roles = sets.List(roles)
+
+	privileges := map[string]sets.Set[string]{}
+	for _, grant := range tu.Spec.Grants {
+		on, err := normalizeGrantObject(grant.On)
+		if err != nil {
+			return nil, err
+		}
+		if privileges[on] == nil {
+			privileges[on] = sets.New[string]()
+		}
+		for _, p := range grant.Privileges {
+			p = strings.Join(strings.Fields(strings.ToUpper(p)), " ")
+			if !privilegePattern.MatchString(p) {
+				return nil, fmt.Errorf("invalid privilege %q on %s", p, grant.On)
+			}
+			privileges[on].Insert(p)
+		}
+	}
+	for _, on := range sortedKeys(privileges) {
+		desired.Grants = append(desired.Grants, v1alpha1.TidbUserGrant{On: on, Privileges: sets.List(privileges[on])})
+	}
+	return desired, nil
+}
+
+// planTidbUser returns the statements which change the user from the applied state to the desired state.
+// The password is changed if passwordChanged is true, or for the accounts which are newly created.
+func planTidbUser(applied, desired *v1alpha1.TidbUserStatus, role bool, password string, passwordChanged bool) []userStatement {
+	var stmts []userStatement
+	add := func(revoke bool, format string, args ...interface{}) {
+		stmts = append(stmts, userStatement{sql: fmt.Sprintf(format, args...), revoke: revoke})
+	}
+	kind := "USER"
+	if role {
+		kind = "ROLE"
+	}
+
+	appliedHosts := sets.New[string]()
+	if applied.User == desired.User {
+		appliedHosts.Insert(applied.Hosts...)
+	}
+	var dropped []string
+	for _, host := range applied.Hosts {
+		if applied.User != desired.User || !sets.New(desired.Hosts...).Has(host) {
+			dropped = append(dropped, account(applied.User, host))
+		}
+	}
+	if len(dropped) > 0 {
+		add(false, "DROP %s IF EXISTS %s", kind, strings.Join(dropped, ", "))
+	}
+
+	for _, host := range desired.Hosts {
+		acc := account(desired.User, host)
+		created := !appliedHosts.Has(host)
+		// the account is created from scratch, everything in the spec is applied
+		base := applied
+		if created {
+			base = &v1alpha1.TidbUserStatus{}
+			add(false, "CREATE %s IF NOT EXISTS %s", kind, acc)
+		}
+		if password != "" && (created || passwordChanged) {
+			add(false, "ALTER USER %s IDENTIFIED BY %s", acc, quoteString(password))
+		}
+
+		revokes, grants := diffGrants(base.Grants, desired.Grants)
+		for _, g := range revokes {
+			add(true, "REVOKE %s ON %s FROM %s", strings.Join(g.Privileges, ", "), g.On, acc)
+		}
+		for _, g := range grants {
+			add(false, "GRANT %s ON %s TO %s", strings.Join(g.Privileges, ", "), g.On, acc)
+		}
+
+		revokedRoles := sets.List(sets.New(base.Roles...).Delete(desired.Roles...))
+		grantedRoles := sets.List(sets.New(desired.Roles...).Delete(base.Roles...))
+		if len(revokedRoles) > 0 {
+			add(true, "REVOKE %s FROM %s", roleAccounts(revokedRoles), acc)
+		}
+		if len(grantedRoles) > 0 {
+			add(false, "GRANT %s TO %s", roleAccounts(grantedRoles), acc)
+		}
+		if role {
+			continue
+		}
+		if len(revokedRoles) > 0 || len(grantedRoles) > 0 {
+			if len(desired.Roles) > 0 {
+				add(false, "SET DEFAULT ROLE ALL TO %s", acc)
+			} else {
+				add(false, "SET DEFAULT ROLE NONE TO %s", acc)
+			}
+		}
+		// the resource group statement is not supported before TiDB v7.1, so it is only
+		// executed if the resource group is set or changed
+		if desired.ResourceGroup != base.ResourceGroup {
+			rg := desired.ResourceGroup
+			if rg == "" {
+				rg = defaultResourceGroup
+			}
+			add(false, "ALTER USER %s RESOURCE GROUP %s", acc, quoteIdentifier(rg))
+		}
+	}
+	return stmts
+}
+
+// diffGrants returns the privileges to revoke and to grant, the grants are both normalized by desiredTidbUser.
+func diffGrants(applied, desired []v1alpha1.TidbUserGrant) (revokes, grants []v1alpha1.TidbUserGrant) {
+	toMap := func(gs []v1alpha1.TidbUserGrant) map[string]sets.Set[string] {
+		m := map[string]sets.Set[string]{}
+		for _, g := range gs {
+			m[g.On] = sets.New(g.Privileges...)
+		}
+		return m
+	}
+	appliedMap, desiredMap := toMap(applied), toMap(desired)
+	for _, on := range sortedKeys(appliedMap) {
+		if ps := appliedMap[on].Difference(desiredMap[on]); ps.Len() > 0 {
+			revokes = append(revokes, v1alpha1.TidbUserGrant{On: on, Privileges: sets.List(ps)})
+		}
+	}
+	for _, on := range sortedKeys(desiredMap) {
+		if ps := desiredMap[on].Difference(appliedMap[on]); ps.Len() > 0 {
+			grants = append(grants, v1alpha1.TidbUserGrant{On: on, Privileges: sets.List(ps)})
+		}
+	}
+	return revokes, grants
+}
+
+// normalizeGrantObject quotes the database and the table of `db.table`, `db.*` or `*.*`.
+func normalizeGrantObject(on string) (string, error) {
+	parts := strings.SplitN(on, ".", 2)
+	if len(parts) != 2 {
+		return "", fmt.Errorf("invalid grant object %q, it must be in the form of db.table", on)
+	}
+	for i, part := range parts {
+		part = strings.Trim(strings.TrimSpace(part), "`")
+		if part == "" {
+			return "", fmt.Errorf("invalid grant object %q, it must be in the form of db.table", on)
+		}
+		if part != "*" {
+			part = quoteIdentifier(part)
+		}
+		parts[i] = part
+	}
+	if parts[0] == "*" && parts[1] != "*" {
+		return "", fmt.Errorf("invalid grant object %q, the table must be * for all databases", on)
+	}
+	return parts[0] + "." + parts[1], nil
+}
+
+// splitRole splits `name@host` into the name and the host, the host defaults to `%`.
+func splitRole(role string) (string, string) {
+	if i := strings.LastIndex(role, "@"); i >= 0 {
+		return role[:i], role[i+1:]
+	}
+	return role, defaultTidbUserHost
+}
+
+func roleAccounts(roles []string) string {
+	accounts := make([]string, 0, len(roles))
+	for _, role := range roles {
+		accounts = append(accounts, account(splitRole(role)))
+	}
+	return strings.Join(accounts, ", ")
+}
+
+func account(user, host string) string {
+	return quoteString(user) + "@" + quoteString(host)
+}
+
+// quoteString quotes s as a string literal, the characters are escaped as the mysql client does.
+func quoteString(s string) string {
+	var b strings.Builder
+	b.WriteByte('\'')
+	for _, r := range s {
+		switch r {
+		case 0:
+			b.WriteString(`\0`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\x1a':
+			b.WriteString(`\Z`)
+		case '\'', '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('\'')
+	return b.String()
+}
+
+func sortedKeys(m map[string]sets.Set[string]) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func setTidbUserFailed(tu *v1alpha1.TidbUser, err error) {
+	tu.Status.Phase = v1alpha1.TidbUserFailed
+	tu.Status.Message = err.Error()
+}
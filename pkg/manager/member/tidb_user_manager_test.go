@@ -0,0 +1,317 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+)
+
+type fakeUserExecutor struct {
+	executed []string
+	errs     map[string]error
+}
+
+func (e *fakeUserExecutor) Exec(_ context.Context, stmt string) error {
+	e.executed = append(e.executed, stmt)
+	return e.errs[stmt]
+}
+
+func (e *fakeUserExecutor) Close() error {
+	return nil
+}
+
+func newTidbUser() *v1alpha1.TidbUser {
+	return &v1alpha1.TidbUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "app",
+			Namespace:  corev1.NamespaceDefault,
+			Generation: 1,
+		},
+		Spec: v1alpha1.TidbUserSpec{
+			Cluster: v1alpha1.TidbClusterRef{Name: "test"},
+			PasswordSecret: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "app-password"},
+				Key:                  "password",
+			},
+			Roles: []string{"reader"},
+			Grants: []v1alpha1.TidbUserGrant{
+				{Privileges: []string{"select", "insert"}, On: "app.*"},
+			},
+		},
+	}
+}
+
+func TestDesiredTidbUser(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tu := newTidbUser()
+	tu.Spec.Hosts = []string{"10.0.0.%", "%", "%"}
+	tu.Spec.Roles = []string{"reader", "writer@10.%"}
+	tu.Spec.Grants = append(tu.Spec.Grants,
+		v1alpha1.TidbUserGrant{Privileges: []string{"all  privileges"}, On: "`app`.`t`"},
+		v1alpha1.TidbUserGrant{Privileges: []string{"SELECT"}, On: "app.*"},
+	)
+	desired, err := desiredTidbUser(tu)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(desired.User).To(Equal("app"))
+	g.Expect(desired.Hosts).To(Equal([]string{"%", "10.0.0.%"}))
+	g.Expect(desired.Roles).To(Equal([]string{"reader@%", "writer@10.%"}))
+	g.Expect(desired.Grants).To(Equal([]v1alpha1.TidbUserGrant{
+		{On: "`app`.*", Privileges: []string{"INSERT", "SELECT"}},
+		{On: "`app`.`t`", Privileges: []string{"ALL PRIVILEGES"}},
+	}))
+
+	invalid := []func(tu *v1alpha1.TidbUser){
+		func(tu *v1alpha1.TidbUser) { tu.Spec.UserName = "root" },
+		func(tu *v1alpha1.TidbUser) { tu.Spec.Grants[0].On = "app" },
+		func(tu *v1alpha1.TidbUser) { tu.Spec.Grants[0].On = "*.t" },
+		func(tu *v1alpha1.TidbUser) { tu.Spec.Grants[0].Privileges = []string{"SELECT; DROP"} },
+		func(tu *v1alpha1.TidbUser) { tu.Spec.Role, tu.Spec.ResourceGroup = true, "rg" },
+	}
+	for _, fn := range invalid {
+		tu := newTidbUser()
+		fn(tu)
+		_, err := desiredTidbUser(tu)
+		g.Expect(err).To(HaveOccurred())
+	}
+}
+
+func TestQuoteString(t *testing.T) {
+	g := NewGomegaWithT(t)
+	g.Expect(quoteString("pass")).To(Equal(`'pass'`))
+	g.Expect(quoteString(`a'b\c`)).To(Equal(`'a\'b\\c'`))
+	g.Expect(quoteString("a\nb\x00")).To(Equal(`'a\nb\0'`))
+}
+
+func TestPlanTidbUser(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	applied := &v1alpha1.TidbUserStatus{
+		User:          "app",
+		Hosts:         []string{"%", "10.%"},
+		Roles:         []string{"reader@%"},
+		Grants:        []v1alpha1.TidbUserGrant{{On: "`app`.*", Privileges: []string{"INSERT", "SELECT"}}},
+		ResourceGroup: "rg1",
+	}
+
+	type testcase struct {
+		name            string
+		desired         v1alpha1.TidbUserStatus
+		role            bool
+		password        string
+		passwordChanged bool
+		expected        []string
+	}
+	tests := []testcase{
+		{
+			name:     "create",
+			desired:  v1alpha1.TidbUserStatus{User: "new", Hosts: []string{"%"}, Roles: []string{"reader@%"}, Grants: applied.Grants, ResourceGroup: "rg1"},
+			password: "pass",
+			expected: []string{
+				"DROP USER IF EXISTS 'app'@'%', 'app'@'10.%'",
+				"CREATE USER IF NOT EXISTS 'new'@'%'",
+				"ALTER USER 'new'@'%' IDENTIFIED BY 'pass'",
+				"GRANT INSERT, SELECT ON `app`.* TO 'new'@'%'",
+				"GRANT 'reader'@'%' TO 'new'@'%'",
+				"SET DEFAULT ROLE ALL TO 'new'@'%'",
+				"ALTER USER 'new'@'%' RESOURCE GROUP `rg1`",
+			},
+		},
+		{
+			name:     "nothing changed",
+			desired:  *applied,
+			password: "pass",
+		},
+		{
+			name:            "rotate password",
+			desired:         *applied,
+			password:        "new",
+			passwordChanged: true,
+			expected: []string{
+				"ALTER USER 'app'@'%' IDENTIFIED BY 'new'",
+				"ALTER USER 'app'@'10.%' IDENTIFIED BY 'new'",
+			},
+		},
+		{
+			name: "revoke",
+			desired: v1alpha1.TidbUserStatus{User: "app", Hosts: []string{"%"},
+				Grants: []v1alpha1.TidbUserGrant{{On: "`app`.*", Privileges: []string{"SELECT"}}, {On: "*.*", Privileges: []string{"PROCESS"}}}},
+			password: "pass",
+			expected: []string{
+				"DROP USER IF EXISTS 'app'@'10.%'",
+				"REVOKE INSERT ON `app`.* FROM 'app'@'%'",
+				"GRANT PROCESS ON *.* TO 'app'@'%'",
+				"REVOKE 'reader'@'%' FROM 'app'@'%'",
+				"SET DEFAULT ROLE NONE TO 'app'@'%'",
+				"ALTER USER 'app'@'%' RESOURCE GROUP `default`",
+			},
+		},
+		{
+			name:    "role",
+			desired: v1alpha1.TidbUserStatus{User: "reader", Hosts: []string{"%"}, Grants: []v1alpha1.TidbUserGrant{{On: "`app`.*", Privileges: []string{"SELECT"}}}},
+			role:    true,
+			expected: []string{
+				"DROP ROLE IF EXISTS 'app'@'%', 'app'@'10.%'",
+				"CREATE ROLE IF NOT EXISTS 'reader'@'%'",
+				"GRANT SELECT ON `app`.* TO 'reader'@'%'",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Log(test.name)
+		stmts := planTidbUser(applied, &test.desired, test.role, test.password, test.passwordChanged)
+		var sqls []string
+		for _, stmt := range stmts {
+			sqls = append(sqls, stmt.sql)
+		}
+		g.Expect(sqls).To(Equal(test.expected))
+	}
+}
+
+func TestTidbUserManagerSync(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	executor := &fakeUserExecutor{}
+	m := &tidbUserManager{deps: deps}
+	m.executorFactory = func(_ context.Context, _ *v1alpha1.TidbUser, _ *v1alpha1.TidbCluster) (userExecutor, error) {
+		return executor, nil
+	}
+
+	tu := newTidbUser()
+	err := m.Sync(tu)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(tu.Status.Phase).To(Equal(v1alpha1.TidbUserFailed))
+
+	tc := newTidbClusterForTiDB()
+	g.Expect(deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer().Add(tc)).To(Succeed())
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-password", Namespace: tu.Namespace, ResourceVersion: "1"},
+		Data:       map[string][]byte{"password": []byte("pass")},
+	}
+	secretIndexer := deps.KubeInformerFactory.Core().V1().Secrets().Informer().GetIndexer()
+	g.Expect(secretIndexer.Add(secret)).To(Succeed())
+
+	g.Expect(m.Sync(tu)).To(Succeed())
+	g.Expect(tu.Status.Phase).To(Equal(v1alpha1.TidbUserSynced))
+	g.Expect(tu.Status.PasswordSecretVersion).To(Equal("1"))
+	g.Expect(tu.Status.Message).To(BeEmpty())
+	g.Expect(executor.executed).To(ContainElement("ALTER USER 'app'@'%' IDENTIFIED BY 'pass'"))
+
+	// TiDB is not accessed if nothing changes
+	executor.executed = nil
+	g.Expect(m.Sync(tu)).To(Succeed())
+	g.Expect(executor.executed).To(BeEmpty())
+
+	// the password is rotated when the Secret changes
+	secret = secret.DeepCopy()
+	secret.ResourceVersion = "2"
+	secret.Data["password"] = []byte("new")
+	g.Expect(secretIndexer.Update(secret)).To(Succeed())
+	g.Expect(m.Sync(tu)).To(Succeed())
+	g.Expect(executor.executed).To(Equal([]string{"ALTER USER 'app'@'%' IDENTIFIED BY 'new'"}))
+	g.Expect(tu.Status.PasswordSecretVersion).To(Equal("2"))
+
+	// revoking the privileges which are already revoked is not an error
+	tu.Generation++
+	tu.Spec.Grants[0].Privileges = []string{"SELECT"}
+	executor.executed = nil
+	executor.errs = map[string]error{
+		"REVOKE INSERT ON `app`.* FROM 'app'@'%'": &mysql.MySQLError{Number: mysqlErrNonexistingGrant},
+	}
+	g.Expect(m.Sync(tu)).To(Succeed())
+	g.Expect(executor.executed).To(Equal([]string{"REVOKE INSERT ON `app`.* FROM 'app'@'%'"}))
+	g.Expect(tu.Status.Grants).To(Equal([]v1alpha1.TidbUserGrant{{On: "`app`.*", Privileges: []string{"SELECT"}}}))
+
+	// the applied state is kept if the sync fails
+	tu.Generation++
+	tu.Spec.Roles = nil
+	executor.executed = nil
+	executor.errs = map[string]error{
+		"REVOKE 'reader'@'%' FROM 'app'@'%'": &mysql.MySQLError{Number: 1105, Message: "unknown error"},
+	}
+	g.Expect(m.Sync(tu)).To(HaveOccurred())
+	g.Expect(tu.Status.Phase).To(Equal(v1alpha1.TidbUserFailed))
+	g.Expect(tu.Status.Roles).To(Equal([]string{"reader@%"}))
+}
+
+func TestTidbUserManagerRevoke(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	executor := &fakeUserExecutor{}
+	m := &tidbUserManager{deps: deps}
+	m.executorFactory = func(_ context.Context, _ *v1alpha1.TidbUser, _ *v1alpha1.TidbCluster) (userExecutor, error) {
+		return executor, nil
+	}
+
+	// the cluster is deleted
+	tu := newTidbUser()
+	g.Expect(m.Revoke(tu)).To(Succeed())
+	g.Expect(executor.executed).To(BeEmpty())
+
+	g.Expect(deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer().Add(newTidbClusterForTiDB())).To(Succeed())
+	tu.Spec.UserName = "renamed"
+	tu.Status.User = "app"
+	tu.Status.Hosts = []string{"%", "10.%"}
+	g.Expect(m.Revoke(tu)).To(Succeed())
+	g.Expect(executor.executed).To(Equal([]string{"DROP USER IF EXISTS 'app'@'%', 'app'@'10.%'"}))
+}
+
+func TestTidbUserManagerCrossNamespace(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	executor := &fakeUserExecutor{}
+	m := &tidbUserManager{deps: deps}
+	m.executorFactory = func(_ context.Context, _ *v1alpha1.TidbUser, _ *v1alpha1.TidbCluster) (userExecutor, error) {
+		return executor, nil
+	}
+	tc := newTidbClusterForTiDB()
+	g.Expect(deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer().Add(tc)).To(Succeed())
+	g.Expect(deps.KubeInformerFactory.Core().V1().Secrets().Informer().GetIndexer().Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-password", Namespace: "app", ResourceVersion: "1"},
+		Data:       map[string][]byte{"password": []byte("pass")},
+	})).To(Succeed())
+
+	// the root password of the cluster in another namespace is never used
+	tu := newTidbUser()
+	tu.Namespace = "app"
+	tu.Spec.Cluster.Namespace = tc.Namespace
+	err := m.Sync(tu)
+	g.Expect(controller.IsIgnoreError(err)).To(BeTrue())
+	g.Expect(tu.Status.Phase).To(Equal(v1alpha1.TidbUserFailed))
+	g.Expect(executor.executed).To(BeEmpty())
+
+	tu.Status = v1alpha1.TidbUserStatus{User: "app", Hosts: []string{"%"}}
+	g.Expect(m.Revoke(tu)).To(Succeed())
+	g.Expect(executor.executed).To(BeEmpty())
+
+	tu.Spec.AdminSecretName = pointer.StringPtr("app-admin")
+	g.Expect(m.Sync(tu)).To(Succeed())
+	g.Expect(tu.Status.Phase).To(Equal(v1alpha1.TidbUserSynced))
+	g.Expect(m.Revoke(tu)).To(Succeed())
+	g.Expect(executor.executed).To(ContainElement("DROP USER IF EXISTS 'app'@'%'"))
+}
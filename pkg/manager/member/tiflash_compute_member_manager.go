@@ -0,0 +1,448 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager/member/startscript"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+	"github.com/pingcap/tidb-operator/pkg/util"
+
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+)
+
+// syncComputeHeadlessService syncs the headless service of TiFlash compute nodes in the disaggregated mode
+func (m *tiflashMemberManager) syncComputeHeadlessService(tc *v1alpha1.TidbCluster) error {
+	if tc.Spec.Paused {
+		klog.V(4).Infof("tiflash cluster %s/%s is paused, skip syncing for tiflash compute service", tc.GetNamespace(), tc.GetName())
+		return nil
+	}
+
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	svcName := controller.TiFlashComputePeerMemberName(tcName)
+
+	newSvc := getNewTiFlashComputeHeadlessService(tc)
+	oldSvcTmp, err := m.deps.ServiceLister.Services(ns).Get(svcName)
+	if errors.IsNotFound(err) {
+		err = controller.SetServiceLastAppliedConfigAnnotation(newSvc)
+		if err != nil {
+			return err
+		}
+		return m.deps.ServiceControl.CreateService(tc, newSvc)
+	}
+	if err != nil {
+		return fmt.Errorf("syncComputeHeadlessService: failed to get svc %s for cluster %s/%s, error: %s", svcName, ns, tcName, err)
+	}
+
+	_, err = m.deps.ServiceControl.SyncComponentService(tc, newSvc, oldSvcTmp.DeepCopy(), false)
+	return err
+}
+
+// syncComputeStatefulSet syncs the StatefulSet of TiFlash compute nodes in the disaggregated mode.
+// The compute nodes are stateless, so they are upgraded by the rolling update of StatefulSet directly.
+func (m *tiflashMemberManager) syncComputeStatefulSet(tc *v1alpha1.TidbCluster) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	setName := controller.TiFlashComputeMemberName(tcName)
+
+	oldSetTmp, err := m.deps.StatefulSetLister.StatefulSets(ns).Get(setName)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("syncComputeStatefulSet: fail to get sts %s for cluster %s/%s, error: %s", setName, ns, tcName, err)
+	}
+	setNotExist := errors.IsNotFound(err)
+
+	oldSet := oldSetTmp.DeepCopy()
+
+	if err := m.syncComputeStatus(tc, oldSet); err != nil {
+		return err
+	}
+
+	if tc.Spec.Paused {
+		klog.V(4).Infof("tiflash cluster %s/%s is paused, skip syncing for tiflash compute statefulset", ns, tcName)
+		return nil
+	}
+
+	cm, err := m.syncComputeConfigMap(tc, oldSet)
+	if err != nil {
+		return err
+	}
+
+	newSet, err := getNewTiFlashComputeStatefulSet(tc, cm)
+	if err != nil {
+		return err
+	}
+	if setNotExist {
+		// the compute nodes rely on the write nodes to serve the data
+		if tc.Status.TiFlash.StatefulSet == nil {
+			klog.Infof("TidbCluster: %s/%s, waiting for TiFlash write nodes created", ns, tcName)
+			return nil
+		}
+		err = mngerutils.SetStatefulSetLastAppliedConfigAnnotation(newSet)
+		if err != nil {
+			return err
+		}
+		err = m.deps.StatefulSetControl.CreateStatefulSet(tc, newSet)
+		if err != nil {
+			return err
+		}
+		tc.Status.TiFlash.Compute.StatefulSet = &apps.StatefulSetStatus{}
+		return nil
+	}
+
+	if err := m.computeScaler.Scale(tc, oldSet, newSet); err != nil {
+		return err
+	}
+
+	return mngerutils.UpdateStatefulSetWithPrecheck(m.deps, tc, "FailedUpdateTiFlashComputeSTS", newSet, oldSet)
+}
+
+func (m *tiflashMemberManager) syncComputeConfigMap(tc *v1alpha1.TidbCluster, set *apps.StatefulSet) (*corev1.ConfigMap, error) {
+	newCm, err := getTiFlashComputeConfigMap(tc)
+	if err != nil {
+		return nil, err
+	}
+
+	var inUseName string
+	if set != nil {
+		inUseName = mngerutils.FindConfigMapVolume(&set.Spec.Template.Spec, func(name string) bool {
+			return strings.HasPrefix(name, controller.TiFlashComputeMemberName(tc.Name))
+		})
+	} else {
+		inUseName, err = mngerutils.FindConfigMapNameFromTCAnno(context.Background(), m.deps.ConfigMapLister, tc, v1alpha1.TiFlashComputeMemberType, newCm)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = mngerutils.UpdateConfigMapIfNeed(m.deps.ConfigMapLister, tc.BaseTiFlashComputeSpec().ConfigUpdateStrategy(), inUseName, newCm)
+	if err != nil {
+		return nil, err
+	}
+	return m.deps.TypedControl.CreateOrUpdateConfigMap(tc, newCm)
+}
+
+func (m *tiflashMemberManager) syncComputeStatus(tc *v1alpha1.TidbCluster, set *apps.StatefulSet) error {
+	if tc.Status.TiFlash.Compute == nil {
+		tc.Status.TiFlash.Compute = &v1alpha1.TiFlashComputeStatus{}
+	}
+	if set == nil {
+		// skip if not created yet
+		return nil
+	}
+	status := tc.Status.TiFlash.Compute
+	status.StatefulSet = &set.Status
+	if tc.TiFlashComputeReplicas() != *set.Spec.Replicas {
+		status.Phase = v1alpha1.ScalePhase
+	} else if mngerutils.StatefulSetIsUpgrading(set) {
+		status.Phase = v1alpha1.UpgradePhase
+	} else {
+		status.Phase = v1alpha1.NormalPhase
+	}
+
+	pdCli := controller.GetPDClient(m.deps.PDControl, tc)
+	// This only returns Up/Down/Offline stores
+	storesInfo, err := pdCli.GetStores()
+	if err != nil {
+		status.Synced = false
+		klog.Warningf("Fail to GetStores for TidbCluster %s/%s: %s", tc.Namespace, tc.Name, err)
+		return err
+	}
+	pattern, err := regexp.Compile(fmt.Sprintf(tiflashComputeStorePattern, tc.Name, tc.Name, tc.Namespace, controller.FormatClusterDomainForRegex(tc.Spec.ClusterDomain)))
+	if err != nil {
+		return err
+	}
+
+	stores := map[string]v1alpha1.TiKVStore{}
+	for _, store := range storesInfo.Stores {
+		if store.Store == nil || !pattern.Match([]byte(store.Store.Address)) {
+			continue
+		}
+		s := m.getTiFlashStore(store)
+		if s == nil {
+			continue
+		}
+		s.LastTransitionTime = metav1.Now()
+		if oldStore, exist := status.Stores[s.ID]; exist && s.State == oldStore.State {
+			s.LastTransitionTime = oldStore.LastTransitionTime
+		}
+		stores[s.ID] = *s
+	}
+
+	// this returns all tombstone stores
+	tombstoneStoresInfo, err := pdCli.GetTombStoneStores()
+	if err != nil {
+		status.Synced = false
+		klog.Warningf("Fail to GetTombStoneStores for TidbCluster %s/%s", tc.Namespace, tc.Name)
+		return err
+	}
+	tombstoneStores := map[string]v1alpha1.TiKVStore{}
+	for _, store := range tombstoneStoresInfo.Stores {
+		if store.Store == nil || !pattern.Match([]byte(store.Store.Address)) {
+			continue
+		}
+		s := m.getTiFlashStore(store)
+		if s == nil {
+			continue
+		}
+		s.LastTransitionTime = metav1.Now()
+		if oldStore, exist := status.TombstoneStores[s.ID]; exist && s.State == oldStore.State {
+			s.LastTransitionTime = oldStore.LastTransitionTime
+		}
+		tombstoneStores[s.ID] = *s
+	}
+
+	status.Synced = true
+	status.Stores = stores
+	status.TombstoneStores = tombstoneStores
+	status.Image = ""
+	if c := findContainerByName(set, v1alpha1.TiFlashMemberType.String()); c != nil {
+		status.Image = c.Image
+	}
+	return nil
+}
+
+func getNewTiFlashComputeHeadlessService(tc *v1alpha1.TidbCluster) *corev1.Service {
+	svc := getNewHeadlessService(tc)
+	svcLabel := label.New().Instance(tc.GetInstanceName()).TiFlashCompute().Labels()
+	svc.Name = controller.TiFlashComputePeerMemberName(tc.Name)
+	svc.Labels = svcLabel
+	svc.Spec.Selector = svcLabel
+	return svc
+}
+
+func getTiFlashComputeConfigMap(tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
+	config, err := GetTiFlashComputeConfig(tc)
+	if err != nil {
+		return nil, err
+	}
+
+	configText, err := config.Common.MarshalTOML()
+	if err != nil {
+		return nil, err
+	}
+	proxyText, err := config.Proxy.MarshalTOML()
+	if err != nil {
+		return nil, err
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            controller.TiFlashComputeMemberName(tc.Name),
+			Namespace:       tc.Namespace,
+			Labels:          label.New().Instance(tc.GetInstanceName()).TiFlashCompute().Labels(),
+			OwnerReferences: []metav1.OwnerReference{controller.GetOwnerRef(tc)},
+		},
+		Data: map[string]string{
+			"config_templ.toml": string(configText),
+			"proxy_templ.toml":  string(proxyText),
+		},
+	}
+	return cm, nil
+}
+
+// getTiFlashS3CredentialEnv returns the env of the S3 credential used by TiFlash in the disaggregated mode.
+// Nothing is returned if no secret is specified, e.g. the credential is provided by the IAM role.
+func getTiFlashS3CredentialEnv(tc *v1alpha1.TidbCluster) []corev1.EnvVar {
+	s3 := tc.Spec.TiFlash.Disaggregated.S3
+	if s3 == nil || s3.SecretName == "" {
+		return nil
+	}
+	return []corev1.EnvVar{
+		{
+			Name: "S3_ACCESS_KEY_ID",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: s3.SecretName},
+					Key:                  constants.S3AccessKey,
+				},
+			},
+		},
+		{
+			Name: "S3_SECRET_ACCESS_KEY",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: s3.SecretName},
+					Key:                  constants.S3SecretKey,
+				},
+			},
+		},
+	}
+}
+
+func getNewTiFlashComputeStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.StatefulSet, error) {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	baseSpec := tc.BaseTiFlashComputeSpec()
+	spec := &tc.Spec.TiFlash.Disaggregated.Compute
+
+	configMapName := controller.TiFlashComputeMemberName(tcName)
+	if cm != nil {
+		configMapName = cm.Name
+	}
+
+	annoMount, annoVolume := annotationsMountVolume()
+	volMounts := []corev1.VolumeMount{
+		annoMount,
+		{Name: "data0", MountPath: "/data0"},
+		{Name: "config", ReadOnly: true, MountPath: "/etc/tiflash"},
+	}
+	// the local cache of the data in S3, which can be rebuilt at any time
+	dataVolume := corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+	if spec.CacheCapacity != nil {
+		dataVolume.EmptyDir.SizeLimit = spec.CacheCapacity
+	}
+	vols := []corev1.Volume{
+		annoVolume,
+		{Name: "data0", VolumeSource: dataVolume},
+		{
+			Name: "config", VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: configMapName,
+					},
+				},
+			},
+		},
+	}
+	if tc.IsTLSClusterEnabled() {
+		volMounts = append(volMounts, corev1.VolumeMount{
+			Name: tiflashCertVolumeName, ReadOnly: true, MountPath: tiflashCertPath,
+		})
+		vols = append(vols, corev1.Volume{
+			Name: tiflashCertVolumeName, VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: util.ClusterTLSSecretName(tc.Name, label.TiFlashLabelVal),
+				},
+			},
+		})
+	}
+	volMounts = append(volMounts, baseSpec.AdditionalVolumeMounts()...)
+
+	stsLabels := label.New().Instance(tc.GetInstanceName()).TiFlashCompute()
+	setName := controller.TiFlashComputeMemberName(tcName)
+	podLabels := util.CombineStringMap(stsLabels, baseSpec.Labels())
+	podAnnotations := util.CombineStringMap(baseSpec.Annotations(), controller.AnnProm(v1alpha1.DefaultTiFlashMetricsPort, "/metrics"))
+	podAnnotations = util.CombineStringMap(controller.AnnAdditionalProm("tiflash.proxy", v1alpha1.DefaultTiFlashProxyStatusPort), podAnnotations)
+	stsAnnotations := getStsAnnotations(tc.Annotations, label.TiFlashComputeLabelVal)
+	headlessSvcName := controller.TiFlashComputePeerMemberName(tcName)
+
+	env := []corev1.EnvVar{
+		{
+			Name: "NAMESPACE",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "metadata.namespace",
+				},
+			},
+		},
+		{
+			Name:  "CLUSTER_NAME",
+			Value: tcName,
+		},
+		{
+			Name:  "HEADLESS_SERVICE_NAME",
+			Value: headlessSvcName,
+		},
+		{
+			Name: "POD_NAME",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "metadata.name",
+				},
+			},
+		},
+		{
+			Name:  "TZ",
+			Value: tc.Timezone(),
+		},
+	}
+	env = append(env, getTiFlashS3CredentialEnv(tc)...)
+
+	startScript, err := startscript.RenderTiFlashComputeStartScript(tc)
+	if err != nil {
+		return nil, fmt.Errorf("render start-script of tiflash compute for tc %s/%s failed: %v", ns, tcName, err)
+	}
+
+	tiflashContainer := corev1.Container{
+		Name:            v1alpha1.TiFlashMemberType.String(),
+		Image:           tc.TiFlashImage(),
+		ImagePullPolicy: baseSpec.ImagePullPolicy(),
+		Command:         []string{"/bin/sh", "-c", startScript},
+		SecurityContext: baseSpec.SecurityContext(),
+		Ports:           getTiFlashContainerPorts(),
+		VolumeMounts:    volMounts,
+		Resources:       controller.ContainerResource(spec.ResourceRequirements),
+		Env:             util.AppendEnv(env, baseSpec.Env()),
+		EnvFrom:         baseSpec.EnvFrom(),
+	}
+
+	podSpec := baseSpec.BuildPodSpec()
+	podSpec.Volumes = append(vols, baseSpec.AdditionalVolumes()...)
+	podSpec.SecurityContext = baseSpec.PodSecurityContext()
+	podSpec.InitContainers = baseSpec.InitContainers()
+	podSpec.Containers = append(podSpec.Containers, tiflashContainer)
+	podSpec.Containers, err = MergePatchContainers(podSpec.Containers, baseSpec.AdditionalContainers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge containers spec for TiFlash compute of [%s/%s], err: %v", ns, tcName, err)
+	}
+	podSpec.ServiceAccountName = tc.Spec.TiFlash.ServiceAccount
+	if podSpec.ServiceAccountName == "" {
+		podSpec.ServiceAccountName = tc.Spec.ServiceAccount
+	}
+
+	updateStrategy := apps.StatefulSetUpdateStrategy{}
+	if baseSpec.StatefulSetUpdateStrategy() == apps.OnDeleteStatefulSetStrategyType {
+		updateStrategy.Type = apps.OnDeleteStatefulSetStrategyType
+	} else {
+		updateStrategy.Type = apps.RollingUpdateStatefulSetStrategyType
+	}
+
+	return &apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            setName,
+			Namespace:       ns,
+			Labels:          stsLabels.Labels(),
+			Annotations:     stsAnnotations,
+			OwnerReferences: []metav1.OwnerReference{controller.GetOwnerRef(tc)},
+		},
+		Spec: apps.StatefulSetSpec{
+			Replicas: pointer.Int32Ptr(tc.TiFlashComputeReplicas()),
+			Selector: stsLabels.LabelSelector(),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      podLabels,
+					Annotations: podAnnotations,
+				},
+				Spec: podSpec,
+			},
+			ServiceName:         headlessSvcName,
+			PodManagementPolicy: baseSpec.PodManagementPolicy(),
+			UpdateStrategy:      updateStrategy,
+		},
+	}, nil
+}
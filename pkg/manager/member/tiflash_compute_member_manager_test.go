@@ -0,0 +1,155 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func newDisaggregatedTidbCluster() *v1alpha1.TidbCluster {
+	tc := newTidbCluster()
+	tc.Spec.Version = "v7.5.0"
+	tc.Spec.TiFlash = &v1alpha1.TiFlashSpec{
+		BaseImage: "pingcap/tiflash",
+		Replicas:  2,
+		StorageClaims: []v1alpha1.StorageClaim{
+			{
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse("10Gi"),
+					},
+				},
+			},
+		},
+		Disaggregated: &v1alpha1.TiFlashDisaggregatedSpec{
+			S3: &v1alpha1.S3StorageProvider{
+				Bucket:     "bucket",
+				Region:     "us-west-2",
+				SecretName: "s3-secret",
+			},
+			Compute: v1alpha1.TiFlashComputeSpec{
+				Replicas: 3,
+			},
+		},
+	}
+	return tc
+}
+
+func TestGetTiFlashDisaggregatedConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newDisaggregatedTidbCluster()
+	tc.Spec.TiFlash.Config = v1alpha1.NewTiFlashConfig()
+	tc.Spec.TiFlash.Config.Common.Set("logger.level", "info")
+	tc.Spec.TiFlash.Config.Common.Set("storage.main.dir", []string{"/data0/main"})
+	tc.Spec.TiFlash.Disaggregated.Compute.Config = v1alpha1.NewTiFlashConfig()
+	tc.Spec.TiFlash.Disaggregated.Compute.Config.Common.Set("logger.level", "debug")
+	capacity := resource.MustParse("100Gi")
+	tc.Spec.TiFlash.Disaggregated.Compute.CacheCapacity = &capacity
+
+	write := GetTiFlashConfig(tc)
+	g.Expect(write.Common.Get("flash.disaggregated_mode").MustString()).To(Equal(tiflashDisaggregatedModeWrite))
+	g.Expect(write.Common.Get("storage.s3.endpoint").MustString()).To(Equal("https://s3.us-west-2.amazonaws.com"))
+	g.Expect(write.Common.Get("storage.s3.bucket").MustString()).To(Equal("bucket"))
+	g.Expect(write.Common.Get("storage.s3.root").MustString()).To(Equal("/default/test-pd/"))
+	g.Expect(write.Common.Get("storage.main.dir").MustStringSlice()).To(Equal([]string{"/data0/main"}))
+	g.Expect(write.Common.Get("logger.level").MustString()).To(Equal("info"))
+
+	compute, err := GetTiFlashComputeConfig(tc)
+	g.Expect(err).To(Succeed())
+	g.Expect(compute.Common.Get("flash.disaggregated_mode").MustString()).To(Equal(tiflashDisaggregatedModeCompute))
+	g.Expect(compute.Common.Get("storage.s3.bucket").MustString()).To(Equal("bucket"))
+	g.Expect(compute.Common.Get("storage.main.dir").MustStringSlice()).To(Equal([]string{"/data0/db"}))
+	g.Expect(compute.Common.Get("storage.remote.cache.dir").MustString()).To(Equal(tiflashComputeCacheDir))
+	g.Expect(compute.Common.Get("storage.remote.cache.capacity").MustInt()).To(Equal(capacity.Value()))
+	g.Expect(compute.Common.Get("flash.proxy.config").MustString()).To(Equal("/etc/tiflash/proxy_templ.toml"))
+	g.Expect(compute.Common.Get("logger.level").MustString()).To(Equal("debug"))
+	// the user config of write nodes should not be changed
+	g.Expect(tc.Spec.TiFlash.Config.Common.Get("storage.main.dir").MustStringSlice()).To(Equal([]string{"/data0/main"}))
+
+	tc.Spec.TiFlash.Disaggregated.S3.Prefix = "/tiflash/data/"
+	tc.Spec.TiFlash.Disaggregated.S3.Endpoint = "http://minio:9000"
+	write = GetTiFlashConfig(tc)
+	g.Expect(write.Common.Get("storage.s3.endpoint").MustString()).To(Equal("http://minio:9000"))
+	g.Expect(write.Common.Get("storage.s3.root").MustString()).To(Equal("/tiflash/data/"))
+}
+
+func TestGetNewTiFlashComputeStatefulSet(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newDisaggregatedTidbCluster()
+	sts, err := getNewTiFlashComputeStatefulSet(tc, nil)
+	g.Expect(err).To(Succeed())
+
+	g.Expect(sts.Name).To(Equal("test-pd-tiflash-compute"))
+	g.Expect(*sts.Spec.Replicas).To(Equal(int32(3)))
+	g.Expect(sts.Spec.ServiceName).To(Equal("test-pd-tiflash-compute-peer"))
+	g.Expect(sts.Spec.VolumeClaimTemplates).To(BeEmpty())
+	g.Expect(sts.Spec.UpdateStrategy.Type).To(Equal(apps.RollingUpdateStatefulSetStrategyType))
+	g.Expect(sts.Spec.UpdateStrategy.RollingUpdate).To(BeNil())
+	g.Expect(sts.Labels[label.ComponentLabelKey]).To(Equal(label.TiFlashComputeLabelVal))
+	g.Expect(sts.Spec.Template.Labels[label.ComponentLabelKey]).To(Equal(label.TiFlashComputeLabelVal))
+
+	var data0 *corev1.Volume
+	for i := range sts.Spec.Template.Spec.Volumes {
+		if sts.Spec.Template.Spec.Volumes[i].Name == "data0" {
+			data0 = &sts.Spec.Template.Spec.Volumes[i]
+		}
+	}
+	g.Expect(data0).NotTo(BeNil())
+	g.Expect(data0.EmptyDir).NotTo(BeNil())
+
+	c := sts.Spec.Template.Spec.Containers[0]
+	g.Expect(c.Image).To(Equal(tc.TiFlashImage()))
+	g.Expect(c.Command[2]).To(ContainSubstring("/etc/tiflash/config_templ.toml"))
+	env := map[string]corev1.EnvVar{}
+	for _, e := range c.Env {
+		env[e.Name] = e
+	}
+	g.Expect(env["HEADLESS_SERVICE_NAME"].Value).To(Equal("test-pd-tiflash-compute-peer"))
+	g.Expect(env["S3_ACCESS_KEY_ID"].ValueFrom.SecretKeyRef.Name).To(Equal("s3-secret"))
+	g.Expect(env["S3_ACCESS_KEY_ID"].ValueFrom.SecretKeyRef.Key).To(Equal(constants.S3AccessKey))
+	g.Expect(env["S3_SECRET_ACCESS_KEY"].ValueFrom.SecretKeyRef.Key).To(Equal(constants.S3SecretKey))
+
+	// the write nodes also need the credential of S3
+	writeSts, err := getNewStatefulSet(tc, nil)
+	g.Expect(err).To(Succeed())
+	found := false
+	for _, e := range writeSts.Spec.Template.Spec.Containers[0].Env {
+		if e.Name == "S3_ACCESS_KEY_ID" {
+			found = true
+		}
+	}
+	g.Expect(found).To(BeTrue())
+}
+
+func TestGetNewTiFlashComputeHeadlessService(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newDisaggregatedTidbCluster()
+	svc := getNewTiFlashComputeHeadlessService(tc)
+	g.Expect(svc.Name).To(Equal("test-pd-tiflash-compute-peer"))
+	g.Expect(svc.Spec.ClusterIP).To(Equal(corev1.ClusterIPNone))
+	g.Expect(svc.Spec.Selector[label.ComponentLabelKey]).To(Equal(label.TiFlashComputeLabelVal))
+	// the headless service of write nodes should not select the compute nodes
+	g.Expect(getNewHeadlessService(tc).Spec.Selector[label.ComponentLabelKey]).To(Equal(label.TiFlashLabelVal))
+}
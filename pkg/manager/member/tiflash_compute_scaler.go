@@ -0,0 +1,103 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"strconv"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	apps "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// tiflashComputeScaler scales the compute nodes of TiFlash in the disaggregated mode.
+// The compute nodes hold no data and have no PVC, so scaling out needs nothing to do
+// and scaling in only needs to remove the store from PD.
+type tiflashComputeScaler struct {
+	generalScaler
+}
+
+// NewTiFlashComputeScaler returns a Scaler for TiFlash compute nodes
+func NewTiFlashComputeScaler(deps *controller.Dependencies) Scaler {
+	return &tiflashComputeScaler{generalScaler: generalScaler{deps: deps}}
+}
+
+func (s *tiflashComputeScaler) Scale(meta metav1.Object, oldSet *apps.StatefulSet, newSet *apps.StatefulSet) error {
+	scaling, _, _, _ := scaleOne(oldSet, newSet)
+	if scaling > 0 {
+		return s.ScaleOut(meta, oldSet, newSet)
+	} else if scaling < 0 {
+		return s.ScaleIn(meta, oldSet, newSet)
+	}
+	return nil
+}
+
+// ScaleOut creates all the new compute nodes at once because they are stateless
+func (s *tiflashComputeScaler) ScaleOut(_ metav1.Object, oldSet *apps.StatefulSet, newSet *apps.StatefulSet) error {
+	klog.Infof("scaling out tiflash compute statefulset %s/%s, replicas: %d -> %d",
+		oldSet.Namespace, oldSet.Name, *oldSet.Spec.Replicas, *newSet.Spec.Replicas)
+	return nil
+}
+
+func (s *tiflashComputeScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, newSet *apps.StatefulSet) error {
+	tc, ok := meta.(*v1alpha1.TidbCluster)
+	if !ok {
+		klog.Errorf("tiflashComputeScaler.ScaleIn: failed to convert cluster %s/%s, scale in will do nothing", meta.GetNamespace(), meta.GetName())
+		return nil
+	}
+
+	_, ordinal, replicas, deleteSlots := scaleOne(oldSet, newSet)
+	resetReplicas(newSet, oldSet)
+	klog.Infof("scaling in tiflash compute statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)",
+		oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+
+	if err := s.scaleInOne(tc, ordinal); err != nil {
+		return err
+	}
+	setReplicasAndDeleteSlots(newSet, replicas, deleteSlots)
+	return nil
+}
+
+func (s *tiflashComputeScaler) scaleInOne(tc *v1alpha1.TidbCluster, ordinal int32) error {
+	ns := tc.GetNamespace()
+	podName := ordinalPodName(v1alpha1.TiFlashComputeMemberType, tc.GetName(), ordinal)
+	status := tc.Status.TiFlash.Compute
+	if status == nil {
+		return nil
+	}
+
+	for _, store := range status.Stores {
+		if store.PodName != podName {
+			continue
+		}
+		id, err := strconv.ParseUint(store.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+		if store.State != v1alpha1.TiKVStateOffline {
+			if err := controller.GetPDClient(s.deps.PDControl, tc).DeleteStore(id); err != nil {
+				klog.Errorf("tiflash compute scale in: failed to delete store %d, %v", id, err)
+				return err
+			}
+			klog.Infof("tiflash compute scale in: delete store %d for tiflash compute %s/%s successfully", id, ns, podName)
+		}
+		return controller.RequeueErrorf("TiFlash compute %s/%s store %d is still in cluster, state: %s", ns, podName, id, store.State)
+	}
+
+	// the store has become tombstone or has never been registered, the pod can be deleted directly
+	// as there is no data on the compute nodes.
+	return nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"k8s.io/utils/pointer"
+)
+
+func TestTiFlashComputeScalerScaleOut(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForPD()
+	scaler := NewTiFlashComputeScaler(controller.NewFakeDependencies())
+	oldSet := newStatefulSetForPDScale()
+	newSet := oldSet.DeepCopy()
+	newSet.Spec.Replicas = pointer.Int32Ptr(8)
+
+	g.Expect(scaler.Scale(tc, oldSet, newSet)).To(Succeed())
+	// all the stateless compute nodes are created at once
+	g.Expect(*newSet.Spec.Replicas).To(Equal(int32(8)))
+}
+
+func TestTiFlashComputeScalerScaleIn(t *testing.T) {
+	g := NewGomegaWithT(t)
+	type testcase struct {
+		name        string
+		stores      map[string]v1alpha1.TiKVStore
+		delStoreErr bool
+		errExpectFn func(*GomegaWithT, error)
+		storeDelete bool
+		changed     bool
+	}
+
+	testFn := func(test testcase) {
+		t.Log(test.name)
+		tc := newTidbClusterForPD()
+		tc.Status.TiFlash.Compute = &v1alpha1.TiFlashComputeStatus{Stores: test.stores}
+
+		deps := controller.NewFakeDependencies()
+		scaler := NewTiFlashComputeScaler(deps)
+		pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+		storeDeleted := false
+		pdClient.AddReaction(pdapi.DeleteStoreActionType, func(action *pdapi.Action) (interface{}, error) {
+			if test.delStoreErr {
+				return nil, fmt.Errorf("delete store error")
+			}
+			storeDeleted = true
+			return nil, nil
+		})
+
+		oldSet := newStatefulSetForPDScale()
+		newSet := oldSet.DeepCopy()
+		newSet.Spec.Replicas = pointer.Int32Ptr(3)
+
+		err := scaler.Scale(tc, oldSet, newSet)
+		test.errExpectFn(g, err)
+		g.Expect(storeDeleted).To(Equal(test.storeDelete))
+		if test.changed {
+			g.Expect(*newSet.Spec.Replicas).To(Equal(int32(4)))
+		} else {
+			g.Expect(*newSet.Spec.Replicas).To(Equal(int32(5)))
+		}
+	}
+
+	podName := ordinalPodName(v1alpha1.TiFlashComputeMemberType, "test", 4)
+	tests := []testcase{
+		{
+			name: "store is up",
+			stores: map[string]v1alpha1.TiKVStore{
+				"1": {ID: "1", PodName: podName, State: v1alpha1.TiKVStateUp},
+			},
+			errExpectFn: errExpectRequeue,
+			storeDelete: true,
+			changed:     false,
+		},
+		{
+			name: "delete store failed",
+			stores: map[string]v1alpha1.TiKVStore{
+				"1": {ID: "1", PodName: podName, State: v1alpha1.TiKVStateUp},
+			},
+			delStoreErr: true,
+			errExpectFn: errExpectNotNil,
+			storeDelete: false,
+			changed:     false,
+		},
+		{
+			name: "store is offline",
+			stores: map[string]v1alpha1.TiKVStore{
+				"1": {ID: "1", PodName: podName, State: v1alpha1.TiKVStateOffline},
+			},
+			errExpectFn: errExpectRequeue,
+			storeDelete: false,
+			changed:     false,
+		},
+		{
+			name:        "store is removed",
+			stores:      map[string]v1alpha1.TiKVStore{},
+			errExpectFn: errExpectNil,
+			storeDelete: false,
+			changed:     true,
+		},
+	}
+	for _, test := range tests {
+		testFn(test)
+	}
+}
@@ -46,8 +46,10 @@ import (
 const (
 	// find a better way to manage store only managed by tiflash in Operator
 	tiflashStoreLimitPattern = `%s-tiflash-\d+\.%s-tiflash-peer\.%s\.svc%s:\d+`
-	tiflashCertPath          = "/var/lib/tiflash-tls"
-	tiflashCertVolumeName    = "tiflash-tls"
+	// tiflashComputeStorePattern matches the stores of TiFlash compute nodes in the disaggregated mode
+	tiflashComputeStorePattern = `%s-tiflash-compute-\d+\.%s-tiflash-compute-peer\.%s\.svc%s:\d+`
+	tiflashCertPath            = "/var/lib/tiflash-tls"
+	tiflashCertVolumeName      = "tiflash-tls"
 )
 
 // tiflashMemberManager implements manager.Manager.
@@ -55,6 +57,7 @@ type tiflashMemberManager struct {
 	deps                     *controller.Dependencies
 	failover                 Failover
 	scaler                   Scaler
+	computeScaler            Scaler
 	upgrader                 Upgrader
 	suspender                suspender.Suspender
 	podVolumeModifier        volumes.PodVolumeModifier
@@ -67,6 +70,7 @@ func NewTiFlashMemberManager(deps *controller.Dependencies, tiflashFailover Fail
 		deps:              deps,
 		failover:          tiflashFailover,
 		scaler:            tiflashScaler,
+		computeScaler:     NewTiFlashComputeScaler(deps),
 		upgrader:          tiflashUpgrader,
 		suspender:         spder,
 		podVolumeModifier: pvm,
@@ -107,7 +111,18 @@ func (m *tiflashMemberManager) Sync(tc *v1alpha1.TidbCluster) error {
 		return err
 	}
 
-	return m.syncStatefulSet(tc)
+	if err = m.syncStatefulSet(tc); err != nil {
+		return err
+	}
+
+	if !tc.TiFlashDisaggregated() {
+		return nil
+	}
+	// Sync TiFlash compute nodes in the disaggregated mode
+	if err = m.syncComputeHeadlessService(tc); err != nil {
+		return err
+	}
+	return m.syncComputeStatefulSet(tc)
 }
 
 func (m *tiflashMemberManager) syncRecoveryForTiFlash(tc *v1alpha1.TidbCluster) error {
@@ -583,40 +598,9 @@ func getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.St
 		ImagePullPolicy: baseTiFlashSpec.ImagePullPolicy(),
 		Command:         []string{"/bin/sh", "-c", startScript},
 		SecurityContext: securityContext,
-		Ports: []corev1.ContainerPort{
-			{
-				Name:          "tiflash",
-				ContainerPort: v1alpha1.DefaultTiFlashFlashPort,
-				Protocol:      corev1.ProtocolTCP,
-			},
-			{
-				Name:          "proxy",
-				ContainerPort: v1alpha1.DefaultTiFlashProxyPort,
-				Protocol:      corev1.ProtocolTCP,
-			},
-			{
-				Name:          "tcp",
-				ContainerPort: v1alpha1.DefaultTiFlashTcpPort,
-				Protocol:      corev1.ProtocolTCP,
-			},
-			{
-				Name:          "http",
-				ContainerPort: v1alpha1.DefaultTiFlashHttpPort,
-				Protocol:      corev1.ProtocolTCP,
-			},
-			{
-				Name:          "internal",
-				ContainerPort: v1alpha1.DefaultTiFlashInternalPort,
-				Protocol:      corev1.ProtocolTCP,
-			},
-			{
-				Name:          "metrics",
-				ContainerPort: v1alpha1.DefaultTiFlashMetricsPort,
-				Protocol:      corev1.ProtocolTCP,
-			},
-		},
-		VolumeMounts: volMounts,
-		Resources:    controller.ContainerResource(tc.Spec.TiFlash.ResourceRequirements),
+		Ports:           getTiFlashContainerPorts(),
+		VolumeMounts:    volMounts,
+		Resources:       controller.ContainerResource(tc.Spec.TiFlash.ResourceRequirements),
 	}
 	podSpec := baseTiFlashSpec.BuildPodSpec()
 	if baseTiFlashSpec.HostNetwork() {
@@ -629,8 +613,16 @@ func getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.St
 			},
 		})
 	}
+	if tc.TiFlashDisaggregated() {
+		env = append(env, getTiFlashS3CredentialEnv(tc)...)
+	}
 	tiflashContainer.Env = util.AppendEnv(env, baseTiFlashSpec.Env())
 	tiflashContainer.EnvFrom = baseTiFlashSpec.EnvFrom()
+	logShipper, logShipperVols, err := buildLogShipperContainer(tc, v1alpha1.TiFlashMemberType, cm, tiflashContainer.VolumeMounts)
+	if err != nil {
+		return nil, err
+	}
+	vols = append(vols, logShipperVols...)
 	podSpec.Volumes = append(vols, baseTiFlashSpec.AdditionalVolumes()...)
 	podSpec.SecurityContext = podSecurityContext
 	podSpec.InitContainers = append(initContainers, baseTiFlashSpec.InitContainers()...)
@@ -646,6 +638,7 @@ func getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.St
 	} else {
 		podSpec.Containers = append(podSpec.Containers, containers...)
 	}
+	podSpec.Containers, podSpec.InitContainers = appendLogShipperContainer(logShipper, podSpec.Containers, podSpec.InitContainers)
 
 	podSpec.Containers, err = MergePatchContainers(podSpec.Containers, baseTiFlashSpec.AdditionalContainers())
 	if err != nil {
@@ -694,6 +687,41 @@ func getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.St
 	return tiflashset, nil
 }
 
+func getTiFlashContainerPorts() []corev1.ContainerPort {
+	return []corev1.ContainerPort{
+		{
+			Name:          "tiflash",
+			ContainerPort: v1alpha1.DefaultTiFlashFlashPort,
+			Protocol:      corev1.ProtocolTCP,
+		},
+		{
+			Name:          "proxy",
+			ContainerPort: v1alpha1.DefaultTiFlashProxyPort,
+			Protocol:      corev1.ProtocolTCP,
+		},
+		{
+			Name:          "tcp",
+			ContainerPort: v1alpha1.DefaultTiFlashTcpPort,
+			Protocol:      corev1.ProtocolTCP,
+		},
+		{
+			Name:          "http",
+			ContainerPort: v1alpha1.DefaultTiFlashHttpPort,
+			Protocol:      corev1.ProtocolTCP,
+		},
+		{
+			Name:          "internal",
+			ContainerPort: v1alpha1.DefaultTiFlashInternalPort,
+			Protocol:      corev1.ProtocolTCP,
+		},
+		{
+			Name:          "metrics",
+			ContainerPort: v1alpha1.DefaultTiFlashMetricsPort,
+			Protocol:      corev1.ProtocolTCP,
+		},
+	}
+}
+
 func flashVolumeClaimTemplate(storageClaims []v1alpha1.StorageClaim) ([]corev1.PersistentVolumeClaim, error) {
 	var pvcs []corev1.PersistentVolumeClaim
 	for k := range storageClaims {
@@ -741,6 +769,9 @@ func getTiFlashConfigMap(tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
 			"proxy_templ.toml":  string(proxyText),
 		},
 	}
+	if err := addLogShipperConfig(tc, v1alpha1.TiFlashMemberType, cm.Data); err != nil {
+		return nil, fmt.Errorf("render log shipper config for tc %s/%s failed: %v", tc.Namespace, tc.Name, err)
+	}
 
 	return cm, nil
 }
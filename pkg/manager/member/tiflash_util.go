@@ -34,6 +34,10 @@ const (
 	defaultServerLog  = "/data0/logs/server.log"
 	listenHostForIPv4 = "0.0.0.0"
 	listenHostForIPv6 = "[::]"
+
+	tiflashDisaggregatedModeWrite   = "tiflash_write"
+	tiflashDisaggregatedModeCompute = "tiflash_compute"
+	tiflashComputeCacheDir          = "/data0/cache"
 )
 
 var (
@@ -131,6 +135,10 @@ func buildSidecarContainer(name, path, image string,
 
 func GetTiFlashConfig(tc *v1alpha1.TidbCluster) *v1alpha1.TiFlashConfigWraper {
 	version := tc.TiFlashVersion()
+	// the disaggregated mode is only supported by TiFlash v7.0.0+
+	if tc.TiFlashDisaggregated() {
+		return getTiFlashConfigV2(tc)
+	}
 	if ok, err := tiflashEqualOrGreaterThanV540.Check(version); err == nil && ok {
 		return getTiFlashConfigV2(tc)
 	}
@@ -148,7 +156,84 @@ func getTiFlashConfigV2(tc *v1alpha1.TidbCluster) *v1alpha1.TiFlashConfigWraper
 	if config.Proxy == nil {
 		config.Proxy = v1alpha1.NewTiFlashProxyConfig()
 	}
-	mountCMInTiflashContainer := tc.Spec.TiFlash.DoesMountCMInTiflashContainer()
+	setTiFlashConfigV2Default(tc, config, tc.Spec.TiFlash.DoesMountCMInTiflashContainer())
+	if tc.TiFlashDisaggregated() {
+		setTiFlashDisaggregatedConfig(tc, config.Common, tiflashDisaggregatedModeWrite)
+	}
+	return config
+}
+
+// GetTiFlashComputeConfig returns the config of TiFlash compute nodes in the disaggregated mode.
+// The compute config is merged on top of the write config, except the items about local storage
+// which only make sense for the write nodes.
+func GetTiFlashComputeConfig(tc *v1alpha1.TidbCluster) (*v1alpha1.TiFlashConfigWraper, error) {
+	config := tc.Spec.TiFlash.Config.DeepCopy()
+	if config == nil {
+		config = v1alpha1.NewTiFlashConfig()
+	}
+	if config.Common == nil {
+		config.Common = v1alpha1.NewTiFlashCommonConfig()
+	}
+	if config.Proxy == nil {
+		config.Proxy = v1alpha1.NewTiFlashProxyConfig()
+	}
+	for _, key := range []string{"path", "storage.main.dir", "storage.latest.dir", "storage.raft.dir", "raft.kvstore_path",
+		"flash.service_addr", "flash.proxy.advertise-addr"} {
+		config.Common.Del(key)
+	}
+	for _, key := range []string{"server.engine-addr", "server.advertise-status-addr"} {
+		config.Proxy.Del(key)
+	}
+
+	compute := tc.Spec.TiFlash.Disaggregated.Compute
+	if compute.Config != nil {
+		if compute.Config.Common != nil {
+			if err := config.Common.Merge(compute.Config.Common.GenericConfig); err != nil {
+				return nil, err
+			}
+		}
+		if compute.Config.Proxy != nil {
+			if err := config.Proxy.Merge(compute.Config.Proxy.GenericConfig); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	common := config.Common
+	common.SetIfNil("storage.main.dir", []string{"/data0/db"})
+	common.SetIfNil("storage.remote.cache.dir", tiflashComputeCacheDir)
+	if compute.CacheCapacity != nil {
+		common.SetIfNil("storage.remote.cache.capacity", compute.CacheCapacity.Value())
+	}
+	// the compute nodes always read config from the ConfigMap mounted in the tiflash container
+	setTiFlashConfigV2Default(tc, config, true)
+	setTiFlashDisaggregatedConfig(tc, common, tiflashDisaggregatedModeCompute)
+	return config, nil
+}
+
+// setTiFlashDisaggregatedConfig sets the S3 storage and the role of the node in the disaggregated mode
+func setTiFlashDisaggregatedConfig(tc *v1alpha1.TidbCluster, common *v1alpha1.TiFlashCommonConfigWraper, mode string) {
+	s3 := tc.Spec.TiFlash.Disaggregated.S3
+	common.SetIfNil("flash.disaggregated_mode", mode)
+	if s3 == nil {
+		return
+	}
+	endpoint := s3.Endpoint
+	if endpoint == "" && s3.Region != "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", s3.Region)
+	}
+	if endpoint != "" {
+		common.SetIfNil("storage.s3.endpoint", endpoint)
+	}
+	common.SetIfNil("storage.s3.bucket", s3.Bucket)
+	root := strings.Trim(s3.Prefix, "/")
+	if root == "" {
+		root = path.Join(tc.Namespace, tc.Name)
+	}
+	common.SetIfNil("storage.s3.root", "/"+root+"/")
+}
+
+func setTiFlashConfigV2Default(tc *v1alpha1.TidbCluster, config *v1alpha1.TiFlashConfigWraper, mountCMInTiflashContainer bool) {
 	common := config.Common
 	proxy := config.Proxy
 
@@ -283,8 +368,6 @@ func getTiFlashConfigV2(tc *v1alpha1.TidbCluster) *v1alpha1.TiFlashConfigWraper
 			proxy.Set("security.cert-allowed-cn", commonVal.Interface())
 		}
 	}
-
-	return config
 }
 
 func getTiFlashConfig(tc *v1alpha1.TidbCluster) *v1alpha1.TiFlashConfigWraper {
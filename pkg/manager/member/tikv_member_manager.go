@@ -741,6 +741,13 @@ func getNewTiKVSetForTidbCluster(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap)
 	tikvContainer.EnvFrom = baseTiKVSpec.EnvFrom()
 	containers = append(containers, tikvContainer)
 
+	logShipper, logShipperVols, err := buildLogShipperContainer(tc, v1alpha1.TiKVMemberType, cm, tikvContainer.VolumeMounts)
+	if err != nil {
+		return nil, err
+	}
+	containers, initContainers = appendLogShipperContainer(logShipper, containers, initContainers)
+	vols = append(vols, logShipperVols...)
+
 	podSpec.Volumes = append(vols, baseTiKVSpec.AdditionalVolumes()...)
 	podSpec.SecurityContext = podSecurityContext
 	podSpec.InitContainers = append(initContainers, baseTiKVSpec.InitContainers()...)
@@ -856,6 +863,9 @@ func getTikVConfigMap(tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
 		Labels:          tikvLabel,
 		OwnerReferences: []metav1.OwnerReference{controller.GetOwnerRef(tc)},
 	}
+	if err := addLogShipperConfig(tc, v1alpha1.TiKVMemberType, cm.Data); err != nil {
+		return nil, fmt.Errorf("render log shipper config for tc %s/%s failed: %v", tc.Namespace, tc.Name, err)
+	}
 
 	return cm, nil
 }
@@ -58,6 +58,10 @@ func (m *reclaimPolicyManager) SyncTiDBDashboard(td *v1alpha1.TidbDashboard) err
 	return m.sync(v1alpha1.TiDBDashboardKind, td, false, *td.Spec.PVReclaimPolicy)
 }
 
+func (m *reclaimPolicyManager) SyncDrainer(dr *v1alpha1.Drainer) error {
+	return m.sync(v1alpha1.DrainerKind, dr, false, *dr.Spec.PVReclaimPolicy)
+}
+
 func (m *reclaimPolicyManager) sync(kind string, obj runtime.Object, isPVReclaimEnabled bool, policy corev1.PersistentVolumeReclaimPolicy) error {
 	if m.deps.PVLister == nil {
 		klog.V(4).Infof("Persistent volumes lister is unavailable, skip syncing reclaim policy for %s. This may be caused by no relevant permissions", kind)
@@ -83,6 +87,8 @@ func (m *reclaimPolicyManager) sync(kind string, obj runtime.Object, isPVReclaim
 		selector, err = label.NewTiDBNGMonitoring().Instance(instanceName).Selector()
 	case v1alpha1.TiDBDashboardKind:
 		selector, err = label.NewTiDBDashboard().Instance(instanceName).Selector()
+	case v1alpha1.DrainerKind:
+		selector, err = label.NewDrainer().Instance(instanceName).Selector()
 	default:
 		return fmt.Errorf("unsupported kind %s", kind)
 	}
@@ -151,3 +157,7 @@ func (m *FakeReclaimPolicyManager) SyncTiDBNGMonitoring(_ *v1alpha1.TidbNGMonito
 func (m *FakeReclaimPolicyManager) SyncTiDBDashboard(_ *v1alpha1.TidbDashboard) error {
 	return m.err
 }
+
+func (m *FakeReclaimPolicyManager) SyncDrainer(_ *v1alpha1.Drainer) error {
+	return m.err
+}
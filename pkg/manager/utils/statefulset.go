@@ -21,6 +21,7 @@ import (
 
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	listers "github.com/pingcap/tidb-operator/pkg/client/listers/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/features"
 	"github.com/pingcap/tidb-operator/pkg/util"
@@ -216,6 +217,7 @@ func DeleteStatefulSetWithOrphan(
 	ctx context.Context,
 	setCtl controller.StatefulSetControlInterface,
 	tcCtl controller.TidbClusterControlInterface,
+	tcLister listers.TidbClusterLister,
 	tc *v1alpha1.TidbCluster, sts *apps.StatefulSet) error {
 
 	// Store the name of currently using configmap into TC to make sure xxx_member_manager can use the same ConfigMap name
@@ -224,13 +226,27 @@ func DeleteStatefulSetWithOrphan(
 	inUseCMName := FindConfigMapVolume(&sts.Spec.Template.Spec, func(name string) bool {
 		return strings.HasPrefix(name, controller.MemberName(tc.Name, memberType))
 	})
-	if tc.Annotations == nil {
-		tc.Annotations = map[string]string{}
+	owner, key := tc, label.AnnoKeyOfConfigMapNameForNewSTS(string(memberType))
+	if tc.IsComponentGroup() {
+		// a component group is not a real TidbCluster, the name is stored in the TidbCluster which the group belongs to
+		ref := metav1.GetControllerOf(tc)
+		if ref == nil {
+			return fmt.Errorf("component group %s/%s has no owner", tc.Namespace, tc.Name)
+		}
+		realTC, err := tcLister.TidbClusters(tc.Namespace).Get(ref.Name)
+		if err != nil {
+			return fmt.Errorf("get tc %s/%s of component group %s failed: %w", tc.Namespace, ref.Name, tc.Name, err)
+		}
+		owner = realTC.DeepCopy()
+		key = label.AnnoKeyOfConfigMapNameForNewGroupSTS(string(memberType), tc.Labels[label.ComponentGroupLabelKey])
+	}
+	if owner.Annotations == nil {
+		owner.Annotations = map[string]string{}
 	}
-	tc.Annotations[label.AnnoKeyOfConfigMapNameForNewSTS(string(memberType))] = inUseCMName
-	logger := klog.FromContext(ctx).WithValues("comp", memberType, "tc", fmt.Sprintf("%s/%s", tc.Namespace, tc.Name))
-	logger.Info("store inuse configmap name in tc annotation", "name", inUseCMName)
-	if _, err := tcCtl.Update(tc); err != nil {
+	owner.Annotations[key] = inUseCMName
+	logger := klog.FromContext(ctx).WithValues("comp", memberType, "tc", fmt.Sprintf("%s/%s", owner.Namespace, owner.Name))
+	logger.Info("store inuse configmap name in tc annotation", "name", inUseCMName, "key", key)
+	if _, err := tcCtl.Update(owner); err != nil {
 		return fmt.Errorf("update tc to save name of currently using configmap: %w", err)
 	}
 
@@ -14,12 +14,17 @@
 package utils
 
 import (
+	"context"
 	"testing"
 
 	. "github.com/onsi/gomega"
 	apps "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
 )
 
 func TestStatefulSetIsUpgrading(t *testing.T) {
@@ -167,3 +172,50 @@ func TestNotExistMount(t *testing.T) {
 	mp = notExistMount(newSTS, oldSTS)
 	g.Expect(mp).ShouldNot(BeEmpty())
 }
+
+func TestDeleteStatefulSetWithOrphan(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	tcIndexer := deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer()
+	tc := &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "basic", Namespace: corev1.NamespaceDefault, UID: "uid"},
+	}
+	g.Expect(tcIndexer.Add(tc)).To(Succeed())
+	newSts := func(name, cm string) *apps.StatefulSet {
+		sts := &apps.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: tc.Namespace, Labels: label.New().TiKV()},
+		}
+		sts.Spec.Template.Spec.Volumes = []corev1.Volume{{
+			Name:         "config",
+			VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: cm}}},
+		}}
+		return sts
+	}
+
+	err := DeleteStatefulSetWithOrphan(context.TODO(), deps.StatefulSetControl, deps.TiDBClusterControl, deps.TiDBClusterLister,
+		tc.DeepCopy(), newSts("basic-tikv", "basic-tikv-1"))
+	g.Expect(err).To(Succeed())
+	updated, err := deps.TiDBClusterLister.TidbClusters(tc.Namespace).Get(tc.Name)
+	g.Expect(err).To(Succeed())
+	g.Expect(updated.Annotations).To(HaveKeyWithValue(label.AnnoKeyOfConfigMapNameForNewSTS("tikv"), "basic-tikv-1"))
+
+	// the name of a component group is stored in the TidbCluster which the group belongs to
+	gtc := &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "basic-hot",
+			Namespace:       tc.Namespace,
+			Labels:          map[string]string{label.ComponentGroupLabelKey: "hot"},
+			OwnerReferences: []metav1.OwnerReference{controller.GetOwnerRef(tc)},
+		},
+	}
+	err = DeleteStatefulSetWithOrphan(context.TODO(), deps.StatefulSetControl, deps.TiDBClusterControl, deps.TiDBClusterLister,
+		gtc, newSts("basic-hot-tikv", "basic-hot-tikv-1"))
+	g.Expect(err).To(Succeed())
+	updated, err = deps.TiDBClusterLister.TidbClusters(tc.Namespace).Get(tc.Name)
+	g.Expect(err).To(Succeed())
+	g.Expect(updated.Annotations).To(HaveKeyWithValue(label.AnnoKeyOfConfigMapNameForNewSTS("tikv"), "basic-tikv-1"))
+	g.Expect(updated.Annotations).To(HaveKeyWithValue(label.AnnoKeyOfConfigMapNameForNewGroupSTS("tikv", "hot"), "basic-hot-tikv-1"))
+	_, err = deps.TiDBClusterLister.TidbClusters(tc.Namespace).Get(gtc.Name)
+	g.Expect(err).To(HaveOccurred())
+}
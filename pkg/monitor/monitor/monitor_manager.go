@@ -104,6 +104,10 @@ func (m *MonitorManager) SyncMonitor(monitor *v1alpha1.TidbMonitor) error {
 		if firstTc == nil && !tc.WithoutLocalPD() {
 			firstTc = tc
 		}
+		if monitor.Spec.PrometheusOperator != nil {
+			// the Prometheus is managed by prometheus-operator, TiDB Dashboard can't be configured with its address
+			continue
+		}
 		err = m.syncDashboardMetricStorage(tc, monitor)
 		if err != nil {
 			klog.Errorf("Fail to sync TiDB Dashboard metrics config for TiDB cluster [%s/%s], error: %v", tc.Namespace, tc.Name, err)
@@ -144,6 +148,19 @@ func (m *MonitorManager) SyncMonitor(monitor *v1alpha1.TidbMonitor) error {
 		return err
 	}
 
+	// In prometheus-operator mode, Prometheus and Grafana are managed outside of the TidbMonitor,
+	// only the scrape targets, the alert rules and the dashboards are synced.
+	if monitor.Spec.PrometheusOperator != nil {
+		if err := m.syncPrometheusOperatorObjects(monitor, firstTc); err != nil {
+			if !controller.IsRequeueError(err) {
+				message := fmt.Sprintf("Sync TidbMonitor[%s/%s] prometheus-operator objects failed, err: %v", monitor.Namespace, monitor.Name, err)
+				m.deps.Recorder.Event(monitor, corev1.EventTypeWarning, FailedSync, message)
+			}
+			return err
+		}
+		return nil
+	}
+
 	// Sync Service
 	if err := m.syncTidbMonitorService(monitor); err != nil {
 		message := fmt.Sprintf("Sync TidbMonitor[%s/%s] Service failed, err: %v", monitor.Namespace, monitor.Name, err)
@@ -345,13 +362,14 @@ func (m *MonitorManager) syncTidbMonitorSecret(monitor *v1alpha1.TidbMonitor) (*
 	return m.deps.TypedControl.CreateOrUpdateSecret(monitor, newSt)
 }
 
-func (m *MonitorManager) syncTidbMonitorConfig(monitor *v1alpha1.TidbMonitor, store *Store) error {
+// getClusterRegexInfos returns the scrape info of the monitored TiDB clusters and DM clusters.
+func (m *MonitorManager) getClusterRegexInfos(monitor *v1alpha1.TidbMonitor) ([]ClusterRegexInfo, []ClusterRegexInfo, error) {
 	var monitorClusterInfos []ClusterRegexInfo
 	for _, tcRef := range monitor.Spec.Clusters {
 		tc, err := m.deps.TiDBClusterLister.TidbClusters(tcRef.Namespace).Get(tcRef.Name)
 		if err != nil {
 			rerr := fmt.Errorf("get tm[%s/%s]'s target tc[%s/%s] failed, err: %v", monitor.Namespace, monitor.Name, tcRef.Namespace, tcRef.Name, err)
-			return rerr
+			return nil, nil, rerr
 		}
 		clusterRegex := ClusterRegexInfo{
 			Name:      tcRef.Name,
@@ -370,7 +388,7 @@ func (m *MonitorManager) syncTidbMonitorConfig(monitor *v1alpha1.TidbMonitor, st
 			dm, err := m.deps.DMClusterLister.DMClusters(dmRef.Namespace).Get(dmRef.Name)
 			if err != nil {
 				rerr := fmt.Errorf("get tm[%s/%s]'s target dm[%s/%s] failed, err: %v", monitor.Namespace, monitor.Name, dmRef.Namespace, dmRef.Name, err)
-				return rerr
+				return nil, nil, rerr
 			}
 			clusterRegex := ClusterRegexInfo{
 				Name:      dmRef.Name,
@@ -384,6 +402,15 @@ func (m *MonitorManager) syncTidbMonitorConfig(monitor *v1alpha1.TidbMonitor, st
 		}
 	}
 
+	return monitorClusterInfos, dmClusterInfos, nil
+}
+
+func (m *MonitorManager) syncTidbMonitorConfig(monitor *v1alpha1.TidbMonitor, store *Store) error {
+	monitorClusterInfos, dmClusterInfos, err := m.getClusterRegexInfos(monitor)
+	if err != nil {
+		return err
+	}
+
 	shards := monitor.GetShards()
 	promCM, err := getPromConfigMap(monitor, monitorClusterInfos, dmClusterInfos, shards, store)
 	if err != nil {
@@ -409,6 +436,13 @@ func (m *MonitorManager) syncTidbMonitorConfig(monitor *v1alpha1.TidbMonitor, st
 			promCM.Data["prometheus.yml"] = externalContent
 		}
 	}
+	if monitor.Spec.SLO != nil {
+		sloRules, err := m.getSLORuleFile(monitor)
+		if err != nil {
+			return err
+		}
+		promCM.Data[sloRulesFile] = string(sloRules)
+	}
 	_, err = m.deps.TypedControl.CreateOrUpdateConfigMap(monitor, promCM)
 	if err != nil {
 		klog.Errorf("Fail to CreateOrUpdateConfigMap %s for tm[%s/%s]'s, err: %v", promCM.Name, monitor.Namespace, monitor.Name, err)
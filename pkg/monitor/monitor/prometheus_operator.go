@@ -0,0 +1,768 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	yamlv2 "gopkg.in/yaml.v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultOperatorScrapeInterval = "15s"
+	// monitorAssetsVersionAnnotation is the version of the initializer image and the alert rules
+	// that the assets Job renders
+	monitorAssetsVersionAnnotation = "tidb.pingcap.com/monitor-assets-version"
+	// monitorAssetsAppliedAnnotation is the hash of the prometheusOperator spec with which the
+	// assets rendered by the Job are applied
+	monitorAssetsAppliedAnnotation = "tidb.pingcap.com/monitor-assets-applied"
+	// monitorAssetsKey is the key of the tarball of the assets in the binary data of the assets ConfigMap
+	monitorAssetsKey          = "assets.tar.gz"
+	monitorAssetsComponent    = "monitor-assets"
+	grafanaDashboardComponent = "grafana-dashboard"
+	rulesFileSuffix           = ".rules.yml"
+	// maxDashboardSize is the max size of the data of a ConfigMap
+	maxDashboardSize = 1024 * 1024
+)
+
+var (
+	podMonitorGVK     = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PodMonitor"}
+	prometheusRuleGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PrometheusRule"}
+
+	defaultDashboardLabels = map[string]string{"grafana_dashboard": "1"}
+
+	relabelConfigKeys = map[string]string{
+		"source_labels": "sourceLabels",
+		"target_label":  "targetLabel",
+		"separator":     "separator",
+		"regex":         "regex",
+		"replacement":   "replacement",
+		"action":        "action",
+		"modulus":       "modulus",
+	}
+
+	invalidObjectNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+)
+
+// monitorAssets are the alert rules and the Grafana dashboards rendered by the initializer image.
+type monitorAssets struct {
+	// rules maps the name of the rule file to its content
+	rules map[string][]byte
+	// dashboards maps the name of the dashboard file to its content
+	dashboards map[string][]byte
+}
+
+// syncPrometheusOperatorObjects creates a PodMonitor for each component of the monitored clusters,
+// the PrometheusRule of the SLO rules, and the PrometheusRule and the dashboard ConfigMaps rendered by the initializer image.
+func (m *MonitorManager) syncPrometheusOperatorObjects(monitor *v1alpha1.TidbMonitor, tc *v1alpha1.TidbCluster) error {
+	if err := m.cleanLegacyMonitorObjects(monitor); err != nil {
+		return fmt.Errorf("clean tm[%s/%s]'s Prometheus and Grafana failed, err: %v", monitor.Namespace, monitor.Name, err)
+	}
+
+	tcs, dms, err := m.getClusterRegexInfos(monitor)
+	if err != nil {
+		return err
+	}
+	if err := m.syncPodMonitors(monitor, getPodMonitors(monitor, tcs, dms)); err != nil {
+		return fmt.Errorf("sync tm[%s/%s]'s PodMonitors failed, err: %v", monitor.Namespace, monitor.Name, err)
+	}
+	klog.V(4).Infof("tm[%s/%s]'s PodMonitors synced", monitor.Namespace, monitor.Name)
+
+	if err := m.syncSLORules(monitor); err != nil {
+		return err
+	}
+	return m.syncMonitorAssets(monitor, tc)
+}
+
+// cleanLegacyMonitorObjects deletes the Prometheus and Grafana deployed before the TidbMonitor is switched
+// to prometheus-operator mode, the PVCs are kept so that the data is not lost.
+func (m *MonitorManager) cleanLegacyMonitorObjects(monitor *v1alpha1.TidbMonitor) error {
+	ns := monitor.Namespace
+	if err := m.removeIngressIfExist(monitor, PrometheusName(monitor.Name, 0)); err != nil {
+		return err
+	}
+	if err := m.removeIngressIfExist(monitor, GrafanaName(monitor.Name, 0)); err != nil {
+		return err
+	}
+
+	// the StatefulSets of the shards and the Services have different instance labels
+	selector := metav1.FormatLabelSelector(metav1.SetAsLabelSelector(map[string]string{label.ComponentLabelKey: label.TiDBMonitorVal}))
+	stsList, err := m.deps.KubeClientset.AppsV1().StatefulSets(ns).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return err
+	}
+	for i := range stsList.Items {
+		sts := &stsList.Items[i]
+		if !metav1.IsControlledBy(sts, monitor) {
+			continue
+		}
+		if err := m.deps.KubeClientset.AppsV1().StatefulSets(ns).Delete(context.TODO(), sts.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		klog.Infof("tm[%s/%s]'s StatefulSet %s is deleted", ns, monitor.Name, sts.Name)
+	}
+	svcList, err := m.deps.KubeClientset.CoreV1().Services(ns).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return err
+	}
+	for i := range svcList.Items {
+		svc := &svcList.Items[i]
+		if !metav1.IsControlledBy(svc, monitor) {
+			continue
+		}
+		if err := m.deps.KubeClientset.CoreV1().Services(ns).Delete(context.TODO(), svc.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		klog.Infof("tm[%s/%s]'s Service %s is deleted", ns, monitor.Name, svc.Name)
+	}
+
+	for _, name := range []string{GetPromConfigMapName(monitor), GetGrafanaConfigMapName(monitor)} {
+		cm, err := m.deps.KubeClientset.CoreV1().ConfigMaps(ns).Get(context.TODO(), name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if !metav1.IsControlledBy(cm, monitor) {
+			continue
+		}
+		if err := m.deps.KubeClientset.CoreV1().ConfigMaps(ns).Delete(context.TODO(), name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		klog.Infof("tm[%s/%s]'s ConfigMap %s is deleted", ns, monitor.Name, name)
+	}
+
+	// the credentials of Grafana
+	secret, err := m.deps.KubeClientset.CoreV1().Secrets(ns).Get(context.TODO(), GetMonitorObjectName(monitor), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(secret, monitor) {
+		return nil
+	}
+	if err := m.deps.KubeClientset.CoreV1().Secrets(ns).Delete(context.TODO(), secret.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	klog.Infof("tm[%s/%s]'s Secret %s is deleted", ns, monitor.Name, secret.Name)
+	return nil
+}
+
+func getPodMonitors(monitor *v1alpha1.TidbMonitor, tcs, dms []ClusterRegexInfo) []*unstructured.Unstructured {
+	var podMonitors []*unstructured.Unstructured
+	for _, c := range scrapeComponents {
+		clusters := tcs
+		if isDMJob(c.jobName) {
+			clusters = dms
+		}
+		for _, cluster := range clusters {
+			podMonitors = append(podMonitors, getPodMonitor(monitor, cluster, c.jobName, c.componentPattern))
+		}
+	}
+	return podMonitors
+}
+
+// getPodMonitor returns the PodMonitor of the component, it is equivalent to the scrape job of the component
+// in the Prometheus config of TidbMonitor, so that the dashboards and the alert rules work as well.
+func getPodMonitor(monitor *v1alpha1.TidbMonitor, cluster ClusterRegexInfo, jobName, componentPattern string) *unstructured.Unstructured {
+	interval := monitor.Spec.PrometheusOperator.ScrapeInterval
+	if interval == "" {
+		interval = defaultOperatorScrapeInterval
+	}
+	relabelConfigs := scrapeRelabelConfigs(cluster, componentPattern, buildAddressRelabelConfigByComponent(jobName))
+	// the job label is set to `<namespace>/<name>` of the PodMonitor by prometheus-operator
+	relabelConfigs = append(relabelConfigs, yamlv2.MapSlice{
+		{Key: "target_label", Value: "job"},
+		{Key: "replacement", Value: scrapeJobName(cluster, jobName)},
+	})
+
+	scheme, tlsSecretName := scrapeTLS(jobName, cluster)
+	endpoint := map[string]interface{}{
+		"interval":    interval,
+		"honorLabels": true,
+		"scheme":      scheme,
+		"tlsConfig":   map[string]interface{}{"insecureSkipVerify": true},
+		"relabelings": toOperatorRelabelings(relabelConfigs),
+	}
+	if tlsSecretName != "" {
+		assetKey := func(key string) map[string]interface{} {
+			return map[string]interface{}{
+				"name": GetTLSAssetsSecretName(monitor.Name),
+				"key":  TLSAssetKey{"secret", cluster.Namespace, tlsSecretName, key}.String(),
+			}
+		}
+		endpoint["tlsConfig"] = map[string]interface{}{
+			"ca":        map[string]interface{}{"secret": assetKey(corev1.ServiceAccountRootCAKey)},
+			"cert":      map[string]interface{}{"secret": assetKey(corev1.TLSCertKey)},
+			"keySecret": assetKey(corev1.TLSPrivateKeyKey),
+		}
+	}
+
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"namespaceSelector": map[string]interface{}{
+				"matchNames": []interface{}{cluster.Namespace},
+			},
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{label.InstanceLabelKey: cluster.Name},
+			},
+			"podMetricsEndpoints": []interface{}{endpoint},
+		},
+	}}
+	obj.SetGroupVersionKind(podMonitorGVK)
+	obj.SetName(fmt.Sprintf("%s-%s", monitor.Name, scrapeJobName(cluster, jobName)))
+	obj.SetNamespace(monitor.Namespace)
+	obj.SetLabels(util.CombineStringMap(monitor.Spec.PrometheusOperator.Labels, buildTidbMonitorLabel(monitor.Name)))
+	obj.SetOwnerReferences([]metav1.OwnerReference{controller.GetTiDBMonitorOwnerRef(monitor)})
+	return obj
+}
+
+// toOperatorRelabelings converts the relabel configs of Prometheus into the relabelings of prometheus-operator.
+func toOperatorRelabelings(configs []yamlv2.MapSlice) []interface{} {
+	relabelings := make([]interface{}, 0, len(configs))
+	for _, config := range configs {
+		relabeling := map[string]interface{}{}
+		for _, item := range config {
+			key := relabelConfigKeys[item.Key.(string)]
+			switch v := item.Value.(type) {
+			case []string:
+				values := make([]interface{}, 0, len(v))
+				for _, s := range v {
+					values = append(values, s)
+				}
+				relabeling[key] = values
+			case uint64:
+				relabeling[key] = int64(v)
+			default:
+				relabeling[key] = v
+			}
+		}
+		relabelings = append(relabelings, relabeling)
+	}
+	return relabelings
+}
+
+// syncPodMonitors creates or updates the PodMonitors and deletes the ones of the clusters or components no longer monitored.
+func (m *MonitorManager) syncPodMonitors(monitor *v1alpha1.TidbMonitor, podMonitors []*unstructured.Unstructured) error {
+	desired := map[string]bool{}
+	for _, pm := range podMonitors {
+		if err := m.createOrUpdateUnstructured(pm); err != nil {
+			return err
+		}
+		desired[pm.GetName()] = true
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(podMonitorGVK.GroupVersion().WithKind(podMonitorGVK.Kind + "List"))
+	if err := m.deps.GenericClient.List(context.TODO(), list, client.InNamespace(monitor.Namespace),
+		client.MatchingLabels(buildTidbMonitorLabel(monitor.Name))); err != nil {
+		return err
+	}
+	for i := range list.Items {
+		pm := &list.Items[i]
+		if desired[pm.GetName()] || !metav1.IsControlledBy(pm, monitor) {
+			continue
+		}
+		if err := m.deps.GenericClient.Delete(context.TODO(), pm); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		klog.Infof("tm[%s/%s]'s PodMonitor %s is deleted", monitor.Namespace, monitor.Name, pm.GetName())
+	}
+	return nil
+}
+
+// createOrUpdateUnstructured creates the object, or updates the spec, the labels and the annotations of the existing one.
+func (m *MonitorManager) createOrUpdateUnstructured(obj *unstructured.Unstructured) error {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GroupVersionKind())
+	err := m.deps.GenericClient.Get(context.TODO(), client.ObjectKeyFromObject(obj), existing)
+	if errors.IsNotFound(err) {
+		return m.deps.GenericClient.Create(context.TODO(), obj.DeepCopy())
+	}
+	if err != nil {
+		return err
+	}
+
+	if apiequality.Semantic.DeepEqual(existing.Object["spec"], obj.Object["spec"]) &&
+		apiequality.Semantic.DeepEqual(existing.GetLabels(), obj.GetLabels()) &&
+		apiequality.Semantic.DeepEqual(existing.GetAnnotations(), obj.GetAnnotations()) &&
+		apiequality.Semantic.DeepEqual(existing.GetOwnerReferences(), obj.GetOwnerReferences()) {
+		return nil
+	}
+	updated := existing.DeepCopy()
+	updated.Object["spec"] = obj.Object["spec"]
+	updated.SetLabels(obj.GetLabels())
+	updated.SetAnnotations(obj.GetAnnotations())
+	updated.SetOwnerReferences(obj.GetOwnerReferences())
+	return m.deps.GenericClient.Update(context.TODO(), updated)
+}
+
+func (m *MonitorManager) deleteUnstructuredIfExists(gvk schema.GroupVersionKind, ns, name string) error {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetNamespace(ns)
+	obj.SetName(name)
+	err := m.deps.GenericClient.Delete(context.TODO(), obj)
+	if err != nil && !errors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return err
+	}
+	return nil
+}
+
+// syncMonitorAssets runs a Job with the initializer image to render the alert rules and the dashboards,
+// the Job uploads them as a tarball into the assets ConfigMap, then they are read from it and applied.
+// The Job is kept until the version of the initializer image or the alert rules changes.
+func (m *MonitorManager) syncMonitorAssets(monitor *v1alpha1.TidbMonitor, tc *v1alpha1.TidbCluster) error {
+	po := monitor.Spec.PrometheusOperator
+	if po.DisableAlertRules && po.DisableDashboards {
+		return m.applyMonitorAssets(monitor, &monitorAssets{})
+	}
+
+	ns := monitor.Namespace
+	name := GetMonitorAssetsJobName(monitor.Name)
+	version := getMonitorAssetsVersion(monitor)
+	jobClient := m.deps.KubeClientset.BatchV1().Jobs(ns)
+	job, err := jobClient.Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if err == nil && job.Annotations[monitorAssetsVersionAnnotation] != version {
+		klog.Infof("tm[%s/%s]'s monitor assets version changes to %s, recreate Job %s", ns, monitor.Name, version, name)
+		if err := m.deleteMonitorAssetsJob(monitor); err != nil {
+			return err
+		}
+		return controller.RequeueErrorf("tm[%s/%s]: waiting for the old assets Job %s to be deleted", ns, monitor.Name, name)
+	}
+	if errors.IsNotFound(err) {
+		sa, err := m.syncMonitorAssetsRbac(monitor)
+		if err != nil {
+			return err
+		}
+		if err := m.ensureMonitorAssetsConfigMap(monitor); err != nil {
+			return err
+		}
+		job := getMonitorAssetsJob(monitor, tc, version, m.deps.CLIConfig.TiDBDiscoveryImage, sa.Name)
+		if _, err := jobClient.Create(context.TODO(), job, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+		return controller.RequeueErrorf("tm[%s/%s]: waiting for the assets Job %s to complete", ns, monitor.Name, name)
+	}
+
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			return fmt.Errorf("tm[%s/%s]'s assets Job %s failed, reason: %s, message: %s", ns, monitor.Name, name, cond.Reason, cond.Message)
+		}
+	}
+	if job.Status.Succeeded == 0 {
+		return controller.RequeueErrorf("tm[%s/%s]: waiting for the assets Job %s to complete", ns, monitor.Name, name)
+	}
+
+	applied := getMonitorAssetsAppliedHash(monitor)
+	if job.Annotations[monitorAssetsAppliedAnnotation] == applied {
+		return nil
+	}
+	assets, err := m.readMonitorAssets(monitor, version)
+	if err != nil {
+		// the ConfigMap is deleted or modified, render the assets again
+		klog.Errorf("tm[%s/%s]: failed to read the assets uploaded by Job %s, recreate it, err: %v", ns, monitor.Name, name, err)
+		if err := m.deleteMonitorAssetsJob(monitor); err != nil {
+			return err
+		}
+		return controller.RequeueErrorf("tm[%s/%s]: waiting for the assets Job %s to be recreated", ns, monitor.Name, name)
+	}
+	if err := m.applyMonitorAssets(monitor, assets); err != nil {
+		return err
+	}
+
+	job = job.DeepCopy()
+	job.Annotations[monitorAssetsAppliedAnnotation] = applied
+	_, err = jobClient.Update(context.TODO(), job, metav1.UpdateOptions{})
+	return err
+}
+
+func (m *MonitorManager) deleteMonitorAssetsJob(monitor *v1alpha1.TidbMonitor) error {
+	propagation := metav1.DeletePropagationBackground
+	err := m.deps.KubeClientset.BatchV1().Jobs(monitor.Namespace).Delete(context.TODO(), GetMonitorAssetsJobName(monitor.Name),
+		metav1.DeleteOptions{PropagationPolicy: &propagation})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// syncMonitorAssetsRbac grants the assets Job to update the assets ConfigMap only.
+func (m *MonitorManager) syncMonitorAssetsRbac(monitor *v1alpha1.TidbMonitor) (*corev1.ServiceAccount, error) {
+	name := GetMonitorAssetsJobName(monitor.Name)
+	objectMeta := metav1.ObjectMeta{
+		Name:            name,
+		Namespace:       monitor.Namespace,
+		Labels:          buildTidbMonitorAssetsLabel(monitor.Name),
+		OwnerReferences: []metav1.OwnerReference{controller.GetTiDBMonitorOwnerRef(monitor)},
+	}
+	sa, err := m.deps.TypedControl.CreateOrUpdateServiceAccount(monitor, &corev1.ServiceAccount{ObjectMeta: objectMeta})
+	if err != nil {
+		return nil, err
+	}
+	role, err := m.deps.TypedControl.CreateOrUpdateRole(monitor, &rbacv1.Role{
+		ObjectMeta: objectMeta,
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups:     []string{""},
+				Resources:     []string{"configmaps"},
+				ResourceNames: []string{GetMonitorAssetsConfigMapName(monitor.Name)},
+				Verbs:         []string{"get", "update"},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = m.deps.TypedControl.CreateOrUpdateRoleBinding(monitor, &rbacv1.RoleBinding{
+		ObjectMeta: objectMeta,
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      sa.Name,
+				Namespace: sa.Namespace,
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     role.Name,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sa, nil
+}
+
+// ensureMonitorAssetsConfigMap creates the empty assets ConfigMap which the Job uploads the assets to,
+// the existing one is not updated so that the uploaded assets are kept.
+func (m *MonitorManager) ensureMonitorAssetsConfigMap(monitor *v1alpha1.TidbMonitor) error {
+	name := GetMonitorAssetsConfigMapName(monitor.Name)
+	_, err := m.deps.KubeClientset.CoreV1().ConfigMaps(monitor.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if !errors.IsNotFound(err) {
+		return err
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       monitor.Namespace,
+			Labels:          buildTidbMonitorAssetsLabel(monitor.Name),
+			OwnerReferences: []metav1.OwnerReference{controller.GetTiDBMonitorOwnerRef(monitor)},
+		},
+	}
+	_, err = m.deps.KubeClientset.CoreV1().ConfigMaps(monitor.Namespace).Create(context.TODO(), cm, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// readMonitorAssets reads the assets of the version uploaded by the Job from the assets ConfigMap.
+func (m *MonitorManager) readMonitorAssets(monitor *v1alpha1.TidbMonitor, version string) (*monitorAssets, error) {
+	name := GetMonitorAssetsConfigMapName(monitor.Name)
+	cm, err := m.deps.KubeClientset.CoreV1().ConfigMaps(monitor.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if v := cm.Annotations[monitorAssetsVersionAnnotation]; v != version {
+		return nil, fmt.Errorf("the version of the assets in configmap %s/%s is %q, expect %q", cm.Namespace, cm.Name, v, version)
+	}
+	data, ok := cm.BinaryData[monitorAssetsKey]
+	if !ok {
+		return nil, fmt.Errorf("no %s is found in configmap %s/%s", monitorAssetsKey, cm.Namespace, cm.Name)
+	}
+	return parseMonitorAssets(data)
+}
+
+// parseMonitorAssets parses the gzipped tarball uploaded by the assets Job.
+func parseMonitorAssets(data []byte) (*monitorAssets, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decompress assets failed: %v", err)
+	}
+	defer gz.Close()
+
+	assets := &monitorAssets{rules: map[string][]byte{}, dashboards: map[string][]byte{}}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read assets failed: %v", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := strings.TrimPrefix(path.Clean(hdr.Name), "./")
+		var files map[string][]byte
+		switch {
+		case strings.HasPrefix(name, "prometheus-rules/") && strings.HasSuffix(name, rulesFileSuffix):
+			files = assets.rules
+		case strings.HasPrefix(name, "grafana-dashboard-definitions/") && strings.HasSuffix(name, ".json"):
+			files = assets.dashboards
+		default:
+			continue
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("read %s of assets failed: %v", name, err)
+		}
+		files[path.Base(name)] = content
+	}
+	return assets, nil
+}
+
+// applyMonitorAssets creates the PrometheusRule of the alert rules and a ConfigMap for each dashboard,
+// the ones which are disabled or no longer rendered are deleted.
+func (m *MonitorManager) applyMonitorAssets(monitor *v1alpha1.TidbMonitor, assets *monitorAssets) error {
+	po := monitor.Spec.PrometheusOperator
+	version := getMonitorAssetsVersion(monitor)
+
+	ruleName := GetMonitorAlertRulesName(monitor.Name)
+	if po.DisableAlertRules || len(assets.rules) == 0 {
+		if err := m.deleteUnstructuredIfExists(prometheusRuleGVK, monitor.Namespace, ruleName); err != nil {
+			return err
+		}
+	} else {
+		rule, err := getPrometheusRule(monitor, assets.rules, version)
+		if err != nil {
+			return err
+		}
+		if err := m.createOrUpdateUnstructured(rule); err != nil {
+			return fmt.Errorf("sync tm[%s/%s]'s PrometheusRule failed, err: %v", monitor.Namespace, monitor.Name, err)
+		}
+	}
+
+	desired := map[string]bool{}
+	if !po.DisableDashboards {
+		for _, file := range sortedFileNames(assets.dashboards) {
+			content := assets.dashboards[file]
+			if len(content) > maxDashboardSize {
+				message := fmt.Sprintf("dashboard %s is skipped, its size %d exceeds the limit of ConfigMap", file, len(content))
+				m.deps.Recorder.Event(monitor, corev1.EventTypeWarning, FailedSync, message)
+				continue
+			}
+			cm := getDashboardConfigMap(monitor, file, content, version)
+			if _, err := m.deps.TypedControl.CreateOrUpdateConfigMap(monitor, cm); err != nil {
+				return err
+			}
+			desired[cm.Name] = true
+		}
+	}
+	cms, err := m.deps.KubeClientset.CoreV1().ConfigMaps(monitor.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(buildTidbMonitorDashboardLabel(monitor.Name))),
+	})
+	if err != nil {
+		return err
+	}
+	for i := range cms.Items {
+		cm := &cms.Items[i]
+		if desired[cm.Name] || !metav1.IsControlledBy(cm, monitor) {
+			continue
+		}
+		if err := m.deps.KubeClientset.CoreV1().ConfigMaps(cm.Namespace).Delete(context.TODO(), cm.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	klog.V(4).Infof("tm[%s/%s]'s alert rules and %d dashboards synced", monitor.Namespace, monitor.Name, len(desired))
+	return nil
+}
+
+// getPrometheusRule merges the groups of the rule files into a PrometheusRule.
+func getPrometheusRule(monitor *v1alpha1.TidbMonitor, rules map[string][]byte, version string) (*unstructured.Unstructured, error) {
+	var groups []interface{}
+	for _, file := range sortedFileNames(rules) {
+		var content struct {
+			Groups []interface{} `json:"groups"`
+		}
+		if err := yaml.Unmarshal(rules[file], &content); err != nil {
+			return nil, fmt.Errorf("parse rule file %s failed: %v", file, err)
+		}
+		groups = append(groups, content.Groups...)
+	}
+
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"groups": groups},
+	}}
+	obj.SetGroupVersionKind(prometheusRuleGVK)
+	obj.SetName(GetMonitorAlertRulesName(monitor.Name))
+	obj.SetNamespace(monitor.Namespace)
+	obj.SetLabels(util.CombineStringMap(monitor.Spec.PrometheusOperator.Labels, buildTidbMonitorLabel(monitor.Name)))
+	obj.SetAnnotations(map[string]string{monitorAssetsVersionAnnotation: version})
+	obj.SetOwnerReferences([]metav1.OwnerReference{controller.GetTiDBMonitorOwnerRef(monitor)})
+	return obj, nil
+}
+
+func getDashboardConfigMap(monitor *v1alpha1.TidbMonitor, file string, content []byte, version string) *corev1.ConfigMap {
+	po := monitor.Spec.PrometheusOperator
+	dashboardLabels := po.DashboardLabels
+	if len(dashboardLabels) == 0 {
+		dashboardLabels = defaultDashboardLabels
+	}
+	name := invalidObjectNameChars.ReplaceAllString(strings.ToLower(strings.TrimSuffix(file, ".json")), "-")
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-dashboard-%s", monitor.Name, strings.Trim(name, "-")),
+			Namespace: monitor.Namespace,
+			Labels:    util.CombineStringMap(dashboardLabels, buildTidbMonitorDashboardLabel(monitor.Name)),
+			// the version annotation is always set so that the annotations of the existing ConfigMap can be merged
+			Annotations:     util.CombineStringMap(po.DashboardAnnotations, map[string]string{monitorAssetsVersionAnnotation: version}),
+			OwnerReferences: []metav1.OwnerReference{controller.GetTiDBMonitorOwnerRef(monitor)},
+		},
+		Data: map[string]string{file: string(content)},
+	}
+}
+
+// getMonitorAssetsJob returns the Job which renders the alert rules and the dashboards with the initializer
+// as the TidbMonitor Pod does, then uploads them as a gzipped tarball into the assets ConfigMap with the
+// tidb-monitor-assets of the operator image. The size of the tarball is limited by the size of a ConfigMap.
+func getMonitorAssetsJob(monitor *v1alpha1.TidbMonitor, tc *v1alpha1.TidbCluster, version, image, serviceAccount string) *batchv1.Job {
+	initContainer := getMonitorInitContainer(monitor, tc)
+	if monitor.Spec.Grafana == nil {
+		initContainer.VolumeMounts = append(initContainer.VolumeMounts, getGrafanaVolumeMounts()...)
+		initContainer.Env = append(initContainer.Env, getGrafanaEnvs()...)
+	}
+	var volumes []corev1.Volume
+	for _, vm := range initContainer.VolumeMounts {
+		volumes = append(volumes, corev1.Volume{
+			Name:         vm.Name,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
+	}
+	volumes = append(volumes, corev1.Volume{
+		Name:         monitorAssetsComponent,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	assetsMount := corev1.VolumeMount{Name: monitorAssetsComponent, MountPath: "/assets"}
+	packContainer := corev1.Container{
+		Name:            "pack",
+		Image:           initContainer.Image,
+		ImagePullPolicy: initContainer.ImagePullPolicy,
+		Command: []string{
+			"/bin/sh",
+			"-c",
+			"tar -C / -czf /assets/" + monitorAssetsKey + " prometheus-rules grafana-dashboard-definitions",
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "prometheus-rules", MountPath: "/prometheus-rules", ReadOnly: true},
+			{Name: "grafana-dashboard", MountPath: "/grafana-dashboard-definitions/tidb", ReadOnly: true},
+			assetsMount,
+		},
+		Resources:       initContainer.Resources,
+		SecurityContext: initContainer.SecurityContext,
+	}
+	assetsMount.ReadOnly = true
+	uploadContainer := corev1.Container{
+		Name:  "upload",
+		Image: image,
+		Command: []string{
+			"/usr/local/bin/tidb-monitor-assets",
+			"--namespace=" + monitor.Namespace,
+			"--configmap=" + GetMonitorAssetsConfigMapName(monitor.Name),
+			"--file=/assets/" + monitorAssetsKey,
+			"--key=" + monitorAssetsKey,
+			"--annotation=" + monitorAssetsVersionAnnotation,
+			"--assets-version=" + version,
+		},
+		VolumeMounts: []corev1.VolumeMount{assetsMount},
+	}
+
+	labels := buildTidbMonitorAssetsLabel(monitor.Name)
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            GetMonitorAssetsJobName(monitor.Name),
+			Namespace:       monitor.Namespace,
+			Labels:          labels,
+			Annotations:     map[string]string{monitorAssetsVersionAnnotation: version},
+			OwnerReferences: []metav1.OwnerReference{controller.GetTiDBMonitorOwnerRef(monitor)},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: pointer.Int32Ptr(3),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					InitContainers:     []corev1.Container{initContainer, packContainer},
+					Containers:         []corev1.Container{uploadContainer},
+					Volumes:            volumes,
+					ServiceAccountName: serviceAccount,
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ImagePullSecrets:   monitor.Spec.ImagePullSecrets,
+					NodeSelector:       monitor.Spec.NodeSelector,
+					Tolerations:        monitor.Spec.Tolerations,
+					SecurityContext:    monitor.Spec.PodSecurityContext,
+				},
+			},
+		},
+	}
+}
+
+// getMonitorAssetsVersion returns the version of the assets, the assets are rendered again when it changes.
+func getMonitorAssetsVersion(monitor *v1alpha1.TidbMonitor) string {
+	return fmt.Sprintf("%s:%s/%s", monitor.Spec.Initializer.BaseImage, monitor.Spec.Initializer.Version, getAlertManagerRulesVersion(monitor))
+}
+
+// getMonitorAssetsAppliedHash returns the hash of the assets version and the prometheusOperator spec,
+// the assets are applied again when it changes.
+func getMonitorAssetsAppliedHash(monitor *v1alpha1.TidbMonitor) string {
+	data, _ := json.Marshal(monitor.Spec.PrometheusOperator)
+	return fmt.Sprintf("%x", sha256.Sum256(append([]byte(getMonitorAssetsVersion(monitor)), data...)))[:16]
+}
+
+func sortedFileNames(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
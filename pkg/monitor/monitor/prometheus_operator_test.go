@@ -0,0 +1,304 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newOperatorModeMonitor() *v1alpha1.TidbMonitor {
+	return &v1alpha1.TidbMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "basic", Namespace: "monitoring"},
+		Spec: v1alpha1.TidbMonitorSpec{
+			Clusters: []v1alpha1.TidbClusterRef{{Name: "tc", Namespace: "ns"}},
+			PrometheusOperator: &v1alpha1.PrometheusOperatorSpec{
+				Labels: map[string]string{"release": "kube-prometheus-stack"},
+			},
+		},
+	}
+}
+
+func TestGetPodMonitors(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tm := newOperatorModeMonitor()
+	tcs := []ClusterRegexInfo{{Name: "tc", Namespace: "ns"}}
+	dms := []ClusterRegexInfo{{Name: "dc", Namespace: "ns"}}
+
+	pms := getPodMonitors(tm, tcs, dms)
+	g.Expect(pms).To(HaveLen(len(scrapeComponents)))
+	var names []string
+	for _, pm := range pms {
+		names = append(names, pm.GetName())
+	}
+	g.Expect(names).To(ContainElements("basic-ns-tc-pd", "basic-ns-tc-tikv", "basic-ns-dc-dm-worker"))
+	g.Expect(names).NotTo(ContainElement("basic-ns-tc-dm-worker"))
+
+	pd := pms[0]
+	g.Expect(pd.GetNamespace()).To(Equal("monitoring"))
+	g.Expect(pd.GetLabels()).To(HaveKeyWithValue("release", "kube-prometheus-stack"))
+	g.Expect(pd.GetOwnerReferences()).To(HaveLen(1))
+	matchNames, _, _ := unstructured.NestedSlice(pd.Object, "spec", "namespaceSelector", "matchNames")
+	g.Expect(matchNames).To(Equal([]interface{}{"ns"}))
+
+	endpoints, _, _ := unstructured.NestedSlice(pd.Object, "spec", "podMetricsEndpoints")
+	g.Expect(endpoints).To(HaveLen(1))
+	endpoint := endpoints[0].(map[string]interface{})
+	g.Expect(endpoint["interval"]).To(Equal(defaultOperatorScrapeInterval))
+	g.Expect(endpoint["scheme"]).To(Equal("http"))
+	relabelings := endpoint["relabelings"].([]interface{})
+	g.Expect(relabelings[0]).To(Equal(map[string]interface{}{
+		"sourceLabels": []interface{}{"__meta_kubernetes_pod_label_app_kubernetes_io_instance"},
+		"action":       "keep",
+		"regex":        "tc",
+	}))
+	g.Expect(relabelings[len(relabelings)-1]).To(Equal(map[string]interface{}{
+		"targetLabel": "job",
+		"replacement": "ns-tc-pd",
+	}))
+	// the object must be able to be deep copied, which panics on unsupported types
+	g.Expect(func() { pd.DeepCopy() }).NotTo(Panic())
+}
+
+func TestGetPodMonitorTLS(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tm := newOperatorModeMonitor()
+	tm.Spec.PrometheusOperator.ScrapeInterval = "30s"
+	cluster := ClusterRegexInfo{Name: "tc", Namespace: "ns", enableTLS: true}
+
+	pm := getPodMonitor(tm, cluster, "tikv", tikvPattern)
+	endpoints, _, _ := unstructured.NestedSlice(pm.Object, "spec", "podMetricsEndpoints")
+	endpoint := endpoints[0].(map[string]interface{})
+	g.Expect(endpoint["interval"]).To(Equal("30s"))
+	g.Expect(endpoint["scheme"]).To(Equal("https"))
+	tlsConfig := endpoint["tlsConfig"].(map[string]interface{})
+	g.Expect(tlsConfig["keySecret"]).To(Equal(map[string]interface{}{
+		"name": "tidbmonitor-basic-tls-assets",
+		"key":  "secret_ns_tc-cluster-client-secret_tls.key",
+	}))
+	g.Expect(tlsConfig).To(HaveKey("ca"))
+	g.Expect(tlsConfig).To(HaveKey("cert"))
+
+	// the certificate of tiproxy is not verified
+	pm = getPodMonitor(tm, cluster, "tiproxy", tiproxyPattern)
+	endpoints, _, _ = unstructured.NestedSlice(pm.Object, "spec", "podMetricsEndpoints")
+	endpoint = endpoints[0].(map[string]interface{})
+	g.Expect(endpoint["scheme"]).To(Equal("https"))
+	g.Expect(endpoint["tlsConfig"]).To(Equal(map[string]interface{}{"insecureSkipVerify": true}))
+}
+
+// newMonitorAssetsTarball returns the gzipped tarball of the files as the assets Job uploads
+func newMonitorAssetsTarball(g *GomegaWithT, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+	g.Expect(tw.WriteHeader(&tar.Header{Name: "prometheus-rules/", Typeflag: tar.TypeDir, Mode: 0755})).To(Succeed())
+	for name, content := range files {
+		g.Expect(tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(content))})).To(Succeed())
+		_, err := tw.Write([]byte(content))
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+	g.Expect(tw.Close()).To(Succeed())
+	g.Expect(gw.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func TestParseMonitorAssets(t *testing.T) {
+	g := NewGomegaWithT(t)
+	files := map[string]string{
+		"prometheus-rules/tidb.rules.yml":                      "groups:\n- name: alert.rules\n  rules: []\n",
+		"prometheus-rules/README":                              "ignored",
+		"grafana-dashboard-definitions/tidb/tikv_details.json": `{"title": "TiKV-Details"}`,
+		"grafana-dashboard-definitions/tidb/README":            "ignored",
+	}
+
+	assets, err := parseMonitorAssets(newMonitorAssetsTarball(g, files))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(assets.rules).To(HaveLen(1))
+	g.Expect(string(assets.rules["tidb.rules.yml"])).To(Equal(files["prometheus-rules/tidb.rules.yml"]))
+	g.Expect(assets.dashboards).To(HaveLen(1))
+	g.Expect(assets.dashboards).To(HaveKey("tikv_details.json"))
+
+	_, err = parseMonitorAssets([]byte("not gzip"))
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestReadMonitorAssets(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tmm := newFakeTidbMonitorManager()
+	tm := newOperatorModeMonitor()
+	cmClient := tmm.deps.KubeClientset.CoreV1().ConfigMaps(tm.Namespace)
+
+	// the ConfigMap is empty until the Job uploads the assets
+	g.Expect(tmm.ensureMonitorAssetsConfigMap(tm)).To(Succeed())
+	_, err := tmm.readMonitorAssets(tm, "v1")
+	g.Expect(err).To(HaveOccurred())
+
+	cm, err := cmClient.Get(context.TODO(), "basic-monitor-assets", metav1.GetOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(metav1.IsControlledBy(cm, tm)).To(BeTrue())
+	cm.Annotations = map[string]string{monitorAssetsVersionAnnotation: "v1"}
+	cm.BinaryData = map[string][]byte{
+		monitorAssetsKey: newMonitorAssetsTarball(g, map[string]string{"grafana-dashboard-definitions/tidb/pd.json": "{}"}),
+	}
+	_, err = cmClient.Update(context.TODO(), cm, metav1.UpdateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// the uploaded assets are kept
+	g.Expect(tmm.ensureMonitorAssetsConfigMap(tm)).To(Succeed())
+	assets, err := tmm.readMonitorAssets(tm, "v1")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(assets.dashboards).To(HaveKey("pd.json"))
+
+	// the assets of an old version are rendered again
+	_, err = tmm.readMonitorAssets(tm, "v2")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestGetMonitorAssetsJob(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tm := newOperatorModeMonitor()
+	tm.Spec.Initializer = v1alpha1.InitializerSpec{MonitorContainer: v1alpha1.MonitorContainer{BaseImage: "pingcap/tidb-monitor-initializer", Version: "v8.5.0"}}
+
+	job := getMonitorAssetsJob(tm, nil, "v1", "pingcap/tidb-operator:v1.6.0", "basic-monitor-assets")
+	spec := job.Spec.Template.Spec
+	g.Expect(spec.ServiceAccountName).To(Equal("basic-monitor-assets"))
+	g.Expect(spec.InitContainers).To(HaveLen(2))
+	g.Expect(spec.InitContainers[1].Image).To(Equal("pingcap/tidb-monitor-initializer:v8.5.0"))
+	g.Expect(spec.Containers).To(HaveLen(1))
+	g.Expect(spec.Containers[0].Image).To(Equal("pingcap/tidb-operator:v1.6.0"))
+	g.Expect(spec.Containers[0].Command).To(ContainElements("--configmap=basic-monitor-assets", "--assets-version=v1"))
+	g.Expect(job.Annotations).To(HaveKeyWithValue(monitorAssetsVersionAnnotation, "v1"))
+}
+
+func TestCleanLegacyMonitorObjects(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tmm := newFakeTidbMonitorManager()
+	tm := newOperatorModeMonitor()
+	tm.UID = "tm-uid"
+	cli := tmm.deps.KubeClientset
+	ns := tm.Namespace
+
+	owned := func(name string, labels map[string]string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       ns,
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{controller.GetTiDBMonitorOwnerRef(tm)},
+		}
+	}
+	notOwned := func(name string, labels map[string]string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: ns, Labels: labels}
+	}
+	shardLabels := buildTidbMonitorLabel(GetMonitorInstanceName(tm, 1))
+	for _, sts := range []*appsv1.StatefulSet{
+		{ObjectMeta: owned("basic-monitor", buildTidbMonitorLabel(tm.Name))},
+		{ObjectMeta: owned("basic-monitor-shard-1", shardLabels)},
+		{ObjectMeta: notOwned("other-monitor", buildTidbMonitorLabel("other"))},
+	} {
+		_, err := cli.AppsV1().StatefulSets(ns).Create(context.TODO(), sts, metav1.CreateOptions{})
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+	for _, svc := range []*corev1.Service{
+		{ObjectMeta: owned("basic-prometheus", buildTidbMonitorPromLabel(tm.Name))},
+		{ObjectMeta: owned("basic-grafana", buildTidbMonitorGrafanaLabel(tm.Name))},
+		{ObjectMeta: notOwned("other-prometheus", buildTidbMonitorPromLabel("other"))},
+	} {
+		_, err := cli.CoreV1().Services(ns).Create(context.TODO(), svc, metav1.CreateOptions{})
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+	for _, cm := range []*corev1.ConfigMap{
+		{ObjectMeta: owned("basic-monitor", buildTidbMonitorPromLabel(tm.Name))},
+		{ObjectMeta: notOwned("basic-monitor-grafana", nil)},
+		{ObjectMeta: owned("basic-dashboard-pd", buildTidbMonitorDashboardLabel(tm.Name))},
+	} {
+		_, err := cli.CoreV1().ConfigMaps(ns).Create(context.TODO(), cm, metav1.CreateOptions{})
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+	_, err := cli.CoreV1().Secrets(ns).Create(context.TODO(), &corev1.Secret{ObjectMeta: owned("basic-monitor", buildTidbMonitorLabel(tm.Name))}, metav1.CreateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = cli.CoreV1().Secrets(ns).Create(context.TODO(), &corev1.Secret{ObjectMeta: owned(GetTLSAssetsSecretName(tm.Name), buildTidbMonitorLabel(tm.Name))}, metav1.CreateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(tmm.cleanLegacyMonitorObjects(tm)).To(Succeed())
+	// nothing to delete in the next sync
+	g.Expect(tmm.cleanLegacyMonitorObjects(tm)).To(Succeed())
+
+	stsList, err := cli.AppsV1().StatefulSets(ns).List(context.TODO(), metav1.ListOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(stsList.Items).To(HaveLen(1))
+	g.Expect(stsList.Items[0].Name).To(Equal("other-monitor"))
+	svcList, err := cli.CoreV1().Services(ns).List(context.TODO(), metav1.ListOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(svcList.Items).To(HaveLen(1))
+	g.Expect(svcList.Items[0].Name).To(Equal("other-prometheus"))
+	cmList, err := cli.CoreV1().ConfigMaps(ns).List(context.TODO(), metav1.ListOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	var cms []string
+	for _, cm := range cmList.Items {
+		cms = append(cms, cm.Name)
+	}
+	g.Expect(cms).To(ConsistOf("basic-monitor-grafana", "basic-dashboard-pd"))
+	secretList, err := cli.CoreV1().Secrets(ns).List(context.TODO(), metav1.ListOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(secretList.Items).To(HaveLen(1))
+	g.Expect(secretList.Items[0].Name).To(Equal(GetTLSAssetsSecretName(tm.Name)))
+}
+
+func TestGetPrometheusRule(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tm := newOperatorModeMonitor()
+	rules := map[string][]byte{
+		"tikv.rules.yml": []byte("groups:\n- name: tikv\n  rules:\n  - alert: TiKV_down\n    expr: up == 0\n"),
+		"pd.rules.yml":   []byte("groups:\n- name: pd\n  rules: []\n"),
+	}
+	rule, err := getPrometheusRule(tm, rules, "v1")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rule.GetName()).To(Equal("basic-alert-rules"))
+	groups, _, _ := unstructured.NestedSlice(rule.Object, "spec", "groups")
+	g.Expect(groups).To(HaveLen(2))
+	g.Expect(groups[0].(map[string]interface{})["name"]).To(Equal("pd"))
+	g.Expect(groups[1].(map[string]interface{})["name"]).To(Equal("tikv"))
+
+	_, err = getPrometheusRule(tm, map[string][]byte{"bad.rules.yml": []byte("groups: [")}, "v1")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestGetDashboardConfigMap(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tm := newOperatorModeMonitor()
+	cm := getDashboardConfigMap(tm, "TiKV_Details.json", []byte("{}"), "v1")
+	g.Expect(cm.Name).To(Equal("basic-dashboard-tikv-details"))
+	g.Expect(cm.Data).To(HaveKey("TiKV_Details.json"))
+	g.Expect(cm.Labels).To(HaveKeyWithValue("grafana_dashboard", "1"))
+	g.Expect(cm.Annotations).To(HaveKeyWithValue(monitorAssetsVersionAnnotation, "v1"))
+
+	tm.Spec.PrometheusOperator.DashboardLabels = map[string]string{"dashboard": "tidb"}
+	tm.Spec.PrometheusOperator.DashboardAnnotations = map[string]string{"grafana_folder": "TiDB"}
+	cm = getDashboardConfigMap(tm, "pd.json", []byte("{}"), "v1")
+	g.Expect(cm.Labels).NotTo(HaveKey("grafana_dashboard"))
+	g.Expect(cm.Labels).To(HaveKeyWithValue("dashboard", "tidb"))
+	g.Expect(cm.Annotations).To(HaveKeyWithValue("grafana_folder", "TiDB"))
+}
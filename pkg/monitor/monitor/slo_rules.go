@@ -0,0 +1,329 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/prometheus/common/model"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// sloRulesFile is the key of the SLO rules in the ConfigMap of Prometheus
+	sloRulesFile = "slo.rules.yml"
+	sloRulesPath = "/etc/prometheus/config/" + sloRulesFile
+
+	defaultQueryLatencyP99 = "1s"
+	defaultQueryLatencyFor = "5m"
+	defaultStoreDownFor    = "5m"
+	defaultRegionHealthFor = "30m"
+	defaultBackupMaxAge    = "10m"
+	defaultCDCMaxLag       = "1m"
+	defaultCDCLagFor       = "5m"
+	defaultRegionReplicas  = 3
+	storeFaultToleranceFor = "1m"
+	backupCheckpointFor    = "1m"
+	severityWarning        = "warning"
+	severityCritical       = "critical"
+	// tsoPhysicalDivisor shifts out the 18 bits of the logical part of a TSO
+	tsoPhysicalDivisor = 1 << 18
+)
+
+// ruleFile is the rule file of Prometheus
+type ruleFile struct {
+	Groups []ruleGroup `json:"groups"`
+}
+
+type ruleGroup struct {
+	Name  string      `json:"name"`
+	Rules []alertRule `json:"rules"`
+}
+
+type alertRule struct {
+	Alert       string            `json:"alert"`
+	Expr        string            `json:"expr"`
+	For         string            `json:"for,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// clusterTopology is the topology of a TidbCluster which the thresholds of the SLO rules are tuned to
+type clusterTopology struct {
+	// replicas is the replica count of regions
+	replicas int
+	// stores is the count of TiKV stores
+	stores int
+	// zones is the count of zones the TiKV stores are in, 0 if unknown
+	zones int
+}
+
+// storeFaultTolerance returns how many TiKV stores can be down without any region losing its quorum.
+// A region tolerates (replicas-1)/2 down replicas. If there are at least as many zones as replicas,
+// PD places the replicas of a region in different zones, so all the stores of that many zones can be down.
+func (t clusterTopology) storeFaultTolerance() int {
+	tolerance := (t.replicas - 1) / 2
+	if t.zones > 1 && t.zones >= t.replicas {
+		tolerance *= t.stores / t.zones
+	}
+	return tolerance
+}
+
+// syncSLORules creates the PrometheusRule of the SLO rules in prometheus-operator mode,
+// it's deleted if no SLO is configured.
+func (m *MonitorManager) syncSLORules(monitor *v1alpha1.TidbMonitor) error {
+	name := GetMonitorSLORulesName(monitor.Name)
+	if monitor.Spec.SLO == nil {
+		return m.deleteUnstructuredIfExists(prometheusRuleGVK, monitor.Namespace, name)
+	}
+	groups, err := m.getSLORuleGroups(monitor)
+	if err != nil {
+		return err
+	}
+	rule, err := getSLOPrometheusRule(monitor, groups)
+	if err != nil {
+		return err
+	}
+	if err := m.createOrUpdateUnstructured(rule); err != nil {
+		return fmt.Errorf("sync tm[%s/%s]'s PrometheusRule of SLO failed, err: %v", monitor.Namespace, monitor.Name, err)
+	}
+	klog.V(4).Infof("tm[%s/%s]'s SLO rules synced", monitor.Namespace, monitor.Name)
+	return nil
+}
+
+// getSLORuleFile renders the rule file of the SLO rules to be added into the ConfigMap of Prometheus.
+func (m *MonitorManager) getSLORuleFile(monitor *v1alpha1.TidbMonitor) ([]byte, error) {
+	groups, err := m.getSLORuleGroups(monitor)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(ruleFile{Groups: groups})
+}
+
+func (m *MonitorManager) getSLORuleGroups(monitor *v1alpha1.TidbMonitor) ([]ruleGroup, error) {
+	groups := []ruleGroup{}
+	for _, tcRef := range monitor.Spec.Clusters {
+		tc, err := m.deps.TiDBClusterLister.TidbClusters(tcRef.Namespace).Get(tcRef.Name)
+		if err != nil {
+			return nil, fmt.Errorf("get tm[%s/%s]'s target tc[%s/%s] failed, err: %v", monitor.Namespace, monitor.Name, tcRef.Namespace, tcRef.Name, err)
+		}
+		topology, err := m.getClusterTopology(tc)
+		if err != nil {
+			return nil, err
+		}
+		group, err := renderSLORuleGroup(monitor.Spec.SLO, tc, topology)
+		if err != nil {
+			return nil, err
+		}
+		if len(group.Rules) > 0 {
+			groups = append(groups, group)
+		}
+	}
+	return groups, nil
+}
+
+// getClusterTopology gets the replica count of regions from the config of PD, and the zones of TiKV stores
+// from the labels of the nodes the TiKV Pods are scheduled to.
+func (m *MonitorManager) getClusterTopology(tc *v1alpha1.TidbCluster) (clusterTopology, error) {
+	topology := clusterTopology{replicas: defaultRegionReplicas}
+	if tc.Spec.PD != nil && tc.Spec.PD.Config != nil {
+		if v := tc.Spec.PD.Config.Get("replication.max-replicas"); v != nil {
+			replicas, err := v.AsInt()
+			if err != nil {
+				return topology, fmt.Errorf("tc[%s/%s]'s replication.max-replicas of PD is invalid: %v", tc.Namespace, tc.Name, err)
+			}
+			topology.replicas = int(replicas)
+		}
+	}
+	if tc.Spec.TiKV == nil {
+		return topology, nil
+	}
+	topology.stores = int(tc.TiKVStsDesiredReplicas())
+
+	if m.deps.NodeLister == nil {
+		return topology, nil
+	}
+	selector, err := label.New().Instance(tc.Name).TiKV().Selector()
+	if err != nil {
+		return topology, err
+	}
+	pods, err := m.deps.PodLister.Pods(tc.Namespace).List(selector)
+	if err != nil {
+		return topology, err
+	}
+	zones := map[string]struct{}{}
+	for _, pod := range pods {
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+		node, err := m.deps.NodeLister.Get(pod.Spec.NodeName)
+		if err != nil {
+			klog.V(4).Infof("get node %s of pod %s/%s failed, err: %v", pod.Spec.NodeName, pod.Namespace, pod.Name, err)
+			continue
+		}
+		for _, key := range []string{corev1.LabelZoneFailureDomainStable, corev1.LabelZoneFailureDomain} {
+			if zone, ok := node.Labels[key]; ok {
+				zones[zone] = struct{}{}
+				break
+			}
+		}
+	}
+	topology.zones = len(zones)
+	return topology, nil
+}
+
+// renderSLORuleGroup renders the alert rules of the objectives for a TidbCluster,
+// the rules of the components not deployed in the TidbCluster are skipped.
+func renderSLORuleGroup(slo *v1alpha1.SLOSpec, tc *v1alpha1.TidbCluster, topology clusterTopology) (ruleGroup, error) {
+	cluster := fmt.Sprintf("%s-%s", tc.Namespace, tc.Name)
+	selector := fmt.Sprintf(`tidb_cluster="%s"`, cluster)
+	group := ruleGroup{Name: fmt.Sprintf("tidb-slo-%s", cluster)}
+	newRule := func(objective, alert, severity, expr, forDuration, summary string) alertRule {
+		return alertRule{
+			Alert: alert,
+			Expr:  expr,
+			For:   forDuration,
+			Labels: util.CombineStringMap(slo.Labels, map[string]string{
+				"severity":     severity,
+				"slo":          objective,
+				"tidb_cluster": cluster,
+			}),
+			Annotations: map[string]string{
+				"summary": fmt.Sprintf("%s in TiDB cluster %s/%s", summary, tc.Namespace, tc.Name),
+			},
+		}
+	}
+
+	if q := slo.QueryLatency; q != nil && tc.Spec.TiDB != nil {
+		p99, err := durationSeconds(q.P99, defaultQueryLatencyP99)
+		if err != nil {
+			return group, err
+		}
+		r := newRule("query-latency", "TiDBQueryLatencyP99High", severityWarning,
+			fmt.Sprintf(`histogram_quantile(0.99, sum(rate(tidb_server_handle_query_duration_seconds_bucket{%s, sql_type!="internal"}[5m])) by (le)) > %s`, selector, p99),
+			stringOrDefault(q.For, defaultQueryLatencyFor), "The p99 query latency exceeds the objective")
+		r.Annotations["description"] = fmt.Sprintf("The p99 query latency is {{ $value | humanizeDuration }}, the objective is %ss", p99)
+		group.Rules = append(group.Rules, r)
+	}
+
+	if s := slo.StoreDown; s != nil && tc.Spec.PD != nil && topology.stores > 0 {
+		expr := fmt.Sprintf(`max(pd_cluster_status{%s, type="store_disconnected_count"})`, selector)
+		group.Rules = append(group.Rules, newRule("store-down", "TiKVStoreDown", severityWarning,
+			expr+" > 0", stringOrDefault(s.For, defaultStoreDownFor), "TiKV stores are down"))
+
+		tolerance := topology.storeFaultTolerance()
+		r := newRule("store-down", "TiKVStoreDownBeyondFaultTolerance", severityCritical,
+			fmt.Sprintf("%s > %d", expr, tolerance), storeFaultToleranceFor, "More TiKV stores are down than tolerated")
+		topologyDesc := fmt.Sprintf("%d stores", topology.stores)
+		if topology.zones > 0 {
+			topologyDesc += fmt.Sprintf(" in %d zones", topology.zones)
+		}
+		r.Annotations["description"] = fmt.Sprintf("{{ $value }} TiKV stores are down, %s with %d replicas tolerate %d down stores",
+			topologyDesc, topology.replicas, tolerance)
+		group.Rules = append(group.Rules, r)
+	}
+
+	if h := slo.RegionHealth; h != nil && tc.Spec.PD != nil {
+		forDuration := stringOrDefault(h.For, defaultRegionHealthFor)
+		// a region can't miss replicas if it has only one
+		if topology.replicas > 1 {
+			group.Rules = append(group.Rules, newRule("region-health", "TiKVRegionMissPeer", severityWarning,
+				fmt.Sprintf(`max(pd_regions_status{%s, type="miss-peer-region-count"}) > 0`, selector),
+				forDuration, "Regions miss replicas"))
+		}
+		// with less than 3 replicas, a down replica makes the region lose its quorum
+		severity := severityWarning
+		if topology.replicas < 3 {
+			severity = severityCritical
+		}
+		group.Rules = append(group.Rules, newRule("region-health", "TiKVRegionDownPeer", severity,
+			fmt.Sprintf(`max(pd_regions_status{%s, type="down-peer-region-count"}) > 0`, selector),
+			forDuration, "Regions have down replicas"))
+	}
+
+	if b := slo.BackupFreshness; b != nil && tc.Spec.TiDB != nil {
+		maxAge, err := durationSeconds(b.MaxAge, defaultBackupMaxAge)
+		if err != nil {
+			return group, err
+		}
+		// the checkpoint is a TSO, whose physical part is the milliseconds since epoch
+		r := newRule("backup-freshness", "TiDBLogBackupCheckpointStale", severityCritical,
+			fmt.Sprintf(`time() - max by (task) (tidb_log_backup_last_checkpoint{%s}) / %d / 1000 > %s`, selector, tsoPhysicalDivisor, maxAge),
+			backupCheckpointFor, "The checkpoint of the log backup falls behind the objective")
+		r.Annotations["description"] = fmt.Sprintf("The checkpoint of the log backup task {{ $labels.task }} is {{ $value | humanizeDuration }} old, the objective is %ss", maxAge)
+		group.Rules = append(group.Rules, r)
+	}
+
+	if c := slo.CDCLag; c != nil && tc.Spec.TiCDC != nil {
+		maxLag, err := durationSeconds(c.MaxLag, defaultCDCMaxLag)
+		if err != nil {
+			return group, err
+		}
+		r := newRule("cdc-lag", "TiCDCChangefeedLagHigh", severityWarning,
+			fmt.Sprintf(`max by (changefeed) (ticdc_owner_checkpoint_ts_lag{%s}) > %s`, selector, maxLag),
+			stringOrDefault(c.For, defaultCDCLagFor), "The checkpoint lag of TiCDC changefeeds exceeds the objective")
+		r.Annotations["description"] = fmt.Sprintf("The checkpoint lag of changefeed {{ $labels.changefeed }} is {{ $value | humanizeDuration }}, the objective is %ss", maxLag)
+		group.Rules = append(group.Rules, r)
+	}
+	return group, nil
+}
+
+func getSLOPrometheusRule(monitor *v1alpha1.TidbMonitor, groups []ruleGroup) (*unstructured.Unstructured, error) {
+	// convert the groups to the unstructured content
+	data, err := json.Marshal(groups)
+	if err != nil {
+		return nil, err
+	}
+	var content []interface{}
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, err
+	}
+
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"groups": content},
+	}}
+	obj.SetGroupVersionKind(prometheusRuleGVK)
+	obj.SetName(GetMonitorSLORulesName(monitor.Name))
+	obj.SetNamespace(monitor.Namespace)
+	obj.SetLabels(util.CombineStringMap(monitor.Spec.PrometheusOperator.Labels, buildTidbMonitorLabel(monitor.Name)))
+	obj.SetOwnerReferences([]metav1.OwnerReference{controller.GetTiDBMonitorOwnerRef(monitor)})
+	return obj, nil
+}
+
+// durationSeconds parses the Prometheus duration into seconds
+func durationSeconds(d, defaultValue string) (string, error) {
+	duration, err := model.ParseDuration(stringOrDefault(d, defaultValue))
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatFloat(time.Duration(duration).Seconds(), 'f', -1, 64), nil
+}
+
+func stringOrDefault(s, defaultValue string) string {
+	if s == "" {
+		return defaultValue
+	}
+	return s
+}
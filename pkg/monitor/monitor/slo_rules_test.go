@@ -0,0 +1,227 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/promql/parser"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newSLOSpec() *v1alpha1.SLOSpec {
+	return &v1alpha1.SLOSpec{
+		Labels:          map[string]string{"team": "dba"},
+		QueryLatency:    &v1alpha1.QueryLatencySLO{P99: "500ms"},
+		StoreDown:       &v1alpha1.StoreDownSLO{},
+		RegionHealth:    &v1alpha1.RegionHealthSLO{For: "1h"},
+		BackupFreshness: &v1alpha1.BackupFreshnessSLO{},
+		CDCLag:          &v1alpha1.CDCLagSLO{},
+	}
+}
+
+func newSLOTidbCluster() *v1alpha1.TidbCluster {
+	return &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "basic", Namespace: "ns"},
+		Spec: v1alpha1.TidbClusterSpec{
+			PD:    &v1alpha1.PDSpec{Replicas: 3},
+			TiDB:  &v1alpha1.TiDBSpec{Replicas: 2},
+			TiKV:  &v1alpha1.TiKVSpec{Replicas: 6},
+			TiCDC: &v1alpha1.TiCDCSpec{Replicas: 2},
+		},
+	}
+}
+
+func TestStoreFaultTolerance(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		topology clusterTopology
+		expected int
+	}{
+		{clusterTopology{replicas: 1, stores: 3}, 0},
+		{clusterTopology{replicas: 3, stores: 3}, 1},
+		{clusterTopology{replicas: 3, stores: 6, zones: 1}, 1},
+		{clusterTopology{replicas: 3, stores: 6, zones: 2}, 1},
+		{clusterTopology{replicas: 3, stores: 6, zones: 3}, 2},
+		{clusterTopology{replicas: 3, stores: 7, zones: 3}, 2},
+		{clusterTopology{replicas: 3, stores: 8, zones: 4}, 2},
+		{clusterTopology{replicas: 5, stores: 10, zones: 5}, 4},
+		{clusterTopology{replicas: 5, stores: 10, zones: 3}, 2},
+	}
+	for _, tt := range tests {
+		g.Expect(tt.topology.storeFaultTolerance()).To(Equal(tt.expected), fmt.Sprintf("%+v", tt.topology))
+	}
+}
+
+// TestRenderSLORules compares the rendered rules with the fixture, which is also the rule file
+// of the promtool unit tests in testdata/slo.rules_test.yml, run them with `promtool test rules`.
+func TestRenderSLORules(t *testing.T) {
+	g := NewGomegaWithT(t)
+	group, err := renderSLORuleGroup(newSLOSpec(), newSLOTidbCluster(), clusterTopology{replicas: 3, stores: 6, zones: 3})
+	g.Expect(err).NotTo(HaveOccurred())
+	rendered, err := yaml.Marshal(ruleFile{Groups: []ruleGroup{group}})
+	g.Expect(err).NotTo(HaveOccurred())
+	expected, err := os.ReadFile(filepath.Join("testdata", sloRulesFile))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(rendered)).To(Equal(string(expected)))
+
+	alerts := map[string]bool{}
+	for _, rule := range group.Rules {
+		alerts[rule.Alert] = true
+		_, err := parser.ParseExpr(rule.Expr)
+		g.Expect(err).NotTo(HaveOccurred(), rule.Alert)
+		_, err = model.ParseDuration(rule.For)
+		g.Expect(err).NotTo(HaveOccurred(), rule.Alert)
+		g.Expect(rule.Labels).To(HaveKeyWithValue("tidb_cluster", "ns-basic"))
+		g.Expect(rule.Labels).To(HaveKeyWithValue("team", "dba"))
+	}
+
+	// the alerts tested by promtool must be rendered
+	var promtoolTests struct {
+		RuleFiles []string `json:"rule_files"`
+		Tests     []struct {
+			AlertRuleTests []struct {
+				AlertName string `json:"alertname"`
+			} `json:"alert_rule_test"`
+		} `json:"tests"`
+	}
+	data, err := os.ReadFile(filepath.Join("testdata", "slo.rules_test.yml"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(yaml.Unmarshal(data, &promtoolTests)).To(Succeed())
+	g.Expect(promtoolTests.RuleFiles).To(Equal([]string{sloRulesFile}))
+	for _, test := range promtoolTests.Tests {
+		for _, alertTest := range test.AlertRuleTests {
+			g.Expect(alerts).To(HaveKey(alertTest.AlertName))
+		}
+	}
+}
+
+func TestRenderSLORulesWithTopology(t *testing.T) {
+	g := NewGomegaWithT(t)
+	alerts := func(group ruleGroup) map[string]alertRule {
+		m := map[string]alertRule{}
+		for _, r := range group.Rules {
+			m[r.Alert] = r
+		}
+		return m
+	}
+
+	// a single replica can't miss peers, and a down peer makes the region unavailable
+	group, err := renderSLORuleGroup(newSLOSpec(), newSLOTidbCluster(), clusterTopology{replicas: 1, stores: 3})
+	g.Expect(err).NotTo(HaveOccurred())
+	rules := alerts(group)
+	g.Expect(rules).NotTo(HaveKey("TiKVRegionMissPeer"))
+	g.Expect(rules["TiKVRegionDownPeer"].Labels).To(HaveKeyWithValue("severity", severityCritical))
+	g.Expect(rules["TiKVStoreDownBeyondFaultTolerance"].Expr).To(HaveSuffix("> 0"))
+	g.Expect(rules["TiKVStoreDownBeyondFaultTolerance"].Annotations["description"]).To(ContainSubstring("3 stores with 1 replicas tolerate 0 down stores"))
+
+	// the rules of the components which are not deployed are skipped
+	tc := newSLOTidbCluster()
+	tc.Spec.TiCDC = nil
+	tc.Spec.TiDB = nil
+	group, err = renderSLORuleGroup(newSLOSpec(), tc, clusterTopology{replicas: 3, stores: 6})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(alerts(group)).To(HaveLen(4))
+	g.Expect(alerts(group)).NotTo(HaveKey("TiCDCChangefeedLagHigh"))
+
+	// only the configured objectives are alerted
+	group, err = renderSLORuleGroup(&v1alpha1.SLOSpec{CDCLag: &v1alpha1.CDCLagSLO{MaxLag: "90s"}}, newSLOTidbCluster(), clusterTopology{replicas: 3})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(group.Rules).To(HaveLen(1))
+	g.Expect(group.Rules[0].Expr).To(Equal(`max by (changefeed) (ticdc_owner_checkpoint_ts_lag{tidb_cluster="ns-basic"}) > 90`))
+	g.Expect(group.Rules[0].For).To(Equal(defaultCDCLagFor))
+}
+
+func TestGetClusterTopology(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tmm := newFakeTidbMonitorManager()
+	tc := newSLOTidbCluster()
+	tc.Spec.PD.Config = v1alpha1.NewPDConfig()
+	tc.Spec.PD.Config.Set("replication.max-replicas", 5)
+
+	podIndexer := tmm.deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+	nodeIndexer := tmm.deps.KubeInformerFactory.Core().V1().Nodes().Informer().GetIndexer()
+	zones := []string{"zone-a", "zone-b", "zone-c", "zone-a"}
+	for i, zone := range zones {
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:   fmt.Sprintf("node-%d", i),
+			Labels: map[string]string{corev1.LabelZoneFailureDomainStable: zone},
+		}}
+		g.Expect(nodeIndexer.Add(node)).To(Succeed())
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("basic-tikv-%d", i),
+				Namespace: "ns",
+				Labels:    label.New().Instance("basic").TiKV().Labels(),
+			},
+			Spec: corev1.PodSpec{NodeName: node.Name},
+		}
+		g.Expect(podIndexer.Add(pod)).To(Succeed())
+	}
+	// pods of other components are ignored
+	g.Expect(podIndexer.Add(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "basic-pd-0", Namespace: "ns", Labels: label.New().Instance("basic").PD().Labels()},
+		Spec:       corev1.PodSpec{NodeName: "node-x"},
+	})).To(Succeed())
+	g.Expect(nodeIndexer.Add(&corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "node-x",
+		Labels: map[string]string{corev1.LabelZoneFailureDomainStable: "zone-x"},
+	}})).To(Succeed())
+
+	topology, err := tmm.getClusterTopology(tc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(topology).To(Equal(clusterTopology{replicas: 5, stores: 6, zones: 3}))
+}
+
+func TestSLORulesInPrometheusConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tm := newTidbMonitor(v1alpha1.TidbClusterRef{Name: "basic", Namespace: "ns"})
+	tm.Spec.SLO = newSLOSpec()
+	cm, err := getPromConfigMap(tm, []ClusterRegexInfo{{Name: "basic", Namespace: "ns"}}, nil, 1, &Store{})
+	g.Expect(err).NotTo(HaveOccurred())
+	var cfg struct {
+		RuleFiles []string `json:"rule_files"`
+	}
+	g.Expect(yaml.Unmarshal([]byte(cm.Data["prometheus.yml"]), &cfg)).To(Succeed())
+	g.Expect(cfg.RuleFiles).To(Equal([]string{sloRulesPath}))
+
+	tm.Spec.PrometheusReloader = &v1alpha1.PrometheusReloaderSpec{}
+	reloader := getMonitorPrometheusReloaderContainer(tm, 0)
+	g.Expect(reloader.Command).To(ContainElement("--watched-dir=/etc/prometheus/config"))
+}
+
+func TestGetSLOPrometheusRule(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tm := newOperatorModeMonitor()
+	group, err := renderSLORuleGroup(newSLOSpec(), newSLOTidbCluster(), clusterTopology{replicas: 3, stores: 3})
+	g.Expect(err).NotTo(HaveOccurred())
+	rule, err := getSLOPrometheusRule(tm, []ruleGroup{group})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rule.GetName()).To(Equal("basic-slo-rules"))
+	g.Expect(rule.GetLabels()).To(HaveKeyWithValue("release", "kube-prometheus-stack"))
+	groups, _, _ := unstructured.NestedSlice(rule.Object, "spec", "groups")
+	g.Expect(groups).To(HaveLen(1))
+	g.Expect(groups[0].(map[string]interface{})["name"]).To(Equal("tidb-slo-ns-basic"))
+	g.Expect(func() { rule.DeepCopy() }).NotTo(Panic())
+}
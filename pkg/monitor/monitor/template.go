@@ -81,6 +81,7 @@ type MonitorConfigModel struct {
 	RemoteWriteCfg            *yaml.MapItem
 	EnableAlertRules          bool
 	EnableExternalRuleConfigs bool
+	EnableSLORules            bool
 	shards                    int32
 }
 
@@ -91,22 +92,32 @@ type ClusterRegexInfo struct {
 	enableTLS bool
 }
 
+// scrapeComponents are the scrape jobs of each cluster, the job name is also the kind of buildAddressRelabelConfigByComponent.
+var scrapeComponents = []struct {
+	jobName          string
+	componentPattern string
+}{
+	{"pd", pdPattern},
+	{"tso", pdmsTSOPattern},
+	{"scheduling", pdmsSchedulingPattern},
+	{"tidb", tidbPattern},
+	{"tikv", tikvPattern},
+	{"tiproxy", tiproxyPattern},
+	{"tiflash", tiflashPattern},
+	{"tiflash-proxy", tiflashPattern},
+	{"pump", pumpPattern},
+	{"drainer", drainerPattern},
+	{"ticdc", cdcPattern},
+	{"lightning", lightningPattern},
+	{dmWorker, dmWorkerPattern},
+	{dmMaster, dmMasterPattern},
+}
+
 func newPrometheusConfig(cmodel *MonitorConfigModel) yaml.MapSlice {
 	var scrapeJobs []yaml.MapSlice
-	scrapeJobs = append(scrapeJobs, scrapeJob("pd", pdPattern, cmodel, buildAddressRelabelConfigByComponent("pd"))...)
-	scrapeJobs = append(scrapeJobs, scrapeJob("tso", pdmsTSOPattern, cmodel, buildAddressRelabelConfigByComponent("tso"))...)
-	scrapeJobs = append(scrapeJobs, scrapeJob("scheduling", pdmsSchedulingPattern, cmodel, buildAddressRelabelConfigByComponent("scheduling"))...)
-	scrapeJobs = append(scrapeJobs, scrapeJob("tidb", tidbPattern, cmodel, buildAddressRelabelConfigByComponent("tidb"))...)
-	scrapeJobs = append(scrapeJobs, scrapeJob("tikv", tikvPattern, cmodel, buildAddressRelabelConfigByComponent("tikv"))...)
-	scrapeJobs = append(scrapeJobs, scrapeJob("tiproxy", tiproxyPattern, cmodel, buildAddressRelabelConfigByComponent("tiproxy"))...)
-	scrapeJobs = append(scrapeJobs, scrapeJob("tiflash", tiflashPattern, cmodel, buildAddressRelabelConfigByComponent("tiflash"))...)
-	scrapeJobs = append(scrapeJobs, scrapeJob("tiflash-proxy", tiflashPattern, cmodel, buildAddressRelabelConfigByComponent("tiflash-proxy"))...)
-	scrapeJobs = append(scrapeJobs, scrapeJob("pump", pumpPattern, cmodel, buildAddressRelabelConfigByComponent("pump"))...)
-	scrapeJobs = append(scrapeJobs, scrapeJob("drainer", drainerPattern, cmodel, buildAddressRelabelConfigByComponent("drainer"))...)
-	scrapeJobs = append(scrapeJobs, scrapeJob("ticdc", cdcPattern, cmodel, buildAddressRelabelConfigByComponent("ticdc"))...)
-	scrapeJobs = append(scrapeJobs, scrapeJob("lightning", lightningPattern, cmodel, buildAddressRelabelConfigByComponent("lightning"))...)
-	scrapeJobs = append(scrapeJobs, scrapeJob(dmWorker, dmWorkerPattern, cmodel, buildAddressRelabelConfigByComponent(dmWorker))...)
-	scrapeJobs = append(scrapeJobs, scrapeJob(dmMaster, dmMasterPattern, cmodel, buildAddressRelabelConfigByComponent(dmMaster))...)
+	for _, c := range scrapeComponents {
+		scrapeJobs = append(scrapeJobs, scrapeJob(c.jobName, c.componentPattern, cmodel, buildAddressRelabelConfigByComponent(c.jobName))...)
+	}
 	cfg := yaml.MapSlice{}
 	globalItems := yaml.MapSlice{
 		{Key: "evaluation_interval", Value: "15s"},
@@ -232,13 +243,10 @@ func scrapeJob(jobName string, componentPattern string, cmodel *MonitorConfigMod
 	}
 
 	for _, cluster := range currCluster {
-		clusterTargetPattern := cluster.Name
-
-		nsTargetPattern := cluster.Namespace
-
+		scheme, tlsSecretName := scrapeTLS(jobName, cluster)
 		schemeRelabelConfig := yaml.MapItem{
 			Key:   "scheme",
-			Value: "http",
+			Value: scheme,
 		}
 		tlsConfigRelabelConfig := yaml.MapSlice{
 			{
@@ -246,55 +254,25 @@ func scrapeJob(jobName string, componentPattern string, cmodel *MonitorConfigMod
 				Value: true,
 			},
 		}
-
-		if cluster.enableTLS {
-			switch {
-			case jobName == "tiproxy":
-				// tiproxy use certs from tidb. There is no suitable CA for peer addresses.
-				schemeRelabelConfig.Value = "https"
-			case jobName == "lightning":
-				// lightning does not need to authenticate the access of other components,
-				// so there is no need to enable mtls for the time being.
-				schemeRelabelConfig.Value = "https"
-			case isDMJob(jobName):
-				schemeRelabelConfig.Value = "https"
-				dmTlsSecretName := util.DMClientTLSSecretName(cluster.Name)
-				tlsConfigRelabelConfig = yaml.MapSlice{
-					yaml.MapItem{
-						Key:   "ca_file",
-						Value: path.Join(util.ClusterAssetsTLSPath, TLSAssetKey{"secret", cluster.Namespace, dmTlsSecretName, corev1.ServiceAccountRootCAKey}.String()),
-					},
-					yaml.MapItem{
-						Key:   "cert_file",
-						Value: path.Join(util.ClusterAssetsTLSPath, TLSAssetKey{"secret", cluster.Namespace, dmTlsSecretName, corev1.TLSCertKey}.String()),
-					},
-					yaml.MapItem{
-						Key:   "key_file",
-						Value: path.Join(util.ClusterAssetsTLSPath, TLSAssetKey{"secret", cluster.Namespace, dmTlsSecretName, corev1.TLSPrivateKeyKey}.String()),
-					},
-				}
-			default:
-				schemeRelabelConfig.Value = "https"
-				tcTlsSecretName := util.ClusterClientTLSSecretName(cluster.Name)
-				tlsConfigRelabelConfig = yaml.MapSlice{
-					yaml.MapItem{
-						Key:   "ca_file",
-						Value: path.Join(util.ClusterAssetsTLSPath, TLSAssetKey{"secret", cluster.Namespace, tcTlsSecretName, corev1.ServiceAccountRootCAKey}.String()),
-					},
-					yaml.MapItem{
-						Key:   "cert_file",
-						Value: path.Join(util.ClusterAssetsTLSPath, TLSAssetKey{"secret", cluster.Namespace, tcTlsSecretName, corev1.TLSCertKey}.String()),
-					},
-					yaml.MapItem{
-						Key:   "key_file",
-						Value: path.Join(util.ClusterAssetsTLSPath, TLSAssetKey{"secret", cluster.Namespace, tcTlsSecretName, corev1.TLSPrivateKeyKey}.String()),
-					},
-				}
+		if tlsSecretName != "" {
+			tlsConfigRelabelConfig = yaml.MapSlice{
+				yaml.MapItem{
+					Key:   "ca_file",
+					Value: path.Join(util.ClusterAssetsTLSPath, TLSAssetKey{"secret", cluster.Namespace, tlsSecretName, corev1.ServiceAccountRootCAKey}.String()),
+				},
+				yaml.MapItem{
+					Key:   "cert_file",
+					Value: path.Join(util.ClusterAssetsTLSPath, TLSAssetKey{"secret", cluster.Namespace, tlsSecretName, corev1.TLSCertKey}.String()),
+				},
+				yaml.MapItem{
+					Key:   "key_file",
+					Value: path.Join(util.ClusterAssetsTLSPath, TLSAssetKey{"secret", cluster.Namespace, tlsSecretName, corev1.TLSPrivateKeyKey}.String()),
+				},
 			}
 		}
 
 		scrapeConfig := yaml.MapSlice{
-			{Key: "job_name", Value: fmt.Sprintf("%s-%s-%s", cluster.Namespace, cluster.Name, jobName)},
+			{Key: "job_name", Value: scrapeJobName(cluster, jobName)},
 			{Key: "honor_labels", Value: true},
 			{Key: "scrape_interval", Value: "15s"},
 			schemeRelabelConfig,
@@ -322,121 +300,156 @@ func scrapeJob(jobName string, componentPattern string, cmodel *MonitorConfigMod
 			{Key: "tls_config", Value: tlsConfigRelabelConfig},
 		}
 
-		relabelConfigs := []yaml.MapSlice{}
-		relabelConfigs = append(relabelConfigs, yaml.MapSlice{
-			{Key: "source_labels", Value: []string{instanceLabel}},
+		relabelConfigs := scrapeRelabelConfigs(cluster, componentPattern, addressRelabelConfig)
+		relabelConfigs = appendShardingRelabelConfigRules(relabelConfigs, uint64(cmodel.shards))
+		scrapeConfig = append(scrapeConfig, yaml.MapItem{Key: "relabel_configs", Value: relabelConfigs})
+		scrapeJobs = append(scrapeJobs, scrapeConfig)
+
+	}
+	return scrapeJobs
+
+}
+
+func scrapeJobName(cluster ClusterRegexInfo, jobName string) string {
+	return fmt.Sprintf("%s-%s-%s", cluster.Namespace, cluster.Name, jobName)
+}
+
+// scrapeTLS returns the scheme to scrape the component and the name of the Secret of the client certificate,
+// the Secret name is empty if the certificate of the component is not verified.
+func scrapeTLS(jobName string, cluster ClusterRegexInfo) (string, string) {
+	if !cluster.enableTLS {
+		return "http", ""
+	}
+	switch {
+	case jobName == "tiproxy":
+		// tiproxy use certs from tidb. There is no suitable CA for peer addresses.
+		return "https", ""
+	case jobName == "lightning":
+		// lightning does not need to authenticate the access of other components,
+		// so there is no need to enable mtls for the time being.
+		return "https", ""
+	case isDMJob(jobName):
+		return "https", util.DMClientTLSSecretName(cluster.Name)
+	default:
+		return "https", util.ClusterClientTLSSecretName(cluster.Name)
+	}
+}
+
+// scrapeRelabelConfigs returns the relabel configs which keep the pods of the component in the cluster
+// and set the labels used by the dashboards and the alert rules.
+func scrapeRelabelConfigs(cluster ClusterRegexInfo, componentPattern string, addressRelabelConfig yaml.MapSlice) []yaml.MapSlice {
+	clusterTargetPattern := cluster.Name
+
+	nsTargetPattern := cluster.Namespace
+
+	relabelConfigs := []yaml.MapSlice{}
+	relabelConfigs = append(relabelConfigs, yaml.MapSlice{
+		{Key: "source_labels", Value: []string{instanceLabel}},
+		{Key: "action", Value: "keep"},
+		{Key: "regex", Value: clusterTargetPattern},
+	},
+		yaml.MapSlice{
+			{Key: "source_labels", Value: []string{namespaceLabel}},
 			{Key: "action", Value: "keep"},
-			{Key: "regex", Value: clusterTargetPattern},
+			{Key: "regex", Value: nsTargetPattern},
 		},
-			yaml.MapSlice{
-				{Key: "source_labels", Value: []string{namespaceLabel}},
-				{Key: "action", Value: "keep"},
-				{Key: "regex", Value: nsTargetPattern},
+		yaml.MapSlice{
+			{
+				Key: "source_labels", Value: []string{scrapeLabel},
 			},
-			yaml.MapSlice{
-				{
-					Key: "source_labels", Value: []string{scrapeLabel},
-				},
-				{
-					Key: "action", Value: "keep",
-				},
-				{
-					Key: "regex", Value: truePattern,
-				},
+			{
+				Key: "action", Value: "keep",
 			},
-			yaml.MapSlice{
-				{
-					Key: "source_labels", Value: []string{componentLabel},
-				},
-				{
-					Key: "action", Value: "keep",
-				},
-				{
-					Key: "regex", Value: componentPattern,
-				},
+			{
+				Key: "regex", Value: truePattern,
 			},
-			addressRelabelConfig,
-			yaml.MapSlice{
-				{
-					Key: "source_labels", Value: []string{namespaceLabel},
-				},
-				{
-					Key: "action", Value: "replace",
-				},
-				{
-					Key: "target_label", Value: "kubernetes_namespace",
-				},
+		},
+		yaml.MapSlice{
+			{
+				Key: "source_labels", Value: []string{componentLabel},
 			},
-			yaml.MapSlice{
-				{
-					Key: "source_labels", Value: []string{instanceLabel},
-				},
-				{
-					Key: "action", Value: "replace",
-				},
-				{
-					Key: "target_label", Value: "cluster",
-				},
+			{
+				Key: "action", Value: "keep",
 			},
-			yaml.MapSlice{
-				{
-					Key: "source_labels", Value: []string{podNameLabel},
-				},
-				{
-					Key: "action", Value: "replace",
-				},
-				{
-					Key: "target_label", Value: "instance",
-				},
+			{
+				Key: "regex", Value: componentPattern,
 			},
-			yaml.MapSlice{
-				{
-					Key: "source_labels", Value: []string{componentLabel},
-				},
-				{
-					Key: "action", Value: "replace",
-				},
-				{
-					Key: "target_label", Value: "component",
-				},
+		},
+		addressRelabelConfig,
+		yaml.MapSlice{
+			{
+				Key: "source_labels", Value: []string{namespaceLabel},
 			},
-			yaml.MapSlice{
-				{
-					Key: "source_labels", Value: []string{
-						namespaceLabel,
-						instanceLabel,
-					},
-				},
-				{
-					Key: "separator", Value: "-",
-				},
-				{
-					Key: "target_label", Value: "tidb_cluster",
-				},
+			{
+				Key: "action", Value: "replace",
 			},
-			yaml.MapSlice{
-				{
-					Key: "source_labels", Value: []string{metricsPathLabel},
-				},
-				{
-					Key: "action", Value: "replace",
-				},
-				{
-					Key: "target_label", Value: "__metrics_path__",
-				},
-				{
-					Key: "regex", Value: allMatchPattern,
+			{
+				Key: "target_label", Value: "kubernetes_namespace",
+			},
+		},
+		yaml.MapSlice{
+			{
+				Key: "source_labels", Value: []string{instanceLabel},
+			},
+			{
+				Key: "action", Value: "replace",
+			},
+			{
+				Key: "target_label", Value: "cluster",
+			},
+		},
+		yaml.MapSlice{
+			{
+				Key: "source_labels", Value: []string{podNameLabel},
+			},
+			{
+				Key: "action", Value: "replace",
+			},
+			{
+				Key: "target_label", Value: "instance",
+			},
+		},
+		yaml.MapSlice{
+			{
+				Key: "source_labels", Value: []string{componentLabel},
+			},
+			{
+				Key: "action", Value: "replace",
+			},
+			{
+				Key: "target_label", Value: "component",
+			},
+		},
+		yaml.MapSlice{
+			{
+				Key: "source_labels", Value: []string{
+					namespaceLabel,
+					instanceLabel,
 				},
 			},
-		)
-
-		relabelConfigs = appendShardingRelabelConfigRules(relabelConfigs, uint64(cmodel.shards))
-		scrapeConfig = append(scrapeConfig, yaml.MapItem{Key: "relabel_configs", Value: relabelConfigs})
-		scrapeJobs = append(scrapeJobs, scrapeConfig)
-
-	}
-	return scrapeJobs
-
+			{
+				Key: "separator", Value: "-",
+			},
+			{
+				Key: "target_label", Value: "tidb_cluster",
+			},
+		},
+		yaml.MapSlice{
+			{
+				Key: "source_labels", Value: []string{metricsPathLabel},
+			},
+			{
+				Key: "action", Value: "replace",
+			},
+			{
+				Key: "target_label", Value: "__metrics_path__",
+			},
+			{
+				Key: "regex", Value: allMatchPattern,
+			},
+		},
+	)
+	return relabelConfigs
 }
 
 func isDMJob(jobName string) bool {
@@ -492,6 +505,9 @@ func RenderPrometheusConfig(model *MonitorConfigModel) (yaml.MapSlice, error) {
 			"/prometheus-external-rules/*.rules.yml",
 		}
 	}
+	if model.EnableSLORules {
+		rulesPath = append(rulesPath, sloRulesPath)
+	}
 	if rulesPath != nil {
 		cfg = append(cfg, yaml.MapItem{
 			Key:   "rule_files",
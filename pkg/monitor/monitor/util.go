@@ -58,6 +58,22 @@ func GetGrafanaConfigMapName(monitor *v1alpha1.TidbMonitor) string {
 	return fmt.Sprintf("%s-monitor-grafana", monitor.Name)
 }
 
+func GetMonitorAssetsJobName(name string) string {
+	return fmt.Sprintf("%s-monitor-assets", name)
+}
+
+func GetMonitorAssetsConfigMapName(name string) string {
+	return fmt.Sprintf("%s-monitor-assets", name)
+}
+
+func GetMonitorAlertRulesName(name string) string {
+	return fmt.Sprintf("%s-alert-rules", name)
+}
+
+func GetMonitorSLORulesName(name string) string {
+	return fmt.Sprintf("%s-slo-rules", name)
+}
+
 func GetMonitorShardName(name string, shard int32) string {
 	base := fmt.Sprintf("%s-monitor", name)
 	if shard == 0 {
@@ -96,6 +112,14 @@ func buildTidbMonitorGrafanaLabel(name string) map[string]string {
 	return label.NewMonitor().Instance(name).Monitor().Grafana().Labels()
 }
 
+func buildTidbMonitorAssetsLabel(name string) map[string]string {
+	return label.NewMonitor().Instance(name).Component(monitorAssetsComponent).Labels()
+}
+
+func buildTidbMonitorDashboardLabel(name string) map[string]string {
+	return label.NewMonitor().Instance(name).Component(grafanaDashboardComponent).Labels()
+}
+
 func getInitCommand(monitor *v1alpha1.TidbMonitor) []string {
 	c := `mkdir -p /data/prometheus
 chmod 777 /data/prometheus
@@ -157,6 +181,7 @@ func getPromConfigMap(monitor *v1alpha1.TidbMonitor, monitorClusterInfos []Clust
 		DMClusterInfos:   dmClusterInfos,
 		ExternalLabels:   buildExternalLabels(monitor),
 		EnableAlertRules: monitor.Spec.EnableAlertRules,
+		EnableSLORules:   monitor.Spec.SLO != nil,
 		shards:           shard,
 	}
 
@@ -792,6 +817,10 @@ func getMonitorPrometheusReloaderContainer(monitor *v1alpha1.TidbMonitor, shard
 		})
 		c.Command = append(c.Command, "--watched-dir=/prometheus-external-rules")
 	}
+	if monitor.Spec.SLO != nil {
+		// reload the SLO rules which are in the ConfigMap of Prometheus
+		c.Command = append(c.Command, "--watched-dir=/etc/prometheus/config")
+	}
 	return c
 }
 
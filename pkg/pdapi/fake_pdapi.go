@@ -45,10 +45,14 @@ const (
 	GetEvictLeaderSchedulersForStoresActionType ActionType = "GetEvictLeaderSchedulersForStores"
 	GetPDLeaderActionType                       ActionType = "GetPDLeader"
 	TransferPDLeaderActionType                  ActionType = "TransferPDLeader"
+	SetMemberLeaderPriorityActionType           ActionType = "SetMemberLeaderPriority"
 	GetAutoscalingPlansActionType               ActionType = "GetAutoscalingPlans"
 	GetRecoveringMarkActionType                 ActionType = "GetRecoveringMark"
 	GetReadyActionType                          ActionType = "GetReady"
 	PDMSTransferPrimaryActionType               ActionType = "PDMSTransferPrimary"
+	GetPlacementRuleBundleActionType            ActionType = "GetPlacementRuleBundle"
+	SetPlacementRuleBundleActionType            ActionType = "SetPlacementRuleBundle"
+	DeletePlacementRuleBundleActionType         ActionType = "DeletePlacementRuleBundle"
 )
 
 type NotFoundReaction struct {
@@ -64,6 +68,8 @@ type Action struct {
 	Name        string
 	Labels      map[string]string
 	Replication PDReplicationConfig
+	Bundle      *GroupBundle
+	Priority    int
 }
 
 type Reaction func(action *Action) (interface{}, error)
@@ -286,6 +292,15 @@ func (c *FakePDClient) TransferPDLeader(memberName string) error {
 	return nil
 }
 
+func (c *FakePDClient) SetMemberLeaderPriority(name string, priority int) error {
+	if reaction, ok := c.reactions[SetMemberLeaderPriorityActionType]; ok {
+		action := &Action{Name: name, Priority: priority}
+		_, err := reaction(action)
+		return err
+	}
+	return nil
+}
+
 func (c *FakePDClient) GetAutoscalingPlans(strategy Strategy) ([]Plan, error) {
 	if reaction, ok := c.reactions[GetAutoscalingPlansActionType]; ok {
 		action := &Action{}
@@ -314,6 +329,30 @@ func (c *FakePDClient) GetReady() (bool, error) {
 	return result.(bool), nil
 }
 
+func (c *FakePDClient) GetPlacementRuleBundle(groupID string) (*GroupBundle, error) {
+	action := &Action{Name: groupID}
+	result, err := c.fakeAPI(GetPlacementRuleBundleActionType, action)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, nil
+	}
+	return result.(*GroupBundle), nil
+}
+
+func (c *FakePDClient) SetPlacementRuleBundle(bundle *GroupBundle) error {
+	action := &Action{Name: bundle.ID, Bundle: bundle}
+	_, err := c.fakeAPI(SetPlacementRuleBundleActionType, action)
+	return err
+}
+
+func (c *FakePDClient) DeletePlacementRuleBundle(groupID string) error {
+	action := &Action{Name: groupID}
+	_, err := c.fakeAPI(DeletePlacementRuleBundleActionType, action)
+	return err
+}
+
 // FakePDMSClient implements a fake version of PDMSClient.
 type FakePDMSClient struct {
 	reactions map[ActionType]Reaction
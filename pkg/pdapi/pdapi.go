@@ -90,6 +90,9 @@ type PDClient interface {
 	GetPDLeader() (*pdpb.Member, error)
 	// TransferPDLeader transfers pd leader to specified member
 	TransferPDLeader(name string) error
+	// SetMemberLeaderPriority sets the leader priority of the specified member,
+	// a member with higher priority is more likely to be the leader
+	SetMemberLeaderPriority(name string, priority int) error
 	// GetAutoscalingPlans returns the scaling plan for the cluster
 	GetAutoscalingPlans(strategy Strategy) ([]Plan, error)
 	// GetRecoveringMark return the pd recovering mark
@@ -103,6 +106,13 @@ type PDClient interface {
 	GetMSMembers(service string) ([]string, error)
 	// GetMSPrimary returns the primary PDMS member service-addr from cluster by specific microservice
 	GetMSPrimary(service string) (string, error)
+
+	// GetPlacementRuleBundle returns the placement rule bundle of the group, nil if the group does not exist
+	GetPlacementRuleBundle(groupID string) (*GroupBundle, error)
+	// SetPlacementRuleBundle creates or replaces the placement rule bundle of the group
+	SetPlacementRuleBundle(bundle *GroupBundle) error
+	// DeletePlacementRuleBundle deletes the placement rule bundle of the group
+	DeletePlacementRuleBundle(groupID string) error
 }
 
 var (
@@ -116,6 +126,7 @@ var (
 	pdLeaderPrefix         = "pd/api/v1/leader"
 	pdLeaderTransferPrefix = "pd/api/v1/leader/transfer"
 	pdReplicationPrefix    = "pd/api/v1/config/replicate"
+	placementRulePrefix    = "pd/api/v1/config/placement-rule"
 	// evictLeaderSchedulerConfigPrefix is the prefix of evict-leader-scheduler
 	// config API, available since PD v3.1.0.
 	evictLeaderSchedulerConfigPrefix = "pd/api/v1/scheduler-config/evict-leader-scheduler/list"
@@ -271,6 +282,36 @@ type schedulerInfo struct {
 	StoreID uint64 `json:"store_id"`
 }
 
+// LabelConstraint is a constraint on the store labels of a placement rule
+type LabelConstraint struct {
+	Key    string   `json:"key"`
+	Op     string   `json:"op"`
+	Values []string `json:"values,omitempty"`
+}
+
+// PlacementRule is a placement rule of PD, only the fields managed by the operator are kept
+type PlacementRule struct {
+	GroupID          string            `json:"group_id"`
+	ID               string            `json:"id"`
+	Index            int               `json:"index,omitempty"`
+	Override         bool              `json:"override,omitempty"`
+	StartKeyHex      string            `json:"start_key"`
+	EndKeyHex        string            `json:"end_key"`
+	Role             string            `json:"role"`
+	Count            int               `json:"count"`
+	LabelConstraints []LabelConstraint `json:"label_constraints,omitempty"`
+	LocationLabels   []string          `json:"location_labels,omitempty"`
+	IsolationLevel   string            `json:"isolation_level,omitempty"`
+}
+
+// GroupBundle is a rule group and all the rules in the group
+type GroupBundle struct {
+	ID       string           `json:"group_id"`
+	Index    int              `json:"group_index"`
+	Override bool             `json:"group_override"`
+	Rules    []*PlacementRule `json:"rules"`
+}
+
 type RecoveringMark struct {
 	Mark bool `json:"marked"`
 }
@@ -785,6 +826,24 @@ func (c *pdClient) TransferPDLeader(memberName string) error {
 	return fmt.Errorf("failed %v to transfer pd leader to %s,error: %v", res.StatusCode, memberName, err2)
 }
 
+func (c *pdClient) SetMemberLeaderPriority(name string, priority int) error {
+	apiURL := fmt.Sprintf("%s/%s/name/%s", c.url, membersPrefix, name)
+	data, err := json.Marshal(map[string]int{"leader-priority": priority})
+	if err != nil {
+		return err
+	}
+	res, err := c.httpClient.Post(apiURL, "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer httputil.DeferClose(res.Body)
+	if res.StatusCode == http.StatusOK {
+		return nil
+	}
+	err2 := httputil.ReadErrorBody(res.Body)
+	return fmt.Errorf("failed %v to set leader priority of member %s: %v", res.StatusCode, name, err2)
+}
+
 func (c *pdClient) GetAutoscalingPlans(strategy Strategy) ([]Plan, error) {
 	apiURL := fmt.Sprintf("%s/%s", c.url, autoscalingPrefix)
 	data, err := json.Marshal(strategy)
@@ -828,6 +887,71 @@ func (c *pdClient) GetReady() (bool, error) {
 	return true, nil
 }
 
+func (c *pdClient) GetPlacementRuleBundle(groupID string) (*GroupBundle, error) {
+	apiURL := fmt.Sprintf("%s/%s/%s", c.url, placementRulePrefix, groupID)
+	res, err := c.httpClient.Get(apiURL)
+	if err != nil {
+		return nil, err
+	}
+	defer httputil.DeferClose(res.Body)
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		err2 := httputil.ReadErrorBody(res.Body)
+		return nil, fmt.Errorf("failed %v to get placement rule bundle %s: %v", res.StatusCode, groupID, err2)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	bundle := &GroupBundle{}
+	if err := json.Unmarshal(body, bundle); err != nil {
+		return nil, err
+	}
+	// PD returns an empty bundle if the group does not exist
+	if len(bundle.Rules) == 0 && bundle.Index == 0 && !bundle.Override {
+		return nil, nil
+	}
+	return bundle, nil
+}
+
+func (c *pdClient) SetPlacementRuleBundle(bundle *GroupBundle) error {
+	apiURL := fmt.Sprintf("%s/%s/%s", c.url, placementRulePrefix, bundle.ID)
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		return err
+	}
+	res, err := c.httpClient.Post(apiURL, "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer httputil.DeferClose(res.Body)
+	if res.StatusCode == http.StatusOK {
+		return nil
+	}
+	err2 := httputil.ReadErrorBody(res.Body)
+	return fmt.Errorf("failed %v to set placement rule bundle %s: %v", res.StatusCode, bundle.ID, err2)
+}
+
+func (c *pdClient) DeletePlacementRuleBundle(groupID string) error {
+	apiURL := fmt.Sprintf("%s/%s/%s", c.url, placementRulePrefix, groupID)
+	req, err := http.NewRequest("DELETE", apiURL, nil)
+	if err != nil {
+		return err
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer httputil.DeferClose(res.Body)
+	if res.StatusCode == http.StatusOK || res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	err2 := httputil.ReadErrorBody(res.Body)
+	return fmt.Errorf("failed %v to delete placement rule bundle %s: %v", res.StatusCode, groupID, err2)
+}
+
 func getLeaderEvictSchedulerInfo(storeID uint64) *schedulerInfo {
 	return &schedulerInfo{"evict-leader-scheduler", storeID}
 }
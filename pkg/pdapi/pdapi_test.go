@@ -353,6 +353,111 @@ func TestSetStoreLabels(t *testing.T) {
 	}
 }
 
+func TestSetMemberLeaderPriority(t *testing.T) {
+	g := NewGomegaWithT(t)
+	name := "pd-0"
+	for _, success := range []bool{true, false} {
+		svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+			g.Expect(request.Method).To(Equal("POST"), "check method")
+			g.Expect(request.URL.Path).To(Equal(fmt.Sprintf("/%s/name/%s", membersPrefix, name)), "check url")
+
+			body := map[string]int{}
+			err := readJSON(request.Body, &body)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(body).To(Equal(map[string]int{"leader-priority": 5}), "check priority")
+
+			w.Header().Set("Content-Type", ContentTypeJSON)
+			if success {
+				w.WriteHeader(http.StatusOK)
+			} else {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		})
+		defer svc.Close()
+
+		pdClient := NewPDClient(svc.URL, DefaultTimeout, &tls.Config{})
+		err := pdClient.SetMemberLeaderPriority(name, 5)
+		if success {
+			g.Expect(err).NotTo(HaveOccurred())
+		} else {
+			g.Expect(err).To(HaveOccurred())
+		}
+	}
+}
+
+func TestPlacementRuleBundle(t *testing.T) {
+	g := NewGomegaWithT(t)
+	bundle := &GroupBundle{
+		ID:    "tidb-operator",
+		Index: 10,
+		Rules: []*PlacementRule{{
+			GroupID: "tidb-operator",
+			ID:      "hot",
+			Role:    "voter",
+			Count:   3,
+			LabelConstraints: []LabelConstraint{
+				{Key: "disk", Op: "in", Values: []string{"nvme"}},
+			},
+		}},
+	}
+	bundleBytes, err := json.Marshal(bundle)
+	g.Expect(err).NotTo(HaveOccurred())
+	emptyBytes, err := json.Marshal(&GroupBundle{ID: "not-exist"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	tcs := []struct {
+		caseName string
+		groupID  string
+		resp     []byte
+		want     *GroupBundle
+	}{{
+		caseName: "exist",
+		groupID:  "tidb-operator",
+		resp:     bundleBytes,
+		want:     bundle,
+	}, {
+		caseName: "not_exist",
+		groupID:  "not-exist",
+		resp:     emptyBytes,
+		want:     nil,
+	}}
+
+	for _, tc := range tcs {
+		svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+			g.Expect(request.Method).To(Equal("GET"), "check method")
+			g.Expect(request.URL.Path).To(Equal(fmt.Sprintf("/%s/%s", placementRulePrefix, tc.groupID)), "check url")
+
+			w.Header().Set("Content-Type", ContentTypeJSON)
+			w.Write(tc.resp)
+		})
+		defer svc.Close()
+
+		pdClient := NewPDClient(svc.URL, DefaultTimeout, &tls.Config{})
+		result, err := pdClient.GetPlacementRuleBundle(tc.groupID)
+		g.Expect(err).NotTo(HaveOccurred(), tc.caseName)
+		g.Expect(result).To(Equal(tc.want), tc.caseName)
+	}
+
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		g.Expect(request.URL.Path).To(Equal(fmt.Sprintf("/%s/%s", placementRulePrefix, bundle.ID)), "check url")
+		switch request.Method {
+		case "POST":
+			got := &GroupBundle{}
+			g.Expect(readJSON(request.Body, got)).To(Succeed())
+			g.Expect(got).To(Equal(bundle))
+			w.WriteHeader(http.StatusOK)
+		case "DELETE":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	defer svc.Close()
+	pdClient := NewPDClient(svc.URL, DefaultTimeout, &tls.Config{})
+	g.Expect(pdClient.SetPlacementRuleBundle(bundle)).To(Succeed())
+	g.Expect(pdClient.DeletePlacementRuleBundle(bundle.ID)).To(Succeed())
+}
+
 func TestDeleteMember(t *testing.T) {
 	g := NewGomegaWithT(t)
 	name := "testMember"
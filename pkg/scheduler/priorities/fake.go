@@ -0,0 +1,42 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorities
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+type FakePriority struct {
+	FakeName   string
+	FakeWeight int64
+	Scores     map[string]int64
+	Err        error
+}
+
+var _ Priority = &FakePriority{}
+
+func (f *FakePriority) Name() string {
+	return f.FakeName
+}
+
+func (f *FakePriority) Weight() int64 {
+	return f.FakeWeight
+}
+
+func (f *FakePriority) Score(_ string, _ *v1.Pod, _ []v1.Node) (map[string]int64, error) {
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	return f.Scores, nil
+}
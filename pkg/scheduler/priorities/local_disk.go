@@ -0,0 +1,137 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+type localDisk struct {
+	kubeCli  kubernetes.Interface
+	pvcGetFn func(ns, pvcName string) (*apiv1.PersistentVolumeClaim, error)
+	pvListFn func() (*apiv1.PersistentVolumeList, error)
+}
+
+// NewLocalDisk returns a Priority which prefers the nodes with more available local disk capacity
+func NewLocalDisk(kubeCli kubernetes.Interface) Priority {
+	p := &localDisk{
+		kubeCli: kubeCli,
+	}
+	p.pvcGetFn = p.realPVCGetFn
+	p.pvListFn = p.realPVListFn
+	return p
+}
+
+func (p *localDisk) Name() string {
+	return "LocalDisk"
+}
+
+func (p *localDisk) Weight() int64 {
+	return 1
+}
+
+// Score sums up the capacity of the available local persistent volumes on each node which can hold
+// the data volume of the pod, the nodes with more capacity get higher scores. It is a no-op if the
+// data volume of the pod is bound or does not use local persistent volumes.
+func (p *localDisk) Score(_ string, pod *apiv1.Pod, nodes []apiv1.Node) (map[string]int64, error) {
+	ns := pod.GetNamespace()
+	component := pod.Labels[label.ComponentLabelKey]
+	pvcName := fmt.Sprintf("%s-%s", component, pod.GetName())
+
+	pvc, err := p.pvcGetFn(ns, pvcName)
+	if err != nil {
+		return nil, err
+	}
+	if pvc.Status.Phase == apiv1.ClaimBound || pvc.Spec.StorageClassName == nil {
+		return nil, nil
+	}
+	request := pvc.Spec.Resources.Requests[apiv1.ResourceStorage]
+
+	pvList, err := p.pvListFn()
+	if err != nil {
+		return nil, err
+	}
+	capacities := map[string]int64{}
+	for _, node := range nodes {
+		capacities[node.Name] = 0
+	}
+	found := false
+	for i := range pvList.Items {
+		pv := &pvList.Items[i]
+		if pv.Spec.Local == nil || pv.Status.Phase != apiv1.VolumeAvailable || pv.Spec.StorageClassName != *pvc.Spec.StorageClassName {
+			continue
+		}
+		capacity := pv.Spec.Capacity[apiv1.ResourceStorage]
+		if capacity.Cmp(request) < 0 {
+			continue
+		}
+		for _, node := range nodes {
+			if pvMatchesNode(pv, &node) {
+				// count in GiB to avoid overflow when the values are normalized
+				capacities[node.Name] += capacity.Value() >> 30
+				found = true
+			}
+		}
+	}
+	if !found {
+		klog.V(4).Infof("local disk: no available local volume of storage class %s for pod %s/%s", *pvc.Spec.StorageClassName, ns, pod.GetName())
+		return nil, nil
+	}
+	return normalize(capacities), nil
+}
+
+// pvMatchesNode returns whether the node affinity of a persistent volume matches the node,
+// only the `In` operator is supported which is used by the local volume provisioners
+func pvMatchesNode(pv *apiv1.PersistentVolume, node *apiv1.Node) bool {
+	if pv.Spec.NodeAffinity == nil || pv.Spec.NodeAffinity.Required == nil {
+		return false
+	}
+	for _, term := range pv.Spec.NodeAffinity.Required.NodeSelectorTerms {
+		matched := len(term.MatchExpressions) > 0
+		for _, expr := range term.MatchExpressions {
+			if expr.Operator != apiv1.NodeSelectorOpIn || !containsString(expr.Values, node.Labels[expr.Key]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *localDisk) realPVCGetFn(ns, pvcName string) (*apiv1.PersistentVolumeClaim, error) {
+	return p.kubeCli.CoreV1().PersistentVolumeClaims(ns).Get(context.TODO(), pvcName, metav1.GetOptions{})
+}
+
+func (p *localDisk) realPVListFn() (*apiv1.PersistentVolumeList, error) {
+	return p.kubeCli.CoreV1().PersistentVolumes().List(context.TODO(), metav1.ListOptions{})
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorities
+
+import (
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	apiv1 "k8s.io/api/core/v1"
+	schedulerapi "k8s.io/kube-scheduler/extender/v1"
+)
+
+const defaultTopologyKey = "kubernetes.io/hostname"
+
+// Priority is an interface as extender-implemented priority functions
+type Priority interface {
+	// Name return the priority name
+	Name() string
+
+	// Weight returns the weight of the priority when the scores of all priorities are combined
+	Weight() int64
+
+	// Score returns the scores of the given nodes keyed by the node name, the scores are in the range
+	// of [0, MaxExtenderPriority]. Nodes which are not in the result are scored 0.
+	Score(string, *apiv1.Pod, []apiv1.Node) (map[string]int64, error)
+}
+
+// getTopologyKey returns the topology key used to spread the pods of the tidbcluster
+func getTopologyKey(tc *v1alpha1.TidbCluster) string {
+	if key := tc.Annotations[label.AnnHATopologyKey]; key != "" {
+		return key
+	}
+	return defaultTopologyKey
+}
+
+// scoreByTopology assigns the score of each topology to the nodes in it
+func scoreByTopology(topologyKey string, nodes []apiv1.Node, topologyScores map[string]int64) map[string]int64 {
+	scores := map[string]int64{}
+	for _, node := range nodes {
+		topology, ok := node.Labels[topologyKey]
+		if !ok {
+			continue
+		}
+		if score, ok := topologyScores[topology]; ok {
+			scores[node.Name] = score
+		}
+	}
+	return scores
+}
+
+// reverseNormalize maps the values to scores in [0, MaxExtenderPriority], the least value gets the
+// highest score. All values get the highest score if they are the same.
+func reverseNormalize(values map[string]int64) map[string]int64 {
+	scores := normalize(values)
+	for k, score := range scores {
+		scores[k] = schedulerapi.MaxExtenderPriority - score
+	}
+	return scores
+}
+
+// normalize maps the values to scores in [0, MaxExtenderPriority], the greatest value gets the
+// highest score. All values get 0 if they are the same.
+func normalize(values map[string]int64) map[string]int64 {
+	scores := make(map[string]int64, len(values))
+	var min, max int64
+	first := true
+	for _, v := range values {
+		if first || v < min {
+			min = v
+		}
+		if first || v > max {
+			max = v
+		}
+		first = false
+	}
+	for k, v := range values {
+		if max == min {
+			scores[k] = 0
+			continue
+		}
+		scores[k] = (v - min) * schedulerapi.MaxExtenderPriority / (max - min)
+	}
+	return scores
+}
@@ -0,0 +1,257 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorities
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+const zoneKey = "topology.kubernetes.io/zone"
+
+func newTC() *v1alpha1.TidbCluster {
+	return &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "demo",
+			Namespace:   metav1.NamespaceDefault,
+			Annotations: map[string]string{label.AnnHATopologyKey: zoneKey},
+		},
+	}
+}
+
+func newNode(name, zone string) apiv1.Node {
+	return apiv1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{zoneKey: zone},
+		},
+	}
+}
+
+func newPod(name, component, nodeName string) apiv1.Pod {
+	return apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+			Labels: map[string]string{
+				label.InstanceLabelKey:  "demo",
+				label.ComponentLabelKey: component,
+			},
+		},
+		Spec: apiv1.PodSpec{NodeName: nodeName},
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(normalize(map[string]int64{"a": 1, "b": 3, "c": 5})).To(Equal(map[string]int64{"a": 0, "b": 5, "c": 10}))
+	g.Expect(normalize(map[string]int64{"a": 2, "b": 2})).To(Equal(map[string]int64{"a": 0, "b": 0}))
+	g.Expect(reverseNormalize(map[string]int64{"a": 1, "b": 3, "c": 5})).To(Equal(map[string]int64{"a": 10, "b": 5, "c": 0}))
+	g.Expect(reverseNormalize(map[string]int64{"a": 2, "b": 2})).To(Equal(map[string]int64{"a": 10, "b": 10}))
+	g.Expect(normalize(nil)).To(BeEmpty())
+}
+
+func TestTopologySpreadScore(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	nodes := []apiv1.Node{
+		newNode("node-1", "zone-a"),
+		newNode("node-2", "zone-a"),
+		newNode("node-3", "zone-b"),
+		newNode("node-4", "zone-c"),
+	}
+	p := &topologySpread{
+		tcGetFn: func(ns, tcName string) (*v1alpha1.TidbCluster, error) {
+			return newTC(), nil
+		},
+		podListFn: func(ns, instanceName, component string) (*apiv1.PodList, error) {
+			return &apiv1.PodList{Items: []apiv1.Pod{
+				newPod("demo-pd-0", label.PDLabelVal, "node-1"),
+				newPod("demo-pd-1", label.PDLabelVal, "node-2"),
+				newPod("demo-pd-2", label.PDLabelVal, "node-3"),
+				// the pod being scheduled is not counted
+				newPod("demo-pd-3", label.PDLabelVal, "node-4"),
+				// the node is not a candidate
+				newPod("demo-pd-4", label.PDLabelVal, "node-5"),
+			}}, nil
+		},
+		nodeGetFn: func(nodeName string) (*apiv1.Node, error) {
+			node := newNode(nodeName, "zone-c")
+			return &node, nil
+		},
+	}
+	pod := newPod("demo-pd-3", label.PDLabelVal, "")
+
+	scores, err := p.Score("demo", &pod, nodes)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(scores).To(Equal(map[string]int64{
+		"node-1": 0,
+		"node-2": 0,
+		"node-3": 10,
+		"node-4": 10,
+	}))
+}
+
+func TestStoreBalanceScore(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	nodes := []apiv1.Node{
+		newNode("node-1", "zone-a"),
+		newNode("node-2", "zone-b"),
+		newNode("node-3", "zone-c"),
+	}
+	p := &storeBalance{
+		tcGetFn: func(ns, tcName string) (*v1alpha1.TidbCluster, error) {
+			return newTC(), nil
+		},
+		podListFn: func(ns, instanceName, component string) (*apiv1.PodList, error) {
+			return &apiv1.PodList{Items: []apiv1.Pod{
+				newPod("demo-tikv-0", label.TiKVLabelVal, "node-1"),
+				newPod("demo-tikv-1", label.TiKVLabelVal, "node-2"),
+				newPod("demo-tikv-2", label.TiKVLabelVal, "node-3"),
+			}}, nil
+		},
+		storeLoadsFn: func(tc *v1alpha1.TidbCluster) (map[string]storeLoad, error) {
+			return map[string]storeLoad{
+				"demo-tikv-0": {leaders: 100, regions: 300},
+				"demo-tikv-1": {leaders: 50, regions: 300},
+				"demo-tikv-2": {leaders: 0, regions: 100},
+			}, nil
+		},
+	}
+
+	pod := newPod("demo-tikv-3", label.TiKVLabelVal, "")
+	scores, err := p.Score("demo", &pod, nodes)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(scores).To(Equal(map[string]int64{
+		"node-1": 0,
+		"node-2": 2,
+		"node-3": 10,
+	}))
+
+	pod = newPod("demo-pd-0", label.PDLabelVal, "")
+	scores, err = p.Score("demo", &pod, nodes)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(scores).To(BeNil())
+}
+
+func TestLocalDiskScore(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	newPV := func(name, storageClass, size, hostname string, phase apiv1.PersistentVolumePhase) apiv1.PersistentVolume {
+		return apiv1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: apiv1.PersistentVolumeSpec{
+				StorageClassName: storageClass,
+				Capacity:         apiv1.ResourceList{apiv1.ResourceStorage: resource.MustParse(size)},
+				PersistentVolumeSource: apiv1.PersistentVolumeSource{
+					Local: &apiv1.LocalVolumeSource{Path: "/mnt/disks/" + name},
+				},
+				NodeAffinity: &apiv1.VolumeNodeAffinity{
+					Required: &apiv1.NodeSelector{
+						NodeSelectorTerms: []apiv1.NodeSelectorTerm{{
+							MatchExpressions: []apiv1.NodeSelectorRequirement{{
+								Key:      "kubernetes.io/hostname",
+								Operator: apiv1.NodeSelectorOpIn,
+								Values:   []string{hostname},
+							}},
+						}},
+					},
+				},
+			},
+			Status: apiv1.PersistentVolumeStatus{Phase: phase},
+		}
+	}
+	newHostNode := func(name string) apiv1.Node {
+		return apiv1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{"kubernetes.io/hostname": name},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		pvc      *apiv1.PersistentVolumeClaim
+		expected map[string]int64
+	}{
+		{
+			name: "pvc is bound",
+			pvc: &apiv1.PersistentVolumeClaim{
+				Spec:   apiv1.PersistentVolumeClaimSpec{StorageClassName: pointer.String("local-storage")},
+				Status: apiv1.PersistentVolumeClaimStatus{Phase: apiv1.ClaimBound},
+			},
+			expected: nil,
+		},
+		{
+			name: "no matched volume",
+			pvc: &apiv1.PersistentVolumeClaim{
+				Spec: apiv1.PersistentVolumeClaimSpec{StorageClassName: pointer.String("ssd-storage")},
+			},
+			expected: nil,
+		},
+		{
+			name: "prefer the node with more capacity",
+			pvc: &apiv1.PersistentVolumeClaim{
+				Spec: apiv1.PersistentVolumeClaimSpec{
+					StorageClassName: pointer.String("local-storage"),
+					Resources: apiv1.ResourceRequirements{
+						Requests: apiv1.ResourceList{apiv1.ResourceStorage: resource.MustParse("100Gi")},
+					},
+				},
+			},
+			expected: map[string]int64{
+				"node-1": 10,
+				"node-2": 5,
+				"node-3": 0,
+			},
+		},
+	}
+
+	pvs := &apiv1.PersistentVolumeList{Items: []apiv1.PersistentVolume{
+		newPV("pv-1", "local-storage", "200Gi", "node-1", apiv1.VolumeAvailable),
+		newPV("pv-2", "local-storage", "200Gi", "node-1", apiv1.VolumeAvailable),
+		newPV("pv-3", "local-storage", "200Gi", "node-2", apiv1.VolumeAvailable),
+		newPV("pv-4", "local-storage", "200Gi", "node-3", apiv1.VolumeBound),
+		newPV("pv-5", "local-storage", "50Gi", "node-3", apiv1.VolumeAvailable),
+	}}
+	nodes := []apiv1.Node{newHostNode("node-1"), newHostNode("node-2"), newHostNode("node-3")}
+	pod := newPod("demo-tikv-0", label.TiKVLabelVal, "")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &localDisk{
+				pvcGetFn: func(ns, pvcName string) (*apiv1.PersistentVolumeClaim, error) {
+					g.Expect(pvcName).To(Equal("tikv-demo-tikv-0"))
+					return tt.pvc, nil
+				},
+				pvListFn: func() (*apiv1.PersistentVolumeList, error) {
+					return pvs, nil
+				},
+			}
+			scores, err := p.Score("demo", &pod, nodes)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scores).To(Equal(tt.expected))
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorities
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+// storeLoad is the number of leaders and regions of a TiKV store
+type storeLoad struct {
+	leaders int64
+	regions int64
+}
+
+type storeBalance struct {
+	kubeCli   kubernetes.Interface
+	cli       versioned.Interface
+	pdControl pdapi.PDControlInterface
+	podListFn func(ns, instanceName, component string) (*apiv1.PodList, error)
+	tcGetFn   func(ns, tcName string) (*v1alpha1.TidbCluster, error)
+	nodeGetFn func(nodeName string) (*apiv1.Node, error)
+	// storeLoadsFn returns the loads of the stores keyed by the pod name
+	storeLoadsFn func(tc *v1alpha1.TidbCluster) (map[string]storeLoad, error)
+}
+
+// NewStoreBalance returns a Priority which prefers the topologies with the fewest TiKV leaders and regions
+func NewStoreBalance(kubeCli kubernetes.Interface, cli versioned.Interface) Priority {
+	p := &storeBalance{
+		kubeCli: kubeCli,
+		cli:     cli,
+		// the scheduler does not watch secrets, so the client is only used for the clusters without TLS
+		pdControl: pdapi.NewDefaultPDControl(nil),
+	}
+	p.podListFn = p.realPodListFn
+	p.tcGetFn = p.realTCGetFn
+	p.nodeGetFn = p.realNodeGetFn
+	p.storeLoadsFn = p.realStoreLoadsFn
+	return p
+}
+
+func (p *storeBalance) Name() string {
+	return "StoreBalance"
+}
+
+func (p *storeBalance) Weight() int64 {
+	return 1
+}
+
+// Score sums up the leaders and regions of the TiKV stores in each topology domain, the nodes in the
+// topologies with less load get higher scores. It only applies to TiKV.
+func (p *storeBalance) Score(instanceName string, pod *apiv1.Pod, nodes []apiv1.Node) (map[string]int64, error) {
+	ns := pod.GetNamespace()
+	component := pod.Labels[label.ComponentLabelKey]
+	if component != label.TiKVLabelVal {
+		return nil, nil
+	}
+
+	tc, err := p.tcGetFn(ns, instanceName)
+	if err != nil {
+		return nil, err
+	}
+	topologyKey := getTopologyKey(tc)
+	loads, err := p.storeLoadsFn(tc)
+	if err != nil {
+		return nil, err
+	}
+	podList, err := p.podListFn(ns, instanceName, component)
+	if err != nil {
+		return nil, err
+	}
+
+	leaders := map[string]int64{}
+	regions := map[string]int64{}
+	nodeTopologies := map[string]string{}
+	for _, node := range nodes {
+		if topology, ok := node.Labels[topologyKey]; ok {
+			leaders[topology] = 0
+			regions[topology] = 0
+			nodeTopologies[node.Name] = topology
+		}
+	}
+	for _, other := range podList.Items {
+		nodeName := other.Spec.NodeName
+		// the store of the pod being scheduled moves with the pod
+		if other.Name == pod.Name || nodeName == "" {
+			continue
+		}
+		load, ok := loads[other.Name]
+		if !ok {
+			continue
+		}
+		topology, ok := nodeTopologies[nodeName]
+		if !ok {
+			node, err := p.nodeGetFn(nodeName)
+			if err != nil {
+				return nil, err
+			}
+			topology = node.Labels[topologyKey]
+			nodeTopologies[nodeName] = topology
+		}
+		if _, ok := leaders[topology]; ok {
+			leaders[topology] += load.leaders
+			regions[topology] += load.regions
+		}
+	}
+	klog.V(4).Infof("store balance: leaders %v, regions %v in topologies of %s/%s", leaders, regions, ns, instanceName)
+
+	leaderScores := reverseNormalize(leaders)
+	regionScores := reverseNormalize(regions)
+	topologyScores := make(map[string]int64, len(leaderScores))
+	for topology := range leaderScores {
+		topologyScores[topology] = (leaderScores[topology] + regionScores[topology]) / 2
+	}
+	return scoreByTopology(topologyKey, nodes, topologyScores), nil
+}
+
+// realStoreLoadsFn gets the loads of the stores from PD, the leader counts in the status of the
+// tidbcluster are used if PD can not be accessed by the scheduler
+func (p *storeBalance) realStoreLoadsFn(tc *v1alpha1.TidbCluster) (map[string]storeLoad, error) {
+	loads := map[string]storeLoad{}
+	if !tc.IsTLSClusterEnabled() && !tc.WithoutLocalPD() {
+		pdClient := p.pdControl.GetPDClient(pdapi.Namespace(tc.GetNamespace()), tc.GetName(), false)
+		storesInfo, err := pdClient.GetStores()
+		if err == nil {
+			for _, store := range storesInfo.Stores {
+				if store.Store == nil || store.Status == nil {
+					continue
+				}
+				ip := strings.Split(store.Store.GetAddress(), ":")[0]
+				podName := strings.Split(ip, ".")[0]
+				loads[podName] = storeLoad{
+					leaders: int64(store.Status.LeaderCount),
+					regions: int64(store.Status.RegionCount),
+				}
+			}
+			return loads, nil
+		}
+		klog.Warningf("store balance: failed to get stores of tidbcluster %s/%s from pd, use the status instead: %v", tc.GetNamespace(), tc.GetName(), err)
+	}
+
+	for _, store := range tc.Status.TiKV.Stores {
+		loads[store.PodName] = storeLoad{leaders: int64(store.LeaderCount)}
+	}
+	return loads, nil
+}
+
+func (p *storeBalance) realPodListFn(ns, instanceName, component string) (*apiv1.PodList, error) {
+	selector := label.New().Instance(instanceName).Component(component).Labels()
+	return p.kubeCli.CoreV1().Pods(ns).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(selector).String(),
+	})
+}
+
+func (p *storeBalance) realTCGetFn(ns, tcName string) (*v1alpha1.TidbCluster, error) {
+	return p.cli.PingcapV1alpha1().TidbClusters(ns).Get(context.TODO(), tcName, metav1.GetOptions{})
+}
+
+func (p *storeBalance) realNodeGetFn(nodeName string) (*apiv1.Node, error) {
+	return p.kubeCli.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorities
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+type topologySpread struct {
+	kubeCli   kubernetes.Interface
+	cli       versioned.Interface
+	podListFn func(ns, instanceName, component string) (*apiv1.PodList, error)
+	tcGetFn   func(ns, tcName string) (*v1alpha1.TidbCluster, error)
+	nodeGetFn func(nodeName string) (*apiv1.Node, error)
+}
+
+// NewTopologySpread returns a Priority which prefers the topologies with the fewest pods of the same component
+func NewTopologySpread(kubeCli kubernetes.Interface, cli versioned.Interface) Priority {
+	p := &topologySpread{
+		kubeCli: kubeCli,
+		cli:     cli,
+	}
+	p.podListFn = p.realPodListFn
+	p.tcGetFn = p.realTCGetFn
+	p.nodeGetFn = p.realNodeGetFn
+	return p
+}
+
+func (p *topologySpread) Name() string {
+	return "TopologySpread"
+}
+
+func (p *topologySpread) Weight() int64 {
+	return 2
+}
+
+// Score counts the pods of the same component in each topology domain, which is identified by the
+// node label of the HA topology key, the nodes in the topologies with fewer pods get higher scores.
+func (p *topologySpread) Score(instanceName string, pod *apiv1.Pod, nodes []apiv1.Node) (map[string]int64, error) {
+	ns := pod.GetNamespace()
+	component := pod.Labels[label.ComponentLabelKey]
+
+	tc, err := p.tcGetFn(ns, instanceName)
+	if err != nil {
+		return nil, err
+	}
+	topologyKey := getTopologyKey(tc)
+	podList, err := p.podListFn(ns, instanceName, component)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int64{}
+	for _, node := range nodes {
+		if topology, ok := node.Labels[topologyKey]; ok {
+			counts[topology] = 0
+		}
+	}
+	nodeTopologies := map[string]string{}
+	for _, node := range nodes {
+		nodeTopologies[node.Name] = node.Labels[topologyKey]
+	}
+	for _, other := range podList.Items {
+		nodeName := other.Spec.NodeName
+		if other.Name == pod.Name || nodeName == "" {
+			continue
+		}
+		topology, ok := nodeTopologies[nodeName]
+		if !ok {
+			node, err := p.nodeGetFn(nodeName)
+			if err != nil {
+				return nil, err
+			}
+			topology = node.Labels[topologyKey]
+			nodeTopologies[nodeName] = topology
+		}
+		if _, ok := counts[topology]; ok {
+			counts[topology]++
+		}
+	}
+	klog.V(4).Infof("topology spread: pods of component %s in topologies of %s/%s: %v", component, ns, instanceName, counts)
+
+	return scoreByTopology(topologyKey, nodes, reverseNormalize(counts)), nil
+}
+
+func (p *topologySpread) realPodListFn(ns, instanceName, component string) (*apiv1.PodList, error) {
+	selector := label.New().Instance(instanceName).Component(component).Labels()
+	return p.kubeCli.CoreV1().Pods(ns).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(selector).String(),
+	})
+}
+
+func (p *topologySpread) realTCGetFn(ns, tcName string) (*v1alpha1.TidbCluster, error) {
+	return p.cli.PingcapV1alpha1().TidbClusters(ns).Get(context.TODO(), tcName, metav1.GetOptions{})
+}
+
+func (p *topologySpread) realNodeGetFn(nodeName string) (*apiv1.Node, error) {
+	return p.kubeCli.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
 	"github.com/pingcap/tidb-operator/pkg/features"
 	"github.com/pingcap/tidb-operator/pkg/scheduler/predicates"
+	"github.com/pingcap/tidb-operator/pkg/scheduler/priorities"
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -52,6 +53,8 @@ type Scheduler interface {
 type scheduler struct {
 	// component => predicates
 	predicates map[string][]predicates.Predicate
+	// component => priorities
+	priorities map[string][]priorities.Priority
 
 	kubeCli  kubernetes.Interface
 	recorder record.EventRecorder
@@ -77,8 +80,19 @@ func NewScheduler(kubeCli kubernetes.Interface, cli versioned.Interface) Schedul
 			predicates.NewStableScheduling(kubeCli, cli),
 		}
 	}
+	prioritiesByComponent := map[string][]priorities.Priority{
+		label.PDLabelVal: {
+			priorities.NewTopologySpread(kubeCli, cli),
+		},
+		label.TiKVLabelVal: {
+			priorities.NewTopologySpread(kubeCli, cli),
+			priorities.NewStoreBalance(kubeCli, cli),
+			priorities.NewLocalDisk(kubeCli),
+		},
+	}
 	return &scheduler{
 		predicates: predicatesByComponent,
+		priorities: prioritiesByComponent,
 		kubeCli:    kubeCli,
 		recorder:   recorder,
 	}
@@ -250,17 +264,55 @@ func (ferr FailureError) Error() string {
 	return fmt.Sprintf("pod %s had an intentional failure injected", ferr.PodName)
 }
 
-// We don't pass `prioritizeVerb` to kubernetes scheduler extender's config file, this method will not be called.
+// Priority scores the nodes for pd and tikv pods by the weighted average of the scores of the priorities,
+// the nodes are scored 0 for other pods. A priority which fails is skipped rather than failing the scheduling.
 func (s *scheduler) Priority(args *schedulerapi.ExtenderArgs) (schedulerapi.HostPriorityList, error) {
 	result := schedulerapi.HostPriorityList{}
+	if args.Nodes == nil {
+		return result, nil
+	}
+	kubeNodes := args.Nodes.Items
 
-	if args.Nodes != nil {
-		for _, node := range args.Nodes.Items {
-			result = append(result, schedulerapi.HostPriority{
-				Host:  node.Name,
-				Score: 0,
-			})
+	pod := args.Pod
+	var ns, podName, instanceName string
+	var prioritiesByComponent []priorities.Priority
+	if pod != nil {
+		ns = pod.GetNamespace()
+		podName = pod.GetName()
+		instanceName = pod.Labels[label.InstanceLabelKey]
+		prioritiesByComponent = s.priorities[pod.Labels[label.ComponentLabelKey]]
+	}
+
+	scores := map[string]int64{}
+	var totalWeight int64
+	if instanceName != "" {
+		for _, priority := range prioritiesByComponent {
+			priorityScores, err := priority.Score(instanceName, pod, kubeNodes)
+			if err != nil {
+				klog.Warningf("priority %s failed for pod %s/%s, skipped: %v", priority.Name(), ns, podName, err)
+				continue
+			}
+			klog.V(4).Infof("priority %s for pod %s/%s: %v", priority.Name(), ns, podName, priorityScores)
+			weight := priority.Weight()
+			for nodeName, score := range priorityScores {
+				scores[nodeName] += weight * score
+			}
+			totalWeight += weight
+		}
+	}
+
+	for _, node := range kubeNodes {
+		var score int64
+		if totalWeight > 0 {
+			score = scores[node.Name] / totalWeight
 		}
+		result = append(result, schedulerapi.HostPriority{
+			Host:  node.Name,
+			Score: score,
+		})
+	}
+	if totalWeight > 0 {
+		klog.Infof("prioritized nodes for pod %s/%s: %v", ns, podName, result)
 	}
 
 	return result, nil
@@ -23,6 +23,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/scheduler/predicates"
+	"github.com/pingcap/tidb-operator/pkg/scheduler/priorities"
 	apiv1 "k8s.io/api/core/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -187,15 +188,20 @@ func TestSchedulerFilter(t *testing.T) {
 func TestSchedulerPriority(t *testing.T) {
 	g := NewGomegaWithT(t)
 	type testcase struct {
-		name     string
-		args     *schedulerapi.ExtenderArgs
-		expectFn func(*GomegaWithT, schedulerapi.HostPriorityList, error)
+		name       string
+		args       *schedulerapi.ExtenderArgs
+		priorities []priorities.Priority
+		expectFn   func(*GomegaWithT, schedulerapi.HostPriorityList, error)
 	}
 
 	testFn := func(test *testcase, t *testing.T) {
 		t.Log(test.name)
 
-		s := scheduler{}
+		s := scheduler{
+			priorities: map[string][]priorities.Priority{
+				label.TiKVLabelVal: test.priorities,
+			},
+		}
 		re, err := s.Priority(test.args)
 		test.expectFn(g, re, err)
 	}
@@ -265,6 +271,66 @@ func TestSchedulerPriority(t *testing.T) {
 				g.Expect(result[1].Score).To(Equal(int64(0)))
 			},
 		},
+		{
+			name: "weighted average of the priorities",
+			args: &schedulerapi.ExtenderArgs{
+				Pod: &apiv1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "demo-tikv-0",
+						Namespace: corev1.NamespaceDefault,
+						Labels: map[string]string{
+							label.InstanceLabelKey:  "demo",
+							label.ComponentLabelKey: label.TiKVLabelVal,
+						},
+					},
+				},
+				Nodes: &apiv1.NodeList{
+					Items: []apiv1.Node{
+						{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+						{ObjectMeta: metav1.ObjectMeta{Name: "node-2"}},
+					},
+				},
+			},
+			priorities: []priorities.Priority{
+				&priorities.FakePriority{FakeName: "a", FakeWeight: 2, Scores: map[string]int64{"node-1": 10, "node-2": 4}},
+				&priorities.FakePriority{FakeName: "b", FakeWeight: 1, Scores: map[string]int64{"node-2": 10}},
+				&priorities.FakePriority{FakeName: "failed", FakeWeight: 1, Err: fmt.Errorf("failed")},
+			},
+			expectFn: func(g *GomegaWithT, result schedulerapi.HostPriorityList, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(result).To(Equal(schedulerapi.HostPriorityList{
+					{Host: "node-1", Score: 6},
+					{Host: "node-2", Score: 6},
+				}))
+			},
+		},
+		{
+			name: "no priority for the component",
+			args: &schedulerapi.ExtenderArgs{
+				Pod: &apiv1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "demo-tidb-0",
+						Namespace: corev1.NamespaceDefault,
+						Labels: map[string]string{
+							label.InstanceLabelKey:  "demo",
+							label.ComponentLabelKey: label.TiDBLabelVal,
+						},
+					},
+				},
+				Nodes: &apiv1.NodeList{
+					Items: []apiv1.Node{
+						{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+					},
+				},
+			},
+			priorities: []priorities.Priority{
+				&priorities.FakePriority{FakeName: "a", FakeWeight: 1, Scores: map[string]int64{"node-1": 10}},
+			},
+			expectFn: func(g *GomegaWithT, result schedulerapi.HostPriorityList, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(result).To(Equal(schedulerapi.HostPriorityList{{Host: "node-1", Score: 0}}))
+			},
+		},
 	}
 
 	for i := range tests {